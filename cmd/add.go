@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+	"github.com/cnative/servicebuilder/internal/templates"
+)
+
+var (
+	// addCmd groups the commands that extend an existing service
+	addCmd = &cobra.Command{
+		Use:   "add",
+		Short: "adds to an existing service",
+	}
+
+	// addResourceCmd represents the add resource command
+	addResourceCmd = &cobra.Command{
+		Use:   "resource",
+		Short: "adds a CRUD resource to an existing service",
+		Long: `Adds the messages and rpcs of the resource to the proto, extends state.Store and
+its implementation, adds a migration numbered after the highest existing one when
+the service has a postgres store and registers the service handler. Existing files
+are edited in place so local modifications are retained.
+
+It must be run inside a project generated by servicebuilder. For example:
+
+$ servicebuilder add resource --name Invoice
+or with fields
+$ servicebuilder add resource --name Invoice --field number:string:unique:required --field total:double`,
+		Run: addResource,
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(addCmd)
+	addCmd.AddCommand(addResourceCmd)
+
+	addResourceCmd.Flags().StringP("name", "n", "", "name of the resource")
+	addResourceCmd.Flags().StringP("dir", "", ".", "directory of the project")
+	addResourceCmd.Flags().StringArrayP("field", "", []string{}, `field of the resource of the form name:type[:modifier...]
+may be repeated. see 'servicebuilder new --help' for the types and modifiers`)
+	addResourceCmd.Flags().StringP("plural", "", "", "plural of the resource. it is inflected from the name by default, e.g. Category is Categories")
+}
+
+func addResourceOptions(c *cobra.Command) (string, string, *builder.Options, error) {
+
+	name, err := c.Flags().GetString("name")
+	if err != nil {
+		return "", "", nil, err
+	}
+	name = strings.Title(strings.TrimSpace(name))
+	if name == "" {
+		return "", "", nil, errors.New("resource name cannot be empty")
+	}
+	if err := builder.ValidateResourceName(name); err != nil {
+		return "", "", nil, err
+	}
+
+	dir, err := c.Flags().GetString("dir")
+	if err != nil {
+		return "", "", nil, err
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	m, err := builder.ReadManifest(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", "", nil, errors.Errorf("%s not found. run the command inside a project generated by servicebuilder", builder.ManifestFile)
+		}
+		return "", "", nil, err
+	}
+
+	o, err := manifestOptions(m, dir)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	fields, err := resourceFields(c, []string{name}, &builder.Spec{})
+	if err != nil {
+		return "", "", nil, err
+	}
+	if fs, ok := fields[name]; ok {
+		o.ResourceFields[name] = fs
+	}
+
+	plural, err := c.Flags().GetString("plural")
+	if err != nil {
+		return "", "", nil, err
+	}
+	if plural = strings.Title(strings.TrimSpace(plural)); plural != "" {
+		if err := builder.ValidatePlural(name, plural); err != nil {
+			return "", "", nil, err
+		}
+		if o.ResourcePlurals == nil {
+			o.ResourcePlurals = map[string]string{}
+		}
+		o.ResourcePlurals[name] = plural
+	}
+
+	if o.Features.Postgres {
+		added := *o
+		added.Resources = []string{name}
+		if err := builder.ValidateSQLNames(&added); err != nil {
+			return "", "", nil, err
+		}
+	}
+
+	return dir, name, o, nil
+}
+
+func addResource(c *cobra.Command, args []string) {
+
+	dir, name, o, err := addResourceOptions(c)
+	if err != nil {
+		log.WithError(err).Fatal("invalid args")
+		os.Exit(1)
+	}
+
+	templateProvider, err := templates.New(o)
+	if err != nil {
+		log.WithError(err).Fatal("error while creating template provider")
+		os.Exit(1)
+	}
+
+	sb, err := builder.New(templateProvider)
+	if err != nil {
+		log.WithError(err).Fatal("error while creating service builder")
+		os.Exit(1)
+	}
+
+	if err := sb.AddResource(dir, name, os.Stdout); err != nil {
+		log.WithError(err).Fatal("error while adding resource")
+		os.Exit(1)
+	}
+}
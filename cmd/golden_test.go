@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+	"github.com/cnative/servicebuilder/internal/diff"
+	"github.com/cnative/servicebuilder/internal/templates"
+)
+
+// run with -update to rewrite the golden files after an intended change of the templates
+//
+//	go test ./cmd -run Golden -update
+var update = flag.Bool("update", false, "rewrite the golden files with the current output")
+
+const goldenDir = "testdata/golden"
+
+// goldenCase is a combination of options the templates are rendered with
+type goldenCase struct {
+	name           string
+	template       string
+	deploymentType builder.DeploymentType
+	features       builder.Features
+	resources      []string
+	fields         map[string][]string
+	plurals        map[string]string
+}
+
+var goldenCases = []goldenCase{
+	{name: "grpc-gateway-k8s", template: "grpc-gateway", deploymentType: builder.K8SManifest, features: builder.DefaultFeatures()},
+	{name: "grpc-gateway-helm", template: "grpc-gateway", deploymentType: builder.HemlChart, features: builder.DefaultFeatures()},
+	{name: "grpc-gateway-without-db", template: "grpc-gateway", features: builder.Features{Gateway: true, OIDC: true}},
+	{name: "grpc-gateway-without-gateway", template: "grpc-gateway", features: builder.Features{Postgres: true, OIDC: true}},
+	{name: "grpc-gateway-without-oidc", template: "grpc-gateway", features: builder.Features{Postgres: true, Gateway: true}},
+	{name: "grpc-gateway-without-all", template: "grpc-gateway", deploymentType: builder.HemlChart},
+	{
+		name:      "grpc-gateway-fields",
+		template:  "grpc-gateway",
+		features:  builder.DefaultFeatures(),
+		resources: []string{"Contact", "OrderItem"},
+		fields: map[string][]string{
+			"Contact": {"name:string:required", "email:string:unique:required", "age:int32:nullable", "visits:int64:index",
+				"rating:float:nullable", "score:double", "active:bool:nullable", "born_at:timestamp", "seen_at:timestamp:nullable"},
+			"OrderItem": {"sku:string:unique", "quantity:int32"},
+		},
+	},
+	{
+		name:      "grpc-gateway-fields-without-db",
+		template:  "grpc-gateway",
+		features:  builder.Features{Gateway: true, OIDC: true},
+		resources: []string{"Contact", "OrderItem"},
+		fields: map[string][]string{
+			"Contact":   {"name:string:nullable", "email:string:required"},
+			"OrderItem": {"sku:string:required", "quantity:int32"},
+		},
+	},
+	{
+		name:      "grpc-gateway-plurals",
+		template:  "grpc-gateway",
+		features:  builder.DefaultFeatures(),
+		resources: []string{"Category", "Address", "Person"},
+		plurals:   map[string]string{"Person": "Persons"},
+	},
+	{name: "grpc-only", template: "grpc-only", features: builder.Features{Postgres: true, OIDC: true}},
+	{name: "http-only", template: "http-only", resources: []string{"Contact", "OrderItem"}},
+	{name: "worker", template: "worker"},
+	{name: "cli", template: "cli"},
+}
+
+// TestGolden renders every template combination, verifies the generated go sources and compares
+// the output with the golden files. TestAllCombinations covers the combinations without golden files
+func TestGolden(t *testing.T) {
+
+	for _, c := range goldenCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			o, err := c.options(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tp, err := templates.New(o)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sb, err := builder.New(tp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			files, err := sb.Render()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := builder.VerifySources(files); err != nil {
+				t.Fatal(err)
+			}
+
+			compareGolden(t, c.name, archive(files))
+		})
+	}
+}
+
+// TestAllCombinations renders every template with every combination of the features and the deployment
+// types and verifies the generated go sources. combinations with features a template does not support
+// must be rejected
+func TestAllCombinations(t *testing.T) {
+
+	for _, p := range templates.List() {
+		for i := 0; i < 1<<3; i++ {
+			f := builder.Features{Postgres: i&1 != 0, Gateway: i&2 != 0, OIDC: i&4 != 0}
+			for _, dt := range []builder.DeploymentType{builder.K8SManifest, builder.HemlChart} {
+				c := goldenCase{
+					name:           fmt.Sprintf("%s-%s-postgres=%t-gateway=%t-oidc=%t", p.Name, dt, f.Postgres, f.Gateway, f.OIDC),
+					template:       p.Name,
+					deploymentType: dt,
+					features:       f,
+					resources:      []string{"Contact", "OrderItem"},
+				}
+				supported := (!f.Postgres || p.Features.Postgres) && (!f.Gateway || p.Features.Gateway) && (!f.OIDC || p.Features.OIDC)
+				t.Run(c.name, func(t *testing.T) {
+					o, err := c.options(t.TempDir())
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					tp, err := templates.New(o)
+					if !supported {
+						if err == nil {
+							t.Fatal("features the template does not support are not rejected")
+						}
+						return
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					sb, err := builder.New(tp)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					files, err := sb.Render()
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if err := builder.VerifySources(files); err != nil {
+						t.Fatal(err)
+					}
+				})
+			}
+		}
+	}
+}
+
+func (c goldenCase) options(dir string) (*builder.Options, error) {
+
+	resources := c.resources
+	if len(resources) == 0 {
+		resources = []string{"Contact"}
+	}
+
+	fields := map[string][]*builder.Field{}
+	for r, decls := range c.fields {
+		for _, d := range decls {
+			f, err := builder.ParseField(d)
+			if err != nil {
+				return nil, err
+			}
+			fields[r] = append(fields[r], f)
+		}
+
+		var err error
+		if fields[r], err = builder.ValidateFields(fields[r]); err != nil {
+			return nil, fmt.Errorf("invalid fields of resource %s: %v", r, err)
+		}
+	}
+
+	return &builder.Options{
+		Template:              c.template,
+		Name:                  c.name,
+		ModuleName:            path.Join("github.com/kustomers", c.name),
+		ResourceName:          resources[0],
+		Resources:             resources,
+		ResourceFields:        fields,
+		ResourcePlurals:       c.plurals,
+		Features:              c.features,
+		ImageName:             path.Join("kustomers", c.name),
+		Description:           "golden " + c.name + " service",
+		DstDir:                dir,
+		DeploymentType:        c.deploymentType,
+		DomainName:            "localhost",
+		HTTPRoutePrefix:       "/api/v1",
+		ServiceBuilderVersion: "latest",
+		ProtocVersion:         "3.12.3",
+	}, nil
+}
+
+// compareGolden compares out with the golden file name. the golden file is rewritten with -update
+func compareGolden(t *testing.T, name string, out []byte) {
+	t.Helper()
+
+	p := filepath.Join(goldenDir, name+".golden")
+	if *update {
+		if err := os.MkdirAll(goldenDir, os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, out, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	golden, err := ioutil.ReadFile(p)
+	if err != nil {
+		t.Fatalf("missing golden file. run with -update to create it: %v", err)
+	}
+
+	if !bytes.Equal(golden, out) {
+		t.Errorf("output differs from %s. run with -update if the change is intended\n%s",
+			p, diff.Unified(p, name, string(golden), string(out), 3))
+	}
+}
+
+// archive concatenates the files. every file is preceded by a -- path -- line. go.sum is left out as it is
+// a copy of its template that would dominate the size of the golden files
+func archive(files []*builder.File) []byte {
+
+	var b bytes.Buffer
+	for _, f := range files {
+		if f.Path == "go.sum" {
+			continue
+		}
+		fmt.Fprintf(&b, "-- %s --\n", f.Path)
+		b.Write(f.Content)
+		if !bytes.HasSuffix(f.Content, []byte("\n")) {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.Bytes()
+}
@@ -4,21 +4,23 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"go/ast"
-	"go/format"
 	"go/parser"
 	"go/token"
-	"html/template"
-	"io"
+	"go/types"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/iancoleman/strcase"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/tools/imports"
 
+	"github.com/cnative/servicebuilder/internal/builder"
 	"github.com/cnative/servicebuilder/internal/iwrap"
 )
 
@@ -33,13 +35,21 @@ type (
 		templates      []string
 		ignoredMethods []string
 		customImports  []string
+		// contextFallback is how methods without a context.Context parameter are wrapped
+		contextFallback string
+		// version is the version comment at the top of the wrappers
+		version string
 	}
 
 	templateParams struct {
-		PackageName           string
-		InterfaceName         string
-		Methods               []*method
-		CustomImports         []string
+		PackageName   string
+		InterfaceName string
+		// InterfaceType is the interface as it is written in the package of the wrapper
+		InterfaceType string
+		Methods       []*method
+		CustomImports []string
+		// Imports are the custom imports and the packages of the types of the methods
+		Imports               []*iwrap.Import
 		ServiceBuilderVersion string
 		ReceiverSub           string
 	}
@@ -49,14 +59,51 @@ type (
 		Doc     []string
 		Params  []*arg
 		Returns []*arg
+		// Embedded is the interface of another package the method is promoted from, i.e. io.Closer
+		Embedded string
+		// PassThrough methods are delegated to the wrapped interface as they are. these are the ignored
+		// methods and, unless the context fallback is background, the ones without a context.Context parameter
+		PassThrough bool
+		// ContextParam is the name of the first context.Context parameter. it is a local that the template
+		// has to declare as context.Background() when the method has no such parameter
+		ContextParam string
+		// BackgroundContext is set when the method has no context.Context parameter and is wrapped
+		BackgroundContext bool
+		// Ignored methods are passed with --ignore. they are passed through by every template
+		Ignored bool
+		// LogLevel is the level successful calls are logged with, i.e. Debug. it is set with //iwrap:level
+		LogLevel string
+
+		contextIndex int
 	}
 
 	arg struct {
+		// Name is the identifier of the argument in the wrapper. see nameArgs
 		Name string
+		// Key is the name the parameter is declared with in the interface. it is the key the parameter
+		// is logged with, whatever its Name in the wrapper, and the name //iwrap:log and //iwrap:redact refer to
+		Key  string
 		Type string
+		// Log is set when the parameter is logged. these are the ones of //iwrap:log
+		Log bool
+		// Redact is set when the value of the parameter is hidden in logs. it is set with //iwrap:redact
+		Redact bool
 	}
 )
 
+// Wrapped are the methods that are not passed through
+func (p *templateParams) Wrapped() []*method {
+
+	w := []*method{}
+	for _, m := range p.Methods {
+		if !m.PassThrough {
+			w = append(w, m)
+		}
+	}
+
+	return w
+}
+
 func (a *arg) Suffix() string {
 	if strings.HasPrefix(a.Type, "...") {
 		return "..."
@@ -64,6 +111,13 @@ func (a *arg) Suffix() string {
 	return ""
 }
 
+const (
+	// passThroughFallback delegates methods without a context.Context parameter to the wrapped interface
+	passThroughFallback = "pass-through"
+	// backgroundFallback wraps methods without a context.Context parameter with context.Background()
+	backgroundFallback = "background"
+)
+
 var (
 	fns = template.FuncMap{
 		"last": func(x int, a interface{}) bool {
@@ -105,15 +159,17 @@ to quickly create a Cobra application.`,
 func init() {
 	rootCmd.AddCommand(iwrapCmd)
 
-	iwrapCmd.Flags().StringP("file", "f", "", "path to the file containing the interface")
+	iwrapCmd.Flags().StringP("file", "f", "", "path to a file of the package declaring the interface. embedded interfaces of any package are expanded")
 	iwrapCmd.Flags().StringP("interface-name", "i", "", "name of the interface to use")
 	iwrapCmd.Flags().StringP("package-name", "p", "", "package name to use")
 	iwrapCmd.Flags().StringP("template-path", "", "", "path to the template")
-	iwrapCmd.Flags().StringSliceP("templates", "t", []string{"tracing", "metrics"}, "name of the templates to use. If template-path is specified templates will be ignored. If both template-path and templates are not specified then 'metrics' & 'tracing' will be applied")
+	iwrapCmd.Flags().StringSliceP("templates", "t", []string{"tracing", "metrics"}, "name of the templates to use. Possible values [tracing, metrics, logging]. If template-path is specified templates will be ignored. If both template-path and templates are not specified then 'metrics' & 'tracing' will be applied")
 	iwrapCmd.Flags().BoolP("format", "z", true, "format output using gofmt")
 	iwrapCmd.Flags().StringP("output-dir", "o", "-", "path to the output file (use - for stdout)")
-	iwrapCmd.Flags().StringSliceP("ignore", "g", []string{}, "ignore the following methods (separate with commas)")
+	iwrapCmd.Flags().StringSliceP("ignore", "g", []string{}, "methods that are delegated to the wrapped interface without being wrapped (separate with commas)")
 	iwrapCmd.Flags().StringSliceP("imports", "m", []string{}, "custom imports (separate with commas)")
+	iwrapCmd.Flags().String("context-fallback", passThroughFallback, "how methods without a context.Context parameter are generated. Possible values [pass-through, background]")
+	addOutputFlag(iwrapCmd, "the generated wrappers and the warnings")
 }
 
 func contains(needle string, haystack []string) bool {
@@ -126,23 +182,6 @@ func contains(needle string, haystack []string) bool {
 	return false
 }
 
-func getType(n ast.Expr) string {
-	switch x := n.(type) {
-	case *ast.SelectorExpr:
-		return fmt.Sprintf("%s.%s", x.X, x.Sel)
-	case *ast.Ident:
-		return x.Name
-	case *ast.StarExpr:
-		return fmt.Sprintf("*%s", getType(x.X))
-	case *ast.ArrayType:
-		return fmt.Sprintf("[]%s", getType(x.Elt))
-	case *ast.Ellipsis:
-		return fmt.Sprintf("...%s", getType(x.Elt))
-	}
-
-	return fmt.Sprintf("unable to process type: %T", n)
-}
-
 func loadTemplates(templatePath string, knownTemplates []string) ([]*template.Template, error) {
 
 	templates := []*template.Template{}
@@ -243,105 +282,218 @@ func parseCommandArgs(c *cobra.Command) (*parameters, error) {
 		return nil, err
 	}
 
+	contextFallback, err := c.Flags().GetString("context-fallback")
+	if err != nil {
+		return nil, err
+	}
+	if contextFallback != passThroughFallback && contextFallback != backgroundFallback {
+		return nil, fmt.Errorf("invalid context fallback %q. possible values [pass-through, background]", contextFallback)
+	}
+
 	return &parameters{
-		file:           file,
-		interfaceName:  interfaceName,
-		packageName:    packageName,
-		templatePath:   templatePath,
-		templates:      templates,
-		formatCode:     formatCode,
-		outputDir:      outputDir,
-		ignoredMethods: ignoredMethods,
-		customImports:  customImports,
+		file:            file,
+		interfaceName:   interfaceName,
+		packageName:     packageName,
+		templatePath:    templatePath,
+		templates:       templates,
+		formatCode:      formatCode,
+		outputDir:       outputDir,
+		ignoredMethods:  ignoredMethods,
+		customImports:   customImports,
+		contextFallback: contextFallback,
+		version:         versionString(),
 	}, nil
 }
 
-func getAllInterfaceMethods(node ast.Node, interfaceName string) []*ast.Field {
-	methods := []*ast.Field{}
-	ast.Inspect(node, func(n ast.Node) bool {
-		switch x := n.(type) {
-		case *ast.TypeSpec:
-			if x.Name.Name != interfaceName {
-				break
+func hasMethod(iface *iwrap.Interface, name string) bool {
+	for _, m := range iface.Methods {
+		if m.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func asTemplateMethodsParam(iface *iwrap.Interface, params *parameters, im *iwrap.Imports) []*method {
+
+	m := []*method{}
+	for _, met := range iface.Methods {
+
+		sig := met.Type().(*types.Signature)
+		contextIndex := -1
+		args := []*arg{}
+		for i := 0; i < sig.Params().Len(); i++ {
+			par := sig.Params().At(i)
+			if contextIndex < 0 && iwrap.IsContext(par.Type()) {
+				contextIndex = i
 			}
 
-			i, ok := x.Type.(*ast.InterfaceType)
-			if !ok {
-				break
+			typ := im.TypeString(par.Type())
+			if sig.Variadic() && i == sig.Params().Len()-1 {
+				typ = "..." + im.TypeString(par.Type().(*types.Slice).Elem())
 			}
+			args = append(args, &arg{Name: par.Name(), Key: par.Name(), Type: typ, Log: met.Logs(par), Redact: met.Redacts(par)})
+		}
 
-			for _, m := range i.Methods.List {
-				switch x := m.Type.(type) {
-				case *ast.Ident:
-					methods = append(methods, getAllInterfaceMethods(node, x.Name)...)
-				case *ast.FuncType:
-					methods = append(methods, m)
+		returns := []*arg{}
+		for i := 0; i < sig.Results().Len(); i++ {
+			ret := sig.Results().At(i)
+			returns = append(returns, &arg{Name: ret.Name(), Type: im.TypeString(ret.Type())})
+		}
+
+		ignored := contains(met.Name(), params.ignoredMethods)
+		passThrough := ignored || contextIndex < 0 && params.contextFallback == passThroughFallback
+		background := !passThrough && contextIndex < 0
+		if background {
+			im.Add("context")
+		}
+
+		m = append(m, &method{
+			Name:              met.Name(),
+			Params:            args,
+			Returns:           returns,
+			Doc:               met.Doc,
+			Embedded:          met.Embedded,
+			PassThrough:       passThrough,
+			BackgroundContext: background,
+			Ignored:           ignored,
+			LogLevel:          strings.Title(met.Level()),
+			contextIndex:      contextIndex,
+		})
+	}
+
+	return m
+}
+
+// nameArgs names the parameters and the results of the methods. unnamed ones are p0, p1.. and r0, r1... names
+// that are reserved, i.e. the receiver, the imported packages and the locals of the templates, are replaced as well
+func nameArgs(methods []*method, reserved []string) {
+
+	for _, m := range methods {
+		taken := map[string]bool{}
+		for _, r := range reserved {
+			taken[r] = true
+		}
+
+		for _, args := range []struct {
+			list   []*arg
+			prefix string
+		}{{m.Params, "p"}, {m.Returns, "r"}} {
+			for i, a := range args.list {
+				if a.Name == "" || a.Name == "_" || taken[a.Name] {
+					a.Name = fmt.Sprintf("%s%d", args.prefix, i)
+					for j := len(args.list); taken[a.Name]; j++ {
+						a.Name = fmt.Sprintf("%s%d", args.prefix, j)
+					}
 				}
+				taken[a.Name] = true
 			}
 		}
 
-		return true
-	})
-	return methods
+		switch {
+		case m.contextIndex >= 0:
+			m.ContextParam = m.Params[m.contextIndex].Name
+		case m.BackgroundContext:
+			m.ContextParam = "ctx"
+			for i := 1; taken[m.ContextParam]; i++ {
+				m.ContextParam = fmt.Sprintf("ctx%d", i)
+			}
+		}
+	}
 }
 
-func getInterfaceMethodsInFile(file, interfaceName string) ([]*ast.Field, error) {
+// renderWrapper executes the template t for the interface. the imports of the template itself are known once it is
+// executed. it is executed again with these imports reserved, so that the packages of the interface are not imported
+// twice or under the name of another package
+func renderWrapper(t *template.Template, params *parameters, iface *iwrap.Interface) ([]byte, error) {
 
-	fs := token.NewFileSet()
-	f, err := parser.ParseFile(fs, file, nil, parser.ParseComments)
+	b, im, err := executeWrapper(t, params, iface, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return getAllInterfaceMethods(f, interfaceName), nil
+	own := templateImports(b, im)
+	if len(own) == 0 {
+		return b, nil
+	}
+
+	b, _, err = executeWrapper(t, params, iface, own)
+	return b, err
 }
 
-func asTemplateMethodsParam(methods []*ast.Field, ignoredMethods []string) []*method {
+func executeWrapper(t *template.Template, params *parameters, iface *iwrap.Interface, reserved map[string]string) ([]byte, *iwrap.Imports, error) {
 
-	m := []*method{}
-	for _, met := range methods {
+	// the types of the package of the interface are not qualified in a wrapper of the same package
+	var pkg *types.Package
+	if params.packageName == iface.Package.Name() {
+		pkg = iface.Package
+	}
 
-		if t, ok := met.Type.(*ast.FuncType); ok {
-			name := met.Names[0].Name
-			if contains(name, ignoredMethods) {
-				continue
-			}
+	// the custom imports come first and keep their names
+	im := iwrap.NewImports(pkg, reserved)
+	for _, i := range params.customImports {
+		im.Add(i)
+	}
 
-			counter := 0
-			params := []*arg{}
-			for _, par := range t.Params.List {
-				name := fmt.Sprintf("p%d", counter)
-				if len(par.Names) > 0 {
-					name = par.Names[0].Name
-				}
+	methods := asTemplateMethodsParam(iface, params, im)
+	recv := strings.ToLower(string([]rune(params.interfaceName)[0:1]))
+	nameArgs(methods, append(append(im.Names(), recv), iwrap.Locals...))
 
-				params = append(params, &arg{Name: name, Type: getType(par.Type)})
-				counter++
-			}
+	vm := &templateParams{
+		InterfaceName:         params.interfaceName,
+		InterfaceType:         im.TypeString(iface.Type),
+		PackageName:           params.packageName,
+		Methods:               methods,
+		CustomImports:         params.customImports,
+		Imports:               im.List(),
+		ServiceBuilderVersion: params.version,
+		ReceiverSub:           recv,
+	}
 
-			counter = 0
-			returns := []*arg{}
-			for _, ret := range t.Results.List {
-				name := fmt.Sprintf("r%d", counter)
-				if len(ret.Names) > 0 {
-					name = ret.Names[0].Name
-				}
+	var sink bytes.Buffer
+	if err := t.Execute(&sink, vm); err != nil {
+		return nil, nil, err
+	}
 
-				returns = append(returns, &arg{Name: name, Type: getType(ret.Type)})
-				counter++
-			}
+	return sink.Bytes(), im, nil
+}
 
-			doclines := []string{}
-			if met.Doc != nil && len(met.Doc.List) > 0 {
-				for _, line := range met.Doc.List {
-					doclines = append(doclines, line.Text)
-				}
-			}
-			m = append(m, &method{Name: name, Params: params, Returns: returns, Doc: doclines})
+// templateImports returns the packages the generated source b imports by their name, except for the ones of im
+func templateImports(b []byte, im *iwrap.Imports) map[string]string {
+
+	f, err := parser.ParseFile(token.NewFileSet(), "", b, parser.ImportsOnly)
+	if err != nil {
+		return nil
+	}
+
+	// the first import of a collected package is the one of im
+	collected := map[string]bool{}
+	for _, i := range im.List() {
+		collected[i.Path] = true
+	}
+
+	own := map[string]string{}
+	for _, s := range f.Imports {
+		p, err := strconv.Unquote(s.Path.Value)
+		if err != nil {
+			continue
+		}
+		if collected[p] {
+			collected[p] = false
+			continue
+		}
+
+		name := iwrap.ImportName(p)
+		if s.Name != nil {
+			name = s.Name.Name
+		}
+		if name != "_" && name != "." {
+			own[name] = p
 		}
 	}
 
-	return m
+	return own
 }
 
 func execute(c *cobra.Command, args []string) error {
@@ -351,53 +503,96 @@ func execute(c *cobra.Command, args []string) error {
 		return err
 	}
 
-	methods, err := getInterfaceMethodsInFile(params.file, params.interfaceName)
+	iface, err := iwrap.LoadInterface(params.file, params.interfaceName)
 	if err != nil {
 		return err
 	}
 
+	for _, n := range params.ignoredMethods {
+		if !hasMethod(iface, n) {
+			log.WithFields(log.Fields{"method": n, "interface": iface.Name}).Warn("ignored method is not a method of the interface")
+		}
+	}
+
 	tmplts, err := loadTemplates(params.templatePath, params.templates)
 	if err != nil {
 		return err
 	}
 
-	vm := &templateParams{
-		InterfaceName:         params.interfaceName,
-		PackageName:           params.packageName,
-		Methods:               asTemplateMethodsParam(methods, params.ignoredMethods),
-		CustomImports:         params.customImports,
-		ServiceBuilderVersion: versionString(),
-		ReceiverSub:           strings.ToLower(string([]rune(params.interfaceName)[0:1])),
+	// the wrappers are created with a logger
+	if !contains(iwrap.LoggerImport, params.customImports) {
+		params.customImports = append(params.customImports, iwrap.LoggerImport)
 	}
 
 	for _, t := range tmplts {
-		var sink bytes.Buffer
-		err = t.Execute(&sink, vm)
+		b, err := generateWrapper(t, params, iface)
 		if err != nil {
 			return err
 		}
 
-		b := sink.Bytes()
-		if params.formatCode {
-			b, err = format.Source(b)
-			if err != nil {
+		if params.outputDir == "-" && jsonMode() {
+			out.Files = append(out.Files, wrapperResult(fmt.Sprintf("%s_with_%s.go", strcase.ToSnake(params.interfaceName), t.Name()), b, "", true))
+			continue
+		}
+
+		if params.outputDir == "-" {
+			if _, err := os.Stdout.Write(b); err != nil {
 				return err
 			}
+			continue
 		}
 
-		var out io.Writer = os.Stdout
-		if params.outputDir != "-" {
-			fn := fmt.Sprintf("%s%c%s_with_%s.go", params.outputDir, filepath.Separator, strcase.ToSnake(params.interfaceName), t.Name())
-			f, err := os.OpenFile(fn, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-			if err != nil {
-				return err
+		fn := fmt.Sprintf("%s%c%s_with_%s.go", params.outputDir, filepath.Separator, strcase.ToSnake(params.interfaceName), t.Name())
+		status := "added"
+		if existing, err := ioutil.ReadFile(fn); err == nil {
+			status = "updated"
+			if bytes.Equal(existing, b) {
+				status = "unchanged"
 			}
-			defer f.Close()
-			out = f
 		}
+		if err := builder.WriteFile(fn, b, 0644); err != nil {
+			return err
+		}
+		if jsonMode() {
+			out.Files = append(out.Files, wrapperResult(fn, b, status, false))
+		}
+	}
+
+	if jsonMode() {
+		if params.outputDir != "-" {
+			out.Dir = params.outputDir
+		}
+		return out.print(os.Stdout)
+	}
+
+	return nil
+}
+
+// generateWrapper renders the wrapper of the template t and formats it unless formatting is turned off
+func generateWrapper(t *template.Template, params *parameters, iface *iwrap.Interface) ([]byte, error) {
 
-		_, err = out.Write(b)
+	b, err := renderWrapper(t, params, iface)
+	if err != nil || !params.formatCode {
+		return b, err
+	}
+
+	// the imports of the template and the ones of the interface are grouped like goimports does
+	return imports.Process("", b, &imports.Options{FormatOnly: true, Comments: true, TabIndent: true, TabWidth: 8})
+}
+
+// wrapperResult describes the generated wrapper p for the result. the content is part of the result when it is not written
+func wrapperResult(p string, content []byte, status string, withContent bool) *builder.FileResult {
+
+	r := &builder.FileResult{
+		Path:   p,
+		SHA256: builder.Hash(content),
+		Size:   len(content),
+		Mode:   "0644",
+		Status: status,
+	}
+	if withContent {
+		r.Content = string(content)
 	}
 
-	return err
+	return r
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cnative/servicebuilder/internal/iwrap"
+)
+
+// TestIwrapGolden generates the wrappers of every known template for testdata/iwrap and compares them with
+// the golden files
+func TestIwrapGolden(t *testing.T) {
+
+	iface, err := iwrap.LoadInterface("testdata/iwrap/store.go", "Store")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		params parameters
+	}{
+		{
+			name: "same-package",
+			params: parameters{
+				packageName:     "store",
+				contextFallback: passThroughFallback,
+			},
+		},
+		{
+			name: "other-package",
+			params: parameters{
+				packageName:     "wrappers",
+				contextFallback: backgroundFallback,
+				ignoredMethods:  []string{"Delete"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		for _, name := range []string{"metrics", "tracing", "logging"} {
+			c, name := c, name
+			t.Run(c.name+"/"+name, func(t *testing.T) {
+				tmplts, err := loadTemplates("", []string{name})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				params := c.params
+				params.interfaceName = "Store"
+				params.formatCode = true
+				params.customImports = []string{iwrap.LoggerImport}
+				params.version = "// servicebuilder golden"
+
+				b, err := generateWrapper(tmplts[0], &params, iface)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				compareGolden(t, fmt.Sprintf("iwrap-%s-%s", c.name, name), b)
+			})
+		}
+	}
+}
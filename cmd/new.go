@@ -2,17 +2,29 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
-	"path"
+	"os/exec"
+	"path/filepath"
+	"regexp"
 	"strings"
 
+	"github.com/mattn/go-isatty"
 	"github.com/pkg/errors"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/cnative/servicebuilder/internal/builder"
-	"github.com/cnative/servicebuilder/internal/templates/grpcwithgw"
+	"github.com/cnative/servicebuilder/internal/templates"
+)
+
+// derivedRemote is the value of --git-remote without a URL
+const derivedRemote = "<derived>"
+
+var (
+	majorVersionRegEx = regexp.MustCompile(`^v[0-9]+$`)
 )
 
 // newCmd represents the new command
@@ -27,6 +39,25 @@ or
 $ servicebuilder new --module-name github.com/kustomers/contacts --path $GOPATH/src/github.com/kustomers/
 or
 $ servicebuilder new --module-name github.com/kustomers/contacts --image-name gcr.io/mycompany/customer_contants
+or from a project spec file
+$ servicebuilder new --config contacts.yaml
+or preview the generated files without writing them
+$ servicebuilder new --module-name github.com/kustomers/contacts --dry-run --show-content
+or with a different template
+$ servicebuilder new --module-name github.com/kustomers/reaper --template worker
+or a stateless service without the postgres store
+$ servicebuilder new --module-name github.com/kustomers/proxy --without-db
+or in a directory that mirrors the module name, i.e. $GOPATH/src/github.com/kustomers/contacts
+$ servicebuilder new --module-name github.com/kustomers/contacts --path $GOPATH/src --layout module
+or regenerate the files of an existing project that were not modified locally
+$ servicebuilder new --module-name github.com/kustomers/contacts --merge-into-existing
+or in the current directory, e.g. a cloned repository with a LICENSE and a README
+$ servicebuilder new --module-name github.com/kustomers/contacts --in-place --strategy prompt
+or as a git repository with the origin https://github.com/kustomers/contacts.git
+$ servicebuilder new --module-name github.com/kustomers/contacts --git-init --git-remote
+
+When --module-name is not specified and the terminal is interactive, the options
+are prompted for. Use --no-input to disable the prompts.
 
 This application is a tool to generate the needed files
 to quickly create a cloud native micro service.`,
@@ -36,51 +67,92 @@ to quickly create a cloud native micro service.`,
 func init() {
 	rootCmd.AddCommand(newCmd)
 
-	newCmd.Flags().StringP("module-name", "m", "", `module name of the service
+	addOptionFlags(newCmd)
+	newCmd.Flags().StringP("config", "c", "", "YAML or JSON file describing the project. flags override values in the file")
+	newCmd.Flags().StringP("path", "p", ".", "directory path where the project will be generated")
+	newCmd.Flags().StringP("layout", "", string(builder.FlatLayout), `directory of the project within --path. Possible values [module, flat]
+flat generates the project in <path>/<service name>
+module generates the project in <path>/<module name>`)
+	newCmd.Flags().BoolP("force", "", false, "overwrite the files of an existing project. nothing is written when any of them has local modifications")
+	newCmd.Flags().BoolP("merge-into-existing", "", false, "add missing files to an existing project and update the ones without local modifications. modified files are skipped")
+	newCmd.Flags().BoolP("in-place", "", false, "generate the project in --path itself, i.e. the current directory, such as a freshly cloned repository")
+	newCmd.Flags().StringP("strategy", "", "skip", `used with --in-place. how files that already exist and were not generated are treated
+Possible values [skip, overwrite, prompt]`)
+	newCmd.Flags().BoolP("git-init", "", false, "initialize a git repository in the project directory and commit the generated files")
+	newCmd.Flags().StringP("git-branch", "", "main", "used with --git-init. name of the initial branch")
+	newCmd.Flags().StringP("git-message", "", "", "used with --git-init. message of the initial commit (default \"Generate <name> with servicebuilder <version>\")")
+	newCmd.Flags().StringP("git-remote", "", "", `used with --git-init. URL of the origin remote
+without a value the URL is derived from the module name, i.e. https://<module name>.git`)
+	newCmd.Flags().Lookup("git-remote").NoOptDefVal = derivedRemote
+	newCmd.Flags().BoolP("no-input", "", false, "never prompt for missing options. use in scripts")
+	newCmd.Flags().BoolP("dry-run", "", false, "render all templates and print the file tree without writing anything")
+	newCmd.Flags().BoolP("show-content", "", false, "used with --dry-run. print the rendered content of every file")
+	newCmd.Flags().BoolP("diff", "", false, "render all templates and print the differences against an existing project. nothing is written")
+	newCmd.Flags().BoolP("verify", "", false, "check that the generated go sources parse and import the packages they use. nothing is written when the check fails")
+	addOutputFlag(newCmd, "the generated files, the options, the warnings and the next steps")
+}
+
+// addOptionFlags registers the flags that map on to builder.Options
+func addOptionFlags(c *cobra.Command) {
+	c.Flags().StringP("template", "t", templates.Default, "template to generate the service with. run 'servicebuilder templates list' for possible values")
+	c.Flags().StringP("template-dir", "", "", `directory or git repository of a template pack. takes precedence over --template
+a branch or tag of the repository is selected with a #<ref> suffix`)
+	c.Flags().StringP("module-name", "m", "", `module name of the service
 a typical value is of form <gitserver>/<gitorg>/<projectname>
 an example module name is mycompany.com/kustomer/accounts
 in this example 'accounts' is the service name`)
 
-	newCmd.Flags().StringP("description", "", "", "a short description of the service")
-	newCmd.Flags().StringP("image-name", "i", "", "container image name")
-	newCmd.Flags().StringP("protoc-version", "", "3.12.3", "protocol buffer version to use")
-	newCmd.Flags().StringP("http-route-prefix", "", "/api/v1", "http route prefix")
-	newCmd.Flags().StringP("deployment-type", "", "k8s", "deployment artifact to generate. Possible values [helm, k8s]")
-	newCmd.Flags().StringP("domain-name", "", "localhost", "domain name")
-	newCmd.Flags().StringP("resource", "r", "", "resource name")
-	newCmd.Flags().StringP("path", "p", ".", "directory path where the project will be generated")
+	c.Flags().StringP("description", "", "", "a short description of the service")
+	c.Flags().StringP("image-name", "i", "", "container image name")
+	c.Flags().StringP("protoc-version", "", "3.12.3", "protocol buffer version to use")
+	c.Flags().StringP("http-route-prefix", "", "/api/v1", "http route prefix")
+	c.Flags().StringP("deployment-type", "", "k8s", "deployment artifact to generate. Possible values [helm, k8s]")
+	c.Flags().StringP("domain-name", "", "localhost", "domain name")
+	c.Flags().StringSliceP("resource", "r", []string{}, "resource names (separate with commas). a CRUD service is generated for every resource")
+	c.Flags().StringArrayP("field", "", []string{}, `field of a resource of the form [Resource.]name:type[:modifier...]
+may be repeated. the resource can be omitted when there is only one resource
+types [string, int32, int64, float, double, bool, timestamp]
+modifiers [nullable, unique, index, required]
+an example field is Contact.email:string:unique
+resources without fields have a required name and a description`)
+	c.Flags().StringArrayP("resource-plural", "", []string{}, `plural of a resource of the form [Resource=]plural
+may be repeated. the resource can be omitted when there is only one resource
+plurals are inflected from the resource names by default, e.g. Category is Categories`)
+	c.Flags().BoolP("without-db", "", false, "generate the service without the postgres store and migrations. resources are kept in memory")
+	c.Flags().BoolP("without-gateway", "", false, "generate the service without the REST / JSON grpc-gateway and the ingress")
+	c.Flags().BoolP("without-oidc", "", false, "generate the service without OpenID Connect authentication")
 }
 
 func parseAndValidateArgs(c *cobra.Command) (*builder.Options, error) {
-	mname, err := c.Flags().GetString("module-name")
+
+	spec := &builder.Spec{}
+	cfg, err := c.Flags().GetString("config")
 	if err != nil {
 		return nil, err
 	}
-
-	mname = strings.TrimSpace(mname)
-	mname = strings.Trim(mname, "/")
-
-	if mname == "" {
-		return nil, errors.New("module-name cannot be empty")
+	if cfg != "" {
+		if spec, err = builder.LoadSpec(cfg); err != nil {
+			return nil, err
+		}
 	}
-	mparts := strings.Split(mname, "/")
-	sz := len(mparts)
-
-	// service name
-	name := mparts[sz-1]
-	name = strings.TrimSpace(name)
 
-	description, err := c.Flags().GetString("description")
+	mname, err := stringOption(c, "module-name", spec.ModuleName)
 	if err != nil {
 		return nil, err
 	}
 
-	protocVersion, err := c.Flags().GetString("protoc-version")
+	noInput, err := c.Flags().GetBool("no-input")
 	if err != nil {
 		return nil, err
 	}
 
-	p, err := c.Flags().GetString("path")
+	if strings.Trim(strings.TrimSpace(mname), "/") == "" && !noInput && !jsonMode() && isatty.IsTerminal(os.Stdin.Fd()) {
+		if err := runWizard(c, spec, os.Stdin, os.Stdout); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := stringOption(c, "path", spec.Path)
 	if err != nil {
 		return nil, err
 	}
@@ -93,59 +165,300 @@ func parseAndValidateArgs(c *cobra.Command) (*builder.Options, error) {
 		p = cdir
 	}
 
-	da, err := c.Flags().GetString("deployment-type")
+	o, err := optionsFromFlags(c, p, spec)
 	if err != nil {
 		return nil, err
 	}
-	dtype, err := builder.ValueOf(da)
+
+	layout, err := stringOption(c, "layout", spec.Layout)
 	if err != nil {
 		return nil, err
 	}
+	if o.Layout, err = builder.LayoutOf(layout); err != nil {
+		return nil, err
+	}
 
-	routePrefix, err := c.Flags().GetString("http-route-prefix")
+	mode, err := writeMode(c)
 	if err != nil {
 		return nil, err
 	}
 
-	domainName, err := c.Flags().GetString("domain-name")
+	if inPlace, _ := c.Flags().GetBool("in-place"); inPlace {
+		if c.Flags().Changed("layout") {
+			return nil, errors.New("--layout cannot be used with --in-place")
+		}
+		o.Layout = builder.InPlaceLayout
+	}
+
+	if mode == builder.WritePrompt && (noInput || jsonMode() || !isatty.IsTerminal(os.Stdin.Fd())) {
+		return nil, errors.New("--strategy prompt needs an interactive terminal and cannot be used with --no-input or --output json")
+	}
+
+	dryRun, err := c.Flags().GetBool("dry-run")
 	if err != nil {
 		return nil, err
 	}
 
-	resName, err := c.Flags().GetString("resource")
+	showDiff, err := c.Flags().GetBool("diff")
 	if err != nil {
 		return nil, err
 	}
 
-	if resName == "" {
-		resName = name
+	dir := o.ProjectDir()
+	if _, err := os.Stat(dir); !dryRun && !showDiff && mode == builder.WriteNew && !os.IsNotExist(err) {
+		return nil, errors.Errorf("directory %s already exists. use --force or --merge-into-existing to write into it", dir)
+	}
+
+	return o, nil
+}
+
+// writeMode decides how an existing project directory is treated from the --force, --merge-into-existing,
+// --in-place and --strategy flags
+func writeMode(c *cobra.Command) (builder.WriteMode, error) {
+
+	force, err := c.Flags().GetBool("force")
+	if err != nil {
+		return builder.WriteNew, err
 	}
-	resName = strings.Title(resName)
 
-	imgn, err := c.Flags().GetString("image-name")
+	merge, err := c.Flags().GetBool("merge-into-existing")
+	if err != nil {
+		return builder.WriteNew, err
+	}
+
+	inPlace, err := c.Flags().GetBool("in-place")
+	if err != nil {
+		return builder.WriteNew, err
+	}
+
+	strategy, err := c.Flags().GetString("strategy")
+	if err != nil {
+		return builder.WriteNew, err
+	}
+
+	switch {
+	case force && merge:
+		return builder.WriteNew, errors.New("--force and --merge-into-existing cannot be used together")
+	case inPlace && (force || merge):
+		return builder.WriteNew, errors.New("--force and --merge-into-existing cannot be used with --in-place. use --strategy")
+	case !inPlace && c.Flags().Changed("strategy"):
+		return builder.WriteNew, errors.New("--strategy is used with --in-place")
+	case inPlace:
+		return strategyMode(strategy)
+	case force:
+		return builder.WriteForce, nil
+	case merge:
+		return builder.WriteMerge, nil
+	default:
+		return builder.WriteNew, nil
+	}
+}
+
+// strategyMode returns the write mode of the --in-place strategy s
+func strategyMode(s string) (builder.WriteMode, error) {
+
+	switch strings.ToLower(s) {
+	case "skip":
+		return builder.WriteMerge, nil
+	case "overwrite":
+		return builder.WriteOverwrite, nil
+	case "prompt":
+		return builder.WritePrompt, nil
+	default:
+		return builder.WriteNew, errors.Errorf("invalid strategy %q. possible values [skip, overwrite, prompt]", s)
+	}
+}
+
+// gitOptions returns the options of the repository that is initialized with --git-init. nil without --git-init
+func gitOptions(c *cobra.Command, o *builder.Options) (*builder.GitOptions, error) {
+
+	gitInit, err := c.Flags().GetBool("git-init")
 	if err != nil {
 		return nil, err
 	}
 
-	if imgn == "" {
-		imgr := strings.Builder{}
-		if sz > 2 {
-			imgr.WriteString(strings.Trim(mparts[sz-2], " "))
-			imgr.WriteString("/")
+	if !gitInit {
+		for _, flag := range []string{"git-branch", "git-message", "git-remote"} {
+			if c.Flags().Changed(flag) {
+				return nil, errors.Errorf("--%s is used with --git-init", flag)
+			}
+		}
+		return nil, nil
+	}
+
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, errors.Wrap(err, "--git-init needs git")
+	}
+
+	g := &builder.GitOptions{}
+	if g.Branch, err = c.Flags().GetString("git-branch"); err != nil {
+		return nil, err
+	}
+	if g.Message, err = c.Flags().GetString("git-message"); err != nil {
+		return nil, err
+	}
+	if g.RemoteURL, err = c.Flags().GetString("git-remote"); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(g.Message) == "" {
+		g.Message = fmt.Sprintf("Generate %s with servicebuilder %s", o.Name, o.ServiceBuilderVersion)
+	}
+	if g.RemoteURL == derivedRemote {
+		g.RemoteURL = builder.RemoteURL(o.ModuleName)
+	}
+
+	return g, nil
+}
+
+// stringOption returns value of the flag. value from the spec is used if it is set and the flag is not explicitly specified
+func stringOption(c *cobra.Command, flag, fromSpec string) (string, error) {
+	if fromSpec != "" && !c.Flags().Changed(flag) {
+		return fromSpec, nil
+	}
+
+	return c.Flags().GetString(flag)
+}
+
+// optionsFromFlags builds the options from the flags registered by addOptionFlags, falling back to values in spec.
+// p is the destination directory
+func optionsFromFlags(c *cobra.Command, p string, spec *builder.Spec) (*builder.Options, error) {
+	mname, err := stringOption(c, "module-name", spec.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	mname = strings.TrimSpace(mname)
+	mname = strings.Trim(mname, "/")
+
+	if mname == "" {
+		return nil, errors.New("module-name cannot be empty")
+	}
+	if err := builder.ValidateModuleName(mname); err != nil {
+		return nil, err
+	}
+
+	name := serviceName(mname)
+	if err := builder.ValidateServiceName(name); err != nil {
+		return nil, err
+	}
+
+	description, err := stringOption(c, "description", spec.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	protocVersion, err := stringOption(c, "protoc-version", spec.ProtocVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	da, err := stringOption(c, "deployment-type", spec.DeploymentType)
+	if err != nil {
+		return nil, err
+	}
+	dtype, err := builder.ValueOf(da)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid deployment-type %q. possible values [helm, k8s]", da)
+	}
+
+	routePrefix, err := stringOption(c, "http-route-prefix", spec.HTTPRoutePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	domainName, err := stringOption(c, "domain-name", spec.DomainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := c.Flags().GetStringSlice("resource")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources) == 0 {
+		resources = append(resources, spec.Resources...)
+	}
+	if len(resources) == 0 {
+		resources = []string{defaultResourceName(name)}
+	}
+
+	seen := map[string]bool{}
+	for i, r := range resources {
+		r = strings.Title(strings.TrimSpace(r))
+		if r == "" {
+			return nil, errors.New("resource name cannot be empty")
+		}
+		if err := builder.ValidateResourceName(r); err != nil {
+			return nil, err
 		}
-		imgr.WriteString(name)
-		imgn = imgr.String()
+		if seen[r] {
+			return nil, errors.Errorf("resource %q is specified more than once", r)
+		}
+		seen[r] = true
+		resources[i] = r
+	}
+
+	fields, err := resourceFields(c, resources, spec)
+	if err != nil {
+		return nil, err
 	}
 
-	dir := path.Join(p, mname)
-	if _, err := os.Stat(dir); !os.IsNotExist(err) {
-		return nil, errors.Errorf("directory %s already exists", dir)
+	plurals, err := resourcePlurals(c, resources, spec)
+	if err != nil {
+		return nil, err
 	}
 
-	return &builder.Options{
+	tname, err := stringOption(c, "template", spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	tdir, err := stringOption(c, "template-dir", spec.TemplateDir)
+	if err != nil {
+		return nil, err
+	}
+	if tdir == "" {
+		if _, err := templates.Lookup(tname); err != nil {
+			return nil, err
+		}
+	} else if !templates.IsGitURL(tdir) {
+		if tdir, err = filepath.Abs(tdir); err != nil {
+			return nil, err
+		}
+	}
+
+	features := builder.DefaultFeatures()
+	if tdir == "" {
+		features = templates.DefaultFeatures(tname)
+	}
+	spec.ApplyFeatures(&features)
+	if err := applyFeatureFlags(c, &features); err != nil {
+		return nil, err
+	}
+
+	imgn, err := stringOption(c, "image-name", spec.ImageName)
+	if err != nil {
+		return nil, err
+	}
+
+	if imgn == "" {
+		imgn = defaultImageName(mname)
+	}
+	if err := builder.ValidateImageName(imgn); err != nil {
+		return nil, err
+	}
+
+	o := &builder.Options{
+		Template:              tname,
+		TemplateDir:           tdir,
 		Name:                  name,
 		ModuleName:            mname,
-		ResourceName:          resName,
+		ResourceName:          resources[0],
+		Resources:             resources,
+		ResourceFields:        fields,
+		ResourcePlurals:       plurals,
+		Features:              features,
 		ImageName:             imgn,
 		Description:           description,
 		DstDir:                p,
@@ -154,7 +467,168 @@ func parseAndValidateArgs(c *cobra.Command) (*builder.Options, error) {
 		HTTPRoutePrefix:       routePrefix,
 		ServiceBuilderVersion: getServiceBuilderVersion(),
 		ProtocVersion:         protocVersion,
-	}, nil
+	}
+
+	if features.Postgres {
+		if err := builder.ValidateSQLNames(o); err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// applyFeatureFlags turns off the features that are excluded with the --without-* flags
+func applyFeatureFlags(c *cobra.Command, f *builder.Features) error {
+
+	for flag, enabled := range map[string]*bool{
+		"without-db":      &f.Postgres,
+		"without-gateway": &f.Gateway,
+		"without-oidc":    &f.OIDC,
+	} {
+		without, err := c.Flags().GetBool(flag)
+		if err != nil {
+			return err
+		}
+		if without {
+			*enabled = false
+		}
+	}
+
+	return nil
+}
+
+// resourceFields collects the fields of every resource from the field flags. fields declared
+// in the spec are used for resources that have no field flags
+func resourceFields(c *cobra.Command, resources []string, spec *builder.Spec) (map[string][]*builder.Field, error) {
+
+	decls, err := c.Flags().GetStringArray("field")
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string][]*builder.Field{}
+	for _, d := range decls {
+		r := ""
+		if i := strings.Index(d, "."); i >= 0 && i < strings.Index(d, ":") {
+			r, d = strings.Title(strings.TrimSpace(d[:i])), d[i+1:]
+		}
+
+		switch {
+		case r == "" && len(resources) > 1:
+			return nil, errors.Errorf("field %q must be prefixed with one of the resources %v", d, resources)
+		case r == "":
+			r = resources[0]
+		case !contains(r, resources):
+			return nil, errors.Errorf("field %q is of unknown resource %q", d, r)
+		}
+
+		f, err := builder.ParseField(d)
+		if err != nil {
+			return nil, err
+		}
+		fields[r] = append(fields[r], f)
+	}
+
+	for _, r := range resources {
+		if _, ok := fields[r]; !ok {
+			if fs := spec.ResourceFields(r); len(fs) > 0 {
+				fields[r] = fs
+			}
+		}
+
+		if _, ok := fields[r]; !ok {
+			continue
+		}
+
+		if fields[r], err = builder.ValidateFields(fields[r]); err != nil {
+			return nil, errors.Wrapf(err, "invalid fields of resource %s", r)
+		}
+	}
+
+	return fields, nil
+}
+
+// resourcePlurals collects the overridden plurals of the resources from the resource-plural flags.
+// plurals declared in the spec are used for resources that have no resource-plural flag
+func resourcePlurals(c *cobra.Command, resources []string, spec *builder.Spec) (map[string]string, error) {
+
+	decls, err := c.Flags().GetStringArray("resource-plural")
+	if err != nil {
+		return nil, err
+	}
+
+	plurals := map[string]string{}
+	for _, d := range decls {
+		r, p := "", d
+		if i := strings.Index(d, "="); i >= 0 {
+			r, p = strings.Title(strings.TrimSpace(d[:i])), d[i+1:]
+		}
+		p = strings.Title(strings.TrimSpace(p))
+
+		switch {
+		case r == "" && len(resources) > 1:
+			return nil, errors.Errorf("plural %q must be prefixed with one of the resources %v", d, resources)
+		case r == "":
+			r = resources[0]
+		case !contains(r, resources):
+			return nil, errors.Errorf("plural %q is of unknown resource %q", d, r)
+		}
+
+		if err := builder.ValidatePlural(r, p); err != nil {
+			return nil, err
+		}
+		plurals[r] = p
+	}
+
+	for _, r := range resources {
+		if _, ok := plurals[r]; !ok {
+			if p := spec.ResourcePlural(r); p != "" {
+				plurals[r] = p
+			}
+		}
+	}
+
+	return plurals, nil
+}
+
+// serviceName is the last element of the module name that is not a major version suffix
+func serviceName(mname string) string {
+	mparts := strings.Split(mname, "/")
+	if sz := len(mparts); sz > 1 && majorVersionRegEx.MatchString(mparts[sz-1]) {
+		mparts = mparts[:sz-1]
+	}
+	return strings.TrimSpace(mparts[len(mparts)-1])
+}
+
+// defaultResourceName is the resource of a service for which no resources are specified. it is named after the service
+func defaultResourceName(name string) string {
+	if r := builder.SuggestResourceName(name); r != "" {
+		return r
+	}
+
+	return strings.Title(name)
+}
+
+// defaultImageName is of the form <org>/<service name> when the module name has an org
+func defaultImageName(mname string) string {
+	mparts := strings.Split(mname, "/")
+	sz := len(mparts)
+
+	if sz > 1 && majorVersionRegEx.MatchString(mparts[sz-1]) {
+		mparts = mparts[:sz-1]
+		sz--
+	}
+
+	imgr := strings.Builder{}
+	if sz > 2 {
+		imgr.WriteString(strings.Trim(mparts[sz-2], " "))
+		imgr.WriteString("/")
+	}
+	imgr.WriteString(serviceName(mname))
+
+	// repositories of images are lower case
+	return strings.ToLower(imgr.String())
 }
 
 func getServiceBuilderVersion() string {
@@ -174,17 +648,20 @@ func scafoldNewService(c *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	fmt.Println()
+	if !jsonMode() {
+		fmt.Println()
+	}
 	log.WithField("version", version).Infof("sevicebuilder")
 	log.WithFields(log.Fields{
 		"name":            o.Name,
 		"module-name":     o.ModuleName,
 		"image-name":      o.ImageName,
 		"destination-dir": o.DstDir,
+		"project-dir":     o.ProjectDir(),
 		"protoc-version":  o.ProtocVersion,
 	}).Info("parse and argument validation success")
 
-	templateProvider, err := grpcwithgw.New(o)
+	templateProvider, err := templates.New(o)
 	if err != nil {
 		log.WithError(err).Fatal("error while creating template provider")
 		os.Exit(1)
@@ -196,8 +673,100 @@ func scafoldNewService(c *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	if err := sb.Generate(); err != nil {
+	g, err := gitOptions(c, o)
+	if err != nil {
+		log.WithError(err).Fatal("invalid args")
+		os.Exit(1)
+	}
+
+	if verify, _ := c.Flags().GetBool("verify"); verify {
+		if err := sb.Verify(); err != nil {
+			log.WithError(err).Fatal("generated project is invalid")
+			os.Exit(1)
+		}
+		log.Info("generated go sources verified")
+	}
+
+	dryRun, _ := c.Flags().GetBool("dry-run")
+	showContent, _ := c.Flags().GetBool("show-content")
+	showDiff, _ := c.Flags().GetBool("diff")
+
+	if jsonMode() {
+		if showDiff {
+			log.Fatal("--diff cannot be used with --output json")
+			os.Exit(1)
+		}
+		out.Dir = o.ProjectDir()
+		out.Options = optionsOf(o)
+	}
+
+	// the report of what is written to an existing project is part of the result with --output json
+	var report io.Writer = os.Stdout
+	if jsonMode() {
+		report = ioutil.Discard
+	}
+
+	var files []*builder.FileResult
+	switch {
+	case showDiff:
+		err = sb.Diff(os.Stdout)
+	case dryRun && jsonMode():
+		files, err = renderedFiles(sb)
+	case dryRun:
+		err = sb.DryRun(os.Stdout, showContent)
+	default:
+		mode, _ := writeMode(c)
+		files, err = sb.Generate(mode, report, confirmOverwrite(os.Stdin, os.Stdout))
+	}
+
+	if err != nil {
 		log.WithError(err).Fatal("error while generating project structure")
 		os.Exit(1)
 	}
+
+	if dryRun || showDiff {
+		if jsonMode() {
+			out.Files = files
+			out.print(os.Stdout)
+		}
+		return
+	}
+
+	if g != nil {
+		if err := builder.GitInit(o.ProjectDir(), *g); err != nil {
+			log.WithError(err).Fatal("error while initializing git repository")
+			os.Exit(1)
+		}
+	}
+
+	if jsonMode() {
+		out.Files = files
+		out.NextSteps = builder.NextSteps(o)
+		out.print(os.Stdout)
+		return
+	}
+	printNextSteps(os.Stdout, builder.NextSteps(o))
+}
+
+// renderedFiles are the files that would be generated along with their content. their status is empty as
+// nothing is written
+func renderedFiles(sb builder.ServiceBuilder) ([]*builder.FileResult, error) {
+
+	files, err := sb.Render()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*builder.FileResult, len(files))
+	for i, f := range files {
+		results[i] = &builder.FileResult{
+			Path:    f.Path,
+			SHA256:  builder.Hash(f.Content),
+			Size:    len(f.Content),
+			Mode:    fmt.Sprintf("%04o", f.Mode()),
+			Content: string(f.Content),
+		}
+	}
+
+	return results, nil
 }
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+)
+
+const (
+	textOutput = "text"
+	jsonOutput = "json"
+)
+
+type (
+	// result is printed with --output json once a command is done. it is printed with the error
+	// when the command fails
+	result struct {
+		Command   string                `json:"command"`
+		Version   string                `json:"version"`
+		Dir       string                `json:"dir,omitempty"`
+		Options   *optionsResult        `json:"options,omitempty"`
+		Files     []*builder.FileResult `json:"files"`
+		Warnings  []string              `json:"warnings"`
+		NextSteps []*builder.NextStep   `json:"nextSteps,omitempty"`
+		Error     string                `json:"error,omitempty"`
+
+		printed bool
+	}
+
+	// optionsResult are the options the project was generated with
+	optionsResult struct {
+		Template        string                      `json:"template,omitempty"`
+		TemplateDir     string                      `json:"templateDir,omitempty"`
+		Name            string                      `json:"name"`
+		ModuleName      string                      `json:"moduleName"`
+		Description     string                      `json:"description,omitempty"`
+		ImageName       string                      `json:"imageName"`
+		DeploymentType  string                      `json:"deploymentType"`
+		HTTPRoutePrefix string                      `json:"httpRoutePrefix"`
+		DomainName      string                      `json:"domainName"`
+		ProtocVersion   string                      `json:"protocVersion"`
+		Layout          builder.Layout              `json:"layout"`
+		Resources       []string                    `json:"resources"`
+		Features        builder.Features            `json:"features"`
+		Fields          map[string][]*builder.Field `json:"fields,omitempty"`
+	}
+
+	// resultHook collects the warnings in the result and prints the result with the error on a fatal log
+	resultHook struct {
+		r *result
+	}
+)
+
+var (
+	output = textOutput
+
+	// out is the result of the command with --output json. nil otherwise
+	out *result
+)
+
+// jsonMode reports whether the result of the command is printed as json
+func jsonMode() bool {
+	return out != nil
+}
+
+// addOutputFlag registers --output on the commands that print their result as json. printed is what
+// the json result holds
+func addOutputFlag(c *cobra.Command, printed string) {
+	c.Flags().StringVarP(&output, "output", "", textOutput, fmt.Sprintf(`format of the result printed on stdout. Possible values [text, json]
+json prints %s. logs are printed on stderr`, printed))
+}
+
+// setupOutput validates --output and prepares the result of command c. only the commands with the output
+// flag print a json result
+func setupOutput(c *cobra.Command) error {
+
+	switch output {
+	case textOutput:
+		return nil
+	case jsonOutput:
+	default:
+		return errors.Errorf("invalid output %q. possible values [text, json]", output)
+	}
+
+	if c.Flags().Lookup("output") == nil {
+		return errors.Errorf("command %s does not support --output %s", c.CommandPath(), output)
+	}
+
+	out = &result{Command: c.Name(), Version: getServiceBuilderVersion(), Files: []*builder.FileResult{}, Warnings: []string{}}
+	log.AddHook(&resultHook{r: out})
+	// the result is the only thing printed on stdout
+	log.SetOutput(os.Stderr)
+
+	return nil
+}
+
+func (h *resultHook) Levels() []log.Level {
+	return []log.Level{log.PanicLevel, log.FatalLevel, log.WarnLevel}
+}
+
+func (h *resultHook) Fire(entry *log.Entry) error {
+
+	msg := entry.Message
+	if err, ok := entry.Data[log.ErrorKey].(error); ok {
+		msg = fmt.Sprintf("%s: %v", msg, err)
+	}
+
+	if entry.Level == log.WarnLevel {
+		h.r.Warnings = append(h.r.Warnings, msg)
+		return nil
+	}
+
+	h.r.Error = msg
+	return h.r.print(os.Stdout)
+}
+
+// print writes the result as json to w. it is written only once
+func (r *result) print(w io.Writer) error {
+
+	if r.printed {
+		return nil
+	}
+	r.printed = true
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(r)
+}
+
+// optionsOf returns the options the project is generated with as they are printed in the result
+func optionsOf(o *builder.Options) *optionsResult {
+
+	return &optionsResult{
+		Template:        o.Template,
+		TemplateDir:     o.TemplateDir,
+		Name:            o.Name,
+		ModuleName:      o.ModuleName,
+		Description:     o.Description,
+		ImageName:       o.ImageName,
+		DeploymentType:  o.DeploymentType.String(),
+		HTTPRoutePrefix: o.HTTPRoutePrefix,
+		DomainName:      o.DomainName,
+		ProtocVersion:   o.ProtocVersion,
+		Layout:          o.Layout,
+		Resources:       o.Resources,
+		Features:        o.Features,
+		Fields:          o.ResourceFields,
+	}
+}
+
+// printNextSteps prints the commands to build and run the project
+func printNextSteps(w io.Writer, steps []*builder.NextStep) {
+
+	fmt.Fprintln(w)
+	for i, s := range steps {
+		if s.Description != "" {
+			fmt.Fprintln(w, color.GreenString("\t# %s", s.Description))
+		}
+		fmt.Fprintln(w, color.GreenString("\t%s", s.Command))
+		if i < len(steps)-1 {
+			fmt.Fprintln(w, color.GreenString("\t"))
+		}
+	}
+}
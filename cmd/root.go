@@ -43,7 +43,12 @@ func Execute(semVar, commit, time string) {
 	compiledAt = time
 
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		if jsonMode() {
+			out.Error = err.Error()
+			out.print(os.Stdout)
+		} else {
+			fmt.Println(err)
+		}
 		os.Exit(1)
 	}
 }
@@ -65,5 +70,5 @@ func preRun(c *cobra.Command, args []string) error {
 		log.SetLevel(log.FatalLevel)
 	}
 
-	return nil
+	return setupOutput(c)
 }
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cnative/servicebuilder/internal/templates"
+	// template providers register themselves with the templates package
+	_ "github.com/cnative/servicebuilder/internal/templates/grpcwithgw"
+	_ "github.com/cnative/servicebuilder/internal/templates/simple"
+)
+
+var (
+	// templatesCmd groups the commands about template providers
+	templatesCmd = &cobra.Command{
+		Use:   "templates",
+		Short: "lists the templates a service can be generated with",
+	}
+
+	// templatesListCmd represents the templates list command
+	templatesListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "describes every template that can be used with 'new --template'",
+		Long: `for example:
+
+$ servicebuilder templates list
+	`,
+		Run: func(c *cobra.Command, args []string) {
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tDESCRIPTION")
+			for _, p := range templates.List() {
+				name := p.Name
+				if name == templates.Default {
+					name += " (default)"
+				}
+				fmt.Fprintf(w, "%s\t%s\n", name, p.Description)
+			}
+			w.Flush()
+		},
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(templatesCmd)
+	templatesCmd.AddCommand(templatesListCmd)
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type (
+	// Ctx is an alias of the context
+	Ctx = context.Context
+
+	// Item is kept in the Store
+	Item struct {
+		ID      string
+		Expires time.Time
+	}
+
+	// Store of the items
+	Store interface {
+		io.Closer
+
+		// Get returns the item id
+		//iwrap:log id
+		Get(ctx context.Context, id string) (*Item, error)
+
+		// Put saves the item
+		//iwrap:level info
+		//iwrap:log item token
+		//iwrap:redact token
+		Put(ctx context.Context, item *Item, token string) error
+
+		// Delete removes the items
+		Delete(ctx context.Context, ids ...string) (int, error)
+
+		// Healthy reports whether the store can be reached
+		Healthy() error
+
+		Ping(context.Context)
+
+		// Touch extends the expiry of the item id
+		Touch(ctx Ctx, id string) error
+
+		// Find returns the items matching s that expire after start
+		//iwrap:log s start
+		Find(ctx context.Context, s string, start time.Time) ([]*Item, error)
+	}
+)
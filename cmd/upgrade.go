@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+	"github.com/cnative/servicebuilder/internal/templates"
+)
+
+// upgradeCmd represents the upgrade command
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "re-applies the current templates to an existing service",
+	Long: `Re-renders the templates with the options the service was generated with and
+merges them into the existing project. Local modifications are retained. Overlapping
+changes are written with conflict markers that need to be resolved manually.
+
+The options are recovered from the .servicebuilder.yaml manifest written at generation
+time. For projects without a manifest the same flags as 'new' must be specified.
+
+For example:
+
+$ servicebuilder upgrade --dir $GOPATH/src/github.com/kustomers/contacts
+or
+$ servicebuilder upgrade --dry-run`,
+	Run: upgradeService,
+}
+
+func init() {
+	rootCmd.AddCommand(upgradeCmd)
+
+	addOptionFlags(upgradeCmd)
+	upgradeCmd.Flags().StringP("dir", "", ".", "directory of the project to upgrade")
+	upgradeCmd.Flags().BoolP("dry-run", "", false, "report what would change without writing anything")
+}
+
+func upgradeOptions(c *cobra.Command) (string, *builder.Options, error) {
+
+	dir, err := c.Flags().GetString("dir")
+	if err != nil {
+		return "", nil, err
+	}
+
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if _, err := os.Stat(dir); err != nil {
+		return "", nil, err
+	}
+
+	m, err := builder.ReadManifest(dir)
+	if err == nil {
+		o, err := manifestOptions(m, dir)
+		if err != nil {
+			return "", nil, err
+		}
+		o.ServiceBuilderVersion = getServiceBuilderVersion()
+		return dir, o, nil
+	}
+
+	if !os.IsNotExist(err) {
+		return "", nil, err
+	}
+
+	log.WithField("dir", dir).Warn("manifest not found. using options from flags")
+	o, err := optionsFromFlags(c, filepath.Dir(dir), &builder.Spec{})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "project has no manifest")
+	}
+
+	return dir, o, nil
+}
+
+func upgradeService(c *cobra.Command, args []string) {
+
+	dir, o, err := upgradeOptions(c)
+	if err != nil {
+		log.WithError(err).Fatal("invalid args")
+		os.Exit(1)
+	}
+
+	log.WithFields(log.Fields{
+		"name":        o.Name,
+		"module-name": o.ModuleName,
+		"dir":         dir,
+		"version":     o.ServiceBuilderVersion,
+	}).Info("upgrading service")
+
+	templateProvider, err := templates.New(o)
+	if err != nil {
+		log.WithError(err).Fatal("error while creating template provider")
+		os.Exit(1)
+	}
+
+	sb, err := builder.New(templateProvider)
+	if err != nil {
+		log.WithError(err).Fatal("error while creating service builder")
+		os.Exit(1)
+	}
+
+	dryRun, _ := c.Flags().GetBool("dry-run")
+	if err := sb.Upgrade(dir, os.Stdout, dryRun); err != nil {
+		log.WithError(err).Fatal("error while upgrading project")
+		os.Exit(1)
+	}
+}
+
+// manifestOptions recreates the options of the project in dir from its manifest. manifests that predate
+// the features have the default features of their template
+func manifestOptions(m *builder.Manifest, dir string) (*builder.Options, error) {
+
+	o, err := m.Options(dir)
+	if err != nil {
+		return nil, err
+	}
+	if m.Features == nil && o.TemplateDir == "" {
+		o.Features = templates.DefaultFeatures(o.Template)
+	}
+
+	return o, nil
+}
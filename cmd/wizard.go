@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+	"github.com/cnative/servicebuilder/internal/diff"
+	"github.com/cnative/servicebuilder/internal/templates"
+)
+
+type (
+	// prompter asks questions on an interactive terminal
+	prompter struct {
+		in  *bufio.Reader
+		out io.Writer
+	}
+)
+
+// runWizard prompts for the options that are usually passed as flags of new. the values of the flags or else of
+// spec are the defaults of the prompts. only the flags of the answers that differ from their default are set,
+// so values of the spec that are accepted are not overridden
+func runWizard(c *cobra.Command, spec *builder.Spec, in io.Reader, out io.Writer) error {
+
+	p := &prompter{in: bufio.NewReader(in), out: out}
+	fmt.Fprintln(out, color.HiWhiteString("\n    NEW SERVICE (use --no-input to skip the prompts)\n"))
+
+	mname, err := p.ask("module name (e.g. mycompany.com/kustomer/accounts)", "", func(v string) error {
+		if strings.Trim(v, "/") == "" {
+			return errors.New("module name cannot be empty")
+		}
+		if err := builder.ValidateModuleName(strings.Trim(v, "/")); err != nil {
+			return err
+		}
+		return builder.ValidateServiceName(serviceName(strings.Trim(v, "/")))
+	})
+	if err != nil {
+		return err
+	}
+	mname = strings.Trim(mname, "/")
+	name := serviceName(mname)
+
+	defaults := map[string]string{}
+	for flag, fromSpec := range map[string]string{
+		"template":        spec.Template,
+		"description":     spec.Description,
+		"deployment-type": spec.DeploymentType,
+		"image-name":      spec.ImageName,
+		"domain-name":     spec.DomainName,
+	} {
+		if defaults[flag], err = stringOption(c, flag, fromSpec); err != nil {
+			return err
+		}
+	}
+
+	tname, err := p.ask(fmt.Sprintf("template [%s]", strings.Join(templates.Names(), ", ")), defaults["template"], func(v string) error {
+		_, err := templates.Lookup(v)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	description, err := p.ask("description", defaults["description"], nil)
+	if err != nil {
+		return err
+	}
+
+	resources, err := c.Flags().GetStringSlice("resource")
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		resources = append(resources, spec.Resources...)
+	}
+	if len(resources) == 0 {
+		resources = []string{defaultResourceName(name)}
+	}
+	defaults["resource"] = strings.Join(resources, ",")
+	resource, err := p.ask("resource names (separate with commas)", defaults["resource"], func(v string) error {
+		for _, r := range strings.Split(v, ",") {
+			if err := builder.ValidateResourceName(strings.Title(strings.TrimSpace(r))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	dtype, err := p.ask("deployment type [helm, k8s]", defaults["deployment-type"], func(v string) error {
+		_, err := builder.ValueOf(v)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if defaults["image-name"] == "" {
+		defaults["image-name"] = defaultImageName(mname)
+	}
+	imgn, err := p.ask("image name", defaults["image-name"], builder.ValidateImageName)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := p.ask("domain name", defaults["domain-name"], nil)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out)
+
+	answers := map[string]string{
+		"module-name":     mname,
+		"template":        tname,
+		"description":     description,
+		"resource":        resource,
+		"deployment-type": dtype,
+		"image-name":      imgn,
+		"domain-name":     domainName,
+	}
+	for k, v := range answers {
+		if v == defaults[k] {
+			continue
+		}
+		if err := setFlag(c, k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setFlag sets the flag name of c to the answer v. the values of slice flags are replaced, as Set appends
+// to a slice flag that was already changed on the command line
+func setFlag(c *cobra.Command, name, v string) error {
+
+	f := c.Flags().Lookup(name)
+	if f == nil {
+		return errors.Errorf("unknown flag %s", name)
+	}
+
+	if s, ok := f.Value.(pflag.SliceValue); ok {
+		var values []string
+		for _, e := range strings.Split(v, ",") {
+			values = append(values, strings.TrimSpace(e))
+		}
+		if err := s.Replace(values); err != nil {
+			return err
+		}
+		f.Changed = true
+		return nil
+	}
+
+	return c.Flags().Set(name, v)
+}
+
+// confirmOverwrite asks on the terminal whether to overwrite a file with local modifications. d shows
+// how the file would change and a overwrites the remaining files without asking
+func confirmOverwrite(in io.Reader, out io.Writer) builder.ConfirmFunc {
+
+	p := &prompter{in: bufio.NewReader(in), out: out}
+	all := false
+	return func(f string, existing, generated []byte) (bool, error) {
+
+		for !all {
+			answer, err := p.ask(fmt.Sprintf("%s exists. overwrite? y(es), n(o), a(ll), d(iff)", f), "n", func(a string) error {
+				switch strings.ToLower(a) {
+				case "y", "yes", "n", "no", "a", "all", "d", "diff":
+					return nil
+				default:
+					return errors.Errorf("unknown answer %q", a)
+				}
+			})
+			if err != nil {
+				return false, err
+			}
+
+			switch strings.ToLower(answer)[0] {
+			case 'y':
+				return true, nil
+			case 'n':
+				return false, nil
+			case 'a':
+				all = true
+			default:
+				fmt.Fprint(out, diff.Unified(path.Join("a", f), path.Join("b", f), string(existing), string(generated), 3))
+			}
+		}
+
+		return true, nil
+	}
+}
+
+// ask prints the question and reads the answer. def is returned for an empty answer.
+// the question is repeated till validate accepts the answer
+func (p *prompter) ask(question, def string, validate func(string) error) (string, error) {
+
+	for {
+		if def != "" {
+			fmt.Fprintf(p.out, "    %s %s: ", color.CyanString(question), color.YellowString("[%s]", def))
+		} else {
+			fmt.Fprintf(p.out, "    %s: ", color.CyanString(question))
+		}
+
+		line, err := p.in.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return "", errors.Wrap(err, "unable to read answer")
+		}
+
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			answer = def
+		}
+
+		if validate == nil {
+			return answer, nil
+		}
+
+		verr := validate(answer)
+		if verr == nil {
+			return answer, nil
+		}
+		if err == io.EOF {
+			return "", verr
+		}
+		fmt.Fprintf(p.out, "    %s\n", color.RedString("✖ %v", verr))
+	}
+}
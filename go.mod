@@ -1,15 +1,26 @@
 module github.com/cnative/servicebuilder
 
-go 1.15
+go 1.22.0
 
 require (
 	github.com/fatih/color v1.9.0
 	github.com/go-bindata/go-bindata v3.1.2+incompatible
 	github.com/iancoleman/strcase v0.1.2
-	github.com/mattn/go-colorable v0.1.7 // indirect
+	github.com/jinzhu/inflection v1.0.0
+	github.com/mattn/go-isatty v0.0.12
 	github.com/pkg/errors v0.9.1
 	github.com/sirupsen/logrus v1.6.0
 	github.com/spf13/cobra v1.0.0
-	github.com/spf13/pflag v1.0.5 // indirect
-	golang.org/x/sys v0.0.0-20200909081042-eff7692f9009 // indirect
+	github.com/spf13/pflag v1.0.5
+	golang.org/x/mod v0.22.0
+	golang.org/x/tools v0.28.0
+	gopkg.in/yaml.v2 v2.2.2
+)
+
+require (
+	github.com/inconshreveable/mousetrap v1.0.0 // indirect
+	github.com/konsorten/go-windows-terminal-sequences v1.0.3 // indirect
+	github.com/mattn/go-colorable v0.1.7 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
 )
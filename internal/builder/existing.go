@@ -0,0 +1,120 @@
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// writeInto writes files to the existing project directory dir. files with local modifications, i.e.
+// the ones that differ from what was generated, are treated according to mode. WriteForce aborts without
+// writing anything when there is such a file, WriteMerge skips them, WriteOverwrite overwrites them and
+// WritePrompt asks confirm. every file is reported to w followed by a summary. the status of every file is returned
+func writeInto(dir string, options *Options, files []*File, mode WriteMode, w io.Writer, confirm ConfirmFunc) ([]fileStatus, error) {
+
+	previous, err := ReadManifest(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if mode == WritePrompt && confirm == nil {
+		return nil, errors.New("prompt needs a confirm func")
+	}
+
+	statuses := make([]fileStatus, len(files))
+	modified := []string{}
+	for i, f := range files {
+		existing, err := readIfExists(path.Join(dir, f.Path))
+		if err != nil {
+			return nil, err
+		}
+
+		pristine, err := isPristine(dir, previous, f.Path, existing)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case existing == nil:
+			statuses[i] = statusAdded
+		case bytes.Equal(existing, f.Content):
+			statuses[i] = statusUnchanged
+		case pristine:
+			statuses[i] = statusUpdated
+		case mode == WriteOverwrite:
+			statuses[i] = statusOverwritten
+		case mode == WritePrompt:
+			overwrite, err := confirm(f.Path, existing, f.Content)
+			if err != nil {
+				return nil, err
+			}
+			statuses[i] = statusSkipped
+			if overwrite {
+				statuses[i] = statusOverwritten
+			}
+		default:
+			statuses[i] = statusSkipped
+			modified = append(modified, f.Path)
+		}
+	}
+
+	if mode == WriteForce && len(modified) > 0 {
+		return nil, errors.Errorf("%d files in %s have local modifications. nothing is written\n\t%s",
+			len(modified), dir, strings.Join(modified, "\n\t"))
+	}
+
+	counts := map[fileStatus]int{}
+	skipped := []string{}
+	for i, f := range files {
+		fmt.Fprintf(w, "%s %s\n", statuses[i], f.Path)
+		counts[statuses[i]]++
+		if statuses[i] == statusSkipped {
+			// the local modifications are not merged yet. the previous base is kept for the next upgrade
+			skipped = append(skipped, f.Path)
+			continue
+		}
+		if statuses[i] == statusUnchanged {
+			continue
+		}
+
+		dst := path.Join(dir, f.Path)
+		if err := WriteFile(dst, f.Content, existingMode(dst, f.Mode())); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := saveState(dir, options, files, skipped...); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(w, "\n%d added, %d updated, %d overwritten, %d skipped, %d unchanged. state saved in %s and %s\n",
+		counts[statusAdded], counts[statusUpdated], counts[statusOverwritten], counts[statusSkipped], counts[statusUnchanged],
+		ManifestFile, BaseDir)
+
+	return statuses, nil
+}
+
+// isPristine reports whether content of the file p in the project dir is what servicebuilder generated,
+// going by the hash in the manifest or the pristine copy
+func isPristine(dir string, m *Manifest, p string, content []byte) (bool, error) {
+
+	if content == nil {
+		return true, nil
+	}
+
+	if m != nil && m.Files[p] == Hash(content) {
+		return true, nil
+	}
+
+	base, err := readIfExists(path.Join(dir, BaseDir, p))
+	if err != nil {
+		return false, err
+	}
+
+	return base != nil && bytes.Equal(base, content), nil
+}
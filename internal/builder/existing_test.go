@@ -0,0 +1,263 @@
+package builder
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+type testTemplateProvider struct {
+	options   *Options
+	templates map[string]*template.Template
+}
+
+func (p *testTemplateProvider) GetOptions() *Options {
+	return p.options
+}
+
+func (p *testTemplateProvider) GetTemplates() map[string]*template.Template {
+	return p.templates
+}
+
+// generate renders the templates, keyed by their path, into the project in dir
+func generate(t *testing.T, dir string, templates map[string]string, mode WriteMode, confirm ConfirmFunc) {
+	t.Helper()
+
+	sb, err := New(newTestTemplateProvider(t, dir, templates))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sb.Generate(mode, ioutil.Discard, confirm); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// upgrade merges the templates into the project in dir
+func upgrade(t *testing.T, dir string, templates map[string]string) string {
+	t.Helper()
+
+	sb, err := New(newTestTemplateProvider(t, dir, templates))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := sb.Upgrade(dir, &out, false); err != nil {
+		t.Fatal(err)
+	}
+
+	return out.String()
+}
+
+func newTestTemplateProvider(t *testing.T, dir string, templates map[string]string) *testTemplateProvider {
+	t.Helper()
+
+	p := &testTemplateProvider{
+		options: &Options{
+			Name:           "svc",
+			ModuleName:     "example.com/svc",
+			ResourceName:   "Contact",
+			Resources:      []string{"Contact"},
+			DstDir:         dir,
+			Layout:         InPlaceLayout,
+			DeploymentType: K8SManifest,
+		},
+		templates: map[string]*template.Template{},
+	}
+	for k, v := range templates {
+		p.templates[k] = template.Must(template.New(k).Parse(v))
+	}
+
+	return p
+}
+
+func writeTestFile(t *testing.T, dir, p, content string) {
+	t.Helper()
+
+	if err := WriteFile(path.Join(dir, p), []byte(content), defaultFileMode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestFile(t *testing.T, dir, p string) string {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(path.Join(dir, p))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
+
+// TestUpgradeAfterSkip checks that a file skipped because of its local modifications keeps its previous base,
+// so that the template change that was not written is merged by the next upgrade
+func TestUpgradeAfterSkip(t *testing.T) {
+
+	v1 := map[string]string{
+		"Makefile":  "build:\n\tgo build\n\ntest:\n\tgo test\n",
+		"README.md": "# svc\n",
+	}
+	v2 := map[string]string{
+		"Makefile":  "build:\n\tgo build -v\n\ntest:\n\tgo test\n",
+		"README.md": "# svc\n\nthe service\n",
+	}
+	local := "build:\n\tgo build\n\ntest:\n\tgo test\n\nlint:\n\tgolangci-lint run\n"
+	merged := "build:\n\tgo build -v\n\ntest:\n\tgo test\n\nlint:\n\tgolangci-lint run\n"
+
+	tests := []struct {
+		name    string
+		mode    WriteMode
+		confirm ConfirmFunc
+	}{
+		{name: "skip", mode: WriteMerge},
+		{
+			name: "declined prompt",
+			mode: WritePrompt,
+			confirm: func(string, []byte, []byte) (bool, error) {
+				return false, nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := path.Join(t.TempDir(), "svc")
+			generate(t, dir, v1, WriteNew, nil)
+			writeTestFile(t, dir, "Makefile", local)
+
+			generate(t, dir, v2, tt.mode, tt.confirm)
+			if got := readTestFile(t, dir, "Makefile"); got != local {
+				t.Fatalf("Makefile with local modifications is written: %q", got)
+			}
+			if got := readTestFile(t, dir, "README.md"); got != v2["README.md"] {
+				t.Fatalf("README.md = %q, want %q", got, v2["README.md"])
+			}
+			if got := readTestFile(t, dir, path.Join(BaseDir, "Makefile")); got != v1["Makefile"] {
+				t.Fatalf("base of the skipped Makefile = %q, want the previous base %q", got, v1["Makefile"])
+			}
+
+			out := upgrade(t, dir, v2)
+			if !strings.Contains(out, "Makefile") || strings.Contains(out, statusUnchanged.String()+" Makefile") {
+				t.Errorf("upgrade does not merge the skipped Makefile\n%s", out)
+			}
+			if got := readTestFile(t, dir, "Makefile"); got != merged {
+				t.Errorf("Makefile = %q, want %q", got, merged)
+			}
+		})
+	}
+}
+
+// TestMergeIntoExistingSkip checks that a file of a project that was not generated by servicebuilder gets no base
+// when it is skipped, so that the next upgrade does not take the template as unchanged
+func TestMergeIntoExistingSkip(t *testing.T) {
+
+	templates := map[string]string{"Makefile": "build:\n\tgo build\n"}
+	dir := path.Join(t.TempDir(), "svc")
+	writeTestFile(t, dir, "Makefile", "all:\n\tmake -C src\n")
+
+	generate(t, dir, templates, WriteMerge, nil)
+
+	m, err := ReadManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, ok := m.Files["Makefile"]; ok {
+		t.Errorf("skipped Makefile has the hash %s of the template", h)
+	}
+	if b, err := readIfExists(path.Join(dir, BaseDir, "Makefile")); err != nil || b != nil {
+		t.Errorf("skipped Makefile has a base %q, %v", b, err)
+	}
+
+	out := upgrade(t, dir, templates)
+	if !strings.Contains(out, statusConflict.String()+" Makefile") {
+		t.Errorf("upgrade does not report a conflict of the Makefile\n%s", out)
+	}
+}
+
+// testResourceAdder renders <resource>.go and edits resources.txt of the project
+type testResourceAdder struct {
+	*testTemplateProvider
+}
+
+func (p *testResourceAdder) AddResource(dir, r string) ([]*File, error) {
+
+	b, err := ioutil.ReadFile(path.Join(dir, "resources.txt"))
+	if err != nil {
+		return nil, err
+	}
+	p.options.Resources = append(p.options.Resources, r)
+	p.options.AddedResources = append(p.options.AddedResources, r)
+
+	return []*File{
+		{Path: strings.ToLower(r) + ".go", Content: []byte("package svc\n")},
+		{Path: "resources.txt", Content: append(b, r+"\n"...)},
+	}, nil
+}
+
+// TestAddResourceState checks that only the files written by AddResource get a new base, so that the next
+// upgrade neither adds the table of the resource to the init migration nor takes it as a local deletion
+func TestAddResourceState(t *testing.T) {
+
+	v1 := map[string]string{
+		ResourceToken + ".go": "package svc\n",
+		"resources.txt":       "{{ range .Resources }}{{ . }}\n{{ end }}",
+		"init.sql":            "{{ range .InitResources }}create table {{ . }};\n{{ end }}",
+		"README.md":           "{{ range .Resources }}- {{ . }}\n{{ end }}",
+	}
+	dir := path.Join(t.TempDir(), "svc")
+	generate(t, dir, v1, WriteNew, nil)
+
+	sb, err := New(&testResourceAdder{newTestTemplateProvider(t, dir, v1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sb.AddResource(dir, "Address", ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := ReadManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.Files["address.go"]; !ok {
+		t.Error("added address.go has no hash")
+	}
+	if m.Files["init.sql"] != Hash([]byte("create table Contact;\n")) {
+		t.Errorf("hash of the init migration that was not written changed")
+	}
+	if got := readTestFile(t, dir, path.Join(BaseDir, "README.md")); got != "- Contact\n" {
+		t.Errorf("base of README.md that was not written = %q, want the previous base", got)
+	}
+	if got := readTestFile(t, dir, path.Join(BaseDir, "resources.txt")); got != "Contact\nAddress\n" {
+		t.Errorf("base of the edited resources.txt = %q", got)
+	}
+
+	v2 := map[string]string{
+		ResourceToken + ".go": v1[ResourceToken+".go"],
+		"resources.txt":       v1["resources.txt"],
+		"init.sql":            "{{ range .InitResources }}create table if not exists {{ . }};\n{{ end }}",
+		"README.md":           v1["README.md"],
+	}
+	p := newTestTemplateProvider(t, dir, v2)
+	o, err := m.Options(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.options = o
+
+	sb, err = New(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := sb.Upgrade(dir, &out, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := readTestFile(t, dir, "init.sql"); got != "create table if not exists Contact;\n" {
+		t.Errorf("init.sql = %q\n%s", got, out.String())
+	}
+}
@@ -0,0 +1,245 @@
+package builder
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxFields is the number of fields that fit between id and the audit fields in the proto message
+	maxFields = 19
+)
+
+type (
+	// Field of a resource
+	Field struct {
+		Name     string `yaml:"name" json:"name"`
+		Type     string `yaml:"type" json:"type"`
+		Nullable bool   `yaml:"nullable,omitempty" json:"nullable,omitempty"`
+		Unique   bool   `yaml:"unique,omitempty" json:"unique,omitempty"`
+		Index    bool   `yaml:"index,omitempty" json:"index,omitempty"`
+		Required bool   `yaml:"required,omitempty" json:"required,omitempty"`
+
+		// Number is the proto field number
+		Number int `yaml:"-" json:"-"`
+	}
+
+	fieldType struct {
+		goType    string
+		protoType string
+		sqlType   string
+		// nullableProtoType is the proto type of nullable fields. it is a message, so that null is an unset
+		// field rather than the zero value
+		nullableProtoType string
+		// helper used for conversion of nullable values
+		helper string
+	}
+)
+
+var (
+	fieldTypes = map[string]fieldType{
+		"string":    {goType: "string", protoType: "string", sqlType: "text", nullableProtoType: "google.protobuf.StringValue", helper: "string"},
+		"int32":     {goType: "int32", protoType: "int32", sqlType: "integer", nullableProtoType: "google.protobuf.Int32Value", helper: "int32"},
+		"int64":     {goType: "int64", protoType: "int64", sqlType: "bigint", nullableProtoType: "google.protobuf.Int64Value", helper: "int64"},
+		"float":     {goType: "float32", protoType: "float", sqlType: "real", nullableProtoType: "google.protobuf.FloatValue", helper: "float32"},
+		"double":    {goType: "float64", protoType: "double", sqlType: "double precision", nullableProtoType: "google.protobuf.DoubleValue", helper: "float64"},
+		"bool":      {goType: "bool", protoType: "bool", sqlType: "boolean", nullableProtoType: "google.protobuf.BoolValue", helper: "bool"},
+		"timestamp": {goType: "time.Time", protoType: "google.protobuf.Timestamp", sqlType: "timestamptz", nullableProtoType: "google.protobuf.Timestamp", helper: "timestamp"},
+	}
+
+	// columns that are part of every resource
+	systemFields = []string{"id", "created_by", "created_at", "updated_by", "updated_at"}
+
+	fieldNameRegEx = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+)
+
+// DefaultFields are the fields of a resource for which no fields are declared
+func DefaultFields() []*Field {
+	return numbered([]*Field{
+		{Name: "name", Type: "string", Required: true},
+		{Name: "description", Type: "string"},
+	})
+}
+
+// ParseField parses field declaration of the form name:type[:modifier...].
+// modifiers are nullable, unique, index and required
+func ParseField(s string) (*Field, error) {
+
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) < 2 {
+		return nil, errors.Errorf("invalid field %q. expected name:type[:modifier...]", s)
+	}
+
+	f := &Field{Name: parts[0], Type: strings.ToLower(parts[1])}
+	for _, m := range parts[2:] {
+		switch strings.ToLower(m) {
+		case "nullable":
+			f.Nullable = true
+		case "unique":
+			f.Unique = true
+		case "index":
+			f.Index = true
+		case "required":
+			f.Required = true
+		default:
+			return nil, errors.Errorf("invalid field %q. unknown modifier %q. possible values [nullable, unique, index, required]", s, m)
+		}
+	}
+
+	return f, f.Validate()
+}
+
+// UnmarshalYAML accepts the short form name:type[:modifier...] as well as the expanded form
+func (f *Field) UnmarshalYAML(unmarshal func(interface{}) error) error {
+
+	var s string
+	if err := unmarshal(&s); err == nil {
+		p, err := ParseField(s)
+		if err != nil {
+			return err
+		}
+		*f = *p
+		return nil
+	}
+
+	type plain Field
+	return unmarshal((*plain)(f))
+}
+
+// Validate the field declaration
+func (f *Field) Validate() error {
+
+	if !fieldNameRegEx.MatchString(f.Name) {
+		return errors.Errorf("invalid field name %q. must start with a letter and contain only letters, digits and '_'", f.Name)
+	}
+
+	for _, s := range systemFields {
+		if f.Column() == s {
+			return errors.Errorf("field %q is part of every resource and cannot be declared", f.Name)
+		}
+	}
+
+	if _, ok := fieldTypes[f.Type]; !ok {
+		return errors.Errorf("field %q has unknown type %q. possible values [string, int32, int64, float, double, bool, timestamp]", f.Name, f.Type)
+	}
+
+	// resources are listed filtered by name
+	if f.Column() == "name" && f.Type != "string" {
+		return errors.Errorf("field %q is used to filter lists and must be of type string", f.Name)
+	}
+
+	if f.Required && (f.Type != "string" || f.Nullable) {
+		return errors.Errorf("field %q: only non nullable string fields can be required", f.Name)
+	}
+
+	return nil
+}
+
+// ValidateFields checks the fields of a resource and assigns the proto field numbers
+func ValidateFields(fields []*Field) ([]*Field, error) {
+
+	if len(fields) > maxFields {
+		return nil, errors.Errorf("a resource can have at most %d fields", maxFields)
+	}
+
+	seen := map[string]bool{}
+	for _, f := range fields {
+		if err := f.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[f.Column()] {
+			return nil, errors.Errorf("field %q is declared more than once", f.Name)
+		}
+		seen[f.Column()] = true
+	}
+
+	return numbered(fields), nil
+}
+
+func numbered(fields []*Field) []*Field {
+	for i, f := range fields {
+		f.Number = i + 2 // 1 is the id
+	}
+
+	return fields
+}
+
+// GoName is the name of the struct field in Go. It is also the name protoc-gen-go uses
+func (f *Field) GoName() string {
+	return strcase.ToCamel(f.Name)
+}
+
+// ProtoName is the name of the field in the proto message
+func (f *Field) ProtoName() string {
+	return strcase.ToLowerCamel(f.Name)
+}
+
+// Column is the name of the column in the sql table
+func (f *Field) Column() string {
+	return strcase.ToSnake(f.Name)
+}
+
+// GoType of the field. nullable fields are pointers
+func (f *Field) GoType() string {
+	if f.Nullable {
+		return "*" + fieldTypes[f.Type].goType
+	}
+
+	return fieldTypes[f.Type].goType
+}
+
+// ProtoType of the field. nullable fields are wrapper messages, i.e. google.protobuf.StringValue,
+// that are unset for null
+func (f *Field) ProtoType() string {
+	if f.Nullable {
+		return fieldTypes[f.Type].nullableProtoType
+	}
+
+	return fieldTypes[f.Type].protoType
+}
+
+// SQLType is the column definition of the field
+func (f *Field) SQLType() string {
+	var sb strings.Builder
+	sb.WriteString(fieldTypes[f.Type].sqlType)
+	if !f.Nullable {
+		sb.WriteString(" not null")
+	}
+	if f.Unique {
+		sb.WriteString(" unique")
+	}
+
+	return sb.String()
+}
+
+// ToProto returns the expression that converts the field of Go value v to its proto type
+func (f *Field) ToProto(v string) string {
+	e := fmt.Sprintf("%s.%s", v, f.GoName())
+	h := fieldTypes[f.Type].helper
+	switch {
+	case f.Nullable:
+		return fmt.Sprintf("%sValue(%s)", h, e)
+	case f.Type == "timestamp":
+		return fmt.Sprintf("timestampProto(%s)", e)
+	default:
+		return e
+	}
+}
+
+// FromProto returns the expression that converts the field of proto value v to its Go type
+func (f *Field) FromProto(v string) string {
+	e := fmt.Sprintf("%s.%s", v, f.GoName())
+	h := fieldTypes[f.Type].helper
+	switch {
+	case f.Nullable:
+		return fmt.Sprintf("%sPtr(%s)", h, e)
+	case f.Type == "timestamp":
+		return fmt.Sprintf("timestampFromProto(%s)", e)
+	default:
+		return e
+	}
+}
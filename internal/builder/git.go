@@ -0,0 +1,133 @@
+package builder
+
+import (
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// GitRemote is the name of the remote added by GitInit
+	GitRemote = "origin"
+
+	gitUserName  = "servicebuilder"
+	gitUserEmail = "servicebuilder@localhost"
+)
+
+type (
+	// GitOptions configure the repository created by GitInit
+	GitOptions struct {
+		// Branch is the name of the initial branch
+		Branch string
+		// Message of the initial commit
+		Message string
+		// RemoteURL is added as the origin remote when it is not empty
+		RemoteURL string
+	}
+)
+
+// GitInit makes dir a git repository and commits all of its files. A dir that already is the root of a git
+// repository, e.g. a project generated in place in a clone, is not initialized again and the initial branch
+// is not changed. A dir inside another repository becomes a repository of its own, so that no file outside
+// of dir is committed. The commit is authored by servicebuilder when git has no user configured
+func GitInit(dir string, o GitOptions) error {
+
+	if o.Message == "" {
+		return errors.New("missing message of the initial commit")
+	}
+
+	if !isRepoRoot(dir) {
+		if _, err := git(dir, "init", "--quiet"); err != nil {
+			return err
+		}
+		if o.Branch != "" {
+			// symbolic-ref works with versions of git that have no --initial-branch
+			if _, err := git(dir, "symbolic-ref", "HEAD", "refs/heads/"+o.Branch); err != nil {
+				return err
+			}
+		}
+		log.WithFields(log.Fields{"dir": dir, "branch": o.Branch}).Info("git repository initialized")
+	} else if o.Branch != "" {
+		log.WithField("dir", dir).Debug("already a git repository. the branch is not changed")
+	}
+
+	if _, err := git(dir, "add", "--all", "--", "."); err != nil {
+		return err
+	}
+
+	args := []string{"commit", "--quiet", "--message", o.Message, "--", "."}
+	if name, _ := git(dir, "config", "user.name"); name == "" {
+		args = append([]string{"-c", "user.name=" + gitUserName}, args...)
+	}
+	if email, _ := git(dir, "config", "user.email"); email == "" {
+		args = append([]string{"-c", "user.email=" + gitUserEmail}, args...)
+	}
+	if _, err := git(dir, args...); err != nil {
+		return err
+	}
+	log.WithField("message", o.Message).Info("initial commit created")
+
+	if o.RemoteURL == "" {
+		return nil
+	}
+
+	if url, _ := git(dir, "remote", "get-url", GitRemote); url != "" {
+		log.WithFields(log.Fields{"remote": GitRemote, "url": url}).Warn("remote already exists. it is not changed")
+		return nil
+	}
+	if _, err := git(dir, "remote", "add", GitRemote, o.RemoteURL); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"remote": GitRemote, "url": o.RemoteURL}).Info("git remote added")
+
+	return nil
+}
+
+// isRepoRoot reports whether dir is the top level directory of a git work tree
+func isRepoRoot(dir string) bool {
+
+	top, err := git(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return false
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	// the top level is reported with the symbolic links resolved
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		abs = resolved
+	}
+
+	return filepath.Clean(top) == abs
+}
+
+// RemoteURL derives the https URL of the repository of the module. the major version suffix
+// of the module is not part of the repository, i.e. example.com/org/service/v2 is in example.com/org/service
+func RemoteURL(moduleName string) string {
+
+	repo := strings.Trim(moduleName, "/")
+	if majorVersionRegEx.MatchString(path.Base(repo)) {
+		repo = path.Dir(repo)
+	}
+
+	return "https://" + repo + ".git"
+}
+
+// git runs the git command with args in dir and returns its trimmed output
+func git(dir string, args ...string) (string, error) {
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", errors.Wrapf(err, "git %s failed: %s", args[0], strings.TrimSpace(string(out)))
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,64 @@
+package builder
+
+import (
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jinzhu/inflection"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Name is a resource name in the forms used by the generated code
+	Name struct {
+		// Pascal is used for exported go identifiers and proto messages, i.e. OrderItem
+		Pascal string
+		// Camel is used for unexported go identifiers, i.e. orderItem
+		Camel string
+		// Snake is used for sql tables, proto fields and file names, i.e. order_item
+		Snake string
+		// Kebab is used for http routes, i.e. order-item
+		Kebab string
+		// Lower is used in messages, i.e. orderitem
+		Lower string
+	}
+)
+
+// NameOf returns the forms of the name n
+func NameOf(n string) Name {
+	return Name{
+		Pascal: strcase.ToCamel(n),
+		Camel:  strcase.ToLowerCamel(n),
+		Snake:  strcase.ToSnake(n),
+		Kebab:  strcase.ToKebab(n),
+		Lower:  strings.ToLower(n),
+	}
+}
+
+// Singular is the name of the resource ResourceName
+func (o *Options) Singular() Name {
+	return NameOf(o.ResourceName)
+}
+
+// Plural is the plural of the resource ResourceName. it is inflected unless it is overridden in ResourcePlurals
+func (o *Options) Plural() Name {
+	if p, ok := o.ResourcePlurals[o.ResourceName]; ok && p != "" {
+		return NameOf(p)
+	}
+
+	return NameOf(inflection.Plural(o.ResourceName))
+}
+
+// ValidatePlural checks that p can be used as the plural of the resource r
+func ValidatePlural(r, p string) error {
+
+	if !resourceNameRegEx.MatchString(p) {
+		msg := "must start with a letter and contain only letters and digits"
+		if s := SuggestResourceName(p); s != "" {
+			return errors.Errorf("invalid plural %q of resource %s. it %s. did you mean %q?", p, r, msg, s)
+		}
+		return errors.Errorf("invalid plural %q of resource %s. it %s", p, r, msg)
+	}
+
+	return nil
+}
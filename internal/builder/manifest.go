@@ -0,0 +1,252 @@
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ManifestFile is the name of the file, in the project root, that records how the project was generated
+	ManifestFile = ".servicebuilder.yaml"
+
+	// BaseDir holds a pristine copy of the generated files. It is used as the common ancestor while upgrading
+	BaseDir = ".servicebuilder/base"
+)
+
+type (
+	// Manifest records the options used to generate a project along with the hash of every generated file
+	Manifest struct {
+		ServiceBuilderVersion string    `yaml:"servicebuilderVersion"`
+		Template              string    `yaml:"template,omitempty"`
+		TemplateDir           string    `yaml:"templateDir,omitempty"`
+		CreatedAt             time.Time `yaml:"createdAt"`
+		UpdatedAt             time.Time `yaml:"updatedAt"`
+		ModuleName            string    `yaml:"moduleName"`
+		Name                  string    `yaml:"name"`
+		Description           string    `yaml:"description,omitempty"`
+		ResourceName          string    `yaml:"resourceName"`
+		ImageName             string    `yaml:"imageName"`
+		DeploymentType        string    `yaml:"deploymentType"`
+		HTTPRoutePrefix       string    `yaml:"httpRoutePrefix"`
+		DomainName            string    `yaml:"domainName"`
+		ProtocVersion         string    `yaml:"protocVersion"`
+		Resources             []string  `yaml:"resources,omitempty"`
+		// Plurals are the overridden plurals of the resources
+		Plurals map[string]string `yaml:"plurals,omitempty"`
+		// AddedResources are the resources added with add resource after the project was generated
+		AddedResources []string  `yaml:"addedResources,omitempty"`
+		Features       *Features `yaml:"features,omitempty"`
+
+		// Fields are the declared fields of each resource
+		Fields map[string][]*Field `yaml:"fields,omitempty"`
+
+		// Files maps path of each generated file to the sha256 of its generated content
+		Files map[string]string `yaml:"files"`
+	}
+)
+
+// NewManifest creates a manifest from the options and the generated files
+func NewManifest(o *Options, files []*File) *Manifest {
+
+	now := time.Now().UTC().Truncate(time.Second)
+	hashes := make(map[string]string, len(files))
+	for _, f := range files {
+		hashes[f.Path] = Hash(f.Content)
+	}
+
+	return &Manifest{
+		ServiceBuilderVersion: o.ServiceBuilderVersion,
+		Template:              o.Template,
+		TemplateDir:           o.TemplateDir,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+		ModuleName:            o.ModuleName,
+		Name:                  o.Name,
+		Description:           o.Description,
+		ResourceName:          o.ResourceName,
+		ImageName:             o.ImageName,
+		DeploymentType:        o.DeploymentType.String(),
+		HTTPRoutePrefix:       o.HTTPRoutePrefix,
+		DomainName:            o.DomainName,
+		ProtocVersion:         o.ProtocVersion,
+		Resources:             o.Resources,
+		Plurals:               o.ResourcePlurals,
+		AddedResources:        o.AddedResources,
+		Features:              &o.Features,
+		Fields:                o.ResourceFields,
+		Files:                 hashes,
+	}
+}
+
+// Hash returns the hex encoded sha256 of content
+func Hash(content []byte) string {
+	h := sha256.Sum256(content)
+	return hex.EncodeToString(h[:])
+}
+
+// ReadManifest reads the manifest from the project directory
+func ReadManifest(dir string) (*Manifest, error) {
+
+	b, err := ioutil.ReadFile(path.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{}
+	if err := yaml.UnmarshalStrict(b, m); err != nil {
+		return nil, errors.Wrapf(err, "invalid manifest %s", ManifestFile)
+	}
+
+	return m, nil
+}
+
+// Write saves the manifest in the project directory
+func (m *Manifest) Write(dir string) error {
+
+	b, err := yaml.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return WriteFile(path.Join(dir, ManifestFile), b, defaultFileMode)
+}
+
+// Options recreates the options that were used to generate the project in dir. the project is in dir
+// itself whatever the layout it was generated with
+func (m *Manifest) Options(dir string) (*Options, error) {
+
+	if m.ModuleName == "" || m.Name == "" {
+		return nil, errors.Errorf("manifest %s is missing module or service name", ManifestFile)
+	}
+
+	dtype, err := ValueOf(m.DeploymentType)
+	if err != nil {
+		return nil, err
+	}
+
+	features := DefaultFeatures()
+	if m.Features != nil {
+		features = *m.Features
+	}
+
+	resources := m.Resources
+	if len(resources) == 0 {
+		resources = []string{m.ResourceName}
+	}
+
+	fields := make(map[string][]*Field, len(m.Fields))
+	for r, fs := range m.Fields {
+		if fields[r], err = ValidateFields(fs); err != nil {
+			return nil, errors.Wrapf(err, "invalid fields of resource %s in manifest %s", r, ManifestFile)
+		}
+	}
+
+	plurals := make(map[string]string, len(m.Plurals))
+	for r, p := range m.Plurals {
+		if err := ValidatePlural(r, p); err != nil {
+			return nil, errors.Wrapf(err, "manifest %s", ManifestFile)
+		}
+		plurals[r] = p
+	}
+
+	return &Options{
+		Template:              m.Template,
+		TemplateDir:           m.TemplateDir,
+		Name:                  m.Name,
+		ModuleName:            m.ModuleName,
+		ResourceName:          m.ResourceName,
+		ImageName:             m.ImageName,
+		Description:           m.Description,
+		DstDir:                path.Clean(dir),
+		Layout:                InPlaceLayout,
+		HTTPRoutePrefix:       m.HTTPRoutePrefix,
+		DeploymentType:        dtype,
+		DomainName:            m.DomainName,
+		ProtocVersion:         m.ProtocVersion,
+		Resources:             resources,
+		ResourceFields:        fields,
+		ResourcePlurals:       plurals,
+		AddedResources:        m.AddedResources,
+		Features:              features,
+		ServiceBuilderVersion: m.ServiceBuilderVersion,
+	}, nil
+}
+
+// saveState writes the manifest and the pristine copy of the generated files in the project directory.
+// the hash and the pristine copy of the files in kept, which were not written, are retained from the
+// previous state. creation time of an existing manifest is retained
+func saveState(dir string, o *Options, files []*File, kept ...string) error {
+
+	m := NewManifest(o, files)
+	previous, err := ReadManifest(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if previous != nil && !previous.CreatedAt.IsZero() {
+		m.CreatedAt = previous.CreatedAt
+	}
+
+	for _, p := range kept {
+		delete(m.Files, p)
+		if h, ok := previous.fileHash(p); ok {
+			m.Files[p] = h
+		}
+	}
+
+	if err := m.Write(dir); err != nil {
+		return err
+	}
+
+	return writeBase(dir, files, kept)
+}
+
+// fileHash returns the hash of the generated file p. m may be nil
+func (m *Manifest) fileHash(p string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+
+	h, ok := m.Files[p]
+	return h, ok
+}
+
+// writeBase saves a pristine copy of the generated files in the project directory. the previous copy
+// of the files in kept is retained. they have none when there is no previous copy
+func writeBase(dir string, files []*File, kept []string) error {
+
+	base := path.Join(dir, BaseDir)
+	retained := map[string][]byte{}
+	for _, p := range kept {
+		b, err := readIfExists(path.Join(base, p))
+		if err != nil {
+			return err
+		}
+		retained[p] = b
+	}
+
+	if err := os.RemoveAll(base); err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		content := f.Content
+		if b, ok := retained[f.Path]; ok {
+			if b == nil {
+				continue
+			}
+			content = b
+		}
+		if err := WriteFile(path.Join(base, f.Path), content, f.Mode()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
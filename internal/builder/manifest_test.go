@@ -0,0 +1,25 @@
+package builder
+
+import (
+	"path"
+	"testing"
+)
+
+// TestManifestOptionsProjectDir checks that the options of a manifest point at the project directory
+// whatever the layout the project was generated with
+func TestManifestOptionsProjectDir(t *testing.T) {
+
+	m := &Manifest{ModuleName: "example.com/acme/svc", Name: "svc", DeploymentType: K8SManifest.String()}
+	for _, dir := range []string{"/src/example.com/acme/svc", "/src/checkout", "/src/checkout/"} {
+		o, err := m.Options(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := o.ProjectDir(); got != path.Clean(dir) {
+			t.Errorf("ProjectDir() = %s, want %s", got, path.Clean(dir))
+		}
+		if got := NextSteps(o)[0].Command; got != "cd "+path.Clean(dir) {
+			t.Errorf("first next step = %q, want cd %s", got, path.Clean(dir))
+		}
+	}
+}
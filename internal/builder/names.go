@@ -0,0 +1,224 @@
+package builder
+
+import (
+	"go/token"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+	"golang.org/x/mod/module"
+)
+
+const (
+	maxDNSLabelLength  = 63
+	maxImageNameLength = 255
+)
+
+var (
+	resourceNameRegEx = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+	dnsLabelRegEx     = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+	// image reference grammar of the OCI distribution spec. [domain[:port]/]path[:tag][@digest]
+	imageDomainRegEx    = regexp.MustCompile(`^(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*(?::[0-9]+)?$`)
+	imageComponentRegEx = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*$`)
+	imageTagRegEx       = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	imageDigestRegEx    = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}$`)
+
+	digitNames = []string{"Zero", "One", "Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine"}
+
+	// names the generated state package declares next to the resources
+	reservedResourceNames = toSet("Store", "ListRequest", "ListOption", "SortOrder")
+
+	// reserved key words of postgres that cannot be used as table or column names without quoting
+	sqlReservedWords = toSet("all", "analyse", "analyze", "and", "any", "array", "as", "asc", "asymmetric",
+		"authorization", "binary", "both", "case", "cast", "check", "collate", "collation", "column", "concurrently",
+		"constraint", "create", "cross", "current_catalog", "current_date", "current_role", "current_schema",
+		"current_time", "current_timestamp", "current_user", "default", "deferrable", "desc", "distinct", "do",
+		"else", "end", "except", "false", "fetch", "for", "foreign", "freeze", "from", "full", "grant", "group",
+		"having", "ilike", "in", "initially", "inner", "intersect", "into", "is", "isnull", "join", "lateral",
+		"leading", "left", "like", "limit", "localtime", "localtimestamp", "natural", "not", "notnull", "null",
+		"offset", "on", "only", "or", "order", "outer", "overlaps", "placing", "primary", "references", "returning",
+		"right", "select", "session_user", "similar", "some", "symmetric", "table", "tablesample", "then", "to",
+		"trailing", "true", "union", "unique", "user", "using", "variadic", "verbose", "when", "where", "window", "with")
+)
+
+// ValidateModuleName checks m against the rules of go module paths. a major version suffix must be v2 or later
+func ValidateModuleName(m string) error {
+
+	if err := module.CheckImportPath(m); err != nil {
+		return errors.Wrap(err, "invalid module name")
+	}
+
+	if v := path.Base(m); majorVersionRegEx.MatchString(v) {
+		if v == "v0" || v == "v1" {
+			return errors.Errorf("invalid module name %q. the major version suffix must be v2 or later. use %q", m, path.Dir(m))
+		}
+		if path.Dir(m) == "." {
+			return errors.Errorf("invalid module name %q. a major version suffix must follow the path of the module", m)
+		}
+	}
+
+	return nil
+}
+
+// ValidateServiceName checks that the service name n can be used as the name of the kubernetes objects,
+// i.e. it is a DNS-1123 label
+func ValidateServiceName(n string) error {
+
+	if len(n) <= maxDNSLabelLength && dnsLabelRegEx.MatchString(n) {
+		return nil
+	}
+
+	msg := "must be a DNS-1123 label of at most 63 lower case letters, digits and '-' that starts and ends with a letter or digit"
+	if s := suggestDNSLabel(n); s != "" {
+		return errors.Errorf("invalid service name %q. it names the kubernetes objects and %s. a module name ending in %q would do", n, msg, s)
+	}
+
+	return errors.Errorf("invalid service name %q. it names the kubernetes objects and %s", n, msg)
+}
+
+func suggestDNSLabel(n string) string {
+
+	s := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return unicode.ToLower(r)
+		default:
+			return '-'
+		}
+	}, n)
+	if len(s) > maxDNSLabelLength {
+		s = s[:maxDNSLabelLength]
+	}
+	s = strings.Trim(s, "-")
+
+	if !dnsLabelRegEx.MatchString(s) {
+		return ""
+	}
+
+	return s
+}
+
+// ValidateResourceName checks that the resource r can be used as the name of the go types and the proto
+// messages generated for it. r must be an exported go identifier without '_'
+func ValidateResourceName(r string) error {
+
+	if reservedResourceNames[r] {
+		return errors.Errorf("invalid resource name %q. it is declared by the generated code. use a more specific name such as %q", r, r+"Item")
+	}
+
+	if resourceNameRegEx.MatchString(r) {
+		return nil
+	}
+
+	msg := "must start with a letter and contain only letters and digits"
+	if s := SuggestResourceName(r); s != "" {
+		return errors.Errorf("invalid resource name %q. it %s. did you mean %q?", r, msg, s)
+	}
+
+	return errors.Errorf("invalid resource name %q. it %s", r, msg)
+}
+
+// SuggestResourceName returns a valid resource name that is close to r. i.e. my-resource is MyResource and
+// 2fa is TwoFa. it is empty when there is no such name
+func SuggestResourceName(r string) string {
+
+	words := strings.FieldsFunc(r, func(c rune) bool {
+		return !(c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c)))
+	})
+
+	s := strcase.ToCamel(strings.Join(words, "_"))
+	for len(s) > 0 && s[0] >= '0' && s[0] <= '9' {
+		s = digitNames[s[0]-'0'] + strings.Title(s[1:])
+	}
+
+	if !resourceNameRegEx.MatchString(s) || !token.IsIdentifier(s) || reservedResourceNames[s] {
+		return ""
+	}
+
+	return s
+}
+
+// ValidateSQLNames checks that the table and the columns of every resource are not reserved words of postgres
+func ValidateSQLNames(o *Options) error {
+
+	for _, r := range o.Resources {
+		ro := o.ForResource(r)
+
+		if t := ro.Plural().Snake; sqlReservedWords[t] {
+			return errors.Errorf("resource %q is stored in table %q which is a reserved word of postgres", r, t)
+		}
+
+		for _, f := range ro.Fields() {
+			if sqlReservedWords[f.Column()] {
+				return errors.Errorf("field %q of resource %s is stored in column %q which is a reserved word of postgres. use a more specific name such as %q",
+					f.Name, r, f.Column(), ro.Singular().Snake+"_"+f.Column())
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateImageName checks that i is an OCI image reference of the form [domain[:port]/]path[:tag][@digest]
+func ValidateImageName(i string) error {
+
+	ref := i
+	if at := strings.Index(ref, "@"); at >= 0 {
+		if !imageDigestRegEx.MatchString(ref[at+1:]) {
+			return errors.Errorf("invalid image name %q. digest %q is not of the form <algorithm>:<hex>", i, ref[at+1:])
+		}
+		ref = ref[:at]
+	}
+
+	// a tag follows the last ':' unless it is part of the domain, i.e. a port
+	if colon := strings.LastIndex(ref, ":"); colon > strings.LastIndex(ref, "/") {
+		if tag := ref[colon+1:]; !imageTagRegEx.MatchString(tag) {
+			return errors.Errorf("invalid image name %q. tag %q must be at most 128 letters, digits, '_', '.' and '-' and not start with '.' or '-'", i, tag)
+		}
+		ref = ref[:colon]
+	}
+
+	if ref == "" {
+		return errors.Errorf("invalid image name %q. missing repository", i)
+	}
+
+	if len(ref) > maxImageNameLength {
+		return errors.Errorf("invalid image name %q. the repository must be at most %d characters", i, maxImageNameLength)
+	}
+
+	components := strings.Split(ref, "/")
+	// the first component is a domain when it has a '.' or a port or is localhost
+	if first := components[0]; len(components) > 1 && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		if !imageDomainRegEx.MatchString(first) {
+			return errors.Errorf("invalid image name %q. %q is not a valid registry host", i, first)
+		}
+		components = components[1:]
+	}
+
+	for _, c := range components {
+		if imageComponentRegEx.MatchString(c) {
+			continue
+		}
+		if lc := strings.ToLower(c); lc != c && imageComponentRegEx.MatchString(lc) {
+			return errors.Errorf("invalid image name %q. repository must be lower case. use %q", i, strings.Replace(i, c, lc, 1))
+		}
+		return errors.Errorf("invalid image name %q. path component %q must be lower case letters and digits separated by '.', '_', '__' or '-'", i, c)
+	}
+
+	return nil
+}
+
+func toSet(words ...string) map[string]bool {
+	s := make(map[string]bool, len(words))
+	for _, w := range words {
+		s[w] = true
+	}
+
+	return s
+}
@@ -0,0 +1,63 @@
+package builder
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/fatih/color"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func (g *serviceBuilder) AddResource(dir, resource string, w io.Writer) error {
+
+	adder, ok := g.templateProvider.(ResourceAdder)
+	if !ok {
+		return errors.New("template provider does not support adding resources")
+	}
+
+	files, err := adder.AddResource(dir, resource)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		dst := path.Join(dir, f.Path)
+
+		status := color.YellowString("updated")
+		if _, err := os.Stat(dst); os.IsNotExist(err) {
+			status = color.GreenString("added  ")
+		}
+
+		if err := WriteFile(dst, f.Content, existingMode(dst, f.Mode())); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s %s\n", status, f.Path)
+	}
+
+	// only the files that were written are in the state of a project generated with the new resource.
+	// the others, e.g. the init migration which does not create the table of the resource, keep their
+	// previous hash and base
+	rendered, err := g.Render()
+	if err != nil {
+		return err
+	}
+	written := map[string]bool{}
+	for _, f := range files {
+		written[f.Path] = true
+	}
+	kept := []string{}
+	for _, f := range rendered {
+		if !written[f.Path] {
+			kept = append(kept, f.Path)
+		}
+	}
+	if err := saveState(dir, g.templateProvider.GetOptions(), rendered, kept...); err != nil {
+		return err
+	}
+
+	log.WithField("resource", resource).Info("resource added. run 'make gen' to regenerate the api and migrations")
+	return nil
+}
@@ -3,15 +3,20 @@ package builder
 import (
 	"bytes"
 	"fmt"
+	"go/format"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"text/template"
 
 	"github.com/fatih/color"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+
+	"github.com/cnative/servicebuilder/internal/diff"
 )
 
 const (
@@ -25,30 +30,131 @@ const (
 	UnknownDeployemntType
 )
 
+const (
+	// FlatLayout generates the project in a directory named after the service, i.e. <dst>/<name>
+	FlatLayout Layout = "flat"
+	// ModuleLayout generates the project in a directory that mirrors the module name, i.e. <dst>/<module name>
+	ModuleLayout Layout = "module"
+	// InPlaceLayout generates the project in the destination directory itself
+	InPlaceLayout Layout = "in-place"
+)
+
+const (
+	// WriteNew fails when the project directory already exists
+	WriteNew WriteMode = iota
+	// WriteForce overwrites the files of an existing project. nothing is written when any of the
+	// files that would be overwritten has local modifications
+	WriteForce
+	// WriteMerge adds the missing files to an existing project and updates the files without local
+	// modifications. files with local modifications are skipped
+	WriteMerge
+	// WriteOverwrite overwrites every file of an existing project including the ones with local modifications
+	WriteOverwrite
+	// WritePrompt asks whether to overwrite each of the files with local modifications in an existing project
+	WritePrompt
+)
+
+// ResourceToken in a template path indicates that the template is rendered once for every resource.
+// the token is replaced by the snake cased resource name
+const ResourceToken = "{resource}"
+
 type (
 
 	// DeploymentType indicates artifacts to use for deployment
 	DeploymentType int8
 
+	// Layout decides the directory of the project within the destination directory
+	Layout string
+
+	// WriteMode decides how Generate treats a project directory that already exists
+	WriteMode int8
+
+	// ConfirmFunc is asked by WritePrompt whether to overwrite the file p. existing is the content of
+	// the file with local modifications and generated is what the file would be overwritten with
+	ConfirmFunc func(p string, existing, generated []byte) (bool, error)
+
 	// Options used for Service builder
 	Options struct {
+		// Template is the name of the template provider
+		Template string
+		// TemplateDir is a directory or git repository of a template pack. it takes precedence over Template
+		TemplateDir     string
 		Name            string
 		ModuleName      string
 		ResourceName    string
 		ImageName       string
 		Description     string
 		DstDir          string
+		Layout          Layout
 		HTTPRoutePrefix string
 		DeploymentType  DeploymentType
 		DomainName      string
 
+		// Resources are the names of the CRUD resources. ResourceName is the first one
+		Resources []string
+		// ResourceFields are the declared fields of each resource. resources without declared fields use DefaultFields
+		ResourceFields map[string][]*Field
+		// ResourcePlurals overrides the inflected plural of the resources
+		ResourcePlurals map[string]string
+		// AddedResources are the resources added to the project after it was generated. their tables are
+		// created by migrations of their own rather than by the init migration
+		AddedResources []string
+		Features       Features
+
 		ProtocVersion         string
 		ServiceBuilderVersion string
 	}
 
+	// Features that can be toggled in the generated service
+	Features struct {
+		Postgres bool `json:"postgres"`
+		Gateway  bool `json:"gateway"`
+		OIDC     bool `json:"oidc"`
+	}
+
 	// ServiceBuilder that register templates can generates a service
 	ServiceBuilder interface {
-		Generate() error
+		// Generate writes all rendered files to the project directory. mode decides how an existing
+		// project directory is treated. what is written to an existing directory is reported to w.
+		// confirm is only used by WritePrompt. it returns what was done with every rendered file
+		Generate(mode WriteMode, w io.Writer, confirm ConfirmFunc) ([]*FileResult, error)
+		// Render executes all the templates and returns the rendered files sorted by path
+		Render() ([]*File, error)
+		// Verify renders all templates and checks the go sources with VerifySources
+		Verify() error
+		// DryRun renders all templates and prints the resulting file tree without writing to the destination
+		DryRun(w io.Writer, showContent bool) error
+		// Diff renders all templates and prints how they differ from the files at the destination
+		Diff(w io.Writer) error
+		// Upgrade re-renders all templates and merges them into the existing project at dir
+		Upgrade(dir string, w io.Writer, dryRun bool) error
+		// AddResource adds a CRUD resource to the existing project at dir
+		AddResource(dir, resource string, w io.Writer) error
+	}
+
+	// File is the rendered output of a template
+	File struct {
+		Path    string
+		Content []byte
+	}
+
+	// FileResult describes what Generate did with a rendered file
+	FileResult struct {
+		Path string `json:"path"`
+		// SHA256 is the hash of the rendered content, which is not what is in a skipped file
+		SHA256 string `json:"sha256"`
+		Size   int    `json:"size"`
+		Mode   string `json:"mode"`
+		// Status is one of added, updated, overwritten, skipped or unchanged. it is empty for a dry run
+		Status string `json:"status,omitempty"`
+		// Content is only set for a file that is not written to the file system
+		Content string `json:"content,omitempty"`
+	}
+
+	// NextStep is a command to run in the generated project
+	NextStep struct {
+		Command     string `json:"command"`
+		Description string `json:"description,omitempty"`
 	}
 
 	// TemplateProvider for service builder
@@ -57,82 +163,348 @@ type (
 		GetTemplates() map[string]*template.Template
 	}
 
+	// ResourceAdder is implemented by template providers that can add a resource to an existing project.
+	// AddResource returns the new and the edited files and appends the resource to the options
+	ResourceAdder interface {
+		AddResource(dir, resource string) ([]*File, error)
+	}
+
 	serviceBuilder struct {
-		tmpDirPath       string
 		templateProvider TemplateProvider
 	}
 )
 
 //New ServiceBuilder with a given template provider
 func New(templateProvider TemplateProvider) (ServiceBuilder, error) {
-	tempDirPath, err := ioutil.TempDir("", "servicebuilder")
-	if err != nil {
-		return nil, err
-	}
-	log.WithField("dir", tempDirPath).Debugf("temp folder created")
 
 	return &serviceBuilder{
-		tmpDirPath:       tempDirPath,
 		templateProvider: templateProvider,
 	}, nil
 }
 
-func (g *serviceBuilder) Generate() error {
+// Render executes all the templates and returns the rendered files sorted by path
+func (g *serviceBuilder) Render() ([]*File, error) {
 
 	if g.templateProvider == nil {
-		return errors.New("builder not initialized")
+		return nil, errors.New("builder not initialized")
 	}
 
 	tmplts := g.templateProvider.GetTemplates()
 	options := g.templateProvider.GetOptions()
 
+	files := []*File{}
 	for k, v := range tmplts {
-		p := path.Join(g.tmpDirPath, path.Dir(k))
-		if err := os.MkdirAll(p, os.ModePerm); err != nil {
-			return err
+		if !strings.Contains(k, ResourceToken) {
+			f, err := render(k, v, options)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, f)
+			continue
 		}
 
-		var sink bytes.Buffer
-		if err := v.Execute(&sink, options); err != nil {
-			return err
+		for _, r := range options.Resources {
+			ro := options.ForResource(r)
+			f, err := render(strings.Replace(k, ResourceToken, ro.Singular().Snake, -1), v, ro)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, f)
 		}
-		f, err := os.Create(path.Join(g.tmpDirPath, k))
-		if err != nil {
-			log.WithError(err).Fatal("error while creating file")
-			return err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	return files, nil
+}
+
+func render(p string, t *template.Template, data interface{}) (*File, error) {
+
+	var sink bytes.Buffer
+	if err := t.Execute(&sink, data); err != nil {
+		return nil, errors.Wrapf(err, "unable to render %s", p)
+	}
+
+	return &File{Path: p, Content: FormatSource(p, sink.Bytes())}, nil
+}
+
+// FormatSource formats content of go source file p. content of other files and
+// sources that cannot be parsed are returned as is
+func FormatSource(p string, content []byte) []byte {
+	if path.Ext(p) != ".go" {
+		return content
+	}
+
+	b, err := format.Source(content)
+	if err != nil {
+		log.WithError(err).WithField("file", p).Debug("unable to format")
+		return content
+	}
+
+	return b
+}
+
+func (g *serviceBuilder) Generate(mode WriteMode, w io.Writer, confirm ConfirmFunc) ([]*FileResult, error) {
+
+	files, err := g.Render()
+	if err != nil {
+		return nil, err
+	}
+	options := g.templateProvider.GetOptions()
+	dir := options.ProjectDir()
+
+	statuses := make([]fileStatus, len(files))
+	fi, err := os.Stat(dir)
+	switch {
+	case os.IsNotExist(err):
+		err = create(dir, options, files)
+		for i := range statuses {
+			statuses[i] = statusAdded
 		}
-		defer f.Close()
-		if _, err := f.Write(sink.Bytes()); err != nil {
+	case err != nil:
+		return nil, err
+	case !fi.IsDir():
+		return nil, errors.Errorf("%s already exists and is not a directory", dir)
+	case mode == WriteNew:
+		return nil, errors.Errorf("directory %s already exists", dir)
+	default:
+		statuses, err = writeInto(dir, options, files, mode, w, confirm)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Info("generation done")
+
+	results := make([]*FileResult, len(files))
+	for i, f := range files {
+		results[i] = &FileResult{
+			Path:   f.Path,
+			SHA256: Hash(f.Content),
+			Size:   len(f.Content),
+			Mode:   fmt.Sprintf("%04o", f.Mode()),
+			Status: statuses[i].Name(),
+		}
+	}
+
+	return results, nil
+}
+
+// NextSteps are the commands to build and run the generated project
+func NextSteps(o *Options) []*NextStep {
+
+	return []*NextStep{
+		{Command: fmt.Sprintf("cd %s", o.ProjectDir())},
+		{Command: "make install-deptools", Description: "Download dependent tools. dep, protoc and protoc plugins"},
+		{Command: "make clean build", Description: "build"},
+		{Command: fmt.Sprintf("./bin/%s", o.Name)},
+	}
+}
+
+// create renders files into a temp directory that is moved to dir once every file is written, so dir
+// either does not exist or has the complete project
+func create(dir string, options *Options, files []*File) error {
+
+	tmpDirPath, err := ioutil.TempDir("", "servicebuilder")
+	if err != nil {
+		return err
+	}
+	// the partially generated project is removed on failure. it no longer exists once it is moved
+	defer os.RemoveAll(tmpDirPath)
+	log.WithField("dir", tmpDirPath).Debugf("temp folder created")
+
+	if err := os.Chmod(tmpDirPath, defaultDirMode); err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if err := WriteFile(path.Join(tmpDirPath, f.Path), f.Content, f.Mode()); err != nil {
+			log.WithError(err).Error("error while creating file")
 			return err
 		}
 	}
 
-	if err := os.MkdirAll(options.DstDir, os.ModePerm); err != nil {
+	if err := saveState(tmpDirPath, options, files); err != nil {
 		return err
 	}
 
-	dir := path.Join(options.DstDir, options.Name)
-	if err := os.Rename(g.tmpDirPath, dir); err != nil {
+	if err := os.MkdirAll(path.Dir(dir), os.ModePerm); err != nil {
 		return err
 	}
-	log.Info("generation done")
 
-	f := color.GreenString(`
-	cd %s
-	
-	# Download dependent tools. dep, protoc and protoc plugins
-	make install-deptools
+	return moveDir(tmpDirPath, dir)
+}
+
+func (g *serviceBuilder) Verify() error {
+
+	files, err := g.Render()
+	if err != nil {
+		return err
+	}
+
+	return VerifySources(files)
+}
+
+func (g *serviceBuilder) DryRun(w io.Writer, showContent bool) error {
+
+	files, err := g.Render()
+	if err != nil {
+		return err
+	}
+	options := g.templateProvider.GetOptions()
+
+	fmt.Fprintln(w, color.HiWhiteString("%s (dry run)", options.ProjectDir()))
+
+	total := 0
+	printed := map[string]bool{}
+	for _, f := range files {
+		// print parent directories that are not printed yet
+		dirs := strings.Split(path.Dir(f.Path), "/")
+		for i := range dirs {
+			d := strings.Join(dirs[:i+1], "/")
+			if d == "." || printed[d] {
+				continue
+			}
+			printed[d] = true
+			fmt.Fprintf(w, "%s%s/\n", strings.Repeat("    ", i+1), color.CyanString(dirs[i]))
+		}
+
+		depth := strings.Count(f.Path, "/") + 1
+		fmt.Fprintf(w, "%s%s  %s\n", strings.Repeat("    ", depth), path.Base(f.Path), color.YellowString("%d bytes", len(f.Content)))
+		total += len(f.Content)
+	}
+	fmt.Fprintf(w, "\n%d files, %d bytes\n", len(files), total)
+
+	if !showContent {
+		return nil
+	}
+
+	for _, f := range files {
+		fmt.Fprintf(w, "\n%s\n", color.HiWhiteString("==> %s <==", f.Path))
+		if _, err := w.Write(f.Content); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	}
+
+	return nil
+}
+
+func (g *serviceBuilder) Diff(w io.Writer) error {
+
+	files, err := g.Render()
+	if err != nil {
+		return err
+	}
+	dir := g.templateProvider.GetOptions().ProjectDir()
+
+	for _, f := range files {
+		existing, err := ioutil.ReadFile(path.Join(dir, f.Path))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		switch {
+		case os.IsNotExist(err):
+			fmt.Fprintf(w, "%s %s\n", color.GreenString("new      "), f.Path)
+		case bytes.Equal(existing, f.Content):
+			fmt.Fprintf(w, "%s %s\n", color.WhiteString("unchanged"), f.Path)
+		default:
+			fmt.Fprintf(w, "%s %s\n", color.YellowString("modified "), f.Path)
+			fmt.Fprint(w, diff.Unified(path.Join("a", f.Path), path.Join("b", f.Path), string(existing), string(f.Content), 3))
+		}
+	}
+
+	return nil
+}
 
-	# build 
-	make clean build
+// DefaultFeatures enables all features
+func DefaultFeatures() Features {
+	return Features{Postgres: true, Gateway: true, OIDC: true}
+}
 
-	./bin/%s`)
-	fmt.Printf(f, dir, options.Name)
-	fmt.Println()
+// ForResource returns a copy of the options in which ResourceName is r
+func (o *Options) ForResource(r string) *Options {
+	c := *o
+	c.ResourceName = r
+	return &c
+}
+
+// InitResources are the resources whose tables are created by the init migration
+func (o *Options) InitResources() []string {
+	added := make(map[string]bool, len(o.AddedResources))
+	for _, r := range o.AddedResources {
+		added[r] = true
+	}
+
+	resources := []string{}
+	for _, r := range o.Resources {
+		if !added[r] {
+			resources = append(resources, r)
+		}
+	}
+
+	return resources
+}
+
+// Fields of the resource ResourceName
+func (o *Options) Fields() []*Field {
+	if fields, ok := o.ResourceFields[o.ResourceName]; ok && len(fields) > 0 {
+		return fields
+	}
+
+	return DefaultFields()
+}
+
+// Field returns the field of resource ResourceName stored in column. nil if there is no such field
+func (o *Options) Field(column string) *Field {
+	for _, f := range o.Fields() {
+		if f.Column() == column {
+			return f
+		}
+	}
 
 	return nil
 }
 
+// RequiredFields of the resource ResourceName
+func (o *Options) RequiredFields() []*Field {
+	fields := []*Field{}
+	for _, f := range o.Fields() {
+		if f.Required {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// ProjectDir is the directory in which the project is generated. it depends on the Layout
+func (o *Options) ProjectDir() string {
+	switch o.Layout {
+	case ModuleLayout:
+		return path.Join(o.DstDir, o.ModuleName)
+	case InPlaceLayout:
+		return path.Clean(o.DstDir)
+	}
+
+	return path.Join(o.DstDir, o.Name)
+}
+
+// LayoutOf returns the typed Layout. an empty string is the FlatLayout
+func LayoutOf(l string) (Layout, error) {
+
+	switch Layout(strings.ToLower(l)) {
+	case FlatLayout, "":
+		return FlatLayout, nil
+	case ModuleLayout:
+		return ModuleLayout, nil
+	default:
+		return "", errors.Errorf("unknown layout %q. possible values [module, flat]", l)
+	}
+}
+
 func (d DeploymentType) String() string {
 
 	switch d {
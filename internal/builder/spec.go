@@ -0,0 +1,188 @@
+package builder
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// Spec is a declarative description of the project to generate. It is read from a YAML or JSON file
+	Spec struct {
+		Template        string   `yaml:"template"`
+		TemplateDir     string   `yaml:"templateDir"`
+		ModuleName      string   `yaml:"moduleName"`
+		Description     string   `yaml:"description"`
+		ImageName       string   `yaml:"imageName"`
+		ProtocVersion   string   `yaml:"protocVersion"`
+		HTTPRoutePrefix string   `yaml:"httpRoutePrefix"`
+		DeploymentType  string   `yaml:"deploymentType"`
+		DomainName      string   `yaml:"domainName"`
+		Path            string   `yaml:"path"`
+		Layout          string   `yaml:"layout"`
+		Resources       []string `yaml:"resources"`
+		// Plurals override the inflected plural of the resources keyed by resource name
+		Plurals  map[string]string `yaml:"plurals"`
+		Features *FeaturesSpec     `yaml:"features"`
+
+		// Fields of the resources keyed by resource name. a field is either of the form
+		// name:type[:modifier...] or a map with name, type, nullable, unique, index and required
+		Fields map[string][]*Field `yaml:"fields"`
+
+		file string
+	}
+
+	// FeaturesSpec toggles optional features of the generated service. Unset features are enabled
+	FeaturesSpec struct {
+		Postgres *bool `yaml:"postgres"`
+		Gateway  *bool `yaml:"gateway"`
+		OIDC     *bool `yaml:"oidc"`
+	}
+)
+
+var (
+	protocVersionRegEx = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
+)
+
+// LoadSpec reads and validates the project spec in file
+func LoadSpec(file string) (*Spec, error) {
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Spec{file: file}
+	// JSON is a subset of YAML so both formats are handled by the YAML decoder
+	if err := yaml.UnmarshalStrict(b, s); err != nil {
+		return nil, errors.Wrapf(err, "%s: invalid spec", file)
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Validate checks every field that is set in the spec
+func (s *Spec) Validate() error {
+
+	if s.ModuleName != "" && strings.Trim(strings.TrimSpace(s.ModuleName), "/") == "" {
+		return s.fieldError("moduleName", "must not be blank")
+	}
+	if s.ModuleName != "" {
+		if err := ValidateModuleName(strings.Trim(strings.TrimSpace(s.ModuleName), "/")); err != nil {
+			return s.fieldError("moduleName", "%v", err)
+		}
+	}
+
+	if s.DeploymentType != "" {
+		if _, err := ValueOf(s.DeploymentType); err != nil {
+			return s.fieldError("deploymentType", "unknown deployment type %q. possible values [helm, k8s]", s.DeploymentType)
+		}
+	}
+
+	if _, err := LayoutOf(s.Layout); err != nil {
+		return s.fieldError("layout", "%v", err)
+	}
+
+	if s.HTTPRoutePrefix != "" && !strings.HasPrefix(s.HTTPRoutePrefix, "/") {
+		return s.fieldError("httpRoutePrefix", "%q must start with '/'", s.HTTPRoutePrefix)
+	}
+
+	if s.ProtocVersion != "" && !protocVersionRegEx.MatchString(s.ProtocVersion) {
+		return s.fieldError("protocVersion", "%q is not of the form <major>.<minor>.<patch>", s.ProtocVersion)
+	}
+
+	if s.ImageName != "" {
+		if err := ValidateImageName(s.ImageName); err != nil {
+			return s.fieldError("imageName", "%v", err)
+		}
+	}
+
+	seen := map[string]int{}
+	for i, r := range s.Resources {
+		field := fmt.Sprintf("resources[%d]", i)
+		if strings.TrimSpace(r) == "" {
+			return s.fieldError(field, "resource name must not be empty")
+		}
+		if err := ValidateResourceName(strings.Title(strings.TrimSpace(r))); err != nil {
+			return s.fieldError(field, "%v", err)
+		}
+		key := strings.ToLower(r)
+		if j, ok := seen[key]; ok {
+			return s.fieldError(field, "duplicate resource %q. already declared at resources[%d]", r, j)
+		}
+		seen[key] = i
+	}
+
+	for r, p := range s.Plurals {
+		field := fmt.Sprintf("plurals.%s", r)
+		if _, ok := seen[strings.ToLower(r)]; len(s.Resources) > 0 && !ok {
+			return s.fieldError(field, "resource %q is not declared in resources", r)
+		}
+		if err := ValidatePlural(r, strings.Title(strings.TrimSpace(p))); err != nil {
+			return s.fieldError(field, "%v", err)
+		}
+	}
+
+	for r, fields := range s.Fields {
+		field := fmt.Sprintf("fields.%s", r)
+		if _, ok := seen[strings.ToLower(r)]; len(s.Resources) > 0 && !ok {
+			return s.fieldError(field, "resource %q is not declared in resources", r)
+		}
+		if _, err := ValidateFields(fields); err != nil {
+			return s.fieldError(field, "%v", err)
+		}
+	}
+
+	return nil
+}
+
+// ResourceFields returns the fields declared for resource r
+func (s *Spec) ResourceFields(r string) []*Field {
+	for k, fields := range s.Fields {
+		if strings.EqualFold(k, r) {
+			return fields
+		}
+	}
+
+	return nil
+}
+
+// ResourcePlural returns the plural declared for resource r. empty if it is not declared
+func (s *Spec) ResourcePlural(r string) string {
+	for k, p := range s.Plurals {
+		if strings.EqualFold(k, r) {
+			return strings.Title(strings.TrimSpace(p))
+		}
+	}
+
+	return ""
+}
+
+// ApplyFeatures overrides the features that are set in the spec
+func (s *Spec) ApplyFeatures(f *Features) {
+	if s.Features == nil {
+		return
+	}
+
+	if s.Features.Postgres != nil {
+		f.Postgres = *s.Features.Postgres
+	}
+	if s.Features.Gateway != nil {
+		f.Gateway = *s.Features.Gateway
+	}
+	if s.Features.OIDC != nil {
+		f.OIDC = *s.Features.OIDC
+	}
+}
+
+func (s *Spec) fieldError(field, format string, args ...interface{}) error {
+	return errors.Errorf("%s: %s: %s", s.file, field, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,166 @@
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/fatih/color"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cnative/servicebuilder/internal/diff"
+)
+
+const (
+	statusUnchanged fileStatus = iota
+	statusAdded
+	statusUpdated
+	statusMerged
+	statusConflict
+	statusSkipped
+	statusOverwritten
+)
+
+type fileStatus int8
+
+// Name of the status without color or padding
+func (s fileStatus) Name() string {
+	switch s {
+	case statusUnchanged:
+		return "unchanged"
+	case statusAdded:
+		return "added"
+	case statusUpdated:
+		return "updated"
+	case statusMerged:
+		return "merged"
+	case statusConflict:
+		return "conflict"
+	case statusOverwritten:
+		return "overwritten"
+	default:
+		return "skipped"
+	}
+}
+
+func (s fileStatus) String() string {
+	switch s {
+	case statusUnchanged:
+		return color.WhiteString("unchanged")
+	case statusAdded:
+		return color.GreenString("added    ")
+	case statusUpdated:
+		return color.GreenString("updated  ")
+	case statusMerged:
+		return color.CyanString("merged   ")
+	case statusConflict:
+		return color.RedString("conflict ")
+	case statusOverwritten:
+		return color.MagentaString("overwrote")
+	default:
+		return color.YellowString("skipped  ")
+	}
+}
+
+func (g *serviceBuilder) Upgrade(dir string, w io.Writer, dryRun bool) error {
+
+	files, err := g.Render()
+	if err != nil {
+		return err
+	}
+	options := g.templateProvider.GetOptions()
+
+	labels := diff.MergeLabels{
+		Ours:   "local",
+		Base:   "base",
+		Theirs: fmt.Sprintf("servicebuilder %s", options.ServiceBuilderVersion),
+	}
+
+	previous, err := ReadManifest(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	conflicts := 0
+	for _, f := range files {
+		dst := path.Join(dir, f.Path)
+
+		ours, err := readIfExists(dst)
+		if err != nil {
+			return err
+		}
+		base, err := readIfExists(path.Join(dir, BaseDir, f.Path))
+		if err != nil {
+			return err
+		}
+
+		status := statusUnchanged
+		content := f.Content
+		switch {
+		case ours == nil && base != nil:
+			status = statusSkipped // removed locally after generation
+		case ours == nil:
+			status = statusAdded
+		case bytes.Equal(ours, f.Content):
+			status = statusUnchanged
+		case base != nil && bytes.Equal(ours, base):
+			status = statusUpdated
+		case base == nil && previous != nil && previous.Files[f.Path] == Hash(ours):
+			status = statusUpdated // no pristine copy, but the manifest shows it was not modified locally
+		case base != nil && bytes.Equal(base, f.Content):
+			status = statusUnchanged // template did not change. retain local modifications
+		default:
+			var merged string
+			var n int
+			if base != nil {
+				merged, n = diff.Merge3(string(base), string(ours), string(f.Content), labels)
+			} else {
+				merged, n = diff.Merge2(string(ours), string(f.Content), labels)
+			}
+			content = []byte(merged)
+			status = statusMerged
+			if n > 0 {
+				status = statusConflict
+				conflicts += n
+			}
+		}
+
+		fmt.Fprintf(w, "%s %s\n", status, f.Path)
+		if dryRun || status == statusUnchanged || status == statusSkipped {
+			continue
+		}
+
+		if err := WriteFile(dst, content, existingMode(dst, f.Mode())); err != nil {
+			return err
+		}
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	if err := saveState(dir, options, files); err != nil {
+		return err
+	}
+
+	if conflicts > 0 {
+		log.WithField("conflicts", conflicts).Warn("upgrade done. resolve the conflict markers before building")
+		return nil
+	}
+	log.Info("upgrade done")
+
+	return nil
+}
+
+func readIfExists(p string) ([]byte, error) {
+
+	b, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return b, err
+}
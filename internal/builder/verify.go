@@ -0,0 +1,155 @@
+package builder
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	majorVersionRegEx = regexp.MustCompile(`^v[0-9]+$`)
+)
+
+// VerifySources checks the go sources in files. Every source must parse and import exactly the packages it
+// refers to. The problems of all the files are returned in one error
+func VerifySources(files []*File) error {
+
+	fs := token.NewFileSet()
+	packages := map[string][]*ast.File{}
+	problems := []string{}
+	for _, f := range files {
+		if path.Ext(f.Path) != ".go" {
+			continue
+		}
+
+		af, err := parser.ParseFile(fs, f.Path, f.Content, parser.AllErrors)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		pkg := path.Dir(f.Path) + ":" + af.Name.Name
+		packages[pkg] = append(packages[pkg], af)
+	}
+
+	names := make([]string, 0, len(packages))
+	for pkg := range packages {
+		names = append(names, pkg)
+	}
+	sort.Strings(names)
+
+	for _, pkg := range names {
+		decls := packageDecls(packages[pkg])
+		for _, af := range packages[pkg] {
+			problems = append(problems, checkImports(fs, af, decls)...)
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.Errorf("%d problems in the generated go sources\n%s", len(problems), strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+// packageDecls are the names declared at the package level of the files of a package
+func packageDecls(files []*ast.File) map[string]bool {
+
+	decls := map[string]bool{}
+	for _, f := range files {
+		for name := range f.Scope.Objects {
+			decls[name] = true
+		}
+	}
+
+	return decls
+}
+
+// checkImports reports the qualified identifiers of f that refer to packages that are not imported
+// and the imports that are not used. decls are the package level names declared in other files
+func checkImports(fs *token.FileSet, f *ast.File, decls map[string]bool) []string {
+
+	imports := map[string]*ast.ImportSpec{}
+	guessed := true
+	for _, is := range f.Imports {
+		p, _ := strconv.Unquote(is.Path.Value)
+		name := importName(p)
+		if is.Name != nil {
+			name = is.Name.Name
+		}
+
+		switch name {
+		case "_":
+		case ".", "":
+			// the names that the import brings into scope are not known
+			guessed = false
+		default:
+			imports[name] = is
+		}
+	}
+
+	unresolved := map[*ast.Ident]bool{}
+	for _, id := range f.Unresolved {
+		unresolved[id] = true
+	}
+
+	problems := []string{}
+	used := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		se, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		id, ok := se.X.(*ast.Ident)
+		if !ok || !unresolved[id] {
+			return true
+		}
+
+		switch _, imported := imports[id.Name]; {
+		case imported:
+			used[id.Name] = true
+		case guessed && !decls[id.Name]:
+			problems = append(problems, fmt.Sprintf("%s: undefined: %s", fs.Position(id.Pos()), id.Name))
+		}
+
+		return true
+	})
+
+	for name, is := range imports {
+		if !used[name] {
+			problems = append(problems, fmt.Sprintf("%s: %s imported and not used", fs.Position(is.Pos()), is.Path.Value))
+		}
+	}
+	sort.Strings(problems)
+
+	return problems
+}
+
+// importName guesses the name of the package imported with path p from the last element of p.
+// it is empty when the last element is not a valid package name
+func importName(p string) string {
+
+	name := path.Base(p)
+	if majorVersionRegEx.MatchString(name) {
+		name = path.Base(path.Dir(p))
+	}
+	if strings.HasPrefix(p, "gopkg.in/") {
+		name = strings.SplitN(name, ".", 2)[0]
+	}
+	name = strings.TrimPrefix(name, "go-")
+
+	if !token.IsIdentifier(name) {
+		return ""
+	}
+
+	return name
+}
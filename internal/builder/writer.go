@@ -0,0 +1,132 @@
+package builder
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultFileMode    os.FileMode = 0644
+	executableFileMode os.FileMode = 0755
+	defaultDirMode     os.FileMode = 0755
+)
+
+// Mode of the generated file. scripts, i.e. files that start with a #! line, are executable
+func (f *File) Mode() os.FileMode {
+	if bytes.HasPrefix(f.Content, []byte("#!")) {
+		return executableFileMode
+	}
+
+	return defaultFileMode
+}
+
+// WriteFile writes content to the file p. The content is written to a temp file in the directory of p
+// which is synced and then renamed to p, so p is either left as it was or has the complete content.
+// The temp file is removed on failure. mode is applied regardless of the umask
+func WriteFile(p string, content []byte, mode os.FileMode) (err error) {
+
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(p)+".")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(content); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(mode); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
+
+// existingMode returns the mode of the file p or mode when p does not exist. local changes to the mode
+// of a generated file are retained when the file is rewritten
+func existingMode(p string, mode os.FileMode) os.FileMode {
+	if fi, err := os.Stat(p); err == nil {
+		return fi.Mode().Perm()
+	}
+
+	return mode
+}
+
+// moveDir moves the directory src to dst. A directory cannot be renamed across file systems, in which
+// case src is copied into a temp directory next to dst that is renamed to dst once every file is synced
+func moveDir(src, dst string) error {
+
+	err := os.Rename(src, dst)
+	if err == nil || !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+	log.WithFields(log.Fields{"src": src, "dst": dst}).Debug("unable to rename across file systems. copying")
+
+	staging, err := ioutil.TempDir(filepath.Dir(dst), "."+filepath.Base(dst)+".")
+	if err != nil {
+		return err
+	}
+
+	if err := copyDir(src, staging); err != nil {
+		os.RemoveAll(staging)
+		return err
+	}
+
+	if err := os.Rename(staging, dst); err != nil {
+		os.RemoveAll(staging)
+		return err
+	}
+
+	return os.RemoveAll(src)
+}
+
+// copyDir copies the files in src to dst retaining their modes
+func copyDir(src, dst string) error {
+
+	return filepath.Walk(src, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if fi.IsDir() {
+			if err := os.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+			return os.Chmod(target, fi.Mode().Perm())
+		}
+
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		return WriteFile(target, b, fi.Mode().Perm())
+	})
+}
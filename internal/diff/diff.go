@@ -0,0 +1,238 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// Equal line is present in both a and b
+	Equal OpKind = iota
+	// Delete line is present only in a
+	Delete
+	// Insert line is present only in b
+	Insert
+)
+
+type (
+	// OpKind indicates the kind of edit operation
+	OpKind int8
+
+	// Op is a single line level edit operation
+	Op struct {
+		Kind OpKind
+		Line string
+	}
+)
+
+// Lines splits text into lines. The line terminator is retained, so the last line of a text without a final
+// newline differs from the same line followed by one and the missing newline survives a merge
+func Lines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+
+	l := strings.SplitAfter(s, "\n")
+	if l[len(l)-1] == "" {
+		l = l[:len(l)-1]
+	}
+
+	return l
+}
+
+// Compute returns the line edit script that transforms a into b. It uses the algorithm of Myers which takes
+// O((n+m)d) time and O(d²) memory for d differing lines after the common prefix and suffix are trimmed
+func Compute(a, b []string) []Op {
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]Op, 0, len(a)+len(b)-prefix-suffix)
+	for _, l := range a[:prefix] {
+		ops = append(ops, Op{Kind: Equal, Line: l})
+	}
+	ops = append(ops, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		ops = append(ops, Op{Kind: Equal, Line: l})
+	}
+
+	return ops
+}
+
+// myers returns the shortest edit script of a and b
+func myers(a, b []string) []Op {
+
+	n, m := len(a), len(b)
+
+	// trace[d][k+d] holds the furthest x reached on diagonal k = x - y with d edits
+	trace := [][]int{}
+	v := []int{0}
+	for d := 0; ; d++ {
+		next := make([]int, 2*d+1)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			switch {
+			case d == 0:
+				x = 0
+			case k == -d || k != d && v[k-1+d-1] < v[k+1+d-1]:
+				x = v[k+1+d-1]
+			default:
+				x = v[k-1+d-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			next[k+d] = x
+		}
+		trace = append(trace, next)
+		v = next
+
+		if k := n - m; k >= -d && k <= d && (k+d)%2 == 0 && v[k+d] >= n {
+			break
+		}
+	}
+
+	// walk back from the end and collect the ops in reverse
+	ops := make([]Op, 0, n+m)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		k := x - y
+		prevX, prevY := 0, 0
+		if d > 0 {
+			prev := trace[d-1]
+			prevK := k - 1
+			if k == -d || k != d && prev[k-1+d-1] < prev[k+1+d-1] {
+				prevK = k + 1
+			}
+			prevX = prev[prevK+d-1]
+			prevY = prevX - prevK
+		}
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, Op{Kind: Equal, Line: a[x]})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			y--
+			ops = append(ops, Op{Kind: Insert, Line: b[y]})
+		} else {
+			x--
+			ops = append(ops, Op{Kind: Delete, Line: a[x]})
+		}
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	return ops
+}
+
+// Unified returns a unified diff of a and b with given number of context lines.
+// empty string is returned if a and b are identical
+func Unified(aName, bName, a, b string, context int) string {
+
+	ops := Compute(Lines(a), Lines(b))
+
+	changed := false
+	for _, op := range ops {
+		if op.Kind != Equal {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", aName, bName))
+
+	// line numbers (0 based) in a and b at the start of each op
+	aLine := make([]int, len(ops)+1)
+	bLine := make([]int, len(ops)+1)
+	for k, op := range ops {
+		aLine[k+1], bLine[k+1] = aLine[k], bLine[k]
+		if op.Kind != Insert {
+			aLine[k+1]++
+		}
+		if op.Kind != Delete {
+			bLine[k+1]++
+		}
+	}
+
+	for k := 0; k < len(ops); {
+		if ops[k].Kind == Equal {
+			k++
+			continue
+		}
+
+		// extend the hunk while changes are within 2*context lines of each other
+		start := k - context
+		if start < 0 {
+			start = 0
+		}
+		end := k
+		for end < len(ops) {
+			if ops[end].Kind != Equal {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].Kind == Equal {
+				run++
+			}
+			if run == len(ops) || run-end > 2*context {
+				break
+			}
+			end = run
+		}
+		stop := end + context
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+
+		sb.WriteString(fmt.Sprintf("@@ -%s +%s @@\n",
+			hunkRange(aLine[start], aLine[stop]-aLine[start]),
+			hunkRange(bLine[start], bLine[stop]-bLine[start])))
+		for _, op := range ops[start:stop] {
+			switch op.Kind {
+			case Equal:
+				sb.WriteString(" ")
+			case Delete:
+				sb.WriteString("-")
+			case Insert:
+				sb.WriteString("+")
+			}
+			sb.WriteString(op.Line)
+			if !strings.HasSuffix(op.Line, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		k = stop
+	}
+
+	return sb.String()
+}
+
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
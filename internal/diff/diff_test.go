@@ -0,0 +1,84 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		// edits is the number of inserted and deleted lines of the shortest edit script
+		edits int
+	}{
+		{name: "empty", a: "", b: "", edits: 0},
+		{name: "insert all", a: "", b: "a\nb\n", edits: 2},
+		{name: "delete all", a: "a\nb\n", b: "", edits: 2},
+		{name: "equal", a: "a\nb\nc\n", b: "a\nb\nc\n", edits: 0},
+		{name: "replace", a: "a\nb\nc\n", b: "a\nx\nc\n", edits: 2},
+		{name: "move", a: "a\nb\nc\nd\n", b: "b\nc\nd\na\n", edits: 2},
+		{name: "interleaved", a: "a\nb\nc\na\nb\nb\na\n", b: "c\nb\na\nb\na\nc\n", edits: 5},
+		{name: "missing final newline", a: "a\nb", b: "a\nb\n", edits: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := Compute(Lines(tt.a), Lines(tt.b))
+
+			var a, b strings.Builder
+			edits := 0
+			for _, op := range ops {
+				if op.Kind != Insert {
+					a.WriteString(op.Line)
+				}
+				if op.Kind != Delete {
+					b.WriteString(op.Line)
+				}
+				if op.Kind != Equal {
+					edits++
+				}
+			}
+			if a.String() != tt.a || b.String() != tt.b {
+				t.Errorf("ops do not transform %q into %q: %v", tt.a, tt.b, ops)
+			}
+			if edits != tt.edits {
+				t.Errorf("edits = %d, want %d", edits, tt.edits)
+			}
+		})
+	}
+}
+
+func TestUnified(t *testing.T) {
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{name: "identical", a: "a\nb\n", b: "a\nb\n", want: ""},
+		{
+			name: "changed line",
+			a:    "a\nb\nc\n",
+			b:    "a\nx\nc\n",
+			want: "--- a\n+++ b\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			name: "missing final newline",
+			a:    "a\nb\n",
+			b:    "a\nb",
+			want: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n+b\n\\ No newline at end of file\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("a", "b", tt.a, tt.b, 3); got != tt.want {
+				t.Errorf("Unified() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
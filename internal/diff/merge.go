@@ -0,0 +1,149 @@
+package diff
+
+import (
+	"strings"
+)
+
+// MergeLabels used to annotate conflict markers
+type MergeLabels struct {
+	Ours   string
+	Base   string
+	Theirs string
+}
+
+// Merge3 performs a three way line merge of ours and theirs, which were both derived from base.
+// Non overlapping changes from both sides are combined. Overlapping changes that are not identical
+// are emitted with diff3 style conflict markers. The number of conflicts is returned along with the merged text
+func Merge3(base, ours, theirs string, labels MergeLabels) (string, int) {
+
+	b, o, t := Lines(base), Lines(ours), Lines(theirs)
+	matchO := matches(b, o)
+	matchT := matches(b, t)
+
+	var sb strings.Builder
+	conflicts := 0
+	i, jo, jt := 0, 0, 0
+	for {
+		// next base line that is retained by both ours and theirs
+		l := i
+		for l < len(b) && (matchO[l] < 0 || matchT[l] < 0) {
+			l++
+		}
+
+		eo, et := len(o), len(t)
+		if l < len(b) {
+			eo, et = matchO[l], matchT[l]
+		}
+
+		cb, co, ct := b[i:l], o[jo:eo], t[jt:et]
+		switch {
+		case equal(co, cb):
+			writeLines(&sb, ct)
+		case equal(ct, cb), equal(co, ct):
+			writeLines(&sb, co)
+		default:
+			conflicts++
+			sb.WriteString("<<<<<<< " + labels.Ours + "\n")
+			writeConflict(&sb, co)
+			sb.WriteString("||||||| " + labels.Base + "\n")
+			writeConflict(&sb, cb)
+			sb.WriteString("=======\n")
+			writeConflict(&sb, ct)
+			sb.WriteString(">>>>>>> " + labels.Theirs + "\n")
+		}
+
+		if l == len(b) {
+			break
+		}
+
+		writeLines(&sb, b[l:l+1])
+		i, jo, jt = l+1, eo+1, et+1
+	}
+
+	return sb.String(), conflicts
+}
+
+// Merge2 combines ours and theirs when the common base is not known.
+// Lines common to both are retained and every differing region is emitted as a conflict
+func Merge2(ours, theirs string, labels MergeLabels) (string, int) {
+
+	var sb strings.Builder
+	conflicts := 0
+	co, ct := []string{}, []string{}
+	flush := func() {
+		if len(co) == 0 && len(ct) == 0 {
+			return
+		}
+		conflicts++
+		sb.WriteString("<<<<<<< " + labels.Ours + "\n")
+		writeConflict(&sb, co)
+		sb.WriteString("=======\n")
+		writeConflict(&sb, ct)
+		sb.WriteString(">>>>>>> " + labels.Theirs + "\n")
+		co, ct = []string{}, []string{}
+	}
+
+	for _, op := range Compute(Lines(ours), Lines(theirs)) {
+		switch op.Kind {
+		case Equal:
+			flush()
+			writeLines(&sb, []string{op.Line})
+		case Delete:
+			co = append(co, op.Line)
+		case Insert:
+			ct = append(ct, op.Line)
+		}
+	}
+	flush()
+
+	return sb.String(), conflicts
+}
+
+// matches returns for every line in a the index of the matching line in b or -1
+func matches(a, b []string) []int {
+	m := make([]int, len(a))
+	i, j := 0, 0
+	for _, op := range Compute(a, b) {
+		switch op.Kind {
+		case Equal:
+			m[i] = j
+			i++
+			j++
+		case Delete:
+			m[i] = -1
+			i++
+		case Insert:
+			j++
+		}
+	}
+
+	return m
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func writeLines(sb *strings.Builder, lines []string) {
+	for _, l := range lines {
+		sb.WriteString(l)
+	}
+}
+
+// writeConflict writes the lines of a side of a conflict. a missing final newline is added so that the
+// conflict marker that follows starts on its own line
+func writeConflict(sb *strings.Builder, lines []string) {
+	writeLines(sb, lines)
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		sb.WriteString("\n")
+	}
+}
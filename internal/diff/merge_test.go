@@ -0,0 +1,169 @@
+package diff
+
+import (
+	"testing"
+)
+
+var labels = MergeLabels{Ours: "ours", Base: "base", Theirs: "theirs"}
+
+func TestMerge3(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		base      string
+		ours      string
+		theirs    string
+		merged    string
+		conflicts int
+	}{
+		{
+			name:   "unchanged",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			theirs: "a\nb\nc\n",
+			merged: "a\nb\nc\n",
+		},
+		{
+			name:   "only theirs changed",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			theirs: "a\nB\nc\n",
+			merged: "a\nB\nc\n",
+		},
+		{
+			name:   "only ours changed",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\nd\n",
+			theirs: "a\nb\nc\n",
+			merged: "a\nb\nc\nd\n",
+		},
+		{
+			name:   "non overlapping changes",
+			base:   "a\nb\nc\nd\ne\n",
+			ours:   "A\nb\nc\nd\ne\n",
+			theirs: "a\nb\nc\nd\nE\n",
+			merged: "A\nb\nc\nd\nE\n",
+		},
+		{
+			name:   "identical changes",
+			base:   "a\nb\nc\n",
+			ours:   "a\nx\nc\n",
+			theirs: "a\nx\nc\n",
+			merged: "a\nx\nc\n",
+		},
+		{
+			name:      "conflict",
+			base:      "a\nb\nc\n",
+			ours:      "a\nx\nc\n",
+			theirs:    "a\ny\nc\n",
+			merged:    "a\n<<<<<<< ours\nx\n||||||| base\nb\n=======\ny\n>>>>>>> theirs\nc\n",
+			conflicts: 1,
+		},
+		{
+			name:      "conflict and merged change",
+			base:      "a\nb\nc\nd\ne\n",
+			ours:      "a\nx\nc\nd\ne\n",
+			theirs:    "a\ny\nc\nd\nE\n",
+			merged:    "a\n<<<<<<< ours\nx\n||||||| base\nb\n=======\ny\n>>>>>>> theirs\nc\nd\nE\n",
+			conflicts: 1,
+		},
+		{
+			name:   "missing final newline is kept",
+			base:   "a\nb",
+			ours:   "A\nb",
+			theirs: "a\nb",
+			merged: "A\nb",
+		},
+		{
+			name:   "final newline removed by theirs",
+			base:   "a\nb\nc\n",
+			ours:   "A\nb\nc\n",
+			theirs: "a\nb\nc",
+			merged: "A\nb\nc",
+		},
+		{
+			name:      "conflict without final newline",
+			base:      "a\nb",
+			ours:      "a\nx",
+			theirs:    "a\ny",
+			merged:    "a\n<<<<<<< ours\nx\n||||||| base\nb\n=======\ny\n>>>>>>> theirs\n",
+			conflicts: 1,
+		},
+		{
+			name:   "empty base",
+			base:   "",
+			ours:   "",
+			theirs: "a\n",
+			merged: "a\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := Merge3(tt.base, tt.ours, tt.theirs, labels)
+			if merged != tt.merged {
+				t.Errorf("merged = %q, want %q", merged, tt.merged)
+			}
+			if conflicts != tt.conflicts {
+				t.Errorf("conflicts = %d, want %d", conflicts, tt.conflicts)
+			}
+		})
+	}
+}
+
+func TestMerge2(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		ours      string
+		theirs    string
+		merged    string
+		conflicts int
+	}{
+		{
+			name:   "identical",
+			ours:   "a\nb\n",
+			theirs: "a\nb\n",
+			merged: "a\nb\n",
+		},
+		{
+			name:      "one conflict",
+			ours:      "a\nx\nc\n",
+			theirs:    "a\ny\nc\n",
+			merged:    "a\n<<<<<<< ours\nx\n=======\ny\n>>>>>>> theirs\nc\n",
+			conflicts: 1,
+		},
+		{
+			name:      "two conflicts",
+			ours:      "x\nb\nc\n",
+			theirs:    "b\nc\nd\n",
+			merged:    "<<<<<<< ours\nx\n=======\n>>>>>>> theirs\nb\nc\n<<<<<<< ours\n=======\nd\n>>>>>>> theirs\n",
+			conflicts: 2,
+		},
+		{
+			name:   "missing final newline on both",
+			ours:   "a\nb",
+			theirs: "a\nb",
+			merged: "a\nb",
+		},
+		{
+			name:      "missing final newline on one",
+			ours:      "a\nb",
+			theirs:    "a\nb\n",
+			merged:    "a\n<<<<<<< ours\nb\n=======\nb\n>>>>>>> theirs\n",
+			conflicts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := Merge2(tt.ours, tt.theirs, labels)
+			if merged != tt.merged {
+				t.Errorf("merged = %q, want %q", merged, tt.merged)
+			}
+			if conflicts != tt.conflicts {
+				t.Errorf("conflicts = %d, want %d", conflicts, tt.conflicts)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package iwrap
+
+import (
+	"go/types"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AnnotationPrefix starts the lines of a doc comment that annotate a method, i.e. //iwrap:redact password
+	AnnotationPrefix = "//iwrap:"
+
+	// LevelAnnotation is the level successful calls of the method are logged with. i.e. //iwrap:level info
+	LevelAnnotation = "level"
+	// LogAnnotation are the parameters that are logged. no parameter is logged without it. i.e. //iwrap:log id name
+	LogAnnotation = "log"
+	// RedactAnnotation are the logged parameters whose values are hidden. i.e. //iwrap:redact password
+	RedactAnnotation = "redact"
+)
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+// parseDoc splits the lines of a doc comment into the doc and the annotations
+func parseDoc(lines []string) ([]string, map[string][]string) {
+
+	doc := []string{}
+	annotations := map[string][]string{}
+	for _, l := range lines {
+		if !strings.HasPrefix(l, AnnotationPrefix) {
+			doc = append(doc, l)
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(l, AnnotationPrefix))
+		if len(fields) == 0 {
+			continue
+		}
+		for _, v := range fields[1:] {
+			// lists may be separated by commas as well, i.e. //iwrap:redact password,token
+			for _, s := range strings.Split(v, ",") {
+				if s != "" {
+					annotations[fields[0]] = append(annotations[fields[0]], s)
+				}
+			}
+		}
+		if _, ok := annotations[fields[0]]; !ok {
+			annotations[fields[0]] = []string{}
+		}
+	}
+
+	return doc, annotations
+}
+
+// validateAnnotations checks that the annotations of m refer to its parameters. unknown annotations are ignored
+func (m *Method) validateAnnotations() error {
+
+	params := map[string]bool{}
+	sig := m.Type().(*types.Signature)
+	for i := 0; i < sig.Params().Len(); i++ {
+		params[sig.Params().At(i).Name()] = true
+	}
+
+	for k, values := range m.Annotations {
+		switch k {
+		case LevelAnnotation:
+			if len(values) != 1 || !contains(logLevels, values[0]) {
+				return errors.Errorf("invalid %s%s of method %s. it takes one of [%s]", AnnotationPrefix, k, m.Name(), strings.Join(logLevels, ", "))
+			}
+		case LogAnnotation, RedactAnnotation:
+			for _, p := range values {
+				if !params[p] {
+					return errors.Errorf("invalid %s%s of method %s. %s is not a parameter of the method", AnnotationPrefix, k, m.Name(), p)
+				}
+			}
+		default:
+			log.WithFields(log.Fields{"method": m.Name(), "annotation": AnnotationPrefix + k}).Warn("unknown annotation is ignored")
+		}
+	}
+
+	return nil
+}
+
+// Level is the log level of the method. it is debug when the method is not annotated with one
+func (m *Method) Level() string {
+
+	if l, ok := m.Annotations[LevelAnnotation]; ok && len(l) == 1 {
+		return l[0]
+	}
+
+	return "debug"
+}
+
+// Logs reports whether the parameter p of the method is logged. only the parameters of the log annotation are,
+// so that parameters that may hold secrets are not logged unless they are selected
+func (m *Method) Logs(p *types.Var) bool {
+	return contains(m.Annotations[LogAnnotation], p.Name())
+}
+
+// Redacts reports whether the value of the parameter p of the method is hidden in logs
+func (m *Method) Redacts(p *types.Var) bool {
+	return contains(m.Annotations[RedactAnnotation], p.Name())
+}
+
+func contains(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,183 @@
+package iwrap
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Import of a generated file
+	Import struct {
+		// Name the package is imported as. it is empty when the package is imported by its own name
+		Name string
+		// Path of the package
+		Path string
+
+		// as is the name the package is referred to in the file
+		as string
+	}
+
+	// Imports prints types as they are written in a generated file and collects the packages the file has to import
+	Imports struct {
+		pkg      *types.Package
+		reserved map[string]string
+		names    map[string]string
+		byPath   map[string]*Import
+	}
+)
+
+// String is the import spec, i.e. `"context"` or `otrace "go.opencensus.io/trace"`
+func (i *Import) String() string {
+	if i.Name == "" {
+		return strconv.Quote(i.Path)
+	}
+
+	return i.Name + " " + strconv.Quote(i.Path)
+}
+
+// NewImports creates the imports of a file of the package pkg. the types of pkg are not qualified. pkg is nil when the
+// file is in another package than all the printed types. reserved maps the names that are already imported by the file
+// to their path. a package of another path is imported with an alias instead of one of these names
+func NewImports(pkg *types.Package, reserved map[string]string) *Imports {
+
+	im := &Imports{pkg: pkg, reserved: map[string]string{}, names: map[string]string{}, byPath: map[string]*Import{}}
+	for n, p := range reserved {
+		im.reserved[n] = p
+		im.names[n] = p
+	}
+
+	return im
+}
+
+// Add imports the package path. the name of the package is the last element of the path without a major version
+func (im *Imports) Add(p string) {
+
+	if _, ok := im.byPath[p]; ok || im.isReserved(p) {
+		return
+	}
+
+	im.add(p, ImportName(p))
+}
+
+// TypeString prints t qualified by the names of the packages it refers to. the packages are imported
+func (im *Imports) TypeString(t types.Type) string {
+	return types.TypeString(t, im.qualifier)
+}
+
+// List returns the imports sorted by their path. the reserved imports are not part of it
+func (im *Imports) List() []*Import {
+
+	l := make([]*Import, 0, len(im.byPath))
+	for _, i := range im.byPath {
+		l = append(l, i)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Path < l[j].Path })
+
+	return l
+}
+
+// Names returns the names of all the packages of the file including the reserved ones
+func (im *Imports) Names() []string {
+
+	n := make([]string, 0, len(im.names))
+	for name := range im.names {
+		n = append(n, name)
+	}
+	sort.Strings(n)
+
+	return n
+}
+
+func (im *Imports) qualifier(p *types.Package) string {
+
+	if p == im.pkg {
+		return ""
+	}
+
+	for n, rp := range im.reserved {
+		if rp == p.Path() {
+			return n
+		}
+	}
+
+	if i, ok := im.byPath[p.Path()]; ok {
+		return i.as
+	}
+
+	return im.add(p.Path(), p.Name())
+}
+
+// add imports the package p named name. it is imported with an alias when name is taken by another package.
+// the name the package is referred to is returned
+func (im *Imports) add(p, name string) string {
+
+	alias := name
+	if other, ok := im.names[alias]; ok && other != p {
+		// prefix the name with the parent of the package, i.e. otel/trace is oteltrace and log of the standard library is stdlog
+		parent := "std"
+		if d := path.Dir(p); d != "." {
+			parent = sanitize(path.Base(d))
+		}
+		alias = parent + name
+		for i := 1; im.names[alias] != "" || !isIdentifier(alias); i++ {
+			alias = fmt.Sprintf("%s%d", name, i)
+		}
+	}
+
+	im.names[alias] = p
+	i := &Import{Path: p, as: alias}
+	if alias != name || name != ImportName(p) {
+		i.Name = alias
+	}
+	im.byPath[p] = i
+
+	return alias
+}
+
+func (im *Imports) isReserved(p string) bool {
+
+	for _, rp := range im.reserved {
+		if rp == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ImportName is the name a package is expected to have by its path, i.e. yaml for gopkg.in/yaml.v2 and
+// pkg for example.com/pkg/v2
+func ImportName(p string) string {
+
+	name := path.Base(p)
+	if strings.HasPrefix(name, "v") && len(name) > 1 && strings.Trim(name[1:], "0123456789") == "" && path.Dir(p) != "." {
+		name = path.Base(path.Dir(p))
+	}
+	if i := strings.Index(name, ".v"); i > 0 {
+		name = name[:i]
+	}
+
+	if name = sanitize(name); name == "" {
+		return "pkg"
+	}
+
+	return name
+}
+
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+func isIdentifier(name string) bool {
+	return token.IsIdentifier(name) && types.Universe.Lookup(name) == nil
+}
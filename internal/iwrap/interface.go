@@ -0,0 +1,183 @@
+package iwrap
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/tools/go/packages"
+)
+
+const loadMode = packages.NeedName | packages.NeedTypes | packages.NeedImports | packages.NeedDeps
+
+type (
+	// Interface is an interface type with the complete set of its methods
+	Interface struct {
+		// Name of the interface
+		Name string
+		// Type is the named type of the interface
+		Type types.Type
+		// Package the interface is declared in
+		Package *types.Package
+		// Methods of the interface including the ones of the embedded interfaces. the explicit methods of an
+		// interface come first in the order they are declared followed by the methods of its embedded interfaces
+		Methods []*Method
+	}
+
+	// Method of an interface
+	Method struct {
+		*types.Func
+		// Doc are the lines of the doc comment of the method without the annotations
+		Doc []string
+		// Annotations of the doc comment by their name, i.e. //iwrap:redact password is redact: [password]
+		Annotations map[string][]string
+		// Embedded is the qualified name of the embedded interface of another package the method is promoted from.
+		// it is empty for the methods of the package of the interface, i.e. io.Closer for Close
+		Embedded string
+	}
+
+	// docReader reads the doc comments of methods from the files they are declared in
+	docReader struct {
+		fset   *token.FileSet
+		parsed *token.FileSet
+		files  map[string]*ast.File
+	}
+)
+
+// LoadInterface type checks the package of file and returns the interface named name of the package.
+// embedded interfaces are expanded into their methods regardless of the package they are declared in
+func LoadInterface(file, name string) (*Interface, error) {
+
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &packages.Config{Mode: loadMode, Dir: filepath.Dir(abs)}
+	pkgs, err := packages.Load(cfg, "file="+abs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load the package of %s", file)
+	}
+	if len(pkgs) == 0 || pkgs[0].Types == nil {
+		return nil, errors.Errorf("no package found for %s", file)
+	}
+	pkg := pkgs[0]
+
+	obj := pkg.Types.Scope().Lookup(name)
+	if obj == nil {
+		if len(pkg.Errors) > 0 {
+			return nil, errors.Errorf("interface %s not found in package %s. the package has errors, the first is: %v", name, pkg.PkgPath, pkg.Errors[0])
+		}
+		return nil, errors.Errorf("interface %s not found in package %s", name, pkg.PkgPath)
+	}
+
+	iface, ok := obj.Type().Underlying().(*types.Interface)
+	if _, isTypeName := obj.(*types.TypeName); !isTypeName || !ok {
+		return nil, errors.Errorf("%s in package %s is not an interface", name, pkg.PkgPath)
+	}
+	if n, ok := obj.Type().(*types.Named); ok && n.TypeParams().Len() > 0 {
+		return nil, errors.Errorf("interface %s in package %s has type parameters. generic interfaces cannot be wrapped", name, pkg.PkgPath)
+	}
+
+	// the package of a wrapper usually has errors while the wrapper is outdated. they are
+	// not relevant as long as the interface itself is complete
+	for _, e := range pkg.Errors {
+		log.WithField("package", pkg.PkgPath).Debugf("ignoring package error: %v", e)
+	}
+
+	r := &docReader{fset: pkg.Fset, parsed: token.NewFileSet(), files: map[string]*ast.File{}}
+	seen := map[string]bool{}
+	methods := []*Method{}
+	var collect func(i *types.Interface, embedded string)
+	collect = func(i *types.Interface, embedded string) {
+		explicit := make([]*types.Func, i.NumExplicitMethods())
+		for j := range explicit {
+			explicit[j] = i.ExplicitMethod(j)
+		}
+		// the explicit methods of an interface are declared in the same file
+		sort.SliceStable(explicit, func(a, b int) bool { return explicit[a].Pos() < explicit[b].Pos() })
+
+		for _, f := range explicit {
+			if seen[f.Name()] {
+				continue
+			}
+			seen[f.Name()] = true
+			doc, annotations := parseDoc(r.doc(f))
+			methods = append(methods, &Method{Func: f, Doc: doc, Annotations: annotations, Embedded: embedded})
+		}
+
+		for j := 0; j < i.NumEmbeddeds(); j++ {
+			t := i.EmbeddedType(j)
+			ei, ok := t.Underlying().(*types.Interface)
+			if !ok {
+				continue
+			}
+
+			e := embedded
+			if n, ok := t.(*types.Named); ok && e == "" && n.Obj().Pkg() != nil && n.Obj().Pkg() != pkg.Types {
+				e = n.Obj().Pkg().Name() + "." + n.Obj().Name()
+			}
+			collect(ei, e)
+		}
+	}
+	collect(iface, "")
+
+	for _, m := range methods {
+		if err := m.validateAnnotations(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Interface{Name: name, Type: obj.Type(), Package: pkg.Types, Methods: methods}, nil
+}
+
+// IsContext reports whether t is context.Context or an alias of it
+func IsContext(t types.Type) bool {
+
+	n, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	return n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "context" && n.Obj().Name() == "Context"
+}
+
+// doc returns the lines of the doc comment of the method f. it is empty when the source of f is not available
+func (r *docReader) doc(f *types.Func) []string {
+
+	pos := r.fset.Position(f.Pos())
+	if !pos.IsValid() || pos.Filename == "" {
+		return []string{}
+	}
+
+	file, ok := r.files[pos.Filename]
+	if !ok {
+		// the positions of the methods of the dependencies come from export data which has no comments
+		file, _ = parser.ParseFile(r.parsed, pos.Filename, nil, parser.ParseComments)
+		r.files[pos.Filename] = file
+	}
+	if file == nil {
+		return []string{}
+	}
+
+	lines := []string{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		m, ok := n.(*ast.Field)
+		if !ok || len(m.Names) == 0 || m.Names[0].Name != f.Name() || r.parsed.Position(m.Names[0].Pos()).Line != pos.Line {
+			return true
+		}
+		if m.Doc != nil {
+			for _, c := range m.Doc.List {
+				lines = append(lines, c.Text)
+			}
+		}
+		return false
+	})
+
+	return lines
+}
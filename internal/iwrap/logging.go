@@ -0,0 +1,72 @@
+package iwrap
+
+// LoggingTmplt used to wrap an interface with structured logging. successful calls are logged with the level of
+// //iwrap:level, debug by default, and failed calls with error. only the parameters of //iwrap:log are logged.
+// the values of the ones of //iwrap:redact are hidden
+const LoggingTmplt = `
+// Code generated by servicebuilder iwrap. DO NOT EDIT.
+
+{{ .ServiceBuilderVersion }}
+
+{{$target := .InterfaceName}}
+{{$type := .InterfaceType}}
+{{$recv :=  .ReceiverSub  }}
+
+package {{ .PackageName }}
+
+import (
+	"time"
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+
+// {{ lowerCamelCase $target }}WithLogging wraps {{ $target }} and logs the calls
+type {{ lowerCamelCase $target }}WithLogging struct {
+	wrapped{{$target}} {{ $type }}
+	logger             log.Logger
+}
+
+// {{ $target }}WithLogging creates a new {{ $target }} with logging
+func {{ $target }}WithLogging(toWrap {{ $type }}, logger log.Logger) {{ $type }} {
+	return &{{ lowerCamelCase $target }}WithLogging{wrapped{{$target}}: toWrap, logger: logger.NamedLogger("{{ snakeCase $target }}")}
+}
+
+var _ {{ $type }} = (*{{ lowerCamelCase $target }}WithLogging)(nil)
+
+{{range .Methods}}
+{{- if .Ignored }}
+// {{.Name}} calls {{.Name}} on the wrapped {{$target}}{{if .Embedded}}. it is promoted from {{.Embedded}}{{end}}
+func ({{$recv}} *{{ lowerCamelCase $target }}WithLogging) {{.Name}}({{template "list" .Params}}) ({{template "list" .Returns}}) {
+	{{if .Returns}}return {{end}}{{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}})
+}
+{{else}}
+{{template "doc" . -}}
+func ({{$recv}} *{{ lowerCamelCase $target }}WithLogging) {{.Name}}({{template "list" .Params}}) ({{template "list" .Returns}}) {
+	start := time.Now()
+	{{if .Returns}}{{template "returns" .Returns}} = {{end}}{{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}})
+	{{if isLastReturnError .Returns }}
+		if {{ lastReturnName .Returns }} != nil {
+			{{$recv}}.logger.Errorw("{{.Name}} failed", "method", "{{.Name}}", "duration", time.Since(start){{template "fields" .Params}}, "error", {{ lastReturnName .Returns }})
+			return {{template "returns" .Returns}}
+		}
+	{{end}}
+	{{$recv}}.logger.{{.LogLevel}}w("{{.Name}} called", "method", "{{.Name}}", "duration", time.Since(start){{template "fields" .Params}})
+
+	return {{template "returns" .Returns}}
+}
+{{- end}}
+{{end}}
+
+{{define "list"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{end}} {{$element.Type}}{{end}}{{end}}
+{{define "params"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{$element.Suffix}}{{end}}{{end}}{{end}}
+{{define "returns"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{end}}{{end}}{{end}}
+{{define "fields"}}{{range .}}{{if .Log}}, "{{.Key}}", {{if .Redact}}"[REDACTED]"{{else}}{{.Name}}{{end}}{{end}}{{end}}{{end}}
+{{define "doc"}}
+{{range .Doc}}
+{{.}}
+{{- else}}
+// {{.Name}} .
+{{- end}}
+{{end}}
+`
@@ -7,19 +7,20 @@ const MetricsTmplt = `
 {{ .ServiceBuilderVersion }}
 
 {{$target := .InterfaceName}}
+{{$type := .InterfaceType}}
 {{$recv :=  .ReceiverSub  }}
 
 package {{ .PackageName }}
 import (
 	"context"
 	"time"
+{{- range .Imports}}
+	{{.}}
+{{- end}}
 
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
-	{{range .CustomImports}}
-	"{{.}}"
-	{{end}}
 )
 
 // {{ lowerCamelCase $target }}Observer
@@ -28,18 +29,18 @@ type {{ lowerCamelCase $target }}Observer struct {
 
 // {{ lowerCamelCase $target }}WithMetrics wraps {{ $target }} and gathers metrics
 type {{ lowerCamelCase $target }}WithMetrics struct {
-	wrapped{{$target}}    {{ $target }}
+	wrapped{{$target}}    {{ $type }}
 	observer *{{ lowerCamelCase $target }}Observer
 }
 
 // {{ $target }}WithMetrics creates a new {{ $target }} with metrics
-func {{ $target }}WithMetrics(toWrap {{ $target }}, logger log.Logger) {{ $target }} {
+func {{ $target }}WithMetrics(toWrap {{ $type }}, logger log.Logger) {{ $type }} {
 	return &{{ lowerCamelCase $target }}WithMetrics{wrapped{{$target}}: toWrap, observer: &{{ lowerCamelCase $target }}Observer{}}
 }
 
 var (
 
-	_ {{ $target }} = (*{{ lowerCamelCase $target }}WithMetrics)(nil)
+	_ {{ $type }} = (*{{ lowerCamelCase $target }}WithMetrics)(nil)
 
 	// {{ lowerCamelCase $target }}KeyMethod is the label/tag used while reporting metrics
 	{{ lowerCamelCase $target }}KeyMethod = tag.MustNewKey("method")
@@ -92,36 +93,31 @@ func ({{$recv}} *{{ lowerCamelCase $target }}Observer) Observe(ctx context.Conte
 }
 
 {{range .Methods}}
+{{- if .PassThrough }}
+// {{.Name}} calls {{.Name}} on the wrapped {{$target}}{{if .Embedded}}. it is promoted from {{.Embedded}}{{end}}
+func ({{$recv}} *{{ lowerCamelCase $target }}WithMetrics) {{.Name}}({{template "list" .Params}}) ({{template "list" .Returns}}) {
+	{{if .Returns}}return {{end}}{{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}})
+}
+{{else}}
 {{template "doc" . -}}
 func ({{$recv}} *{{ lowerCamelCase $target }}WithMetrics) {{.Name}}({{template "list" .Params}}) ({{template "list" .Returns}}) {
-	done := {{$recv}}.observer.Observe(ctx, "{{.Name}}")
+	{{- if .BackgroundContext }}
+	{{.ContextParam}} := context.Background()
+	{{- end}}
+	done := {{$recv}}.observer.Observe({{.ContextParam}}, "{{.Name}}")
 	defer done()
-	{{template "returns" .Returns}} = {{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}})
+	{{if .Returns}}{{template "returns" .Returns}} = {{end}}{{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}})
 	{{if isLastReturnError .Returns }}
 		if {{ lastReturnName .Returns }} != nil {
-			stats.Record(ctx, {{ lowerCamelCase $target }}CallErrorCount.M(1))
+			stats.Record({{.ContextParam}}, {{ lowerCamelCase $target }}CallErrorCount.M(1))
 		}
 	{{end}}
 
 	return {{template "returns" .Returns}}
 }
+{{- end}}
 {{end}}
 
-// Healthy calls Healthy on the wrapped wrapped{{$target}}.
-func ({{$recv}} *{{ lowerCamelCase $target }}WithMetrics) Healthy() error {
-	return {{$recv}}.wrapped{{$target}}.Healthy()
-}
-
-// Ready calls ready on the wrapped wrapped{{$target}}.
-func ({{$recv}} *{{ lowerCamelCase $target }}WithMetrics) Ready() (bool, error) {
-	return {{$recv}}.wrapped{{$target}}.Ready()
-}
-
-// Close calls Close on the wrapped wrapped{{$target}}.
-func ({{$recv}} *{{ lowerCamelCase $target }}WithMetrics) Close() error {
-	return {{$recv}}.wrapped{{$target}}.Close()
-}
-
 {{define "list"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{end}} {{$element.Type}}{{end}}{{end}}
 {{define "params"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{$element.Suffix}}{{end}}{{end}}{{end}}
 {{define "returns"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{end}}{{end}}{{end}}
@@ -1,7 +1,14 @@
 package iwrap
 
+// LoggerImport is the package of the logger the wrappers are created with. it is always imported
+const LoggerImport = "github.com/cnative/pkg/log"
+
 // KnownInterfaceTemplates that are available as default
 var KnownInterfaceTemplates = map[string]string{
 	"metrics": MetricsTmplt,
 	"tracing": TracingTmplt,
+	"logging": LoggingTmplt,
 }
+
+// Locals are the identifiers the known templates declare in the wrapper methods. parameters of these names are renamed
+var Locals = []string{"span", "done", "start"}
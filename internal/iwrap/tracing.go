@@ -7,6 +7,7 @@ const TracingTmplt = `
 {{ .ServiceBuilderVersion }}
 
 {{$target := .InterfaceName}}
+{{$type := .InterfaceType}}
 {{$recv :=  .ReceiverSub  }}
 
 package {{ .PackageName }}
@@ -14,23 +15,23 @@ package {{ .PackageName }}
 import (
 	"fmt"
 	"strings"
-	"context"
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+{{- if .Wrapped }}
 
 	"go.opencensus.io/trace"
-
-	{{range .CustomImports}}
-	"{{.}}"
-	{{end}}
+{{- end}}
 )
 
 // {{ lowerCamelCase $target}}WithTrace wraps {{$target}} and records trace information
 type {{ lowerCamelCase $target}}WithTrace struct {
-	wrapped{{$target}}     {{$target}}
+	wrapped{{$target}}     {{$type}}
 	component string
 }
 
 // {{$target}}WithTrace creates a new {{$target}} with trace
-func {{$target}}WithTrace(toWrap  {{$target}}, logger log.Logger) {{$target}} {
+func {{$target}}WithTrace(toWrap  {{$type}}, logger log.Logger) {{$type}} {
 	component := strings.TrimPrefix(fmt.Sprintf("%T", toWrap), "*")
 	logger.Debugf("{{ $target }} tracing enabled for %v", component)
 	
@@ -40,15 +41,24 @@ func {{$target}}WithTrace(toWrap  {{$target}}, logger log.Logger) {{$target}} {
 	}
 }
 
-var _ {{$target}} = (*{{ lowerCamelCase $target}}WithTrace)(nil)
+var _ {{$type}} = (*{{ lowerCamelCase $target}}WithTrace)(nil)
 
 {{range .Methods}}
+{{- if .PassThrough }}
+// {{.Name}} calls {{.Name}} on the wrapped {{$target}}{{if .Embedded}}. it is promoted from {{.Embedded}}{{end}}
+func ({{$recv}} *{{ lowerCamelCase $target}}WithTrace) {{.Name}}({{template "list" .Params}}) ({{template "list" .Returns}}) {
+	{{if .Returns}}return {{end}}{{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}})
+}
+{{else}}
 {{template "doc" . -}}
 func ({{$recv}} *{{ lowerCamelCase $target}}WithTrace) {{.Name}}({{template "list" .Params}}) ({{template "list" .Returns}}) {
-	ctx, span := trace.StartSpan(ctx, "{{.Name}}")
+	{{- if .BackgroundContext }}
+	{{.ContextParam}} := context.Background()
+	{{- end}}
+	{{.ContextParam}}, span := trace.StartSpan({{.ContextParam}}, "{{.Name}}")
 	defer span.End()
 
-	{{template "returns" .Returns}} = {{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}}){{if isLastReturnError .Returns }}
+	{{if .Returns}}{{template "returns" .Returns}} = {{end}}{{$recv}}.wrapped{{$target}}.{{.Name}}({{template "params" .Params}}){{if isLastReturnError .Returns }}
 		if {{ lastReturnName .Returns }} != nil {
 			span.Annotate([]trace.Attribute{trace.StringAttribute("error", {{ lastReturnName .Returns }}.Error())}, "{{.Name}}")
 		}
@@ -56,24 +66,10 @@ func ({{$recv}} *{{ lowerCamelCase $target}}WithTrace) {{.Name}}({{template "lis
 
 	return {{template "returns" .Returns}}
 }
+{{- end}}
 {{end}}
 
 
-// Healthy calls Healthy on the wrapped {{$target}}.
-func ({{$recv}} *{{ lowerCamelCase $target}}WithTrace) Healthy() error {
-	return {{$recv}}.wrapped{{$target}}.Healthy()
-}
-
-// Ready calls Ready on the wrapped {{$target}}.
-func ({{$recv}} *{{ lowerCamelCase $target}}WithTrace) Ready() (bool, error) {
-	return {{$recv}}.wrapped{{$target}}.Ready()
-}
-
-// Close calls Close on the wrapped {{$target}}.
-func ({{$recv}} *{{ lowerCamelCase $target}}WithTrace) Close() error {
-	return {{$recv}}.wrapped{{$target}}.Close()
-}
-
 {{define "list"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{end}} {{$element.Type}}{{end}}{{end}}
 {{define "params"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{$element.Suffix}}{{end}}{{end}}{{end}}
 {{define "returns"}}{{range $index, $element := .}}{{if $index}}, {{end}}{{if $element.Name}}{{$element.Name}}{{end}}{{end}}{{end}}
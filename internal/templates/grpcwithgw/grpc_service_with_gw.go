@@ -7,12 +7,14 @@
 // tmplt/Makefile.tmplt
 // tmplt/README.md.tmplt
 // tmplt/cmd/config.go.tmplt
+// tmplt/cmd/convert.go.tmplt
 // tmplt/cmd/db.go.tmplt
 // tmplt/cmd/main.go.tmplt
 // tmplt/cmd/oce.go.tmplt
 // tmplt/cmd/oidc.go.tmplt
 // tmplt/cmd/ports.go.tmplt
 // tmplt/cmd/service.go.tmplt
+// tmplt/cmd/{resource}_service.go.tmplt
 // tmplt/db/postgres/gen.sh.tmplt
 // tmplt/db/postgres/init.go.tmplt
 // tmplt/db/postgres/migrations/000001_init.down.sql.tmplt
@@ -28,9 +30,12 @@
 // tmplt/helm/templates/ingress.yaml
 // tmplt/helm/templates/service.yaml
 // tmplt/helm/values.yaml
+// tmplt/internal/state/memory.go.tmplt
 // tmplt/internal/state/postgres.go.tmplt
 // tmplt/internal/state/store.go.tmplt
 // tmplt/internal/state/store_observer.go.tmplt
+// tmplt/internal/state/{resource}_memory.go.tmplt
+// tmplt/internal/state/{resource}_postgres.go.tmplt
 // tmplt/kustomize/base/deployment.yaml
 // tmplt/kustomize/base/ingress.yaml
 // tmplt/kustomize/base/kustomization.yaml
@@ -124,7 +129,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _DockerignoreTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x4b\xcd\x4b\xc9\x2f\xe2\xd2\x2b\x2b\x4e\xce\x4f\x49\xe5\xd2\x2b\xc9\xcf\xcf\x29\xe6\x4a\xca\xcc\x03\x04\x00\x00\xff\xff\xde\x92\x0a\x55\x19\x00\x00\x00"
+var _DockerignoreTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x19\x00\xe6\xff\x76\x65\x6e\x64\x6f\x72\x0a\x2e\x76\x73\x63\x6f\x64\x65\x0a\x2e\x74\x6f\x6f\x6c\x73\x0a\x62\x69\x6e\x03\x00\xde\x92\x0a\x55\x19\x00\x00\x00"
 
 func DockerignoreTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -144,7 +149,7 @@ func DockerignoreTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _GitignoreTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x41\x4b\x33\x41\x0c\x86\xef\xf9\x15\x81\x5e\xbe\x6f\x90\x88\x82\xe2\x55\x2c\xde\xa4\x87\x3d\x8a\x2c\xb3\x33\xd9\x6d\xca\x76\xb2\x4e\xb2\x65\x7b\xf1\xb7\xcb\xb6\xea\x29\x79\xf3\x3e\x84\x67\x83\x59\xcc\xa1\x93\x02\x40\xae\x3a\x1a\xc0\x89\x4b\xd6\x0a\x90\xb9\x9b\x07\x80\x0d\xbe\xc5\x84\xbb\x06\x7b\x19\xd9\x80\xb6\x4d\xdb\xb8\x56\x86\xf0\xb5\x96\x2f\x7a\x9c\x64\xe4\x8c\xbb\xee\xc0\xc9\xaf\xd4\x0d\x36\x1e\x5d\x12\xc6\x92\x71\x7b\x2e\xf1\x28\x09\x47\xe9\x0c\xff\x35\xfb\x58\xff\x68\xfb\x0f\x81\x14\x02\x45\x08\x64\xba\xfe\x7b\xd5\x31\x73\x35\x68\xb5\x3b\x40\xeb\x6c\xbe\x5e\x9f\x6b\xda\x8b\x73\xf2\xb9\x32\xda\xc4\x49\x7a\x49\xc8\x8b\x73\x31\xd1\x62\xb7\x53\xe5\x5e\x16\x36\x08\xf4\xfe\xf0\xf8\x74\xfa\xfc\x80\x9f\x49\x3a\x3b\x40\xa0\x34\xe8\x1d\x0d\x7a\xdd\xee\x29\x41\x9b\x06\x6d\x33\xf7\x73\xf9\x0d\x83\xfa\x79\x62\x5b\xa9\x4b\xe6\x65\xd2\xea\x14\xe0\x2a\x72\x8c\x52\xd6\x0e\x02\xf1\xc2\x10\xe8\x62\x17\x68\xaa\xda\x7f\x07\x00\x00\xff\xff\x12\xe2\xfd\xc6\x49\x01\x00\x00"
+var _GitignoreTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x41\x4b\xc3\x40\x10\x85\xef\xef\x57\x2c\xf4\xa2\x8b\x8c\x28\x28\x5e\xc5\xe2\x4d\x7a\xc8\x51\x24\x6c\x76\x27\xe9\x96\x74\x27\xee\x4c\x4a\x7a\xf1\xb7\x4b\xac\x7a\x1a\x66\xbe\x8f\x79\x6f\xe3\x52\x56\x43\x97\x0b\x40\x26\x32\x2a\x70\xe2\x92\xa4\x02\x89\xbb\x79\x00\x36\xee\x2d\x44\xb7\x6b\x5c\x9f\x47\x56\xd0\xb6\x69\x1b\x93\xca\xf0\x5f\x2b\x7c\x91\xe3\x94\x47\x4e\x6e\xd7\x1d\x38\xda\xc5\xba\x71\x8d\x05\xcb\xd1\x85\x92\xdc\xf6\x5c\xc2\x31\x47\x37\xe6\x4e\xdd\x55\xb3\x0f\xf5\xdf\xd6\x6b\x78\x12\x78\x0a\xf0\xa4\xb2\xfe\x7b\x95\x31\x71\x55\xb4\xd2\x1d\xd0\x1a\xab\xad\xd7\xe7\x1a\xf7\xd9\x38\xda\x5c\xd9\xe9\xc4\x31\xf7\x39\x3a\x5e\x8c\x8b\x66\x29\x7a\x3b\x55\xee\xf3\xc2\x0a\x4f\xef\x0f\x8f\x4f\xa7\xcf\x0f\xfc\x4e\x92\xd9\x00\x4f\x71\x90\x3b\x1a\xd6\xb0\x38\xc8\x3d\x45\xb4\x71\x90\x36\x71\x3f\x97\xbf\x65\x10\x3b\x4f\xac\xab\xf5\x03\x79\x99\xa4\x1a\x79\x5c\x8a\x1c\x43\x2e\x2b\x83\x27\x5e\x18\x9e\x8c\xd5\xe0\x69\xaa\xd2\x7f\x0f\x00\x12\xe2\xfd\xc6\x49\x01\x00\x00"
 
 func GitignoreTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -164,7 +169,7 @@ func GitignoreTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _GoreleaserYmlTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xbd\x8a\x23\x3d\x10\xcc\xf5\x14\xc5\x3a\x98\xc8\xe3\x60\x17\x63\x14\x7f\xe1\x77\xd9\xc2\x65\xb7\xb4\xa5\xf6\x8c\xb0\xa4\x36\x52\x8f\x3d\x66\xf1\xbb\x1f\xa3\x1d\x2f\x17\x5c\x70\x20\x44\x75\xab\xba\xfa\x4f\x1b\xbc\x8f\xa1\x22\x54\x50\x06\xcf\x94\x2e\x91\x31\x48\xe1\xc8\x54\xb9\xf4\x77\x4a\x11\xa7\x10\x19\xb7\xa0\x23\xaa\x24\x46\xa5\xcc\xf0\x7c\xa2\x29\x6a\xed\xcd\x06\x3f\xe8\xcc\xa8\x53\x61\xa8\xc0\x8d\xec\xce\xd0\x91\xe1\xc5\x4d\x89\xb3\x92\x06\xc9\x20\xc5\xa8\x7a\xb1\xbb\xdd\x1f\xf2\x4e\x92\x39\xf2\x49\x0a\x5b\x03\x8c\x22\xe7\xba\x00\x60\x83\xbb\x4c\x48\x74\x47\xe1\x24\x57\x86\xb6\x32\x4f\xcd\xed\x25\x77\x8a\xa9\x32\xae\x83\x34\xfa\x16\x69\xa9\xc1\x45\xa6\xfc\xaf\xf1\x99\xd9\x63\x10\x0c\x9c\xb9\x90\xf2\x1a\xb7\x4a\x1d\xa7\x10\xbd\x69\x77\xb5\x66\x71\x86\x6c\xd1\xf7\x3b\x97\xfc\xce\x00\xc7\x90\xa9\xdc\x2d\x2a\x97\x6b\x70\xdc\x88\x5c\x0c\x30\x88\xb4\x1e\xb6\xf0\x54\x6e\x21\x37\x18\x43\x9e\xe6\x86\x6e\x21\x7b\xb9\xd5\x46\xa4\xe2\xc6\xd6\xee\x16\x94\xfc\xfe\xcd\x2c\x8e\x70\x6d\xb3\x28\x7c\x89\xe4\x78\x99\xdf\x3a\x92\x2f\x39\x8b\xff\x9e\xb2\xf8\x92\xb5\xf8\x7f\x55\xc7\x53\xdd\xe2\xe7\x77\x1a\xe0\xf5\xb0\xb7\x08\xaf\x87\x7d\xb3\x5a\x26\x8b\xf9\xb0\xff\xd8\xbf\x99\xb6\xad\x3a\xa5\x25\x45\xa6\xc4\x1f\xca\xe9\x12\x49\xd9\xa2\x7b\xbe\xd5\x5e\x67\xed\x4c\xcd\x74\xa9\xa3\xe8\x5f\xa8\x2f\x9f\x9f\xcb\x79\x3c\xd0\xbf\xd3\x80\x06\x5f\x1e\x8f\x6d\xe6\x59\x5f\x8c\x1b\x29\x0f\x1c\x65\x58\x22\xab\x14\xb5\xa0\xea\x0c\x96\x7f\xa5\x5c\xd6\xf6\x78\x76\x71\xf2\x6c\xd7\x7d\x76\xbf\xbc\xb8\x6a\xbb\x6f\x53\xb9\xaa\xed\xcc\xef\x00\x00\x00\xff\xff\x77\x76\x83\x0f\xb1\x02\x00\x00"
+var _GoreleaserYmlTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x31\x8f\xdb\x30\x0c\x85\x77\xfd\x8a\x87\x64\xf0\x14\x67\xb8\x43\x10\x68\xee\xd8\x6e\x07\x74\xeb\x81\x91\x19\x5b\x88\x24\x06\x12\x9d\x38\x38\xe4\xbf\x17\xd2\x25\x87\x0e\x1d\x0e\x30\x0c\x8a\x7e\xfc\x48\x3e\x6b\x8d\xb7\xc9\x17\xf8\x02\x4a\xe0\x85\xe2\x39\x30\x46\xc9\x1c\x98\x0a\xe7\xfe\x46\x31\xe0\xe8\x03\xe3\xea\x75\x42\x91\xc8\x28\x94\x18\x03\x1f\x69\x0e\x5a\x7a\xb3\xc6\x2f\x3a\x31\xca\x9c\x19\x2a\x70\x13\xbb\x13\x74\x62\x0c\xe2\xe6\xc8\x49\x49\xbd\x24\x90\x62\x52\x3d\xdb\xed\xf6\x1f\xbc\x93\x68\x0e\x7c\x94\xcc\xd6\x00\x93\xc8\xa9\xd4\x00\x58\xe3\x26\x33\x22\xdd\x90\x39\xca\x85\xa1\x6d\xcc\x63\x4b\x0f\x92\x3a\xc5\x5c\x18\x97\x51\x9a\x7c\x83\x58\x67\x70\x81\x29\x7d\xb7\x3e\x31\x0f\x18\x05\x23\x27\xce\xa4\xfc\xa8\x7b\xa0\x0e\xb3\x0f\x83\x69\xef\x62\x4d\x4d\xfa\x64\xd1\xf7\x5b\x17\x87\xad\x01\x0e\x3e\x51\xbe\x59\x14\xce\x17\xef\xb8\x09\x39\x1b\x60\x14\x69\x3b\x6c\x30\x50\xbe\xfa\x3a\xce\x06\xc1\xa7\x79\x69\xd1\xd5\xa7\x41\xae\xa5\x09\x29\xbb\xa9\x4a\xb1\x01\xc5\x61\xf7\x6a\x6a\xc2\x5f\x9a\x17\x99\xcf\x81\x1c\x57\xff\x1e\x96\x7c\xe2\x2c\x7e\x3c\xb1\xf8\xc4\x5a\xfc\x7c\xd0\xf1\xa4\x5b\xfc\xfe\x6a\x03\xbc\xec\x77\x16\xfe\x65\xbf\x6b\xa7\xd6\xc9\x62\xd9\xef\xde\x77\xaf\xa6\xfd\xad\x32\xc7\xda\x22\x51\xe4\x77\xe5\x78\x0e\xa4\x6c\xd1\x3d\xbf\x95\x5e\x17\xed\x4c\x49\x74\x2e\x93\xe8\x7f\xa4\xab\x8f\x8f\xfa\xdc\xef\xe8\xdf\x68\x44\x0b\x57\xf7\xfb\x26\xf1\xa2\x2b\xe3\x26\x4a\x23\x07\x19\x6b\x65\x91\xac\x16\x54\x9c\x41\xbd\x57\xca\xf9\xb1\x1e\x2f\x2e\xcc\x43\xdb\xbd\xfa\xd4\xfd\x19\xc4\x15\xdb\x7d\x1d\x95\x8b\xda\xce\xfc\x1d\x00\x77\x76\x83\x0f\xb1\x02\x00\x00"
 
 func GoreleaserYmlTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -184,7 +189,7 @@ func GoreleaserYmlTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _dockerfileTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xcf\xcf\x6a\x02\x31\x10\xc7\xf1\x7b\x9e\x62\xd8\x83\xb7\x24\xd8\x9e\x2a\xf4\x20\x5a\x45\xaa\xbb\x92\x5a\x8a\x88\x94\x31\x19\xd7\x60\x36\x59\x36\xd9\x4b\xc5\x77\x2f\xfe\xd9\x42\x2f\x5e\x3f\xfc\x60\xe6\x3b\x51\xc5\x02\xca\xe0\xd0\x97\x83\xbe\xe8\x3f\x89\x3e\x60\x84\x5d\x6b\x9d\xa1\x86\x0d\xd5\x14\xe6\xe3\xef\xc9\x7c\x38\xfd\x60\x6c\x54\x2c\xd7\x20\x24\xc8\x32\xc8\xd8\x68\x79\x3a\x01\x88\x45\x30\xad\xa3\x1c\x2b\x02\x38\x9f\x25\xfb\x2a\xd4\xfb\x78\xa6\x1e\x8f\x98\xfa\xcc\x01\xeb\xc4\x4b\x4a\xd0\xd6\x06\x13\x41\xaf\xf7\x27\xd6\xc7\x84\xce\x41\xeb\x7f\x6c\x7d\xdd\x56\x78\xa4\x8e\xb9\xa1\x3a\x85\xe0\x22\x68\x47\xe8\x6f\xcf\x32\x76\x2d\x41\x57\x5b\x4f\x83\x67\xf1\x72\x3f\x71\x04\xce\x7d\xe0\x1a\xf5\x81\x00\x8d\x01\x8d\x5c\x53\x93\xec\xde\x6a\x4c\x14\x6f\x51\x9c\xef\x9b\x50\xbd\xde\xb3\x1f\xfe\xbe\xb3\xfe\xe2\xa2\x03\x90\x6d\x6c\x2e\xca\xde\xf2\x95\x5a\x2f\x8b\x59\xbe\x82\x4d\xd6\xe9\xbf\x6d\xb6\x65\xa3\xc5\x18\x36\x19\x3f\x64\x5b\xf6\x1b\x00\x00\xff\xff\xec\xde\x6a\x7c\x7b\x01\x00\x00"
+var _dockerfileTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xc1\x6a\x32\x31\x14\x46\xf7\x79\x8a\xcb\x2c\xdc\x25\xc1\xff\x5f\x55\xe8\x42\xb4\x8a\x54\x67\x24\xb5\x14\x11\x29\x77\x92\xeb\x18\xcc\x24\xc3\x24\xb3\xa9\xf8\xee\xa5\xea\x14\xba\x71\x7b\x38\xf0\x7d\x67\xa6\x8a\x15\x54\xc1\xa1\xaf\x46\x43\x31\xfc\x27\x86\x80\x11\xca\xce\x3a\x43\x2d\x1b\xab\x39\x2c\xa7\x9f\xb3\xe5\x78\xfe\xc6\xd8\xa4\x58\x6f\x41\x48\x90\x55\x90\xb1\xd5\xf2\x7c\x06\x10\xab\x60\x3a\x47\x39\xd6\x04\x70\xb9\x48\xf6\x51\xa8\xd7\xe9\x42\x3d\x96\x98\x7a\xcf\x01\x9b\xc4\x2b\x4a\xd0\x35\x06\x13\xc1\x60\xf0\x4b\xac\x8f\x09\x9d\x83\xce\x7f\xd9\xe6\xea\xd6\x78\xa2\x1e\x73\x43\x4d\x0a\xc1\x45\xd0\x8e\xd0\xdf\xce\x32\x76\x2d\x41\xd7\x58\x4f\xa3\xff\xe2\xe9\x3e\x71\x02\xce\x7d\xe0\x1a\xf5\x91\x00\x8d\x01\x8d\x5c\x53\x9b\xec\xc1\x6a\x4c\x14\x6f\x51\x9c\x1f\xda\x50\x3f\xdf\xb3\x1f\x7e\x2f\xad\xff\xe1\xa2\x07\x20\xbb\xd8\xca\xd2\x7a\xf6\x92\x6f\xd4\x76\x5d\x2c\xf2\x0d\xec\xb2\x9e\xfe\x71\xb3\x3d\x9b\xac\xa6\xb0\xcb\xf8\x31\xdb\xb3\xef\x01\x00\xec\xde\x6a\x7c\x7b\x01\x00\x00"
 
 func dockerfileTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -204,7 +209,7 @@ func dockerfileTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _makefileTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x95\xef\x6e\xdb\x36\x10\xc0\x3f\x47\x4f\x71\x70\xd4\xc5\xca\x42\x29\x6d\xd7\x7d\x50\xe1\x35\xa9\xeb\xa6\xc6\xf2\xa7\x5d\x82\x62\x5b\xbb\x79\xb4\x74\x92\x88\x50\xa4\x40\x52\x72\xb3\x24\xc0\x9e\x62\x8f\xb0\x47\xd8\x03\xf5\x49\x06\x52\xb2\x65\xa5\xc0\xd0\x2f\x04\x79\x3a\xde\xfd\xee\x0f\x4f\xf8\xa9\x92\xca\xc0\xfb\xd9\x4f\x97\xf3\x8b\x73\x00\x80\x17\x13\xf0\x6f\x4f\xe6\x57\x8b\xe9\xc5\xd9\xd9\xfc\xea\xde\xeb\x54\x7a\x91\x53\x19\xeb\x02\x39\x87\x9c\x19\x48\x51\x27\x8a\x2d\x11\x08\x31\x34\xd7\x40\x08\xe5\x2b\x7a\x63\x37\x29\x53\xe6\x06\x08\x29\xa9\x49\x8a\x49\xb3\x0f\x4f\x7e\x80\x28\xc5\x26\x12\x35\xe7\x70\x77\x07\x98\x14\x12\x6a\x71\x2d\xe4\x4a\x04\x6b\x57\xa7\xaf\x16\xaf\x4f\x8f\x4f\x2e\x61\x02\xe4\x67\x18\x95\x94\x89\x30\x67\x66\x2a\xcb\x92\x99\x89\x3f\xee\x51\x82\x51\xaf\xd1\xa0\xd2\x4c\x8a\x89\x3f\xee\xa2\x09\x46\xde\x06\xfe\xe2\xe5\xfc\x1c\x2c\x36\x5d\xea\x8a\x9a\x02\xc2\x20\x0a\x8d\x94\x5c\x47\x4b\x26\xd6\x6a\x6f\x8f\xaf\xde\x40\x6c\xd5\xdc\x85\x20\x1e\xe8\x2f\x99\x88\xfd\xb1\xd5\xd9\x90\x4e\x4f\x2e\x16\xb3\xf3\xe3\x97\xa7\xb3\x57\x93\xc3\x8d\xb7\xf7\x30\x81\xc3\xf5\xe1\x9d\x73\xcb\x32\xf0\xc7\x19\xe3\x06\x15\x3c\x3e\xf0\xdf\x07\x07\x07\x47\x1b\x2b\x67\xd0\x27\xb4\x52\x4c\x98\x0c\x46\x1f\x0f\x9f\x3e\xfd\xf0\xf4\xbb\xe7\x8f\xcb\xcf\x7f\xff\xeb\x0e\x87\xe5\x28\xd8\xb8\x98\x4e\x61\x02\xb9\x84\x65\xcd\x78\x0a\x84\xa7\x19\xb7\xa9\xdf\xf3\xc7\xeb\xe4\x05\x7b\x9e\x17\xbe\x7d\x73\x71\xfe\x4b\x0c\x05\xf2\xca\xb3\x4b\xec\xed\x1c\xe5\x0a\x2b\x20\x33\xd8\xfb\xfd\x03\x50\xf2\xe7\x31\xf9\x75\x41\x7e\xfb\x36\x0e\xf7\x5f\xec\xee\x42\xb8\xef\xfb\x7b\xe0\x8f\xcf\x8e\x7f\x9c\xbd\x9e\x9f\xce\x16\xa7\xf3\xcb\xab\x00\xee\x80\xae\xae\x61\xef\xe5\xec\x64\x7e\x0e\xb7\xaf\x6d\x65\x46\xdd\x8d\xd1\xfd\x73\xb8\x1d\x52\x7f\x5f\x3e\x22\x4f\x9e\xe9\x8e\x1a\x1e\xe9\x8f\x62\x74\x00\xbe\xff\xd8\x2e\x4f\xee\xb7\xc0\x52\xac\xb4\x67\x97\xd8\xdb\xf1\xc7\x4c\x64\xd2\xfa\x0e\x20\x43\x93\x14\x4c\xe4\x4e\x01\x3e\xff\xf5\x4f\xe0\xed\xf8\xef\x6c\xc0\x39\x1a\x20\x29\x90\x06\xc2\x28\x0c\xc3\xb5\xb8\x94\x29\x18\x96\xde\xf4\xa6\x99\xd0\x86\x72\x4e\x52\xac\x5c\x9d\xbd\x87\x82\xd6\x3b\xec\xee\xae\x55\xed\x19\x45\x8a\xc2\x58\x8b\xed\xa5\x01\x55\xa7\x67\xb9\x04\x26\xa8\x35\x55\x37\xad\x5e\x8f\xa8\x0b\x08\x23\xfb\x1e\x2a\xa3\xa3\xee\xc2\xc2\xe9\x2c\x92\x02\x93\xeb\x50\x17\x3d\x63\x8e\xc2\xcb\x51\xc4\x7d\x70\x02\x15\x35\x08\x61\x54\x5d\xe7\x11\xad\x18\x84\x11\x13\x06\x95\xa0\x3c\xd2\xa6\xfd\x94\x2e\xa3\x4a\x6a\x93\x2b\xd4\xcf\x61\x9b\xaf\xbb\x6e\xf9\x72\x55\x25\x60\xef\x6b\x54\x0d\x2a\x28\xa8\x48\x39\xaa\x03\xc8\xa9\xc1\x15\xbd\x39\x00\xbd\xa2\x79\x8e\x0a\xa8\x48\xa1\x44\xa3\x58\xa2\xe1\x1b\x30\x8a\x26\x08\xda\x48\x85\x6d\x4c\x1b\xd6\xac\x34\x5e\x56\x9a\xd8\x26\x4c\xd5\xc2\xe2\x66\xa5\x01\x29\xc0\xa6\x4e\xcb\x5a\x25\x08\x19\xe3\xf8\x20\x69\x99\x54\x25\x35\x0e\x6a\xab\x8e\xac\xb4\x6d\xac\x81\xac\x80\x70\x99\x50\x0e\xb7\xb7\x10\x9e\xc9\xb4\xe6\x78\x4e\x4b\x84\xfb\x7b\x08\xa3\xa4\x4c\xdb\x54\x6c\xa5\xa1\x27\x6a\xd0\x78\x0d\x0e\x88\x1a\xfc\x0a\xa2\x06\xbf\xc0\x71\x17\xdb\x86\xda\x98\xe7\x4c\x18\xcf\x2e\x5b\x0e\x9c\x6c\x60\xcc\x4a\x06\xc6\xc2\xad\xb9\x12\xe5\x92\x53\x91\x27\x8c\x58\x35\x20\xa4\x41\xb5\x94\x1a\x9d\x35\xe7\xce\xce\x4c\x56\xa2\xac\xcd\xe4\x59\x69\x07\xa5\xcb\x80\x26\xa9\x5c\x09\x2e\x69\x6a\x05\x38\x69\x50\xa4\x52\xf5\x68\xee\xd5\x7b\x6e\x75\x4d\xe4\x2a\x61\x43\xb0\xa4\xed\x48\xb0\x75\x67\x09\x0e\x61\xdd\x27\x4b\x8b\x9f\x30\xa9\x0d\x5d\x72\xec\xc1\xfd\xf1\x74\x1a\x00\x91\x60\xc1\x6d\x35\x06\x75\xe8\x9d\x1b\xd4\xc6\xb3\xcb\x76\xe2\xed\x59\xc3\x8a\x99\x02\x5c\x07\xa5\x68\x30\x31\x52\x0d\xfd\x5b\xad\x87\x99\xb7\x32\x37\x70\x17\x57\xb3\xcb\xab\x6e\x76\xf5\xff\x17\x09\x9c\xe9\xae\x36\xc1\x83\x0c\x10\x56\xd2\x1c\xbd\xad\x7d\xdc\x27\x20\x91\xc2\x50\x26\x50\x81\xfb\x02\xb5\x76\xf3\x44\x26\xd7\xa8\x9c\xef\x76\xbb\x9e\xa0\xa4\xb5\x42\x55\x0e\x7b\xeb\x11\x3a\xd9\x9e\xa6\x40\x8c\xeb\xd1\xb9\xb5\xd6\xa5\x26\xee\xff\x35\x10\xc2\xf0\x31\x6e\x72\xdd\xf9\x69\x29\x86\x6f\xaa\xaa\x75\xd1\x05\xd1\x6f\x07\xc1\xf5\xf1\xd8\x77\x5a\xd5\x4b\xce\x74\xf1\xd5\xb1\x59\xa3\xff\x0b\x3d\x44\xb6\xea\x5f\x10\xff\x31\x44\x4e\x38\x52\xe1\xb9\x35\x7e\x70\xdd\x09\xd7\xe5\xdd\x39\xb2\xe8\x4e\x54\x57\x80\x0d\xaa\x1b\x63\x8d\x7b\x3b\x47\xaa\x04\xa2\x32\xdb\x66\xfd\xc1\x76\x41\xe4\x9a\x28\xdc\x6f\x0f\x89\x6c\x50\xd1\x1c\xc3\xfd\xff\x02\x00\x00\xff\xff\x9c\x00\x55\xda\xa4\x08\x00\x00"
+var _makefileTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x5f\x72\xdb\xb6\x13\x7e\x36\x4f\xb1\x23\x33\x3f\x4b\xfe\x19\xa4\x93\x34\x7d\xa0\x47\x8d\x1d\x45\x76\x34\xf5\x9f\xa4\xf6\x64\xda\x26\xad\x0a\x91\x4b\x12\x63\x10\xe0\x00\x20\x15\x55\xd6\x4c\x4f\xd1\x23\xf4\x08\x3d\x50\x4e\xd2\x01\x44\x89\xa2\xd3\xe9\xe4\x05\x02\x56\x1f\x76\xbf\xfd\x76\xb1\xc4\x4f\xa5\x54\x06\xde\x8f\x7f\xb8\x9d\xdc\x5c\x03\x00\xbc\x1c\x82\xbf\xbc\x98\xdc\x4d\x47\x37\x57\x57\x93\xbb\x95\xd7\x40\x5a\x93\x83\xf4\x75\x8e\x9c\x43\xc6\x0c\x24\xa8\x63\xc5\x66\x08\x84\x18\x9a\x69\x20\x84\xf2\x39\x5d\xd8\x4d\xc2\x94\x59\x00\x21\x05\x35\x71\x3e\xac\x0f\xe1\xd9\x77\x10\x26\x58\x87\xa2\xe2\x1c\x1e\x1e\x00\xe3\x5c\x42\x25\xee\x85\x9c\x8b\xc1\x26\xd4\xe5\xeb\xe9\xf9\xe5\xd9\xc5\x2d\x0c\x81\xfc\x08\xbd\x82\x32\x11\x64\xcc\x8c\x64\x51\x30\x33\xf4\xfb\x2d\x95\x41\xaf\x45\xd4\xa8\x34\x93\x62\xe8\xf7\x9b\x6c\x06\x3d\x6f\x4b\xfe\xe6\xd5\xe4\x1a\x2c\x6d\x3a\xd3\x25\x35\x39\x04\x83\x30\x30\x52\x72\x1d\xce\x98\xd8\xc0\xde\x9e\xdd\xbd\x81\xc8\xc2\xdc\x85\x41\xd4\xc1\xcf\x98\x88\xfc\xbe\xc5\x6c\x99\x8e\x2e\x6e\xa6\xe3\xeb\xb3\x57\x97\xe3\xd7\xc3\xe3\x6d\xb4\xf7\x30\x84\xe3\xcd\xe1\x9d\x0b\xcb\x52\xf0\xfb\x29\xe3\x06\x15\x3c\x3d\xf2\xdf\x0f\x8e\x8e\x4e\xb7\x5e\xae\xa0\x15\xb4\x54\x4c\x98\x14\x7a\x1f\x8f\x9f\x3f\xff\xf0\xfc\x9b\x93\xa7\xc5\xe7\x3f\xff\x76\x87\xe3\xa2\x37\xd8\x86\x18\x8d\x60\x08\x99\x84\x59\xc5\x78\x02\x84\x27\x29\xb7\xd2\x1f\xf8\xfd\x8d\x78\x83\x03\xcf\x0b\xde\xbe\xb9\xb9\xfe\x29\x82\x1c\x79\xe9\xd9\x25\xf2\xf6\x4e\x33\x85\x25\x90\x31\x1c\xfc\xfa\x01\x28\xf9\xfd\x8c\xfc\x3c\x25\xbf\xfc\x3f\x0a\x0e\x5f\xee\xef\x43\x70\xe8\xfb\x07\xe0\xf7\xaf\xce\xbe\x1f\x9f\x4f\x2e\xc7\xd3\xcb\xc9\xed\xdd\x00\x1e\x80\xce\xef\xe1\xe0\xd5\xf8\x62\x72\x0d\xcb\x73\x5b\x99\x5e\x73\xa3\xb7\x3a\x81\x65\x97\xf5\xb7\xc5\x13\xf2\xec\x85\x6e\x58\xc3\x13\xfd\x51\xf4\x8e\xc0\xf7\x9f\xda\xe5\xd9\x6a\x87\x58\x82\xa5\xf6\xec\x12\x79\x7b\x7e\x9f\x89\x54\xda\xd8\x03\x48\xd1\xc4\x39\x13\x19\xd8\xff\xe0\xf3\x1f\x7f\x0d\xbc\x3d\xff\x9d\x4d\x38\x43\x03\x24\x01\x52\x43\x10\x06\x41\xb0\x31\x17\x32\x01\xc3\x92\x45\xeb\x9a\x09\x6d\x28\xe7\x24\xc1\xd2\xd5\xd9\x7b\x6c\x88\xd6\xce\xf7\xf7\x37\x50\x7b\x46\x91\xa0\x30\xd6\xe3\xfa\x52\x87\x55\x83\xb3\xbc\x04\xc6\xa8\x35\x55\x8b\x35\xae\xa5\xa8\x73\x08\x42\xfb\x1e\x4a\xa3\xc3\xe6\xc2\xd4\x61\xa6\x71\x8e\xf1\x7d\xa0\xf3\x96\x63\x86\xc2\xcb\x50\x44\x6d\x72\x02\x15\x35\x08\x41\x58\xde\x67\x21\x2d\x19\x04\x21\x13\x06\x95\xa0\x3c\xd4\x86\x1a\x5c\x2e\x81\xa5\x10\x9c\x23\x35\x95\x42\x1d\xbc\x95\xda\x64\x0a\x35\xac\x56\x10\x84\xc9\x2c\x2c\x1b\xc3\x72\x09\x28\x12\x58\xad\x4e\x60\x37\x87\x26\x84\xcd\x21\x53\x65\x0c\x36\x86\x46\x55\xa3\x82\x9c\x8a\x84\xa3\x7a\x1c\xe1\x82\x1a\x9c\xd3\x05\xac\x56\x47\x90\xad\xf7\x47\xa0\xe7\x34\xcb\x1c\x76\x1d\x04\xa8\x48\xa0\x40\xa3\x58\xac\xe1\x7f\x60\x14\x8d\x11\xb4\x91\x0a\xd7\xd2\x6c\x53\x4e\x0b\xe3\xa5\x85\x89\x60\x7f\x1f\x54\x25\xac\xd2\x69\x61\x40\x0a\xa0\x9c\x83\x96\x95\x8a\x11\x52\xc6\xf1\x91\xf6\xa9\x54\x05\x35\x8e\xf7\x4e\x3b\xb0\xc2\xbe\x06\x0d\x64\x0e\x84\xcb\x98\x72\x58\x2e\x21\xb8\x92\x49\xc5\xf1\x9a\x16\xb8\x56\x25\x2e\x92\xb5\xa2\x3b\x6a\xb6\x8c\x6a\x34\x5e\x8d\x1d\x46\x35\x7e\x05\xa3\x1a\xbf\xa0\xe3\x2e\xae\xfb\x72\xeb\x9e\x33\x61\x3c\xbb\xec\x04\xb0\xc7\xae\x33\x6b\xe9\x38\x0b\x76\xc6\x53\x98\x49\x4e\x45\x16\x33\x62\x61\x40\x48\x8d\x6a\x26\x35\x3a\x6f\x2e\x9c\x1d\xbd\xac\x40\x59\x99\xe1\x8b\xc2\xce\x5b\xa7\x80\x26\x89\x9c\x0b\x2e\x69\x62\x0d\x38\xac\x51\x24\x52\xb5\xd4\xdc\xf0\xf0\xdc\xea\x7a\xd1\x55\xc2\xa6\x60\x99\x3a\xb3\x6b\x0d\x16\x63\x97\xac\xfb\xcb\xb2\xc5\x4f\x18\x57\x86\xce\x78\x53\x65\xdb\xc6\x7e\x7f\x34\x1a\x00\x91\x60\x89\xdb\x6a\x74\xea\xd0\x06\x37\xa8\x8d\x67\x97\x5d\xe1\xed\x59\xc3\x9c\x99\x1c\x5c\x07\x25\x68\x30\x36\x52\x75\xe3\x5b\xd4\x63\xe5\xad\xcd\xcd\xed\xe9\xdd\xf8\xf6\xae\x19\x81\xed\x67\x4a\x02\x67\xba\xa9\xcd\x4e\x37\xba\x4c\x08\x2b\x68\x86\xde\xce\x3e\x6a\x05\x88\xa5\x30\x94\x09\x54\xe0\x50\x50\x69\x1b\x39\x91\xf1\x3d\x5a\x56\xef\x9a\x6d\x83\x26\xc4\xfd\x12\xaa\x32\x38\xd8\x4c\xe2\xe1\xee\x50\x06\x62\x5c\x8f\x4e\xac\xb7\x46\x9a\xa8\xfd\x64\x41\x00\x27\xf0\xaf\x5a\x37\x71\xd6\x2c\xba\x6f\xaa\xac\x74\xde\x24\xd1\x6e\x3b\xc9\xb5\xf9\xd8\x77\x5a\x56\x33\xce\x74\xfe\xd5\xb9\x59\xa7\xff\x49\xba\x4b\xd9\xc2\xbf\x60\xfc\x5b\x97\x72\xcc\x91\x0a\xcf\xad\xd1\xa3\x8c\x9d\x71\x53\xde\xbd\x53\x4b\xdd\x99\xaa\x12\xb0\x46\xb5\x30\xf6\xc3\xe0\xed\x9d\xaa\x02\x88\x4a\x6d\x9b\xb5\x07\xdb\x05\xa1\x5d\x74\x70\x08\xf6\x37\x8c\x65\x8d\x8a\x66\x18\x1c\xfe\x33\x00\xd0\x2a\xf9\xf4\xeb\x08\x00\x00"
 
 func makefileTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -224,7 +229,7 @@ func makefileTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _readmeMdTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x4f\x6f\xdc\xb6\x13\xbd\xeb\x53\x0c\x60\x20\xbf\x9f\x83\x4a\x82\x51\xa0\x87\x00\x41\x91\xc4\x6d\xe0\xb6\x48\x0c\x3b\xb7\x45\x01\x8d\xc8\x91\xc4\x2e\xc5\x91\xc9\xd1\x6e\x16\x41\xbe\x7b\x41\x52\xbb\xd6\x26\xdb\x43\xd1\x8b\x61\x0d\x87\xf3\xe7\x71\xe6\xbd\xbd\x82\x2f\x5f\xa0\xfa\x80\x23\x01\x7c\xfd\x5a\x14\xf1\xeb\x96\x82\xf2\x66\x12\xc3\xee\xd9\x78\x74\x01\x13\x00\x21\x90\xdf\x19\x45\x60\x1c\xb4\x18\x48\x03\x3b\xd8\x34\x8b\xb5\x9d\x8d\xd5\xe4\x9b\x3f\xff\x3f\x88\x4c\xe1\x55\x5d\xf7\x46\x86\xb9\xad\x14\x8f\xb5\x72\x28\x66\x47\xf5\xb9\x6f\x7d\x0d\x32\xa0\x14\x45\x09\xe4\xb0\xb5\x14\xa0\xc3\x20\xa0\x69\x47\x96\xa7\x91\x9c\x00\x77\xb0\xe9\x1f\xee\xdf\xad\xc2\xfa\x49\x55\x86\xeb\xeb\xa5\x88\xd1\x28\xcf\xc7\xda\x42\x8c\xf5\x79\xe2\x40\x01\x64\x20\x88\x57\x4f\x67\x80\x01\x1e\x7e\x79\xfc\x04\x35\xfc\x16\xd8\xc1\xce\x20\x6c\x62\x38\xe8\x51\x68\x8f\x87\x8b\xb5\x47\x87\x92\x14\x87\x43\x10\x5a\x3e\x17\xff\x6b\x30\x4e\xc8\x77\xa8\x68\x95\x77\x24\xf1\x46\x05\x20\xa7\x27\x36\x4e\x7e\x80\xfd\x60\xd4\x00\x9b\x7b\xcf\x23\xc9\x40\x73\x78\x4e\x34\x9d\x6c\xb9\x27\xc5\xb3\xd5\x10\x94\xc7\x89\xa0\xf3\x3c\x16\x25\x84\x79\x9a\xd8\x0b\x88\x47\x65\x5c\x0f\xe8\xf4\x29\x89\x71\x41\xfc\x1c\xa1\xc2\xf4\x74\x73\x88\x1e\x9b\x8f\x13\xb9\x77\xe4\xc2\x3a\x15\x4f\xe4\x54\xb2\xa5\x54\xab\x8a\x07\x42\x2b\x03\xa8\x81\xd4\x36\x96\x0d\xa9\xee\x88\xa5\xa6\xce\x38\x0a\x10\x04\x85\x60\x44\x87\x3d\xa5\x77\x39\x35\x5e\x15\x25\x4c\x9e\x77\x46\x67\x37\xa7\xd1\x6b\x78\xf7\xc7\x1d\x14\x25\xa4\x87\x86\xcd\x2d\xab\x2d\xf9\xe7\x4a\xf6\xfb\x7d\xa5\x93\x2d\x21\x1c\xbb\x76\x82\xc6\x91\x07\x33\x62\x4f\xab\x81\xd8\xfc\x3e\xb7\xe4\x1d\x09\xad\x3a\xd9\x9e\x6c\xa7\x4e\x16\x77\xc5\x2e\x98\x20\xb1\x42\xcb\x7d\x6f\x5c\x5f\x14\x57\x57\xf0\x9e\x44\x22\x2e\x8f\x82\x5e\x48\x47\xdb\x15\xbc\x8d\xc5\x2d\x1e\x57\x57\x70\xef\xa9\x7c\xa0\xa7\x38\x8d\x9b\xf7\x0c\x37\xd5\xcd\xcd\x6a\x1e\xd8\xa2\xeb\x2b\xf6\x7d\xad\x6d\xca\xf8\x5d\x53\x41\xd8\xd3\xba\xad\x40\xe8\xd5\xf0\xf3\xd3\xeb\x17\x72\x98\xe8\x35\x69\x13\x5f\xe8\x05\x77\x1d\x79\xe3\xfa\xd7\x8a\xc7\x71\x76\x46\x0e\x31\xdc\xcb\x8b\x8d\x6a\x56\x61\x1d\x32\xff\x5b\x76\xec\xcb\x11\xd5\x0a\x87\xfa\xfa\x25\x94\xd0\x7c\x4c\xfb\x8b\xb6\x81\xbb\x0e\x0e\x3c\xc3\x1e\x9d\x80\x30\x68\x9a\x2c\x1f\x96\x4e\xef\x5c\x10\xb4\x16\x6e\x69\x22\xa7\xc9\x29\x43\xa1\x28\x9a\x11\xb7\x94\xe6\x09\xad\x2d\x35\x4d\xc2\x6c\x43\x03\x7b\x63\xed\xd1\x0c\x1d\x5b\xcb\xfb\x08\xa5\x3e\xbb\x5c\xc2\x46\xd3\xf4\x1d\x5e\xcb\x1a\x19\xae\x35\x4d\x19\xb6\x7b\xcf\xc2\x8a\x2d\xbc\x9d\x23\x10\x01\x7e\xac\x7e\xaa\x6e\x2e\x6e\xde\xb4\xb8\xb6\xd9\x33\x7f\xb7\x73\x77\x0d\x68\xd9\xf5\xb0\x37\x32\xac\x2a\x9a\xec\xdc\x1b\x17\x0a\x00\x80\xb2\x84\x4d\xbe\x5e\xf6\xe4\xca\x9e\x2f\xaf\x76\xaa\xf2\x14\xb7\x16\x4f\x54\x8f\x18\x84\x7c\x7d\x76\xfb\xfa\x62\xd0\x15\x13\xfc\x5b\xe6\xf8\xc7\x54\x6b\x76\xb9\x94\x34\xec\xb1\xef\xd7\x63\xf7\xdf\xf2\x2d\xe1\xae\x8b\xa2\xf9\xc0\x42\xaf\x9a\xc4\x9a\x4d\xf6\x68\x40\xf1\x38\x19\x4b\x3e\x51\x4e\x3c\x59\x30\x06\xf4\x04\x9a\xf7\xce\x32\x6a\xd2\xe9\x38\xe0\x8e\x74\x9c\xb5\xa6\x3a\x5e\xef\x38\xd2\x3c\xcc\x2e\xfe\x4d\xd7\x3d\xff\x45\x4a\xfe\x17\xc0\x33\x0b\x68\xe3\x49\x09\xfb\xe3\x60\xbe\x35\x0e\xe3\x47\x1e\x45\x65\x09\x1d\xb4\xb3\x35\xba\x59\x1c\xf2\xce\xc1\x5d\x22\x89\xc5\x6d\xd9\x89\x44\x35\xd9\x0f\x6e\x57\x02\xb2\x67\xbf\xed\x2c\xef\xe3\x8c\xbe\xd1\x3a\x6e\x85\x3f\xd6\x01\x61\x22\x65\x3a\xa3\xa0\xb3\xd8\x07\xa8\x63\xc3\x63\x6c\xc6\x1a\x47\x80\xbe\x4f\xcc\x1a\x59\x16\x1a\x35\xea\x7a\xad\x89\x49\xcc\xc8\x57\x3d\x37\x45\x09\xb7\x89\x28\x4f\xd1\xd3\x3c\xc1\x48\x21\x20\xf6\x51\x79\x9c\x86\xa4\x34\x27\x2d\x8a\x21\xd7\xe1\x32\x68\x0d\x74\xc6\x26\x4e\x7d\x98\x1d\xe4\x06\x7b\x72\x4d\x05\x9f\x06\x13\xf2\x2e\xf6\xe4\xc8\x47\x3a\xf6\xf4\x34\x7b\xd2\x10\x15\x40\x49\x4e\x72\xd4\xe8\x28\x2a\xac\x43\x92\x90\x23\xf4\xc2\xa7\xe8\x77\xe3\x64\x33\x91\xc7\xb3\x36\xaa\x06\x85\x10\x39\x33\x82\xc1\x3e\x37\xf2\x4d\xb0\xb3\xaa\x32\xde\xeb\xba\x32\xd9\x23\xb4\xe9\x15\xcf\xbb\x6b\x96\x21\x68\xaa\xba\x35\xee\x38\x19\x45\x09\x1f\x3b\x21\x07\x62\xc6\x45\xb0\x8f\x29\x1d\x91\x0e\x71\x9a\x92\xd0\xa0\x92\xbc\xed\x81\x47\x82\xcc\xb4\xf0\x2b\x7b\xa0\xcf\x18\x3b\x89\x3f\x4e\x9e\x6c\x3e\x00\xf6\x80\xe0\xf8\xd9\x60\xcd\x96\x60\x64\xd7\x73\x3c\x6b\xd9\x6a\xdd\x56\xf0\x06\x1e\xd3\xe9\x49\xc9\x4e\x62\x17\x89\x2e\x41\x46\x3e\x8b\x89\xa2\xda\xd3\xc4\x27\x50\x23\xd0\x91\x5d\x65\x20\x4f\x8b\x64\xb7\x04\xe3\x6c\xc5\xc4\x6a\xcc\x11\xdd\xa4\xc9\x21\x01\xfa\x4d\x40\xb0\x78\x20\x1f\xe1\x5b\xfa\x59\xd5\xb1\x4e\xb3\x43\x6f\x78\x0e\x29\xe4\x2a\x62\xda\xbf\x54\xae\x4e\x93\x94\x2e\x3b\xb4\x75\x12\xea\x06\x26\x54\x5b\xec\xa9\xca\xfb\xf0\x30\x3b\xf7\x2c\x74\xec\xc0\xb2\x42\x3b\x70\x90\xa2\x78\xd3\x49\xdc\x6f\x08\xb3\x52\x14\x42\x37\x1f\x1f\x32\xff\x92\x48\x6f\x1d\x7b\x55\xe8\x8a\x22\x3f\xdf\xd9\x1a\x34\x17\xa2\x2e\x77\xf3\x66\x16\xc5\xa3\x19\x8d\x45\x6f\x0f\xc7\x40\x09\x8c\x7c\x9a\x05\x1f\x30\x56\x51\x14\xcd\x62\xf4\xb3\x83\xb2\xf4\xe3\xd9\xef\xd4\x57\x9a\x76\xab\x6c\x08\xcf\xf2\x07\xca\xce\x21\x47\x10\xd6\xdc\xfc\x1d\x00\x00\xff\xff\x35\x18\x65\x53\xe1\x0a\x00\x00"
+var _readmeMdTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xdf\x8b\x1b\xb7\x13\x7f\xdf\xbf\x62\xe0\x20\xdf\xaf\x43\x77\x97\xa3\xd0\x87\x40\x28\xc9\x5d\x13\xdc\x86\xdc\xf5\x2e\x6f\xa6\xb0\xb2\x34\xab\x55\xad\x9d\xd9\x93\xb4\x76\xcc\x91\xff\xbd\x48\x5a\xdb\xeb\x9c\x5b\x28\xf4\xe5\xb8\x95\x46\x9f\x99\xf9\xcc\x4f\x5f\xc1\xf3\x33\x54\x9f\x45\x8f\x00\xdf\xbe\x15\x45\xfc\xba\x45\x2f\x9d\x19\x82\x61\x3a\x1d\x1e\x44\xc0\x78\x10\xe0\xd1\x6d\x8d\x44\x30\x04\x6b\xe1\x51\x01\x13\xac\x9a\xe9\x74\x3d\x1a\xab\xd0\x35\x7f\xfc\xbf\x0b\x61\xf0\x6f\xea\x5a\x9b\xd0\x8d\xeb\x4a\x72\x5f\x4b\x12\xc1\x6c\xb1\x3e\x97\xad\x17\x10\x3a\x11\x8a\xa2\x04\x24\xb1\xb6\xe8\xa1\x15\x3e\x80\xc2\x2d\x5a\x1e\x7a\xa4\x00\xdc\xc2\x4a\x3f\xdc\xdf\xcc\x60\xdd\x20\x2b\xc3\xf5\x62\x32\xa2\x37\xd2\xf1\xc1\x36\x5f\x3c\x3f\x97\x60\x5a\xa8\x3e\xa0\x08\xa3\x43\x5f\x7d\x14\x01\x77\x62\x1f\x7d\x2a\x01\xbf\x0e\xec\xd1\x43\xe8\x10\x22\xec\xf1\x1d\x08\x0f\x0f\xbf\x3c\x7e\x81\x1a\x7e\xf5\x4c\xb0\x35\x02\x56\xda\x0d\x12\x74\x7e\x7f\xd1\xaf\x28\x50\xa2\x64\xbf\xf7\x01\xa7\xcf\x49\x7e\x01\x86\x02\xba\x56\x48\x4c\x36\x21\xa9\x73\x13\x7a\x0c\xce\x48\x0f\x48\x6a\x60\x43\xe1\x07\xd8\x75\x46\x76\xb0\xba\x77\xdc\x63\xe8\x70\xf4\x27\x9d\xc3\xf1\x2c\xbb\x2e\x79\xb4\x0a\xbc\x74\x62\x40\x68\x1d\xf7\x45\x09\x7e\x1c\x06\x76\x01\x82\x13\xd2\x90\x06\x41\xea\xa8\xc4\x90\x0f\x6e\x8c\x8c\x8a\x14\xe1\xd1\x47\x89\xd5\xdd\x80\x74\x83\xe4\xe7\xaa\x78\x40\x92\xe9\x2c\xa9\x9a\x59\xdc\xa1\xb0\xa1\x03\xd9\xa1\xdc\x44\xb3\x21\xd9\xed\x8b\x12\x14\xb6\x86\xd0\x83\x0f\x22\x20\xf4\x82\x84\xc6\xa8\xec\xc4\x41\xf5\x32\x30\xf7\xec\x83\x76\xe8\x33\x2d\x03\x3a\x6f\x7c\xc8\xa1\x71\xe8\x79\x74\x31\x2c\x86\x60\x35\x09\x3e\xfe\xfe\xe9\x64\xe5\x6e\xb7\xab\x86\x09\xe0\xc9\x56\xec\x74\xbd\x80\x9d\x09\x1d\x6c\x23\x10\x53\xca\x0c\xed\x92\xbb\x39\x2b\xd0\x7a\xcc\xba\x36\x88\xc3\x05\x45\x82\x62\x6a\xf7\xd8\xb3\xdb\x83\x0f\xec\xce\x22\xf7\xc2\xfe\xbb\xe5\xed\x4d\xc6\x13\x63\xe8\x90\x82\x91\x22\xa0\x07\x87\x4f\x23\x46\x4f\x92\x39\x89\xe3\xe5\x2d\xdc\x30\x11\xca\x70\xf2\x20\xf2\x6c\x54\x45\x18\x6a\x99\xef\x62\x3d\xf0\x06\xc9\x9f\x27\xcc\xe0\x78\x6b\x54\x66\x97\x94\x70\x0a\x6e\x3e\x2d\xa1\x28\x21\x95\x1c\xac\x6e\x59\x6e\xd0\x9d\x80\x23\x35\x2a\x9d\xa5\x1c\x8d\xc9\x42\x41\x18\x42\x07\xa6\x17\x1a\x67\xe5\xb6\xfa\x6d\x5c\xa3\x23\x0c\x38\x4b\x80\xcd\xf1\xec\x98\x00\x93\xb8\x64\x8a\x31\x8a\x81\xb5\xac\xb5\x21\x5d\x14\x57\x57\xf0\x11\x43\x30\xa4\xe1\x31\x08\x17\x50\xc5\xb3\x2b\x78\x1f\x8d\x9b\x24\xae\xae\xe0\xde\x61\xf9\x80\x4f\xb1\xd6\x57\x1f\x19\xae\xab\xeb\xeb\x93\x46\xcd\x56\x90\x4e\x41\x54\x36\x69\x7c\xe1\x54\x0a\xc7\xdc\x2d\x8f\xc2\xc9\xee\xe7\xa7\xb7\xaf\xc2\x7e\xc0\xb7\xa8\x4c\x8c\xf4\x2b\x6e\x5b\x74\x86\xf4\x5b\xc9\x7d\x3f\x92\x09\xfb\x08\xf7\xfa\xa2\xa3\x8a\xa5\x9f\x43\xe6\x7f\xcb\x96\x5d\xd9\x0b\x39\xe3\xa1\x5e\xbc\x86\x12\x9a\xbb\xd4\x1d\x85\x6d\x60\xd9\xc2\x9e\x47\xd8\x09\x0a\x10\x18\x14\x0e\x96\xf7\x93\xa7\x4b\xf2\x41\x58\x0b\xb7\x38\x20\x29\x24\x69\xd0\x17\x45\xd3\x8b\x4d\xec\x9c\xe9\xae\x54\x38\x04\x66\xeb\x1b\xd8\x19\x6b\x0f\xc7\xd0\xb2\xb5\xbc\x8b\x54\xaa\xb3\xc7\x25\xac\x14\x0e\x2f\xf8\x9a\x1a\x91\xe1\x5a\xe1\x90\x69\xbb\x77\x1c\x58\xb2\x85\xf7\x63\x24\xc2\xc3\x8f\xd5\x4f\xd5\xf5\xc5\xde\x35\x4c\xa2\xeb\x2c\x99\xbf\xd7\x63\xbb\x00\x61\x99\x74\x2e\xa6\x93\x45\x83\x1d\xb5\x21\x5f\x00\x00\x94\x25\xac\x92\xb8\x2c\x35\x52\xa9\xf9\xa2\x82\x6c\xe5\x11\xb7\x0e\x0e\xb1\xee\x85\x0f\xe8\xea\xb3\xd7\x8b\x7f\x6c\xda\x97\x14\xce\xfa\xec\xbf\xed\xcb\x7f\x6b\xc6\x4c\x66\x71\xc9\x4b\xbf\x13\x5a\xa3\xfb\xaf\xf4\x4d\x70\x8b\x79\xad\x17\xcd\x67\x0e\xf8\xa6\x49\xad\xa9\xc9\x0e\x37\x20\xb9\x1f\x8c\x45\x97\x1a\x7a\xbc\x99\x42\x01\xc2\x21\x28\xde\x91\x65\xa1\x50\xa5\x6b\x2f\xb6\xa8\x62\x4a\x36\xd5\xe1\x79\xcb\x71\xd6\xc2\x48\xf1\x6f\x7a\xee\xf8\x4f\x94\xe1\x7f\x1e\x1c\x73\x00\x65\x1c\xca\xc0\xee\x90\xbf\xef\x0d\x89\xf8\x91\x33\x56\x5a\x14\x04\xeb\xd1\x1a\xd5\x4c\x02\xb9\x34\x61\x99\x7a\xc9\x24\x36\x95\x4e\xea\x48\x59\x0e\x6e\x67\x53\x7c\xc7\x6e\xd3\x5a\xde\xc5\xf2\x7f\xa7\x54\x2c\x1e\x77\xb0\x03\xfc\x80\xd2\xb4\x46\x42\x6b\x85\xf6\x50\x47\x87\xfb\xe8\x8c\x35\x84\x20\x9c\x4e\x73\x2b\x75\xe8\x46\xf6\xaa\x9e\x2f\x26\x69\xa3\x40\x57\x69\x6e\x8a\x12\x6e\xd3\x18\x3a\xa2\xa7\xb4\x83\x1e\xbd\x17\x42\xa3\x4f\x04\xc5\xd0\x9c\x86\x7e\x84\x9c\xc3\x65\xd2\x1a\x68\x8d\xc5\xaa\x28\xe1\x61\x24\xc8\x0e\x6a\xa4\xa6\x82\x2f\x9d\x89\x6d\xdd\x5a\xd0\x48\xe8\xe2\xb0\x8b\xdd\xde\xa1\x82\x38\x5f\x65\xc8\x4a\x26\xfc\x38\x7d\x3b\x56\x3e\x0d\xe8\x03\xf5\x81\x8f\xe8\xcb\x7e\xb0\x79\x4c\xc6\xbb\x75\x9c\xc9\xe8\x3d\x58\xd6\x91\x0c\x76\xd9\x91\xef\xc0\xce\xac\xca\x7c\xcf\xed\x4a\x27\x20\x60\x9d\xa2\x78\xee\x5d\x33\x25\x41\x53\xd5\x6b\x43\x87\xcc\x28\x4a\xb8\x6b\x03\x12\x04\xd3\x4f\x9b\xd1\x41\x25\x21\x2a\x1f\xb3\x29\x8d\x71\x21\x43\x6e\x0a\x9e\x7b\xcc\xf3\xb1\x82\x0f\xec\x00\xbf\x8a\xe8\x49\xdc\x10\x9f\x6c\xbe\x00\x76\x20\x80\xf8\x74\x60\xcd\x06\xa1\x67\xd2\x1c\xef\xd6\x6c\x95\x5a\x57\xf0\x0e\x1e\x93\xf8\x71\x4f\x38\xae\x12\xb1\x85\x46\x5a\xa6\xbd\x20\xee\x24\xb5\xc3\x81\x8f\xa4\x46\xa2\x63\x13\x0e\x1d\x3a\x9c\x16\xa2\x35\x42\x3f\xda\x60\xa2\x35\xe6\xc0\x6e\x5e\x01\x12\xa1\xdf\x01\x82\x15\x7b\x74\x91\xbe\xc9\x9f\xd3\xbe\x72\xa6\x66\x2b\x9c\xe1\xd1\x27\xc8\x19\x62\xaa\xbf\x64\xae\x4a\xc9\x99\x1e\x93\xb0\x75\x5a\x83\x1a\x18\x84\xdc\x08\x8d\x55\xae\x87\x87\x91\xe8\x34\x0f\x99\xc0\xb2\x14\xb6\x63\x1f\x8a\xe2\x5d\x1b\x62\x7d\x83\x1f\xa5\x44\xef\xdb\xf1\x10\xc8\x98\x13\x1a\x52\xac\xa3\xaf\x52\x50\x51\xe4\xf0\x9d\x95\x41\x73\x01\x75\x7a\x9b\x2b\xb3\x28\x1e\x4d\x6f\xac\x70\x76\x7f\x00\x4a\x91\xce\xb7\x79\x2f\x00\x11\xad\x28\x8a\x66\x3a\x74\x23\x41\x59\xba\xfe\xec\xc7\xc2\x1b\x85\xdb\x99\x36\x01\xa7\x29\x09\xd2\x8e\xb1\xcb\x15\x45\x13\x58\x71\xf3\xd7\x00\xd1\xc0\x8c\x39\x66\x0c\x00\x00"
 
 func readmeMdTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -244,7 +249,7 @@ func readmeMdTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _cmdConfigGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x5f\x6f\xdb\xba\x15\x7f\x96\x3e\x05\x23\x20\x85\x14\x38\xf2\xbb\x2f\x8c\xad\x37\xed\xed\xba\xa5\x5d\x50\x67\x77\x0f\x45\x31\xd0\x14\x2d\x13\xa6\x48\x5d\x92\xb6\xdb\xa5\xf9\xee\xc3\x21\x29\x89\x94\xad\xd6\xd9\xf5\x8b\x25\xf2\x77\xfe\xfd\xce\xe1\x21\xa9\x16\x93\x1d\xae\x29\x6a\x30\x13\x69\xca\x9a\x56\x2a\x83\xf2\x34\xc9\x88\x14\x86\x7e\x35\x59\x9a\x64\x9b\xc6\xfe\xb5\xd8\x6c\xbb\xff\xf9\x86\x71\xea\x06\xd2\x24\xab\x99\xd9\xee\xd7\x25\x91\xcd\xbc\xdd\xd5\x73\xaa\x94\x54\x3a\x8b\x27\xf6\x6a\x83\x0f\x74\x4e\x38\x1b\x8b\x10\x81\x0d\x3b\x50\x2b\xca\x65\x6d\xa7\x9f\x9e\x50\xf9\x41\x56\x7b\x4e\x3f\xe2\x86\xa2\xe7\xe7\x39\x13\x86\x2a\x81\xf9\x5c\x1b\x6c\x68\x96\x16\x69\x6a\xbe\xb5\x14\x19\xae\xef\xa4\xd8\xb0\x1a\x69\xa3\xf6\xc4\xa0\xa7\x34\x21\x54\x99\xdf\x18\xa7\x30\xc4\x44\x9d\x26\x3b\xfa\xcd\xbe\xf7\x03\x04\xbb\xf7\x7e\x40\xef\x58\x8b\xe0\xb7\x96\x92\xa7\xcf\x5e\xb9\xa6\xea\x40\xd5\x89\x7e\x49\x5e\xd7\x54\x18\x14\xfc\x24\x79\xfb\x15\xb8\xeb\xd0\x69\x52\xad\xd1\xf8\x57\xad\xbb\x49\xc3\xf5\x78\xb2\x0f\x24\x4d\x2a\xba\xde\xd7\xf1\xac\x75\x2b\xa9\x1f\x20\x3d\xd1\x6f\xcf\x84\x49\x93\xad\x39\x99\xf1\x13\x53\x12\xcd\xd4\x44\x35\x35\x51\x1f\x27\x6c\xd4\xc7\xb7\x02\xaf\x39\xad\xc6\xee\xda\x54\xad\x8c\x54\xb4\x9f\x08\xf9\x7e\x50\x92\x50\xad\x3f\x50\xa3\x18\xd1\x5e\xc6\xe0\x7a\x4c\x4d\x83\xdb\xcf\x4e\xee\x4b\x27\xae\x24\xe7\x6b\xac\x1e\xe5\x8e\x8a\x58\x73\x97\x3a\xc2\xd9\x03\x56\x9a\xaa\x20\x6f\x47\x66\xb6\xef\xb0\xa1\x47\xfc\x6d\x70\x12\x06\xff\xf6\xf8\xf8\xb0\xb2\xb9\x0e\x06\xdf\x7d\x7a\xb8\x8b\x06\x89\xf9\x1a\xba\x75\x43\x38\x2b\xef\xdc\x32\x01\xb3\x9b\xbd\x20\x28\x27\x76\xdc\x99\x2e\x10\xd3\x8f\xf7\xab\x4f\xf4\x8f\x3d\x53\xb4\xca\x0b\xab\x08\x3c\x51\xd4\xec\x95\x40\xa4\x0c\x5d\xfa\xfe\xdd\x0f\x04\xee\xf4\x63\x83\x37\x53\xb6\x6a\x6a\x5c\x01\xe5\x05\xca\x6f\xc2\xd2\x9d\x21\xbb\x24\x0b\xf4\x94\xa6\x09\x54\xa9\xb6\x23\x68\xb1\x44\xa4\xac\xa9\x81\x35\x66\xd3\xae\xf3\x22\x4d\xd8\xc6\x4e\x5e\x2d\x91\x60\xd6\xd9\xce\x5b\xc1\xb8\x95\x4b\x93\xe7\x34\x4d\x0e\x58\x05\x8b\x2f\xa8\x5e\xb6\x41\xa4\x1c\x07\x0e\x6a\x7a\x8c\xb3\xbe\x04\x8f\x1f\xef\x57\x6e\xec\x37\x25\x9b\xbb\xfb\xf7\x39\x29\x89\xf9\x5a\xa4\xc9\x19\x37\x4e\xfd\x48\x9e\x9d\x2f\xb6\x68\x16\x4b\x04\xff\xa0\x68\xc5\x19\xa1\x4e\x55\xf9\x8e\xcb\x35\xe6\x2b\x5b\x1d\x6e\x3c\x33\xb8\xce\x8a\x22\xb5\xae\xfe\x67\x86\xe4\xae\x93\xfd\x9c\x1d\xa8\xd2\x4c\x8a\xec\xcb\x2f\xe8\x4a\xee\x9c\xdb\xf1\x04\x5a\x22\xdc\xb6\xe5\xef\xee\xdd\xd9\xf7\x8e\xbd\x0a\x49\x07\x51\xdf\x28\x16\x61\xd9\xd4\xd4\xfc\xf3\x2e\x6e\x16\x51\xec\x33\x64\xbb\x9f\xef\x7b\x59\x31\x4b\x93\xa4\x5a\x2f\xd0\xf8\x57\x53\xf3\xe6\x57\x9f\x70\x47\x1a\x20\x6d\x87\x18\x81\x6d\xc6\x3f\x67\xb5\x6a\xc9\x2d\x3c\x67\x5f\x00\xe9\x5a\xc6\xe2\x1c\x72\x6b\x4c\x1b\x20\xab\x69\x9d\xb6\x53\x85\x4a\xa7\xa1\x5b\x8a\xb9\xd9\x06\xd8\x66\x1a\xdb\xb8\x96\x10\x80\x5d\xf3\x39\xeb\x6d\xed\x96\x4f\x00\x36\x5c\x9f\xf0\x35\x14\x5f\x9a\x04\x8d\x69\xc0\xb9\x6a\x71\x75\x92\x67\x16\x71\xab\x01\xe2\x53\x00\x91\x8e\xb4\x86\x05\xf6\xab\x94\x3c\x77\x7c\x38\x81\xbe\x2b\x2e\x42\x81\x70\xbd\xbf\x7a\x85\xae\x9c\x0a\x27\x2c\xe4\xad\x8f\xc5\x69\x38\xed\x91\x8b\x33\x26\x01\x75\xdb\x3a\xd8\xad\x27\xce\xc9\x43\xe1\x8e\x79\x80\x31\x98\x0b\x1b\xe8\xe2\x4c\x38\x1d\x0f\x1e\x77\x6b\x00\x68\xd5\x3e\xcf\x60\x01\xfe\xa0\x09\x85\xdd\x04\xe5\x41\xf3\x86\xdd\xe2\xb4\x15\xc1\xda\x6b\xf0\x8e\x5a\x24\x40\x7c\x93\x2f\xfc\xfc\x07\xdc\x46\x90\x40\x19\x2c\xe1\xf9\x1c\xb9\xca\xb2\xe5\x90\x26\xee\xc5\xee\x54\xb6\xbd\x0d\x21\xfd\x8b\x09\x93\x47\x65\xe8\x9a\xdd\x15\xd3\x00\xff\x1d\x73\x56\xe5\x83\x78\x71\xd2\xfa\x36\x8d\x29\xdf\x82\xf7\x9b\x3c\x63\xe2\x00\x78\x14\xa8\x43\x62\xdf\xac\xa9\x5a\xa0\xeb\x2a\x9b\xa1\x40\x11\xf4\x08\x30\xa4\xbb\x56\xe3\x0a\x77\x40\x7c\xf9\x05\xf9\x5e\x33\xea\x70\x52\xe9\xde\xe2\xf5\x01\x61\x11\x1b\x24\x58\x08\x69\xd0\x9a\x22\xb3\xa5\x48\xe3\x86\x66\x33\xa4\x9d\xbd\x13\x1b\x68\x89\xa2\xe0\x07\x7a\x47\x6b\x13\x2d\x03\xe7\x1d\xc3\xbe\xac\x3c\xc5\xfe\x6d\x9a\xe3\x68\xf9\x9e\x21\x39\x50\x70\x19\xcb\xa1\xc2\x98\xe6\x50\xd5\x04\xcf\x01\xe4\x25\x44\x47\x36\x7f\xce\x74\x68\x05\xa8\x8e\x38\x08\xb9\x8e\x7b\x1b\x5a\x86\x21\x38\xb6\xdd\xf1\xcf\x71\x6d\x37\xd3\x89\x2e\x63\x03\xb1\xcf\xd3\xa9\x08\x1a\xb4\xdf\x54\xa3\x4c\xf4\xd2\xc5\xe9\x0e\x7b\x2e\x11\x83\xba\x38\x0d\x83\x1e\xb7\x29\x9f\x49\x43\x0f\x19\x92\x70\x41\x16\x02\x83\x93\x39\xb0\x06\xc7\x46\x20\x07\x41\xf0\x1d\xc2\xa6\x20\xdc\xb5\xd0\x72\x70\xde\x6d\xe6\xf3\x39\x82\xad\x32\x4c\xc0\xe8\x38\x08\xbe\x03\x24\xa6\xdd\x11\x3e\xec\xb2\xe7\xf8\xee\xa4\x2e\xa4\xbb\x57\x16\xb3\xdd\x6b\x99\x24\xbb\x43\xbc\x88\xeb\xc1\xda\x25\x54\xf7\x26\x80\xe9\x21\xea\x88\xe8\xe0\xc8\x01\xc7\x3d\x2f\x11\xd3\xec\xb7\x3c\x4f\xf7\x70\x97\x58\x2c\xa7\x77\xc7\x30\x2d\xc3\x66\x3a\xc8\x3e\xa1\xf9\x1c\x41\x04\x98\x73\x7b\x89\x63\x84\x6a\xa4\xf7\xad\x8d\xae\x3e\xda\x70\x99\x41\x0d\xab\xb7\x2e\xcc\xbd\x12\xb4\x42\x72\xb3\x41\xb2\x35\x4c\x0a\xcc\xf9\xb7\xfe\xd8\x71\x92\xe3\xf0\xc4\x71\x36\xcd\xc7\x97\x24\x39\xd0\x36\xca\xf3\xf1\x27\x59\x3e\xbe\x3c\xc7\xa1\xb1\x8b\xd2\x7c\x1c\x92\x1c\x86\x1d\xe7\x39\x3a\x82\x41\xaa\x8f\x51\xa2\xe1\x40\x39\x5e\x4f\xc1\x25\xe7\xc9\x1e\x47\x4d\x7b\x8e\xeb\xe1\x2c\x7a\x8e\xe8\x4e\xea\x42\xaa\x7b\x65\xa3\xbd\xba\xd3\x32\xc9\x74\x87\x78\x11\xd7\x83\xb5\x4b\x88\xee\x4d\xd8\x8d\xba\x8f\x3a\xe2\x39\x38\x98\xc3\x2e\xed\x25\xa2\x3b\x48\x07\x8e\x4f\x69\x35\x35\xab\xfe\xcc\x9b\xc3\x45\xd6\x7f\xdf\xe9\x2e\xb0\x33\xc4\x65\x5d\x53\x05\x7f\xe5\xbd\x7d\x9c\x21\x89\xa2\x6b\x64\x81\x72\x7b\x20\x46\xf6\x70\x5c\x5a\x5d\xee\x2a\xd7\x9f\xe9\x12\x7d\x64\x86\x6c\x91\x2c\x83\xbb\xff\x53\x9a\x10\xac\x29\xca\xda\x5a\xff\xc1\xb3\x45\x9a\x24\xce\x58\xf9\x5e\x6c\xe4\x31\xcf\x88\x14\x82\x12\xc3\x44\x8d\x8c\x44\x15\x36\x78\x8d\x35\x50\x94\xc1\xb3\x96\x7b\x45\xe0\x0d\x32\xba\x6a\x15\x13\x66\x93\x67\xad\xd4\xa6\x56\x54\x2f\xe6\xf3\x6b\xbd\xb8\xb9\xb9\xb9\xf9\xeb\xb5\x5e\x5c\x57\xf3\x6b\xfd\x17\xad\x79\x23\x2b\xba\xac\x98\x86\x7e\x90\xcd\x90\x2c\xab\x75\xb9\xd7\x36\x28\x78\xdc\x4a\x6d\xfc\x23\x10\xe6\x1f\x05\x6e\x68\x51\xd8\xfb\x41\x1f\xda\xd2\x47\xfb\x91\x1e\x1f\xbc\x49\x47\x22\xf7\x24\xfd\xc0\xab\x6b\xfd\x12\x9f\x5a\xac\xf5\x51\xaa\xea\x32\x17\x2f\xbd\x1e\x57\x74\x83\xf7\xdc\x2c\x2e\x38\x65\x69\x77\x21\xba\xd6\xd6\xb9\x21\x81\xee\x98\xe3\xa5\x3d\x35\xf6\xaf\x7c\x2f\x98\x61\x98\xb3\xff\xda\x9a\x2a\xc2\x72\xfb\x24\xa5\x71\x75\x94\x83\xcf\xfe\xf3\xcc\x0c\x91\x93\x9a\x0a\x2b\x6e\x28\x24\xce\x61\xf5\xc1\x1c\x14\xc9\x3d\x3d\x50\xee\x9b\x87\x3b\x1e\x41\xc4\x9c\x23\x07\x79\x03\x43\x1e\x13\xac\x05\x98\xfa\x48\x8f\xd6\xc2\xbf\x99\xd9\xc2\xcd\xc4\x7a\x53\xd8\x72\xb7\x63\x56\x2a\xe7\x3c\x18\xfa\xe4\xee\x3c\x39\x29\xc3\x5b\x92\x9f\xc7\x4a\x58\x91\x00\xff\x88\x6b\x9d\x93\x12\xae\x56\x45\xc4\xc1\xe9\xc7\x8d\xe8\xa3\x51\x81\x72\xc3\x35\x1a\x7d\x16\x09\xee\x47\xdd\x67\x4b\xdb\x12\xfd\x8d\xcc\x70\xfd\xa0\xd8\x01\x1b\xfa\x0f\x37\x6b\xbf\x14\x14\xc1\x47\xcf\x11\xfa\xce\x8f\x87\xb8\x37\x4c\x9d\x83\xbd\x61\xca\xa3\x2c\xd5\x9d\xf9\xe5\x12\x65\x19\x6c\xb0\xbd\x89\x68\x04\x94\x5d\xd9\x01\xc8\x49\x2f\x84\x5a\x6c\xb6\xe5\xdf\x25\x13\xb9\x47\xcd\x50\x66\xb8\x2e\x77\xd4\xee\xe0\x83\xc3\xd3\x50\xe2\xda\xfe\xb3\xf3\x27\x8f\x1d\xfa\xfe\x7d\xe4\x50\xe1\x6f\xd4\xf6\xbc\xc0\x84\xa6\x64\xaf\xe8\x6a\xc7\xda\xc7\xfb\x95\x8b\xcb\x7a\xe8\x56\xf5\x5b\xa5\x3e\x30\xad\x99\xa8\x1f\xef\x57\x50\x62\xfd\x02\xf1\x9f\x94\xc0\xfc\xe0\x61\xf7\xe8\x26\x86\x20\xfd\x93\xc7\xe3\x0e\xdd\x11\x4b\x38\xa3\xc2\xdc\xbd\x0e\x13\x00\x48\xfb\xb9\x19\x70\xd3\xbe\x0e\x65\xac\xa8\x96\xfc\x40\x5f\xaf\x35\xa8\x79\xc0\x66\x0b\x09\x1b\x2a\xed\xfc\xfc\x50\x57\xae\xce\x4e\x3e\x05\x02\xa5\x51\x94\x43\x12\x37\xfd\xf7\xc1\xee\x3b\x7f\xf9\x7a\xad\xf3\x10\xfd\xe3\x1e\xd4\xdb\x7b\x7a\x0e\x7a\xd1\x98\xd4\x4d\x9f\xda\x90\xd4\x8b\xdc\xf0\xe0\xff\xdb\x8b\x21\x83\xb1\x13\x3e\x85\x97\x51\x81\xff\x1c\x11\x38\xf6\x60\x90\xe8\xf6\xee\xff\x05\x00\x00\xff\xff\xd5\x97\xc7\x75\x9d\x19\x00\x00"
+var _cmdConfigGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x59\x5d\x6f\xdb\x3a\xd2\xbe\x96\x7e\xc5\x44\x40\x02\x29\x70\xe4\x7b\x1f\x18\xef\xdb\x93\x7e\x6c\x77\xd3\x6e\x50\x67\xcf\x5e\x14\xc5\x82\xa6\x28\x99\x30\x2d\xea\x90\x74\xdc\x6c\xea\xff\xbe\x18\x92\x92\x28\xd9\x4e\x9c\x5d\xb7\x80\x24\xf2\x99\x0f\x3e\x33\x1c\x7e\xa4\x21\x74\x4d\x2a\x06\x1b\xc2\xeb\x38\xe6\x9b\x46\x2a\x03\x69\x1c\x25\x54\xd6\x86\xfd\x34\x49\x1c\x25\xe5\xc6\x3e\x1a\x62\x56\xed\x73\x5a\x72\xc1\xf0\x25\x89\xe3\x28\xa9\xb8\x59\x6d\x97\x39\x95\x9b\x69\xb3\xae\xa6\x4c\x29\xa9\x74\x32\xec\xd8\xaa\x92\x3c\xb2\x29\x15\x7c\x2c\x42\x6b\x62\xf8\x23\xb3\xa2\x42\x56\xb6\xfb\xf9\x19\xf2\x2f\xb2\xd8\x0a\xf6\x95\x6c\x18\xec\xf7\x53\x5e\x1b\xa6\x6a\x22\xa6\xda\x10\xc3\x92\x38\x8b\x63\xf3\xd4\x30\x30\x42\xdf\xca\xba\xe4\x15\x68\xa3\xb6\xd4\xc0\x73\x1c\x51\xa6\xcc\x47\x2e\x18\x36\xf1\xba\x8a\xa3\x35\x7b\xb2\xdf\x5d\x03\x25\xee\xbb\x6b\xd0\x6b\xde\x00\xfe\x96\x52\x8a\x78\xef\x95\x6b\xa6\x1e\x99\x3a\xd0\x2f\xe9\xbb\x8a\xd5\x06\x82\x9f\xa4\x1f\x7e\x22\x77\x2d\x3a\x7e\x7e\xbe\x01\x5e\x42\xfe\x91\x11\xb3\x55\x4c\xe7\xf7\x52\x9b\x4a\x31\x0d\xfb\x7d\x1c\x15\xcb\x56\xb0\xfb\x15\xcb\x40\x92\xd5\x85\xc5\x19\xa1\xdb\xfe\xf6\xd7\x0d\x38\x8e\x0a\xb6\xdc\x56\x6d\x3b\xf4\xee\x47\xd5\x3d\x86\x71\xf0\xdb\xf2\xda\xc4\xd1\xca\x1c\xf4\xf8\x8e\x53\x12\x9b\x53\x1d\xc5\x89\x8e\x83\x81\x7f\x22\x86\xed\xc8\x93\x1d\x4f\xb5\x3b\x90\xb2\x42\x51\xb5\xfb\x50\x93\xa5\x60\xc5\x68\x28\x21\x19\x36\xf2\x0b\x23\x15\x6b\x21\x83\xf0\xdd\x2b\x49\x99\xd6\x5f\x98\x51\x9c\x6a\xcf\x84\x21\xd5\x98\xc1\x0d\x69\xbe\x3b\xb9\x1f\xad\xb8\x92\x42\x2c\x89\x7a\x90\x6b\x56\x0f\x35\xb7\x99\x40\x05\xbf\x27\x4a\x33\xd5\xa7\xc1\x8b\x03\xdd\x71\xb3\x6a\xbf\x8f\x8d\x05\xfb\xff\xf2\xf0\x70\xbf\xb0\x19\xe6\x9d\xc5\xc6\x4f\xdf\xee\x6f\x07\x8d\xd4\xfc\xf4\x2e\xd9\xff\xd7\x54\xf0\xfc\xd6\x4d\x4e\xcc\xd3\x72\x5b\x53\x48\x29\x5c\x77\x1e\x66\xc0\xf5\xc3\xdd\xe2\x1b\xfb\x73\xcb\x15\x2b\xd2\xcc\x2a\x7a\xcd\x61\xc5\xcc\x56\xd5\x40\xf3\xd0\xf3\x5f\xbf\x7c\x43\xe0\x6a\xd7\xd6\x7b\x6a\x35\x33\xa1\xd9\xa1\xa6\x73\x04\x1d\x25\x27\xc6\x52\x31\xe3\xe6\x45\x9a\x41\x7a\x1d\x4e\xc8\x09\xd8\x42\x93\xc1\x73\x1c\x47\x38\xf7\xb4\x6d\x81\xd9\x1c\x68\x5e\x31\x83\x95\xc3\x26\xa9\x4e\xb3\x38\xe2\xa5\xed\xbc\x98\x43\xcd\x05\x16\x89\xd6\xcf\x9a\x0b\x2b\x17\x47\xfb\x38\x8e\x1e\x89\x0a\x4a\x4a\x30\xd7\x78\x09\x34\x1f\x13\x8b\x6a\x3a\x8c\xd5\x02\x73\xa8\x98\x79\xb8\x5b\x38\x0d\x1f\x95\xdc\xdc\xde\x7d\x4e\x69\x4e\xcd\xcf\x2c\x8e\x8e\xb8\x71\xe8\x47\xb4\x77\xbe\xd8\xdc\x9d\xcd\x01\x9f\xa8\x68\x21\x38\x65\x4e\x55\xfe\x49\xc8\x25\x11\x0b\x9b\xa4\xae\x3d\x31\xa4\x4a\xb2\x2c\xb6\x43\xfd\xd7\x04\xe4\xba\x95\xfd\x9e\x3c\x32\xa5\xb9\xac\x93\x1f\xbf\xc1\x85\x5c\x5b\xb3\xa3\x0e\x98\x03\x69\x9a\xfc\x0f\x07\x74\xf6\xbd\x63\x57\x21\xe9\x28\xea\xcb\xdf\xac\x4d\x49\xfc\x57\x31\xf3\xf7\xdb\x61\x09\x1c\x8c\x7d\x02\xb6\xa6\xfb\x6a\x9e\x64\x93\x57\x0a\x64\x54\x2c\x07\xfa\x5b\x23\xef\x7f\xf7\xd9\x60\xb5\x66\x93\x30\x83\x22\x57\xf7\x46\x72\xe8\x92\xfe\x9e\x54\xaa\xa1\x37\xf8\x9e\xfc\x98\xc4\x91\x2f\x84\xb3\x63\xc8\x95\x31\x4d\x80\x2c\x4e\xeb\xb4\xf5\x37\x54\x7a\x1a\xba\x62\x44\x98\x55\x80\xdd\x9c\xc6\x6e\x5c\x05\xeb\xc0\x2f\xce\xdb\xa8\xda\x1d\x6a\xf2\x8a\x2a\x87\x1b\x28\xea\xb8\x32\x42\xcf\x4e\xae\x2d\x48\x51\x5f\x6e\x7b\x9c\x65\x3d\x77\x69\x97\x26\x16\x71\xa3\x11\x82\x11\x8d\xdc\x82\x34\xd2\x1a\xe6\xeb\xef\x52\x8a\x34\xb1\xa8\x24\x7b\x7d\x60\x7e\x49\x08\x14\xfa\x02\xe2\x61\x57\x57\x70\xe1\xd4\x3b\xc5\xb5\xbc\xf1\x43\x4e\xb2\xd1\x68\x0f\x17\x88\xd9\x11\xcf\x10\x75\xd3\xb8\x75\xe4\xc6\x87\x01\x55\xb9\xe9\x32\x1a\x98\x9d\x97\xd8\x17\xae\x1e\xb3\x23\xa3\x6e\xe9\xf2\xb8\x1b\x83\x40\xab\x76\x3f\xc1\x32\x70\xaa\x8c\x8f\x6a\x18\xa4\xc1\xca\x85\xab\xe6\x61\x01\xc4\x19\xbf\x21\x6b\x66\x91\x08\xf1\x2b\x5c\xe6\xfb\xbf\x90\x66\x00\x09\x94\x61\xe1\x98\x4e\xc1\xe5\xa9\xcd\xda\x38\x72\x1f\x68\x1e\xa5\xc2\x21\xfd\x83\xd7\x26\x1d\x24\xb5\x2b\xb1\x17\x5c\x23\xfc\x0f\x22\x78\x91\xf6\xe2\xd9\x41\xc1\x2d\x37\x26\xff\x80\xe5\xbb\x4c\x13\x5e\x3f\x22\xde\xdb\xb6\xd9\x0a\xf5\x76\xb3\x64\x6a\x06\x97\x45\x32\xf1\x1d\x56\x11\x56\x26\x34\xa4\xdb\x02\x87\x68\xfd\xbd\x47\xfc\xf8\x0d\xe4\xfa\xc0\x9c\x25\x4a\x77\x16\x2f\x1f\x81\xd4\x43\x83\x94\xd4\xb5\x34\xb0\x64\x60\x56\x0c\x34\xd9\xb0\x64\x02\xda\xd9\x3b\xb0\x01\x73\x18\x0c\xbe\xa7\x77\x34\xd3\x61\x1e\x38\xef\x18\xf6\x69\xe5\x29\xf6\x5f\xa7\x39\xf6\x80\x93\x24\x07\x0a\xce\x63\x39\x54\x38\xa4\x39\x54\x75\x82\xe7\x00\xf2\x16\xa2\xbd\xd8\xb9\x4c\x87\x56\x90\xea\x50\x7c\xc0\xf5\xa0\xe3\x07\xcc\xc3\x21\x38\xb6\x6d\xad\xf1\x5c\xf3\x72\xc0\xef\xa0\x18\x59\xea\xec\xfb\xe9\x50\x04\xe5\xde\x2f\xe5\x83\x48\x74\xd2\xd9\xe1\xba\x7e\x2c\x10\xbd\xba\x61\x18\x7a\x3d\x6e\x2b\x70\x24\x0c\x1d\xa4\x0f\xc2\x19\x51\x08\x0c\x9e\x8c\x81\x35\x38\x36\x82\x31\xe8\x85\x93\x16\x61\x43\x10\xb4\x63\x00\x3a\x21\x4c\x20\x1b\x01\x5c\x78\xc3\x00\x0c\x77\x80\x96\x2a\x84\x0c\x69\x77\x84\xf7\x6b\xf6\x31\xbe\x5b\xa9\x33\xe9\xee\x94\x0d\xd9\xee\xb4\x9c\x24\xbb\x45\xbc\x89\xeb\xde\xda\x39\x54\x77\x26\x90\xe9\x4e\x74\x48\x74\xdf\x8c\x3c\xb7\x12\x96\xe6\x97\xd6\xd1\x3e\x06\x7e\x69\xf4\xb1\xe8\x0f\x5c\xb3\xf9\xe9\x55\x34\x8c\x99\x17\xbf\xba\x82\x5e\xf6\x19\xa6\x53\xc0\xba\x49\x84\x00\xdc\x27\x72\xca\x34\xe8\x6d\x83\x46\xa0\xda\x59\x2e\xb8\x81\x0d\xaf\x56\xae\xb8\x6e\x55\xcd\x0a\x90\x65\x09\xb2\x31\x5c\xd6\x44\x88\xa7\x6e\x17\x73\x90\x00\xe1\x06\xe6\x68\x0e\xec\xde\x92\x01\x81\xb6\x51\x12\xec\x5e\x49\x81\xdd\xdb\x13\x20\x34\x76\x56\x0e\xec\xfa\x0c\x08\x64\x47\x49\x10\xf6\xd8\x3c\xd8\xb5\x59\x10\x6c\x77\x6c\xcc\x71\x1b\x3b\x9e\x77\xc1\x69\x0c\xf3\x18\x21\xc7\x68\xef\x77\xc0\xc7\x38\x6f\xa5\xce\x64\xbd\x53\x36\xa4\xbc\xd3\x72\x92\xf4\x16\xf1\x26\xda\x7b\x6b\xe7\x70\xde\x99\x40\xd6\x3b\xd1\x21\xe5\x7d\x33\xf2\xdd\x4a\x0c\x4e\x48\x2d\x78\xb8\x9b\xab\x98\x59\x74\x5b\xe8\x14\x8f\xf1\xfe\x4e\xad\x3d\xbe\x4f\x40\xc8\xaa\x62\x0a\x1f\xf9\x9d\x7d\x9d\x80\x84\xc1\x21\x37\x83\xd4\xee\xaf\xc1\xee\xb5\x73\xab\xcb\xa6\x5c\xbf\xf7\x8b\xf4\x8e\x1b\xba\x02\x99\x07\x17\x24\xcf\xaf\x9c\xaf\x28\xd1\x0c\x92\xa6\xd2\x7f\x8a\x64\x16\x47\x91\xf3\x24\xff\x5c\x97\x72\x97\xe2\xe5\x5f\xcd\xa8\xe1\x75\x05\x46\x42\x41\x0c\x59\x12\x8d\x1b\xa2\x04\xdf\xb5\xdc\x2a\x8a\x5f\x38\xc9\x16\x8d\xe2\xb5\x29\xd3\xa4\xf1\xea\x67\xd3\xe9\xa5\x9e\x5d\x5f\x5f\x5f\xff\xff\xa5\x9e\x5d\x16\xd3\x4b\xfd\x7f\x5a\x8b\x8d\x2c\xd8\xbc\xe0\x1a\xeb\x46\x32\x01\x99\x17\xcb\x7c\xab\x99\xf2\xaf\x2b\xa9\x8d\x7f\x45\x36\xfd\x6b\x4d\x36\x2c\xc3\x90\xe9\x7e\xdc\x73\x4f\xc5\x57\xb6\x6b\x47\x64\x59\x49\x85\x67\xf0\x05\xaf\x2e\xf5\x5b\x7c\x6a\x88\xd6\x3b\xa9\x8a\xf3\x5c\x3c\xf3\x64\x3f\xb8\x2a\x71\x51\xd8\xb0\x8d\x54\x4f\xe3\x30\xa4\xc9\x56\x63\x00\x78\x0d\x0e\x00\x96\x84\xdc\x86\x03\xb8\x06\x21\xb5\x81\xdd\x8a\xd5\x6e\xc7\x6a\x93\x06\x31\x8d\x4e\x3a\xca\x42\xb6\xbe\x58\x2d\x21\x57\xd9\xe0\x8c\x54\xb0\x92\x6c\x85\x99\x9d\xb1\x87\xb4\x9e\xcc\xe0\x52\x27\x93\x41\xda\xb9\x4d\x9c\x97\xf6\x31\xb3\x8f\xfc\x73\xcd\x0d\x27\x82\xff\xdb\xce\x84\x2c\x9c\x24\xdf\xa4\x34\x2e\xfb\x53\x8c\xb7\xbf\x79\x9b\x00\x3d\x98\x09\xe1\x3c\xe9\xd3\x5f\x08\xac\x5f\xd8\x87\xb4\xdd\xb1\x47\x26\x7c\xc9\xb3\x9b\x11\x84\x44\x42\x80\x83\xbc\xc7\x26\x8f\x09\x66\x30\x76\x7d\x65\x3b\xcc\xa1\xfc\x9f\xdc\xac\xf0\x9e\xc2\x7a\x93\xd9\x49\x6a\xdb\xac\x54\x2a\x44\xd0\xf4\xcd\x9d\xe8\x52\x9a\xfb\xb3\x9d\xbd\x41\xf4\xfd\x44\xd5\x56\x24\xc0\x3f\x90\x4a\xa7\x34\xc7\x83\x63\x36\xe0\xe0\xf0\xc2\x68\x70\xd1\x97\x41\x8a\x37\xc1\xa3\xab\xa6\x8e\x82\xfe\x82\xdb\xae\x9f\xfe\xbc\x69\x84\xbe\x57\xfc\x91\x18\xf6\x37\x77\xfd\x9d\xe3\xa8\xb2\xe0\x7a\x7c\x84\xbe\xf5\xed\x21\xee\x3d\x57\x63\xa5\x08\x7b\xcf\x95\x47\x59\xaa\x5b\xf3\xf3\x39\x24\x09\x5c\x5d\x41\x67\x62\xd0\x82\xca\x2e\x6c\x03\xc6\xa4\x13\x02\xfc\x43\x42\xfe\x57\xc9\xeb\xd4\xa3\x26\x90\x18\xa1\xf3\x35\x7b\xb2\xa9\xdc\x6b\x3b\x09\xa5\x6e\x53\x8e\x21\xe5\x25\xa4\x43\x87\x7e\xfd\x1a\x39\x94\xa1\x8f\x17\xd4\x6d\x78\x78\xad\x19\xdd\x2a\xb6\x58\xf3\xe6\xe1\x6e\xe1\xc6\x65\xb3\x06\xcb\xec\x1c\x3e\x28\xf5\x85\x6b\x9c\x8b\x0f\x77\x0b\x4c\xb1\x6e\x82\xf8\x6b\x3a\x34\xdf\x7b\xd8\xbe\xba\x8e\x7e\x90\xfe\xcd\xe3\x49\x8b\x6e\x89\xa5\x82\xb3\xda\xdc\xbe\x0b\x03\x80\x48\xbc\x92\x80\x39\xbc\xe4\x6b\x9f\xc6\x8a\x69\x29\x1e\xd9\xbb\xa5\x46\x35\xf7\xc4\xac\x30\x60\x7d\xa6\x1d\xef\xef\xf3\xca\xe5\x59\x90\x63\x7e\x8a\xf1\x12\x06\xa3\xec\x83\x58\x76\x77\xae\xed\x5f\x84\xf2\x77\x4b\x9d\x86\xe8\x97\x8b\x63\x67\xef\x79\x1f\x5c\x7f\x8e\x49\x2d\xbb\xd0\x86\xa4\x9e\xe5\x86\x07\xff\xd7\x5e\xb4\xc6\xc6\x4e\x50\x72\xbe\x0f\x94\xfc\x4f\x2e\x50\x32\xf4\xa0\x97\x98\x40\xcd\x45\xbc\x8f\xff\x33\x00\x7b\x02\x5e\x51\xc7\x1b\x00\x00"
 
 func cmdConfigGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -264,7 +269,27 @@ func cmdConfigGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _cmdDbGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4b\x6f\xd3\x40\x10\x3e\x7b\x7e\xc5\xc8\xa7\x04\x95\x54\xe2\x71\x89\xc4\xa1\x4d\x82\x38\x40\x83\xe2\xa6\x1c\x10\x42\xe3\xf5\xc6\x5d\x61\xef\x46\xfb\x48\x41\x96\xff\x3b\x9a\x4d\x62\xd7\xe0\x42\xf7\x94\x7c\xf3\xad\xbf\xc7\xce\x9e\xc4\x0f\x2a\x25\xd6\xa4\x34\x80\xaa\xf7\xc6\x7a\x4c\x4b\xe5\xef\x43\x3e\x13\xa6\xbe\x0c\x76\x47\x07\x79\x29\x2a\x95\x02\x1c\xc8\xe2\x04\x92\x22\x7f\x5f\x51\xe9\xf0\x1d\x7e\xfd\x26\x2a\x35\xe3\x7f\x0d\x24\x09\xff\xce\xbc\x55\xba\x3c\x23\xc9\x0d\xd5\x72\x8e\x88\x69\x91\xbf\xd4\x54\xcb\xf4\x82\xd1\x3b\xaa\x02\xc3\x69\xd3\xe0\x47\xf3\x20\xed\x82\x9c\xc4\xd9\x46\x3a\x13\xac\x90\x7c\x09\xdb\xd6\x1d\xc9\x5b\x47\x65\x24\x17\xe4\x29\x67\x62\xff\xa1\x95\x3e\xdc\x91\x9d\x63\xba\xbc\xfe\x7e\x73\xf5\x69\x15\xd1\xf6\xe2\xff\x5e\xee\x8d\xf3\x7f\x78\xa9\x8c\xa0\xaa\xc7\xff\x96\xed\x67\x8f\x65\x3f\xac\xb3\xdb\xa1\xec\x56\x69\x3f\x2a\xca\xe5\x0e\x45\xdf\xbe\x79\xfd\xea\x09\xb5\x9e\xfc\x58\xed\xf3\x7a\x73\xfb\xec\x90\xc1\x49\xfb\x54\x18\x9e\x8d\xf7\xb8\xcd\x56\x9b\x67\x4b\xec\xc9\xb9\x07\x63\x8b\x7f\xc9\xe0\x90\x34\x88\x73\x95\x65\x5f\xd6\x9b\x65\xa7\xd7\xc2\x14\xc0\xff\xda\x4b\x2c\xf2\x85\xd1\x3b\x55\xa2\xf3\x36\x08\x8f\x0d\x24\xec\x17\xf9\xb8\x68\x08\x12\x7e\x91\x01\x10\xd7\x97\x4f\x50\xda\x43\x12\xd5\x07\xf3\x93\x93\x33\xd0\x02\xec\x82\x16\x58\x4a\xbf\xbc\x3e\xea\x4d\x04\xbe\xe0\xd0\x0b\xa3\xbd\xfc\xe9\xa7\xbd\x91\x06\x20\xb1\xd2\x07\xab\x3b\x8c\xfb\xd0\xa7\x3a\x10\xc5\xa9\xa9\x49\xb7\xed\x53\xce\xc5\x2e\x47\x08\x71\x9d\x22\x81\x5d\x9f\x09\xbc\x3c\x93\x6e\x59\xe2\x98\x53\x8c\xdc\x8f\xaf\x7b\xbc\x7f\x4a\x35\x1f\x12\xba\xda\xa7\xb1\xd8\x16\x7e\x07\x00\x00\xff\xff\x96\x56\x3d\x89\xed\x03\x00\x00"
+var _cmdConvertGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x41\x8f\x9b\x30\x10\x85\xcf\xf8\x57\xbc\xa2\x68\x15\xd0\x16\xee\x2b\xe5\xda\xf3\x4a\x8d\x7a\x77\x92\x81\x58\x05\xdb\xb2\x07\xa2\x15\xf2\x7f\xaf\xec\x04\xa2\x6e\xb2\x9b\xdc\xf0\x78\xfc\xde\x37\x6f\x84\x95\xfb\xbf\xb2\x25\xf4\x52\x69\x21\x54\x6f\x8d\x63\xac\x45\x96\xb3\xea\x29\x17\x22\xcb\x5b\xc5\xc7\x61\x57\xed\x4d\x5f\xb7\xa6\x93\xba\xad\xad\x33\x6c\x76\x43\x53\x5b\xfe\xb0\xe4\x73\x91\xc5\x66\xcf\xb2\xb7\x78\xdc\x5e\x2f\xcd\xf9\x33\xea\xf5\xc9\x49\x6b\xc9\xf9\x5c\x14\x42\xd4\x35\xf6\x46\x8f\xe4\xbc\x32\xda\x63\x47\x7c\x22\xd2\xe0\x23\xa1\x51\xd4\x1d\x3c\x4c\x03\xcf\x92\x09\x52\x1f\x20\xad\x42\x62\xac\xa0\x87\xae\x93\xbb\x8e\x2e\xb7\x97\x6e\xe9\x08\xd6\x28\xcd\xe4\x7c\x14\xe7\xa3\x64\xf4\xd2\xc2\x68\xb0\xc1\xc5\x1b\x3d\x79\x2f\x5b\x4a\xea\xd1\x4b\x5a\x55\x41\xab\x0e\xca\x43\x6a\x0c\xda\x13\x27\xb3\x24\x8b\x9d\xe1\x23\x4e\xf2\xc3\x0b\xd1\x0c\x7a\x8f\x65\xe2\xf7\x38\xdb\x9a\x53\xa1\xda\xaa\x9e\x0a\x94\xcb\x65\x2a\xa4\x2f\x4c\x22\x63\xff\x0a\x72\x0e\x6f\x1b\x9c\x83\xa8\xb6\x9f\x54\x0a\x91\xa9\x26\xf5\xfc\xd8\x24\x98\x49\x64\x99\x23\x1e\x9c\x8e\x47\x91\x05\x31\x1f\xd9\x8b\xf0\x99\xe5\x97\x33\xfd\x45\xc9\xdf\xa5\x28\xae\x98\x09\xe8\x4b\x9e\x35\xfb\xef\x58\x16\x95\x29\xfc\xc7\x74\x8b\xf4\x47\x76\x03\xad\x19\xe5\x13\xf9\xa8\x06\x8c\xcd\xc3\xc1\xe7\x27\xe7\x49\x4b\x2e\x6e\x5d\xdf\xd9\x7d\x1d\xc1\x15\x65\x36\xf5\xdf\xb8\x72\x5c\xd7\xdd\x84\x8b\x05\xe9\x85\x45\x10\xd3\x04\x27\x75\x4b\x58\xf1\x2b\x56\xa7\xf8\xec\xf7\x5e\x76\xd2\x6d\xe3\xaa\x11\xc2\x79\x59\xd3\x84\x15\x23\x84\x73\x30\x23\xca\xb9\x50\xa0\x9c\xff\x8b\x2a\xd6\x4e\x08\xe1\x02\x38\x3e\x4e\xe5\xe5\xe6\xed\x94\x1c\xde\x50\x8e\x61\x09\x68\xf6\x8a\xf9\x8c\x77\xfc\x8a\x2b\xce\x13\xd6\x36\xce\x38\x56\xc9\xe7\x0a\x62\xcf\x59\x90\x3e\xe0\x67\x08\xe2\xdf\x00\x03\x2b\xce\xce\x8e\x04\x00\x00"
+
+func cmdConvertGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_cmdConvertGoTmplt,
+		"cmd/convert.go.tmplt",
+	)
+}
+
+func cmdConvertGoTmplt() (*asset, error) {
+	bytes, err := cmdConvertGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cmd/convert.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cmdDbGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4b\x6f\xda\x40\x10\x3e\xef\xfc\x8a\x91\x4f\x50\x81\x23\xf5\x71\x41\xea\x21\x09\x44\xbd\x34\x20\x1c\xd2\x43\x55\x55\x63\x7b\x31\xab\xd8\x6b\xb4\x0f\xd2\xca\xf2\x7f\xaf\xc6\x18\x3b\x6e\x49\x03\x27\xf6\x9b\x6f\xf4\x3d\x3c\xd3\xe9\x14\x9e\x77\x52\xcf\x30\xbc\x93\xe4\xbc\x91\x36\x5c\x95\xd6\x65\x46\x5a\xe0\xe1\x9e\x92\x27\xca\x24\x16\xa4\x34\x80\x2a\xf6\xa5\x71\x18\x64\xca\xed\x7c\x1c\x26\x65\x71\xe5\xcd\x96\x0e\xf2\x2a\xc9\x55\x00\x70\x20\x83\x23\x10\x69\x7c\x97\x53\x66\xf1\x33\x7e\xff\x91\xe4\x2a\xe4\x57\x05\x42\xf0\xff\xc8\x19\xa5\xb3\x13\x22\xee\xa9\x90\x33\x44\x0c\xd2\x78\xaa\xa9\x90\xc1\x04\x84\x10\x8f\x94\x7b\x86\x83\xaa\xc2\x70\x95\x7b\x43\x79\x18\x69\x7a\x92\x58\xd7\x47\xc6\xc6\x52\xd6\x30\x52\x72\x14\x93\x95\xd8\x6f\x2f\xf4\xe1\x91\xcc\x0c\x83\xf9\xcd\xcf\xfb\xeb\xaf\x8b\x06\xad\x27\x6f\x1b\xd8\x95\xd6\xfd\x65\x20\x2f\x13\xca\x7b\xfc\x5f\xd9\x7e\xf6\x52\xf6\xcb\x32\x7a\x18\xca\x6e\x94\x76\x67\x45\xb9\xd1\xa1\xe8\xa7\x8f\x1f\xde\xbf\xa2\xd6\x93\x5f\xaa\xad\x96\xeb\x87\x8b\x43\x7a\x2b\xcd\x6b\x61\x78\x76\xbe\xc7\x4d\xb4\x58\x5f\x2c\xb1\x27\x6b\x9f\x4b\x93\xfe\x4f\x06\x87\xa4\x41\x9c\xeb\x28\xfa\xb6\x5c\xcf\x3b\xbd\x1a\xc6\x00\xee\xf7\x5e\x62\x1a\xdf\x96\x7a\xab\x32\xb4\xce\xf8\xc4\x61\x05\x82\xfd\x22\xff\x6c\x93\x19\x04\x7f\x91\x01\xc0\xa5\xf1\x1b\xbd\xd2\x0e\x44\xa3\x3e\x98\xb7\x4e\x4e\x40\x0d\xb0\xf5\x3a\xc1\x4c\xba\xf9\xcd\x51\x6f\x94\xe0\x3b\x0e\x7d\x5b\x6a\x27\x7f\xb9\x71\x6f\xa4\x02\x10\x46\x3a\x6f\x74\x87\x71\x1f\xba\xad\x03\x31\x69\x9b\x1a\x75\x27\x3e\xe6\x5c\xec\xf2\x0c\x81\xe1\x23\x81\x5d\x9f\x08\x7c\x3c\xa3\xee\x58\x9a\x31\xa7\x38\xb3\xcf\x70\xbb\xdf\xa6\x9a\x0d\x09\x5d\xed\xe3\x09\x88\x1a\x6a\xf8\x33\x00\x49\xb6\x95\x57\x03\x04\x00\x00"
 
 func cmdDbGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -284,7 +309,7 @@ func cmdDbGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _cmdMainGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x39\xff\x6e\x1b\x37\xd2\x7f\x6b\x9f\x62\x2a\x20\xed\xea\x83\xb4\x4a\xfb\x21\x07\xd4\xad\x0f\x50\x14\x25\x31\xe2\xd8\x86\xe4\xa4\x28\x72\x81\x41\x71\x47\x2b\xc2\x5c\x72\x43\x72\x65\xfb\x02\xbf\xfb\x61\x48\xae\xb4\x2b\x29\x8d\x8b\xbb\x00\xad\xc5\x99\xe1\xfc\x9e\xe1\x90\x5b\x31\x7e\xcb\x0a\x84\x92\x09\x95\x24\xa2\xac\xb4\x71\x90\x26\xbd\x3e\xd7\xca\xe1\xbd\xeb\x27\xbd\xfe\xaa\xf4\x7f\xa4\x2e\xe8\x4f\xc9\xdc\x7a\x6c\x98\xca\x69\xa1\x2d\xfd\xdf\xd4\xca\x89\x12\xe9\xa7\x75\x46\xa8\xc2\x43\x03\x28\xe9\xf5\x0b\xe1\xd6\xf5\x32\xe3\xba\x1c\x73\xc5\x9c\xd8\xe0\xb8\xba\x2d\xc6\xac\x76\xeb\xfe\xb7\xd1\x6b\x64\xf2\x2f\x09\x2c\x9a\x0d\x9a\x3d\x02\x42\xa0\x31\xda\xd8\x3d\x44\x6d\x56\x6c\x83\x63\x2e\x45\x3f\x01\x00\xb8\x81\x36\xb6\xd0\x92\xa9\x62\x54\x8a\xc2\x30\x87\xe3\xe6\x6f\xce\x1c\x5b\x32\x8b\xe3\x4a\x5b\x57\x18\xb4\xfd\x64\x90\x24\x5c\x2b\xeb\x9d\x64\x1f\xac\xc3\x72\x92\x97\x42\xbd\x31\xba\xae\xe0\x14\xfa\x5f\xbf\x42\x76\xc1\x4a\x84\xc7\xc7\x13\x46\x98\x3e\x8c\xc7\x80\xf7\x0e\x8d\x62\x12\x0a\x4f\x77\xb7\x16\x7c\x0d\xc2\x82\x41\x8e\x62\x83\x39\x30\x0b\x0c\xb8\x64\xa2\x4c\x7a\x64\x98\xe0\xe8\xf9\xce\xb5\x44\x62\x1b\x59\xd1\xbf\xf1\x18\x14\x09\xd0\x2b\x70\x6b\x84\x48\x0d\x9e\x02\x8c\x96\x48\x3a\x6e\x98\x21\x0d\x37\x68\xac\xd0\x0a\x80\x78\xd4\xea\x56\xe9\x3b\xd5\x4f\x7a\x85\x70\x53\x5d\x96\xc2\x75\xc0\x49\x8f\x55\x15\xec\xfe\x9d\x02\x97\x22\xbb\xc0\xbb\x49\x55\xa5\x83\xa4\xe7\xfd\x3a\xbb\xa7\xbd\x39\xee\xb0\x04\x99\x11\x2a\xed\xf7\x87\xf0\xf3\x20\x49\x7a\xe3\x31\xd4\x16\x73\x70\x1a\x6c\x85\x5c\xac\x1e\xe0\xfa\x7c\x01\x53\x34\x4e\xac\x04\x67\x0e\x61\x25\x24\x26\x3d\x27\x2d\x01\x5f\x0b\xd9\x30\x5c\xf8\x04\x7a\x2d\x59\xf1\x35\xe9\xf5\xc8\x93\x27\x00\xd0\x77\xd2\x8e\x38\x1a\x37\xa2\x7d\xfd\x61\xd2\xeb\x7d\xb0\xac\x20\x5c\xff\xfe\xc5\xf3\x5f\x21\x24\x03\xf0\xb6\x04\x6d\x48\xaa\x27\x9e\xa9\xcd\x47\x66\x4e\xa0\x7f\x7d\xbe\xb8\x99\xce\xe6\xd7\x37\xaf\xcf\xce\x67\x84\x7a\xfc\xb6\xba\x57\x46\x6c\x88\xd1\x3b\x7c\x80\xd7\x8d\xba\x11\xf8\x0e\x1f\x9e\xa6\x74\x15\xe8\x47\xb7\xf8\xf0\x2d\xdd\x23\x09\xdc\xe2\x03\x94\xcc\xf1\xb5\x50\x05\x8c\x46\x87\x26\x1f\x5a\x71\x35\x3f\xfb\x38\xb9\x9e\xdd\xbc\x9b\xfd\xf9\x2d\x8b\x2a\x2d\x94\xa3\x1f\xb9\x30\xc8\x9d\x36\x0f\x40\xb5\xcd\x84\x22\x31\xfb\x61\x61\x2a\xef\xd8\x4d\xb2\xed\x36\x4e\xaf\x84\x79\x62\x98\x72\x61\xba\x96\xee\xa4\xdf\xad\xd1\xa0\x4f\x5d\x12\x4e\xd4\x16\x98\x41\x90\x9a\x34\xc8\x33\x38\x5b\xed\x5b\xef\xd5\x0a\xb0\x7d\x7f\xfa\xad\x95\xd1\x1b\x91\x63\x3e\x04\xb7\x16\x16\x56\x92\x15\x70\x27\xa4\x84\x25\x82\x28\x94\x36\x98\x7f\xc3\x81\xaf\xce\xe6\x2d\x9f\x79\x5f\x59\x72\x96\x67\xed\xd6\xcc\x51\x95\x36\xae\xdc\x30\x29\x72\xea\x0b\x1b\x34\x94\x24\x53\x29\x90\xe8\x85\x02\xe6\x8d\x59\x33\x95\xdb\x35\xbb\xc5\xa4\xc7\x3d\x6e\x3a\xf9\x7e\x96\x04\xca\x11\x67\x47\xf2\x43\xac\xc0\xa2\x1b\x02\x53\x0f\x60\xf0\x4b\x8d\xd6\x41\x65\xd0\xa2\x72\x14\x3d\x6a\x19\xb4\xb9\x93\xf7\x56\x14\x0a\x73\x58\x3e\x80\x56\xdb\x2e\x41\xcd\x56\x1b\xe1\x04\x7a\x75\x09\xd4\x95\x4b\x76\x12\x11\x71\xf6\x71\x80\x3b\xe1\xd6\xc0\x14\x88\x9c\x60\x8e\xd2\xc6\x18\xb4\x95\x56\x39\xc9\x76\xda\x73\xa1\x3e\xa2\xd5\x45\xab\x21\x1d\xaa\xd4\xf5\xfd\xf4\xfc\x6c\x76\x71\x7d\x33\x9d\xec\x67\xac\xd1\xba\x71\xd8\xdf\x0c\xc4\xc2\x57\xff\xf1\x40\xb4\xb8\xfe\x65\x18\x88\xee\x78\x10\x2a\xe6\xd6\x24\x95\x79\x9b\x82\x46\xd4\x5c\xbc\xb1\xed\xda\x89\x3e\x7e\xd8\xe9\x19\xd4\x8b\x27\x55\xc7\x0b\xf3\xcb\xcb\x63\x3e\xf0\xa9\x4b\xae\xad\x8d\x02\xbd\x5a\x79\x6b\x48\x58\xe0\x91\xf4\x84\xb2\xc8\x6b\x83\x8b\x5b\x51\x11\x2e\xd8\xf4\x52\x6b\x79\x60\x51\x43\x3a\xb2\xb7\xa2\xa2\xe2\xf1\x1a\xbc\x15\x79\x8e\xea\x04\x9c\xa9\xb1\x63\xa6\x57\x5a\x2b\xf9\xe0\xe5\xe5\xb8\x81\xaa\x36\x95\xb6\x98\x81\x75\xcc\xb8\xed\x69\x83\xc6\xe7\x86\xae\x5d\xd3\x5f\xa3\xf2\x67\x2d\xdd\x3e\x7a\xd3\x7d\x85\x6b\xe5\x8c\x96\x96\x0a\xdf\xad\xd1\xec\xb2\xd6\xbb\x87\x32\xd2\xad\xd1\x33\x08\xdc\x7f\xb2\x1d\xb7\xf2\x35\xa3\xb8\xaa\x1c\xd6\xda\x3a\x7f\xf6\x65\x41\xdc\xea\x88\x44\x4a\x13\x6f\x9a\xf7\x1c\xe3\x1c\x2b\xea\x2f\xea\xa1\xcd\x33\x14\x7b\xa8\xa3\x50\x2a\x2d\xdb\x48\x12\xd1\x6f\xa5\x85\x82\x61\x9d\x94\x8e\x1a\xa8\xd0\x70\x4a\x9d\x47\x81\xc2\x82\xad\x2d\x09\x15\x4b\x4a\x5c\x0d\x25\x53\x23\xa1\x46\x6e\x8d\xa3\x52\xe4\x39\x75\x2c\xe7\x18\xbf\xb5\x81\xc5\x35\xed\xb7\x6b\x5d\xcb\x9c\xba\x55\x37\x08\x0e\x2d\xd5\x79\xd6\x0d\xfb\xce\xb5\x4f\x0e\x7e\x48\xc4\xff\x2a\x07\x62\xcc\x42\x3b\xb5\x8d\xb3\x76\x4e\x0a\xc1\xe4\xcc\x09\xad\x9a\x9c\x60\x55\x45\x8a\x59\x38\x85\x4f\x9f\x49\xd5\x46\xcd\x7d\xb5\x77\x7a\xe7\xb8\xac\x0b\xaf\xe4\x4e\xab\x99\x62\xe4\x4c\x8f\x02\xa9\x8b\x42\xa8\x48\xb2\x2d\xa7\x57\xb3\x97\x1f\xde\x78\xd8\xe3\x30\xf2\xff\x20\x94\xfb\x06\xff\x11\x8d\xb9\x7b\x42\x02\x77\x3f\xff\x6a\x15\x27\x34\x85\x6e\xbc\x76\xae\x1a\x57\x95\xd1\x2b\xa0\x71\x90\x22\x8c\xf7\xe4\x92\x70\xa4\xf4\x3e\x32\x59\x13\x03\xbf\xff\x4a\x1b\x77\x44\xb1\x9b\xab\xcb\xf9\xf5\x53\xb4\x0b\x43\x6f\x4b\xbd\x86\x7b\x40\x1c\xb2\x7f\x3b\x9b\x9c\x5f\xbf\x7d\x32\xff\x12\x9d\x11\xdc\x1e\x11\x10\x31\x87\x12\xde\xcf\xae\xe7\x67\xd3\xc5\x11\x11\xc7\x03\xe8\xf3\xad\x32\x9a\xa3\xb5\xa3\xc8\x75\xcf\xd5\x44\x02\x5c\x4b\x89\xdc\x1f\x63\x91\x1a\x3a\xd4\x5b\x05\x16\xef\xce\xae\x6e\xae\xe6\x97\xd3\xd9\x62\x71\x13\xb5\xe9\x2a\x12\x3a\xfa\x42\x0a\x8e\x87\xfa\x38\xb6\x9f\x4e\x42\xad\x34\x15\xa1\x11\xcb\xda\xa1\x6d\xb5\xd7\x2c\xa6\x32\xf9\x04\x2a\x26\x8c\x6d\x0e\xb5\x95\x36\x25\x73\x34\xa2\x9d\x7a\x6c\x06\x06\x2b\x64\xae\x35\x70\xb4\xa6\xc7\xb2\x96\x4e\x54\x12\x41\xb2\x25\xca\x6c\xdf\xa0\xd9\xfc\xe3\x6c\x7e\x73\x3d\x79\x73\xd4\x8e\x43\x13\x8c\x96\x72\xc9\xcc\xc8\xe9\x5b\x54\x7b\xc6\x44\x1c\x78\x1c\xe9\x60\xd0\xe7\xf0\x1d\x33\x7e\xc0\xf3\xdd\x6c\xa9\x37\x34\x5f\x15\x20\x71\x83\x72\xdf\xc1\xf3\xcb\xf3\xf3\x97\x93\xf9\xcd\xf5\xe5\xbb\xd9\xc5\x56\x23\xaa\xdf\x78\xb7\xf8\x76\x0d\x1f\xcf\xb2\xc2\x54\xfc\x48\x8a\x11\xf8\x30\xbf\xde\xcc\xaf\xa6\x4f\xce\xdf\x82\x39\xbc\x63\x0f\xc7\x98\x07\xcc\x11\xfe\x93\xeb\xd9\x1f\x93\x3f\x9f\x9c\xbf\x4a\x8f\x22\xaf\x3d\x37\x5d\x5c\xde\x44\x5e\x4f\x89\x5a\xdf\x3a\x1a\x53\xad\xd3\x06\x3b\x21\xeb\x13\x88\xae\xdc\xb9\x11\x1b\x34\x43\xe0\xb5\x31\xa8\x9c\x7c\x00\x5b\x57\x64\x18\xe6\xf0\xa9\x2a\xec\x17\xf9\xb9\x63\x62\xdf\xc3\x76\xb2\x5b\x77\xa7\xb8\xec\xde\x4d\x5a\x34\xaf\x84\x89\xda\x6e\x67\x52\x5a\xef\xcd\x12\xdd\xa0\xd3\x60\x47\xc9\x13\xce\x98\xb8\x6a\x1d\x31\xfd\xd6\x54\xb3\x33\x8d\xe6\x84\x16\x62\xc2\xe9\x38\x38\x81\x55\xad\x78\xca\xe1\xff\x02\x2b\xff\xb2\x30\x80\x14\x8d\x01\x7f\xa9\x1c\x80\x77\x1d\xaf\xe0\xe4\x14\x7e\xe4\x52\x5c\x31\x63\xd1\x7c\xe5\xee\xfe\x04\xf8\xd0\x8f\x1b\x94\x27\x61\xce\x8b\xe7\x56\x80\x86\x50\x05\xd0\x23\x31\x31\xe8\x67\xa7\xa0\x44\x90\x9f\xf2\x6a\xd0\x78\xcd\xe7\xf2\x09\xb0\xaa\x42\x95\xa7\xed\x04\x1f\x36\x40\x9d\x0b\x1e\x21\xf9\xd2\xff\xc8\xb2\x6c\x40\xff\xed\x46\x9d\x99\x31\xef\x85\xb5\x42\x15\xd7\xe7\x8b\x33\x6a\x28\x71\xd4\x51\xc8\x1d\x50\x87\xa1\x7e\x41\x57\x6f\xa5\x5d\xec\x0b\x02\xf3\xa4\x77\xb8\xf1\x34\xf8\xc0\x66\xfe\x12\xbd\x4a\xe9\x72\xe2\xef\x82\x4c\xe5\x63\x6d\x76\x43\xa7\xed\xf2\xca\x68\x58\x80\x9f\x46\xa3\x67\xf6\x27\xd0\xa6\xf9\x35\x8e\x3f\xfa\x43\xd8\x45\xdf\x3f\x49\x78\x40\x37\x47\x76\xf0\x26\x95\x3c\x64\x90\x0c\x92\x84\x62\x06\xc2\x9f\x08\x1f\x69\xde\x4e\x7d\x5f\xa9\x85\x72\x03\x58\x6a\x2d\x29\x66\xd1\xd9\x1e\xf3\x4f\x78\x0e\x3f\xfe\x18\x7e\xff\x0e\xff\x78\xf1\xe2\xff\x5f\x24\x8f\x91\x8d\x63\x85\x7d\x6d\x74\xe9\x7b\x74\x2a\x97\x16\x3e\x7d\x0e\x4f\x45\x03\x28\x59\xf5\x29\xfc\x8e\x20\x62\xec\x58\xf1\x9e\xf9\x74\x38\x40\x7f\x7d\x4c\x7a\xd4\xb2\x6f\x86\x60\x89\xc0\x30\x55\x20\x10\x4f\x4a\x22\xb5\x21\x58\x7c\x86\xca\x16\x95\x14\x2e\xb5\x43\xe8\x0f\xfb\x94\x02\x71\x9f\xdc\xed\x53\x9b\x90\x7b\xc7\xf6\xc9\x21\xf4\x4f\xfd\xbe\x9e\x58\x81\x44\x95\xaa\xcd\x00\x4e\x4f\xe1\x97\xb0\x27\x6a\xf9\x49\x6d\x3e\x3d\xff\xfc\x19\x4e\x41\x6d\x3e\xfd\xfc\x99\x30\x94\x89\x8f\x21\x59\xa2\x8b\x02\xe9\xd6\x21\x42\x09\x97\xfa\xb4\xa7\x92\xf8\x18\x9e\x69\xae\x8c\x50\x0e\xe9\x8a\x7d\xb4\x5e\x48\xe6\xaa\x74\x99\x27\x5b\xa5\xfd\x67\xf6\x5f\x0a\xe2\xd6\x13\x00\xbf\x7c\x23\x1c\x84\xa7\x9d\x2d\x44\xef\xd3\x5c\x2e\xc6\x13\xc3\xd7\x7e\x39\xf6\x90\x97\xb5\x90\xcd\x86\xd0\x73\xda\xef\x58\xfd\x21\xc4\x77\xa4\x21\x6c\x5f\x8e\x86\x10\x5f\xfd\x1a\xe5\xd3\xc1\x0e\xf4\xe6\xf2\x72\xd1\x5e\x4d\xe6\xd3\xb7\x43\xe0\xd9\xa4\xaa\xa8\x93\x54\x42\x62\x3e\xd8\x8e\x88\x41\x4e\xf7\xed\xac\x1f\x30\x53\x5d\x3d\x18\x51\xac\xfd\x43\x55\xca\x07\xf0\xcb\xf3\x9f\x7f\x85\x2d\x34\x52\xf9\xf6\xd3\x30\x78\x85\x96\x1b\x51\xf9\xda\xf3\x8c\x02\x4d\xd4\x12\x4e\x1b\x5b\x02\xb8\x39\xda\x62\xe9\x37\x03\xeb\x10\x34\x9f\xdd\xfb\x66\x6c\xb6\x1d\xa0\x51\xc9\x77\xc2\xdd\x79\xd8\x6a\x8d\xdd\xd6\xe9\xbb\x45\x13\xf0\xbd\x7e\xe4\x43\x1b\xba\xdc\x41\x23\xd4\x43\x5a\x50\x3a\xf2\x2a\x2b\xd0\x4d\xb5\x5a\x89\x22\x1d\x24\x94\x85\x84\xf9\xe1\x14\x94\xf0\xf5\xd7\x64\xd7\x5e\x07\x11\xca\xdf\x90\xa9\x2b\x94\xa1\xd9\x50\x13\x07\x66\x8a\xba\xf4\x2f\x16\x23\x78\xb6\xe9\x7b\x31\x83\x4e\xd3\xf7\xde\x3f\xd9\x0f\x45\xd2\xa3\xa1\x9b\xce\xaa\xa8\x57\x81\x6e\xae\xb5\x3b\xf7\xd0\xb4\xb5\x77\x08\xfa\x3b\x6a\x92\xb8\x5e\x8e\x2b\x34\x10\x98\x66\xaf\x65\x6d\xd7\xe9\x60\x2b\x25\xa3\xae\xb8\x4a\xc3\x61\x42\xaa\x3f\xb3\xdb\x23\x05\x5a\xb2\x68\x07\x5d\xb9\x2f\xe9\x82\x77\x42\xd1\xa0\x55\x76\xe9\x43\x4f\x42\xfd\x32\xea\x18\x58\x53\x03\x0f\xe0\xdd\xa3\xed\x5c\x4b\x7c\xcf\xaa\x4a\xa8\x22\xdd\x7f\xd0\xdd\xca\xdb\xbe\xc5\xb6\x8e\x80\x25\xb3\x82\x83\xf6\xe2\x6c\xb8\x20\x32\x83\xc0\xfd\x83\x88\x1f\x2a\x99\x94\x51\x73\x9b\xf4\xf4\x56\xcd\x38\x6b\xee\x14\x8d\x80\x43\x55\x23\xe2\x15\x5d\x2d\x52\x9d\xf9\x2b\xc6\x10\x74\x96\x53\x53\x1e\x0c\x9b\xa9\xf5\xed\xf6\x72\x90\xea\x6c\xdd\xc5\xbd\xdf\xcd\xf5\xa9\xce\xca\x80\xdc\xb1\xbe\x0a\xa3\x77\xa4\x4a\x7f\xd0\x19\x0d\xe7\x5d\xe8\x8e\xd9\x35\x2b\x6c\xaa\x33\xea\xe6\x6d\x26\xd7\x86\x71\x4c\x75\xa6\xf9\xa4\x40\xe5\x32\x47\xeb\x70\x69\xcb\xdb\x74\x97\x53\x8f\x9f\x5d\xb5\x68\xe9\x02\x49\x26\x35\xeb\xaa\xa3\x7d\xdc\x41\xe1\xb6\x55\x57\x88\x6a\x60\x4d\x48\xc4\x0a\x7e\xd0\x99\x93\xd6\x9b\xe0\xd3\xce\xfb\x7c\x5b\xdc\xb4\xda\x99\x72\xbe\x98\x1a\xcc\xd3\xb0\x83\x37\x83\x14\x84\xf5\x6d\x1c\xa1\xe2\x92\x33\x5a\x0d\x62\xad\x70\x77\x3f\x04\xce\x14\x47\x7f\x8e\xc4\x2f\x25\xd9\x1f\xc2\xad\xa7\x1e\x9a\x36\xa0\x97\x8c\xdf\x16\x46\xd7\x2a\x4f\x69\x73\x48\xfb\xb0\xd3\x27\xbc\x9f\x0e\xdb\x55\xb5\xa0\xa1\x71\x41\xd0\xd4\x4b\x69\xea\xee\x7b\x55\x45\xc5\xff\x87\x61\xd5\x8a\x5a\xc9\x10\xfa\x75\xb8\x31\x3b\x0d\xdc\x20\x73\xe8\x6b\x28\x8c\xa2\xdd\x12\xda\x55\xa3\x47\x67\x53\xa9\x2d\x52\xab\x59\x33\x95\x4b\xf4\x7a\x29\xbc\x5b\x84\x3d\x6f\x03\x30\x8d\x7a\xc7\x4c\x4d\x8e\xf9\xb9\x93\x62\x4b\xb4\x69\xeb\x1c\x0f\x97\xd9\x80\xf8\xda\x0f\x7a\x9d\x04\x05\x1e\xdb\xf9\x42\x23\xdf\xe4\xea\x2c\x8d\xba\xec\xd2\x82\x10\x31\xa3\x8b\xfd\x8c\x8e\x13\x21\xa1\xee\xb6\x39\x08\x5d\x64\xb3\xf7\xae\xd9\x3c\xf0\x15\x2d\x56\xed\x77\x20\x85\x98\x5b\xd0\x15\x2a\x10\xf9\x76\xb4\x5b\x32\x8b\xb9\x7f\xea\x23\x5a\xe5\xc3\xa2\x45\xce\x9b\x2e\x14\x7f\x53\x4f\xa0\x81\x67\x7a\x7e\x96\xf2\x2a\xe3\xee\x7e\xf0\x9b\x9f\x20\x1a\xda\x81\x9f\x97\x28\x8a\xf1\xdd\x4b\x70\x84\xb2\xb6\x34\x3e\xbb\xba\x02\x85\x1c\x2d\xf3\x8f\xf5\x61\x0e\x97\x42\x21\x75\x70\x1b\xdf\x1e\x85\x8d\xbd\x6c\x4a\x89\x12\x53\xc8\xf7\xb6\x0b\xbc\x9b\x87\xa3\x37\x24\x51\x73\xb6\xc5\x5e\x39\xdc\xea\xdb\x8c\xb5\x49\xef\x48\x72\xfd\x9d\xec\x22\x86\xcd\x71\x1f\x7c\xd3\x7c\x3e\x1c\x84\x41\xe8\xaf\x2a\x71\x52\xbb\x75\xa3\x6f\xb4\xa7\xa9\x34\xe3\xb6\x96\x45\xe2\x7d\xdb\xba\x67\x4f\xb4\xe9\x7f\x50\x2b\x21\x05\x9a\x0f\x9b\x87\x45\xe3\xbf\x86\xf1\xad\x76\xc6\x65\x0b\x3a\xae\x48\xa9\xef\x8a\x6f\x3e\xa1\x05\x3e\xdf\x3b\xf4\xb2\x2c\x3b\x3c\xf7\x88\xfb\x29\xfc\x3e\x22\x25\x80\xce\x22\xa9\xf9\x2d\x6d\xd3\x51\x06\xf0\x35\x53\x0a\xe5\x31\x5d\xa2\xc4\x66\x58\x98\x37\xdf\x20\xc3\xc6\x95\xd1\x65\x97\x47\x77\x56\xe8\x79\x5b\x75\x15\x4c\x4d\xda\x47\x7b\x33\xeb\x94\x4c\xa8\x30\xdc\xfa\x37\x52\xe1\x04\x93\xe2\xdf\xe1\x9b\x4f\x65\xb1\xce\xf5\xc8\x30\x95\xeb\x12\x54\x5d\x2e\xd1\x40\x81\x0a\x0d\x73\xda\xc4\x8f\x0e\x50\x2b\xf1\xa5\x6e\x1e\x62\xac\x86\xbb\xf0\x64\x5b\xa0\x6b\x50\x16\xbf\xd4\xa8\x38\x5a\x60\xdc\x68\x6b\x29\x56\x36\x4b\x7a\xc4\x38\x5b\x20\xe6\xa9\x9f\x3d\x2f\xf4\x5d\x3a\xc8\x3e\x28\x71\x7f\xc1\x94\xa6\x2e\xbc\xf5\xc8\x89\x4f\xc7\x6c\x5e\xab\x54\xdb\x6c\x62\x0a\x3b\xf8\xed\x88\xab\xb2\x05\xfa\x87\x08\x9b\x3e\x1f\x44\xc8\x6b\xe6\x98\xa4\x01\x7c\x43\xe3\xf2\xd6\x33\x8f\xc9\x7f\x02\x00\x00\xff\xff\x1e\x45\x50\x2e\x4e\x1f\x00\x00"
+var _cmdMainGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x3a\xfd\x6f\xdb\x38\x96\x3f\xdb\x7f\xc5\x1b\x63\x3e\xe4\x83\x2c\x77\xf6\xd0\x03\x36\xbb\x39\xc0\x75\x9d\x36\x68\x9a\x04\xb6\xdb\xc1\xa2\x5b\x04\x34\xf5\x2c\x13\xa1\x48\x95\xa4\x9c\xf8\x02\xff\xef\x87\x47\x51\xb6\x64\x3b\x69\x67\x67\x81\x9d\x58\xe4\xe3\xfb\xe2\xfb\x66\x0b\xc6\xef\x59\x86\x90\x33\xa1\xba\x5d\x91\x17\xda\x38\x88\xba\x9d\x1e\xd7\xca\xe1\xa3\xeb\x75\x3b\xbd\x65\xee\xff\x48\x9d\xd1\x9f\x9c\xb9\xd5\xd0\x30\x95\xd2\x87\xb6\xf4\x5f\x53\x2a\x27\x72\xa4\x9f\xd6\x19\xa1\x32\xbf\x5a\x2d\x3d\x3d\x81\x58\x42\x72\x81\xcc\x95\x06\x6d\x72\x73\xf9\x76\x0c\xdb\x6d\xb7\xd3\xcb\x84\x5b\x95\x8b\x84\xeb\x7c\xc8\x15\x73\x62\x8d\xc3\xe2\x3e\x1b\xb2\xd2\xad\xe8\xd8\x00\x50\xa5\x2f\x42\xae\x90\x49\x82\x7d\x16\xc0\xa2\x59\xa3\x39\x00\x20\x1a\x68\x8c\x36\xf6\x60\xa3\x34\x4b\xb6\xc6\x21\x97\xa2\x22\xdf\x62\xfb\x56\x5b\x97\x19\xb4\xc4\x10\x00\xc0\x1d\x34\x8f\x66\x5a\x32\x95\x0d\x72\x91\x19\xe6\x70\x58\xff\x4d\x99\x63\x0b\x66\x71\x58\x84\xd3\x2d\xb9\xfa\xc7\x54\x6a\xe5\x74\xb9\x56\xd6\xdf\x83\xdd\x58\x87\xf9\x28\xcd\x85\x7a\x67\x74\x59\xc0\x39\xf4\x9e\x9e\x20\xb9\x66\x39\xc2\x76\x7b\xc6\x68\xa7\x07\xc3\x21\xe0\xa3\x43\xa3\x98\x84\xcc\xc3\x3d\xac\x04\x5f\x81\xb0\x60\x90\xa3\x58\x63\x0a\xcc\x02\x03\x2e\x99\xc8\xbb\x1d\xd2\x8c\xe0\xe8\xf1\x4e\xb5\x44\x42\x1b\x50\x91\x74\xc3\x21\x28\x22\xa0\x97\xe0\x56\x08\x01\x1a\x3c\x04\x18\x2d\xb1\xdb\x6f\x4a\xd2\x5d\x33\x43\xcc\xae\xd1\x58\xa1\x15\x00\xa1\x2b\xd5\xbd\xd2\x0f\xaa\xd7\xed\x64\xc2\x8d\x75\x9e\x0b\xd7\x5a\xee\x76\x58\x51\xc0\xfe\x7f\xe7\xc0\xa5\x48\xae\xf1\x61\x54\x14\x51\xbf\xdb\xf1\x77\x34\x79\xa4\xb3\x29\xee\x77\x69\x65\x42\x5b\x51\xaf\x17\xc3\xef\xfd\x6e\xb7\x33\x1c\x42\x69\x31\x05\xa7\xc1\x16\xc8\xc5\x72\x03\xf3\xab\x19\x8c\xd1\x38\xb1\x14\x9c\x39\x84\xa5\x90\xd8\xed\x38\x69\x69\xf1\x42\xc8\x1a\xe1\xcc\x9b\xeb\x85\x64\xd9\x53\xb7\xd3\x21\xa5\x9e\x01\x40\xcf\x49\x3b\xe0\x68\xdc\x80\xce\xf5\xe2\x6e\xa7\xf3\xc9\xb2\x0c\xcf\x00\x7a\x8f\xaf\x5f\xfd\xdd\x2b\x04\x0d\xf0\x26\x05\x6d\x88\xaa\x07\x9e\xa8\xf5\x67\x66\xce\xa0\x37\xbf\x9a\xdd\x8d\x27\xd3\xf9\xdd\xc5\xe5\xd5\x84\xb6\xb6\xcf\xb3\x7b\x6b\xc4\x9a\x10\x7d\xc0\x0d\x5c\xd4\xec\x86\xc5\x0f\xb8\xf9\x31\xa6\x8b\x0a\x7e\x70\x8f\x9b\xe7\x78\x0f\x20\x70\x8f\x1b\xc8\x99\xe3\x2b\xa1\x32\x18\x0c\x8e\x45\x3e\x96\xe2\x76\x7a\xf9\x79\x34\x9f\xdc\x7d\x98\xfc\xeb\x39\x89\x0a\x2d\x94\xa3\x1f\xa9\x30\xc8\x9d\x36\x1b\xa0\x48\xc2\x84\x22\x32\x87\xd7\xc2\x54\xda\x92\x9b\x58\xb6\xbb\x7b\x7a\x2b\xcc\x0f\x5e\x53\x2a\x4c\x5b\xd2\x3d\xf5\x87\x15\x1a\xf4\x56\x4c\xc4\x09\xda\x02\x33\x08\x52\x93\x61\xa4\x09\x5c\x2e\x0f\xa5\xf7\x6c\x0d\x06\xa7\xf4\xe9\x8f\x16\x46\xaf\x45\x8a\x69\x0c\x6e\x25\x2c\x2c\x25\xcb\xe0\x41\x48\x09\x0b\x04\x91\x29\x6d\x30\x7d\x46\x81\x6f\x2f\xa7\x0d\x9d\x79\x5d\x59\x52\x96\x47\xed\x56\xcc\x91\xc3\xd6\xaa\x5c\x33\x29\x52\x0a\x23\x6b\x34\x64\x24\x63\x29\x50\x39\x0b\x42\x01\x23\x53\x83\x15\x53\xa9\x5d\xb1\x7b\xec\x76\xb8\xdf\x1b\x8f\xbe\x6f\x25\x15\xe4\x80\xb3\x13\xf6\x21\x96\x60\xd1\xc5\xc0\xd4\x06\x0c\x7e\x2b\xd1\x3a\x28\x0c\x5a\x54\x8e\x6e\x8f\xa2\x07\x1d\x6e\xd9\xbd\x15\x99\xc2\x14\x16\x1b\xd0\x6a\x17\x30\x28\x86\x6b\x23\x9c\x40\xcf\x2e\x69\xbf\x4d\x97\xe4\x24\x20\xc2\x4c\x68\x52\x78\x10\x6e\x05\x4c\x81\x48\x69\xcd\x91\xd9\x18\x83\xb6\xd0\x2a\x25\xda\x4e\x7b\xc4\x14\x47\xb4\xba\x6e\xc4\xa6\x63\x96\xda\xba\x1f\x5f\x5d\x4e\xae\xe7\x77\xe3\xd1\xa1\xc5\x1a\xad\x6b\x85\xfd\xc9\x8b\x98\x79\xef\x3f\x7d\x11\x0d\xac\x2f\x5e\x03\xc1\x9d\xbe\x84\x82\xb9\x15\xb1\xc2\xbc\x4c\x3e\x72\xc1\x52\x1b\x2f\x7e\x53\xf1\xb5\x8e\x37\x7b\x3e\x2b\x3b\x09\x59\xaf\xa5\x85\xe9\xcd\xcd\x29\x1d\x78\xd3\x25\xd5\x96\x46\x81\x5e\x2e\xbd\x34\x44\xac\xc2\xd1\xed\x08\x65\x91\x97\x06\x67\xf7\xa2\xa0\xbd\x4a\xa6\x37\x5a\xcb\x23\x89\x6a\xd0\x81\xbd\x17\x05\x39\x8f\x17\xeb\xbd\x48\x53\x54\x67\xe0\x4c\x89\x2d\x31\x3d\xd3\x5a\xc9\x8d\x17\x2e\xc5\x35\x14\xa5\x29\xb4\xc5\x04\xac\x63\xc6\xed\x12\x0f\x1a\x6f\x1b\xba\x74\x75\x7c\x0d\xcc\x5f\x36\x78\xfb\xec\x45\x27\x0e\x29\xda\x18\x2d\x2d\x3c\xac\xd0\xad\xd0\xec\xad\xd6\xdf\x1e\x59\xa4\x5b\xa1\x47\x50\x09\xf9\x9b\x6d\xd9\x33\x5f\x31\xba\x57\x95\xc2\x4a\x5b\xe7\xd3\x60\xe2\xa1\x2f\x97\x27\x28\x92\x1d\x7b\xd1\x88\x37\x60\x9c\x63\xe1\xac\xf7\x9f\x06\x4e\x7f\x3c\xf8\x51\xe5\x2a\x0d\xd9\x28\xd6\x10\xfc\x8e\x1a\x99\x95\x0f\x05\x0d\x0c\x81\x03\xda\x10\x16\x72\x9d\x06\x82\xc2\x82\x2d\x2d\x11\x15\x0b\x32\x5c\x0d\x39\x53\x03\xa1\x06\x6e\x85\x83\x5c\xa4\x29\x45\x2c\xe7\x18\xbf\xb7\x15\x8a\x39\x05\x2c\xbb\xd2\xa5\x4c\x29\x5a\xb5\x2f\xc1\xa1\x25\x3f\x4f\xda\xd7\xbe\x57\xed\x0f\x5f\xbe\xd7\xf4\xe6\x2f\xd9\x40\xb8\xb3\x2a\x9c\xda\x5a\x59\x7b\x25\x79\x12\xa4\x1b\xa1\x55\x6d\x13\xac\x28\x88\x31\x0b\xe7\xf0\xe5\x2b\xb1\x5a\xb3\x79\xc8\xf6\x9e\xef\x14\x17\x65\x46\xe7\x1b\x5c\x4d\x14\x23\x65\xfa\x2d\x90\x3a\xcb\x84\x0a\x20\x3b\x77\x7a\x3b\x79\xf3\xe9\x9d\x5f\xdb\xc6\x01\xff\x27\xa1\xdc\x33\xf8\x07\x54\x54\x1f\x10\xf1\x1b\x40\x1b\xa0\x55\x28\xd6\x14\xba\xe1\xca\xb9\x62\x58\x14\x46\x2f\x81\xaa\x47\xb2\x2f\x7c\x24\xb7\xa8\x52\x4a\xe7\x33\x93\x25\x21\xf0\xe7\x6f\xb5\x71\x27\x18\xbb\xbb\xbd\x99\xce\x7f\x84\xbb\xaa\x80\x6e\xb0\x57\x63\xaf\x36\x8e\xd1\xbf\x9f\x8c\xae\xe6\xef\x7f\x18\x7f\x8e\xce\x08\x6e\x4f\x10\x08\x3b\xc7\x14\x3e\x4e\xe6\xd3\xcb\xf1\xec\x04\x89\xd3\x17\xe8\xed\xad\x30\x9a\xa3\xb5\x83\x80\xf5\x40\xd5\x04\x02\x5c\x4b\x89\x9c\xcc\x1b\x02\x34\xb4\xa0\x77\x0c\xcc\x3e\x5c\xde\xde\xdd\x4e\x6f\xc6\x93\xd9\xec\x2e\x70\xd3\x66\xa4\x8a\xe8\x33\x29\x38\x1e\xf3\xe3\xd8\xa1\x39\x09\xb5\xd4\xe4\x84\x46\x2c\x4a\x87\xb6\x11\x5e\x93\x60\xca\xa4\x13\x28\x98\x30\xb6\x4e\x6a\x4b\x6d\x72\xe6\xa8\x44\x3b\xf7\xbb\x09\x18\x2c\x90\xb9\x46\xc1\xd1\xa8\x1e\xf3\x52\x3a\x51\x48\x04\xc9\x16\x28\x93\x43\x81\x26\xd3\xcf\x93\xe9\xdd\x7c\xf4\xee\xa4\x1c\xc7\x22\x18\x2d\xe5\x82\x99\x81\xd3\xf7\xa8\x0e\x84\x09\x7b\xe0\xf7\x28\xde\x18\xa4\xcb\x85\x07\x66\x7c\x81\xe7\xa3\xd9\x42\xaf\xa9\xbe\xca\x40\xe2\x1a\xa5\x3d\xe0\x67\x7a\x73\x75\xf5\x66\x34\xbd\x9b\xdf\x7c\x98\x5c\xef\x38\x22\xff\x0d\x6d\xc6\xf3\x3e\x7c\xda\xca\x32\x53\xf0\x13\x26\x46\xcb\xc7\xf6\xf5\x6e\x7a\x3b\x6e\x19\xd7\x51\x0f\xf6\x8e\x39\x7c\x60\x1b\x6a\xc3\x5e\xa4\x5a\x81\x9d\x22\x5c\xed\x9c\xa0\x3d\x9a\x4f\xfe\x18\xfd\xeb\x87\x6d\x5b\xe9\x41\xc0\x75\xa0\xc2\xeb\x9b\xbb\x80\xab\x29\x45\x68\xc6\x5e\xba\xdc\x9e\x75\x54\xcd\x5a\xa7\x0d\xf6\x4e\xc8\xde\xec\x72\xf7\xd7\xde\x23\x78\x1a\x12\xa4\x46\xac\xd1\xc4\xc0\x4b\x63\x50\x39\xb9\x01\x5b\x16\xa4\x00\x4c\xe1\x4b\x91\xd9\x6f\xf2\x6b\x4b\x15\x3d\xbf\x16\x08\xa1\xb4\xf8\x9f\xe0\xcd\x31\xd7\x66\x73\x80\xb8\x5a\xec\x1d\x08\xee\xf5\xd9\x68\xf2\xc2\x67\xbb\x89\x6a\xc0\xbc\x15\x26\xdc\xc0\xae\x78\xa6\xef\x66\xf6\x9b\x5f\xcd\xda\xd6\x49\x15\x28\x59\x79\x95\x0c\xc3\x57\x23\x17\xf6\x1a\xe5\xd7\x5e\x4e\x2a\x68\x1a\x1b\x23\xee\x84\x56\x67\xb0\x2c\x15\x8f\x38\xfc\x57\x85\xca\x0f\x5c\xfa\x10\xa1\x31\xe0\xbb\xdf\x3e\x3c\x7d\xc7\x3e\x3b\xbc\x80\xb3\x73\xf8\x95\x4b\x71\xcb\x8c\x45\xf3\xc4\xdd\xe3\x19\xf0\xd8\xd7\x4c\x64\xec\x55\xb1\x1a\x92\x6f\xb5\x5a\xd9\x54\xb5\xb4\x3d\xbc\x9b\x3f\x85\x70\xdb\xd6\x7f\xc7\xa0\xaf\x24\x2b\x49\x2b\x21\x23\x5e\xf4\x5b\x9e\x46\xca\xdb\x4b\xe3\x27\x1e\xcf\x19\x20\x99\xaf\x3d\x03\x56\x14\xa8\xd2\xa8\x19\x1e\xe2\x7a\x51\xa7\x82\x87\x95\x74\xe1\x7f\x24\x49\xd2\xa7\xff\xc7\x7b\xc9\x4e\xce\x58\x5e\x46\xbf\xc3\xfb\x12\xaa\x1f\xe7\x36\x5d\x9c\x42\xd6\x3c\xd6\x82\x6f\xa9\x35\x94\xbb\x13\x63\x3e\x0a\x6b\x85\xca\xe6\x57\xb3\x4b\x4a\x2a\xa1\xdc\x55\xc8\x1d\x50\x96\xa1\x9c\x41\xe3\x17\xa5\x5d\xc8\x0d\x02\xd3\x6e\xe7\xf8\xe0\x79\x65\x5e\x36\xf1\x83\x94\x65\x44\x1d\x3e\x25\x1b\x2a\x7b\x87\xda\xec\x1b\x0f\xdb\xc6\x95\x50\xab\x01\xbf\x0d\x06\xbf\xd8\xdf\x40\x9b\xfa\xd7\x30\xfc\xe8\xc5\xb0\x77\x2c\x3f\xa1\x8a\xe1\xc8\xfd\xf6\xeb\xb5\x97\xfa\x95\x7e\xb7\xdf\xed\x92\x3b\x80\xb0\x14\x39\x3f\x53\xcf\x15\x51\x6c\x81\x52\x28\xd7\x87\x85\xd6\x12\x9e\xba\xb5\x89\xf9\x9d\xff\x85\x57\xf0\xeb\xaf\x55\x15\xf5\x4f\xf8\x9f\xd7\xaf\xff\xfb\x75\x77\x1b\xd0\x38\x96\xd9\x0b\xa3\x73\x9f\xa7\x23\xb9\xb0\xf0\xe5\x6b\x35\x9c\xec\x43\xce\x8a\x2f\xd5\xef\xb0\x44\x88\x1d\xcb\x3e\x32\x6f\xfc\x47\xdb\x4f\xdb\x6e\x87\xd2\xf6\x5d\x0c\x96\x00\x0c\x53\x19\x02\xe1\x24\xc7\x57\x6b\x5a\x0b\x83\xcf\x64\x56\x48\xe1\x22\x1b\x43\x2f\xee\x91\xe1\x87\x73\x72\x7f\x4e\xad\x89\xdc\xe9\x73\x32\x86\xde\xb9\x3f\xd7\x11\x4b\x90\xa8\x22\xb5\xee\xc3\xf9\x39\xfc\xad\x3a\x13\xb8\xfc\xa2\xd6\x5f\x5e\x7d\xfd\x0a\xe7\xa0\xd6\x5f\x7e\xff\x4a\x3b\x64\x4a\xc1\x58\x82\x8a\x2a\xd0\x9d\x42\x84\x12\x2e\xa2\x88\xe2\xf3\xc3\xe7\x6a\x54\x77\x6b\x84\x72\x48\x63\x96\x93\xa1\x88\x68\x2e\x73\x97\x78\xb0\x65\xd4\xfb\xc5\xfe\x5b\x41\x38\x7a\x06\xe0\x3f\xdf\x09\x07\x14\x06\x85\xdb\xad\xe8\x43\x98\x9b\xd9\x70\x64\xf8\xca\x7f\x0e\xfd\xa9\x37\xa5\x90\xf5\x01\x1f\x12\x3b\xcd\xb1\x66\x2f\x86\x30\x4b\x8c\x61\x37\x3d\x8c\x21\xcc\x99\x93\x80\x3d\xea\xef\x97\xde\xdd\xdc\xcc\x9a\x5f\xa3\xe9\xf8\x7d\x0c\x3c\x19\x15\x45\x32\xd6\x79\x21\x24\xa6\xfd\x5d\x9b\x50\xd1\x69\x8f\x52\x7b\xd5\xce\x58\x17\x1b\x23\xb2\x95\x1f\x56\x46\xbc\x0f\x7f\x7b\xf5\xfb\xdf\x61\xb7\x1a\xa0\x7c\x64\xaf\x11\xbc\x45\xcb\x8d\x28\x28\xda\x81\x47\x54\xc1\x04\x2e\xe1\xbc\x96\xa5\x5a\xae\xcb\x9b\x10\x27\xea\xa6\x25\x06\xcd\x27\x8f\x64\xca\x68\x76\xb1\xa2\x66\xc9\x27\x99\x7d\x4d\xd4\xc8\x3a\x21\x6c\x84\x15\x9f\xaa\xea\x0b\x3f\x88\xc2\xfe\x6a\xab\x98\x7e\x98\x63\x3a\x3a\xa6\x0f\x32\x47\x5e\x24\x19\xba\xb1\x56\x4b\x91\xd1\x14\x56\x2c\xfd\xce\x4f\xe7\xa0\x84\xf7\xbf\xda\x01\x0f\x22\x88\x50\x7e\x5c\x45\x51\x21\xaf\x82\x0d\xe5\x47\x60\x26\x2b\x73\x3f\xb5\x1a\xc0\x2f\xeb\x9e\x27\x13\xae\x21\x70\xee\x6f\xe2\xec\xf0\x2a\xba\x1d\x6a\xbc\xd0\xec\xf8\xca\xd0\x4d\xb5\x76\x57\x7e\xb5\x0e\xae\x74\x36\x06\xfd\x1d\x36\x89\x5c\x27\xc5\x25\x1a\x2a\x4a\x33\x34\xc9\x85\x2c\xed\x2a\xea\xef\xa8\x24\x14\x4e\x97\x11\x55\x47\xc6\xb7\x08\xbf\xd4\x1d\x67\x2f\xae\x03\x33\xd1\x7a\x69\x62\xdf\xa1\x79\xcc\x0d\x75\xff\x67\x74\x4d\xf4\x95\xdc\x78\x9b\x20\xa5\xf9\xcf\xc0\x7c\x45\xb3\x1f\xd7\xcb\xfb\xe1\x3e\x4d\xe2\x3f\xb2\xa2\x10\x2a\x8b\x0e\x07\xff\x31\x1c\xce\xec\x09\x43\x2b\x01\xfb\x34\xb1\x60\x56\x70\xd0\x9e\x32\x8d\x3a\x98\xf3\x33\x4b\xee\x07\x67\xbe\xf9\x60\x52\x06\xe9\x6c\xb7\xa3\x77\x1c\x87\x9e\x64\xcf\x73\x58\x38\xe6\x3a\x6c\xbc\xa5\x16\x34\xd2\x89\x6f\x45\x63\xd0\x49\x4a\x81\xbb\x1f\x07\xe4\xc9\xfb\x5d\x13\x19\xe9\x64\xd5\xde\xfb\xb8\xef\xff\x22\x9d\xe4\xf4\xb7\x89\xfa\xb6\x6a\xd1\x02\x54\xf4\x93\x4e\xa8\x89\x6b\xaf\xee\x91\xcd\x59\x66\x23\x9d\x50\xc4\x6f\x22\x99\x1b\xc6\x31\xd2\x89\xe6\xa3\x0c\x95\x4b\x1c\x7d\x57\xcd\x7d\xda\x84\xbb\x19\xfb\xfd\xc9\x6d\x03\x96\x06\x0d\x31\xec\xbf\x8b\x16\xf7\xe1\x04\x99\x84\x2d\xda\x44\x54\xbd\xd6\x0f\x65\xa3\x58\xc2\x4f\x3a\x71\xd2\x7a\x11\xbc\x69\x7a\x9d\xef\x02\x00\x7d\xed\x45\xb9\x9a\x8d\x0d\xa6\x24\x8d\xb4\x09\xaf\xeb\x58\xa8\xbe\xef\x71\xd3\xfc\xe4\x8c\xbe\xfa\xc1\x9f\xb8\x7b\x8c\x81\x33\xc5\xd1\xe7\x9a\xf0\x7e\x97\xfc\x21\xdc\x6a\xec\x57\xa3\x7a\xe9\x0d\xe3\xf7\xf4\x48\xa4\xd2\x88\x0e\x57\xae\x51\x9d\xf4\x4e\x41\x65\x39\x36\x3d\x6f\x46\x5d\xc3\x8c\x56\x23\x4f\xa5\xf6\xcd\xef\x79\x1e\x95\x18\x7f\x18\x56\x2c\x29\xdc\xc4\xf4\xf6\xc3\xc2\x98\x8a\x1b\xa4\x19\x26\xf9\x19\xa1\x3d\x74\xb3\xbd\xc7\x7a\x5e\x92\xb1\xd4\x16\x29\x1c\xd1\xc8\x5b\xa2\xf1\x26\xcb\x0a\xf1\x3e\x7c\x56\x65\x72\x95\x5e\x7f\xf6\x5c\x27\x53\xb4\xba\x34\xbc\x2e\xce\x9e\x9e\xc0\x61\x5e\x48\xa2\xda\x0b\x58\x7a\x10\xfd\x9c\x5c\x68\x53\x83\xc2\xcf\xa6\x0f\xdb\x96\x4b\x11\x23\x27\xae\x6b\x6f\x3c\xb7\x46\x2f\xd0\x46\x8d\x92\xa1\x9a\x9d\x54\x1b\x4f\xbe\x75\xc2\xde\x59\x25\xe6\xb6\x69\x76\x54\x9c\x8f\x6e\x2f\xa3\x5a\xa4\xbd\x79\xd1\x4e\xf0\x8c\x2c\x78\xc6\xcb\x5d\x40\x7d\xae\x5a\xa3\x63\x0f\x3b\x3b\xdf\x21\xdd\x77\xa5\x1e\xa0\x81\xb8\x96\xf5\x85\xf0\x46\x91\x45\xec\x1f\x03\xd1\x80\x42\x4c\x2d\xe8\x02\x69\x64\xbf\x2b\x43\xe9\xb5\x33\x05\x0a\x6b\x04\xab\xbc\x79\x68\x91\xf2\x3a\x30\x86\xdf\x14\x9b\xa8\x38\x1b\x5f\x5d\x46\xbc\x48\xb8\x7b\xec\xff\xc3\x57\x3b\x35\x6c\xdf\xd7\x76\x64\x4d\x61\x4e\x4b\xcf\x8f\x79\x69\xa9\x8b\x72\x65\x01\x0a\x39\x5a\xe6\x1f\x97\x7c\xe2\x03\x29\x14\x52\xb6\xb1\x61\x56\x2e\x6c\x08\xaf\x63\xf7\xb8\x33\x65\xe2\x8b\x5e\x18\xa7\x55\x1d\x51\x19\x73\xb8\xd7\x3a\x7c\xc7\x35\x8f\xbb\x46\xa2\xdb\x39\x61\xe4\x7f\xc6\xca\x09\x61\x5d\x9a\x54\xba\x09\x9e\xe8\x0b\xbd\xed\xcb\x11\x61\x54\xba\x55\xcd\x6f\x90\xa7\xdf\x3f\x0a\xfc\xc6\xed\x84\x0c\xe7\x0e\xc5\x6c\xf8\x57\x4c\xe9\x21\xd4\x17\x7f\xd9\x7d\x3d\xb5\x5a\xba\x13\x7e\xec\x1f\x72\xf9\x8e\x3b\xe3\x92\x19\x65\x59\x62\xea\xbb\xe4\xeb\xd7\x5f\x12\xf7\xfb\xb9\x3a\x49\x92\x43\xfa\x9e\x38\x9c\xc3\x3f\x07\xc4\x04\xbd\x92\x2f\xa4\xe6\xf7\x94\xe2\x75\xa0\x01\x7c\xc5\x94\x42\x79\x8a\x97\x40\xb1\xae\x71\xa6\xf5\x4b\x7a\x75\x70\x69\x74\xde\xc6\xd1\x2e\x71\x3a\x5e\x56\x5d\x54\xa2\xee\xca\x72\xaa\x48\xea\x12\x8d\xfe\xd5\x45\x55\x93\xd3\x03\x83\x12\x4e\x30\x29\xfe\xaf\x7a\xae\x2c\x2c\x96\xa9\x1e\xd0\xbf\xb4\xd0\x39\xa8\x32\x5f\xa0\x81\x0c\x15\x1a\xe6\xb4\x09\xef\x65\x50\x2a\xf1\xad\xac\x67\x88\x56\xc3\x43\xf5\xb4\x91\xa1\xab\xb7\x2c\x7e\x2b\x51\x51\x20\x64\xdc\x68\x6b\xe9\xae\xe8\x35\x80\x10\x27\x33\xc4\x34\xa2\x9b\x4b\xae\xf5\x43\xd4\x4f\x3e\x29\xf1\x78\xcd\x94\xa6\xc4\xb0\xd3\x08\x79\x4e\x51\x24\xd3\x52\x45\xda\x26\x23\x93\xd9\xfe\x3f\x4e\xa8\x2a\x99\xa1\x9f\x01\xdb\xe8\x55\x3f\xac\x5c\x30\xc7\x24\xf5\x0d\xeb\x7f\xab\x86\x66\xc8\x78\x21\xc5\xa5\x50\xcd\x60\xbc\xdd\x2a\x7c\xa0\x7a\x7a\x26\x54\x56\x4a\x66\x92\x5b\x66\x39\x93\xb0\xdd\xd2\x10\x83\xda\xb8\x90\x99\xea\x5a\xe4\xe9\x09\x50\xa5\x30\xd8\x6e\xbb\xff\x3f\x00\x56\x03\x44\xd2\xc8\x22\x00\x00"
 
 func cmdMainGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -304,7 +329,7 @@ func cmdMainGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _cmdOceGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xd1\x6f\xda\x3e\x10\xc7\x9f\xed\xbf\xe2\x7e\x79\x0a\x3f\x01\x7d\xea\x36\x45\xe2\x21\x0d\x69\x57\xa9\x83\xaa\xd0\xbe\x4c\x13\x32\x9e\x71\xad\x39\xe7\xc8\x76\x50\xa7\x8a\xff\x7d\xb2\x81\x40\xc8\xb4\xcd\x4f\xd8\x77\xc7\xf7\x73\x97\xef\xd5\x8c\xff\x60\x52\x40\xc5\x14\x52\xaa\xaa\xda\x58\x0f\x89\x54\xfe\xb5\x59\x8f\xb9\xa9\xae\x1a\xbb\x61\x5b\x71\xc5\xb5\x4a\x28\xf5\x3f\x6b\x01\x29\x25\x86\x97\x6f\x21\x53\xd8\xc2\xe0\x46\x49\x70\xde\x36\xdc\xc3\x3b\x25\xc4\x5b\xc6\x45\x89\x6c\xad\xc5\x77\x58\x1b\xa3\x29\x21\xaf\xc6\x79\x38\x1e\xe7\xad\x42\x49\x09\x89\x5a\xc7\xd3\x28\xf4\x94\x10\x64\x95\x70\x35\xe3\xe2\x3c\x73\x47\x07\x94\x6e\x99\xed\x4a\xdf\x6a\x26\x1d\x4c\xe0\xeb\x37\xae\xd5\x38\xdc\x82\x7c\xf8\x7d\x63\x8c\x3e\xde\xc9\x8c\x55\x22\x03\x80\x04\xcd\x28\xb2\x25\xc3\xf0\xfc\xec\x98\x0c\xef\xc9\x54\xb9\xc0\x0a\x21\xa6\x50\xee\xa3\x25\x6e\x5f\x98\xcd\x20\x59\x3e\xe5\x45\xb9\x9a\xde\x2f\xf2\x9b\x87\x72\x1a\x83\xbb\xe1\x41\x66\x11\xf1\xfa\x42\x86\x8f\x98\x14\xe8\x47\xa1\xed\xfd\xff\xbd\x30\xdd\x44\x35\x6d\x38\xd3\xa7\xf7\x96\xc2\xd4\x02\xb9\x40\xd7\x38\x88\xb5\x70\xca\x69\x59\xe6\xc5\x2a\xbf\x2b\x67\xcb\xd5\xe7\xf9\x62\xd9\x45\x79\x56\xe8\xff\x00\x12\x06\xd6\x05\xb9\xbe\xfe\xf0\xf1\xd3\x5f\x10\x4e\x55\x7d\x84\xc7\xf9\xd3\xf2\x9f\xa7\xd1\x7e\xd4\x8b\xa6\x9d\xb0\x5b\xc5\x05\x5c\xc4\xcf\xd5\x66\xf9\x97\x72\xf1\x98\x17\x65\x2b\x16\xcd\xb0\x69\x90\x83\x14\x7e\x5e\x74\x8d\x78\x6b\x4d\x55\x3c\xdc\xa7\x1c\xfe\x0f\x4c\x85\x41\x2f\xde\xfc\x10\x0e\x4a\x01\xea\xe0\xaa\x01\xf4\x4c\xfc\x4e\x09\x3a\xc8\x26\xc0\xc7\x77\xda\xac\x99\xde\xb7\x94\x76\x5b\x18\x50\xa2\x36\x80\x0e\x26\x13\x48\x92\x68\xf9\x70\x39\x97\x08\x90\xc4\x0a\xdf\x58\xec\xa9\x5c\xae\x48\x06\xff\x1d\xe5\x82\x6d\xd3\x93\x4d\x07\xc3\xc3\xe6\x64\xed\x92\xfc\x06\xec\xcc\x69\xb1\x20\x68\xf5\x0b\x82\x3f\xd2\x0b\x3f\xc4\xf4\xb6\xaf\x58\x83\x2e\x0e\x78\x47\x7f\x05\x00\x00\xff\xff\x0e\xae\xa5\x54\x17\x04\x00\x00"
+var _cmdOceGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x51\x6f\xda\x3c\x14\x7d\xb6\x7f\xc5\xfd\xf2\x04\x9f\x80\x3e\x75\x9b\x22\xf1\x90\x86\xb4\xab\xd4\x41\x55\x68\x5f\xa6\x09\x19\xcf\xa4\xd6\x9c\xeb\xc8\x76\x50\xa7\x8a\xff\x3e\xdd\x00\x21\x21\xd3\x36\x9e\xb0\xef\x39\x39\xe7\xd8\xc7\xa5\x90\x3f\x44\xae\xa0\x10\x1a\x39\xd7\x45\x69\x5d\x80\x28\xd7\xe1\xb5\xda\x4c\xa4\x2d\xae\x2a\xb7\x15\x3b\x75\x25\x8d\x8e\x38\x0f\x3f\x4b\x05\x03\xce\xac\xcc\xde\x08\xa9\x5c\x6a\x71\xab\x73\xf0\xc1\x55\x32\xc0\x3b\x67\x2c\x38\x21\x55\x86\x62\x63\xd4\x77\xd8\x58\x6b\x38\x63\xaf\xd6\x07\x38\xfd\x7c\x70\x1a\x73\xce\x18\x7d\xe1\xb4\x09\x95\xc6\xc0\x19\x43\x51\x28\x5f\x0a\xa9\xda\xc8\x3d\x1f\x72\xbe\x13\xae\x2b\x7d\x6b\x44\xee\x61\x0a\x5f\xbf\x49\xa3\x27\xb4\x22\x79\xfa\x7f\x63\xad\x39\xad\xd9\x5c\x14\x2a\x06\x80\x08\xed\xb8\xf6\x16\x8d\x38\x63\xec\xd9\x8b\x9c\xf6\xa3\x99\xf6\xe4\x15\x68\xa6\x31\x3f\x4c\x33\xdc\xbd\x08\x17\x43\xb4\x7a\x4a\xd2\x6c\x3d\xbb\x5f\x26\x37\x0f\xd9\xac\x1e\xee\x47\x47\x99\x65\x1d\xa4\x2f\x64\xe5\x58\xe4\x0a\xc3\x98\x62\xd7\x14\xf6\x22\x4c\x45\xc3\xc8\x58\x29\xcc\x79\xbf\x71\x61\x4b\x85\x52\xa1\xaf\x3c\xd4\x5c\x38\x63\x1a\x2f\x8b\x74\x9d\xdc\x65\xf3\xd5\xfa\xf3\x62\xb9\xea\x5a\x79\xd6\x18\xfe\x60\x84\x0e\xac\x6b\xe4\xfa\xfa\xc3\xc7\x4f\x7f\xb1\x70\x66\xf5\x2d\x3c\x2e\x9e\x56\xff\x7c\x1a\xcd\xa5\x5e\x84\xf6\xca\xed\xb4\x54\x70\x31\x6f\xab\xcd\x93\x2f\xd9\xf2\x31\x49\xb3\x46\xac\x2e\xc3\xb6\x42\x09\xb9\x0a\x8b\xb4\x5b\xc4\x5b\x67\x8b\xf4\xe1\x7e\x20\xe1\x7f\x3a\x96\xd4\x62\x50\x6f\x61\x04\x47\x25\x32\x75\x6c\xd5\x10\x7a\x25\x7e\xe7\x0c\x3d\xc4\x53\x90\x93\x3b\x63\x37\xc2\x1c\x2e\x78\xd0\x8d\x30\xe4\x4c\x6f\x01\x3d\x4c\xa7\x10\x45\x75\xe5\x69\xd1\x96\xa0\xc6\x32\xa7\x42\xe5\xb0\xa7\x72\xf9\x44\x62\xf8\xef\x24\x47\xb5\x1d\x9c\x6b\x3a\xa4\xc4\x54\x83\xf8\xf8\x44\xe0\x77\xc6\x5a\x4d\xab\x09\x94\xa8\x4f\xa0\x7e\xb4\xe0\x04\x3a\xc0\x9b\x5c\x35\x07\xfd\x88\xb3\x3d\xdf\xf3\x5f\x03\x00\x0e\xae\xa5\x54\x17\x04\x00\x00"
 
 func cmdOceGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -324,7 +349,7 @@ func cmdOceGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _cmdOidcGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x51\x6f\xe3\x36\x0c\x7e\xb6\x7f\x05\xe7\x01\xd7\x64\x48\x93\xbb\x1b\x6e\x1b\x7a\xc8\x80\x20\x49\x6f\x2e\xb2\x76\x4b\xd2\xbb\x87\xa2\x08\x54\x99\xb6\xb9\xca\x92\x27\xc9\xe9\x82\x43\xff\xfb\x20\xc9\x49\xd3\x26\xbb\xf5\xa5\x85\x4b\xf2\xfb\xc8\x8f\x14\xd9\x9a\xf1\x7b\x56\x20\x54\x8c\x64\x1c\x53\x55\x2b\x6d\xa1\x13\x47\x89\xb1\x9a\x64\x61\x92\x38\x8e\x92\x82\x6c\xd9\xdc\xf5\xb9\xaa\x06\x5c\x32\x4b\x6b\x1c\xd4\xf7\xc5\x80\x35\xb6\x4c\x9e\x9b\x1b\x9d\xb3\x35\x0e\xb8\xa0\x24\xee\xc6\xf1\x9a\x69\x07\xa6\x32\xe2\xe7\x82\x15\x06\x86\x70\x73\xcb\x05\xf5\xdd\xd7\xd7\x38\x8e\xa2\xc1\x00\xae\xd2\xc9\x38\x35\xa6\x41\x7d\x3d\x9f\x41\x2e\x58\x01\x56\x81\xa9\x91\x53\xbe\x01\x50\x94\x71\x20\x6f\x87\x46\x8b\x38\x8a\x1c\xc0\xc2\xa7\x17\x60\xa2\x28\xba\x64\x15\x9e\x01\x40\xe2\xbc\x4f\x83\xf7\x69\xa3\x45\xd2\x73\xd6\x6b\xc3\x0a\x67\x4e\x1c\x81\xca\xc1\x96\x08\x57\x35\xca\x74\xd2\x02\xf7\x40\x49\xb1\x81\xdf\x96\xcb\x3f\x16\x60\x78\x89\x15\xc2\x03\x09\x01\x77\x08\x8c\x73\xac\x2d\x66\x7d\x48\x73\x30\x68\x7b\x40\x76\x67\x6c\x0c\x66\x2e\xdb\x35\x6a\x97\xac\x07\x4e\x27\x63\xb8\x58\x5c\x5d\xc2\x17\xbc\x83\xa5\xba\x47\x09\x9d\x8b\x2f\xcb\x6e\xc8\x65\x2a\xd7\x9f\x99\x3e\x83\xc4\xf9\xad\xd2\xc5\xe2\x7a\x3a\x5f\x5d\xcf\x67\xde\xfa\xd8\xdb\xd3\x64\x2c\x08\xa5\x4d\x27\x07\x92\x78\x45\xb8\xb7\x02\x65\xaf\x11\x24\x38\x9f\x52\xf6\x42\x8f\x16\xc4\x71\x28\xbd\x2f\xcb\x58\x49\x89\xdc\xb6\x2c\x3d\xa8\x1a\x63\x5d\xbd\x06\x2d\x50\x0e\x2f\x54\x06\x32\xce\x72\xac\xc0\xf1\x2c\x9d\x5e\x2e\x57\xe9\xe4\x48\x7d\x23\x97\xed\x41\x75\x5a\x29\x0b\x9c\xb9\x3e\xed\xb7\xde\xa0\x5e\xa3\x7e\x55\xb1\xec\x34\x27\x81\x2f\x4a\xdd\x36\x6f\xaf\xc8\x00\x79\x62\x80\xa3\xb6\x94\x13\x67\xf6\xa9\xed\xbe\xa3\x84\x19\xdc\x6d\x40\x49\xdc\x8e\x8d\x9b\x79\xa5\xc9\x12\x1a\x20\xe9\xff\xb4\x4f\xda\x03\x65\x4b\xd4\x0f\x64\xd0\xdb\x4a\x65\xec\x89\x09\x35\x8d\x47\x5e\xbe\xfd\xd1\x39\xaa\xd8\x68\x35\x9e\xce\x97\xab\xf3\x74\x36\x3d\x14\x6d\x8e\x7f\x37\xa4\x31\x1b\x0b\x46\xd5\x81\x76\x15\x49\xaa\x9a\x0a\x74\xeb\xb5\x1d\x15\x46\x95\x79\x26\xdd\x42\x10\xc7\xff\xd0\x6f\x1b\x7c\xea\xe3\xbe\x21\xa3\xb7\xf7\xe0\xa1\x24\x5e\xba\x11\x90\xac\x42\x58\x33\xd1\x20\xd4\x8c\xb4\xd9\x6a\x96\x2b\x5d\x31\x0b\xf7\xb8\x19\x06\x2b\x99\x27\x79\xad\x72\x62\xd4\x1a\x8d\x1f\xe7\x20\x69\x3a\x69\x1f\xce\x03\xd9\x12\x18\x54\xcc\xf2\x92\x64\x11\xd0\xfb\xa0\xb1\x46\x66\xc1\x96\x64\x0e\x35\x68\x84\xa5\x5a\x20\x08\x76\x87\xe2\x98\xc2\xf3\xe9\x9f\xd7\xe9\x7c\x3a\x59\x8d\x67\xa3\xf4\xf7\xc5\xa1\xc8\x0b\x2a\x24\xc9\x62\x24\x0a\x75\x88\x6f\x82\x11\x98\x28\xdc\x20\x94\x95\x71\xd6\xc6\xe0\x6b\x66\xb3\x0d\x3e\x75\xc1\xe6\x85\xb4\x17\x57\x8b\x29\x30\xb3\xa9\x2a\xb4\x9a\xf8\x11\xa2\x3e\x5c\x7c\x59\x9a\xad\x28\x27\x4c\x14\x27\x50\x22\xcb\x50\xb7\xb2\x4b\xd5\x4a\x48\x06\x04\x99\xa7\x61\xd3\xf8\x17\x72\xbf\xc4\x3e\x3b\x47\x03\x4c\x23\x64\x98\x93\x0c\x13\x3e\x3f\x1f\xc3\xcf\x1f\xde\xfd\x02\xa5\xb5\xb5\x39\x1b\x0c\xac\x52\xc2\xf4\x09\x6d\xde\x57\xba\x18\x94\xb6\x12\x03\x9d\x73\xe7\xf3\xbd\x41\x6e\x49\xc9\xd3\x1f\xfb\xef\x42\x09\x1e\xd3\x95\x30\x5f\xbc\xff\xf0\xd3\x31\xc9\x17\xe9\xa7\xcb\xf4\xf2\xd3\x6a\x34\xfb\x74\xb5\x13\x3c\x7a\x74\x37\xa2\xd5\x1c\x54\xed\x40\x0d\xe4\x5a\x55\xe1\x47\x98\x31\x02\xae\xa4\xc5\x7f\x6c\xdf\x6d\x1e\xa9\xc2\x4c\xe7\xfe\x9c\xec\x57\xb1\xdd\x60\xed\xa2\x18\x0c\xdc\x97\x04\xac\x6a\xbb\x01\xa6\x35\xdb\xb8\xb9\xd3\x68\x1b\x2d\x31\x8b\xf3\x46\x72\x0f\x75\x15\x78\xcf\xb5\xaa\xc6\xb3\xb4\xc3\xe1\x07\xd7\xc5\x71\xe0\xec\x42\x47\xd5\xd6\xc0\xcd\xad\x7b\xf8\xfd\xe0\xdb\x85\xaf\x71\x44\x39\xf0\xb6\xd7\x9d\x83\xab\xd3\x85\xe1\x10\x92\xc4\xf9\x45\x81\xd1\x15\x1b\x47\x7a\xff\xf5\x1a\x38\x1b\x42\xc5\xea\x9b\x70\x6a\x6f\xc3\xaf\xaf\x8f\x71\xe4\x4a\x59\xf5\x40\x38\x07\xcd\x64\x81\x3b\x2a\xff\x6e\x3b\x47\xdf\xa9\xcf\x2a\x92\x6b\x17\xd4\x1e\xef\xfe\xa2\x16\x64\x3b\xa2\x07\xc9\x30\xe9\xc6\x91\x4b\x5a\xa0\xec\xc8\xb5\x4f\xf0\xbd\x8f\x78\x91\xd4\x8d\x5c\xdf\xbc\xbd\xbd\x85\x21\xc8\xf5\xcd\xbb\x5b\xd7\xa9\x90\x3b\xe5\xed\x2a\x76\x47\xf4\x6c\xf8\xad\xea\x3f\xee\x79\x7e\xb7\x13\xc2\x0b\x39\x04\x56\xd7\x28\x33\x2f\x6b\x0f\x82\xaa\xbb\x7f\x00\x3a\xbb\xb8\x6e\xd7\xb1\x7a\x95\xb7\x67\xf0\x90\xf3\xe9\xac\x75\x3f\x3e\xf9\xbd\x92\x71\xd4\x64\x84\x92\x63\x67\x1b\x18\x28\x03\x27\x3b\x27\x81\xc7\x18\xdb\xdb\xe2\xf8\x82\xcf\x2b\xd9\xc6\x23\xe7\xdd\x09\x41\x5b\x26\xc3\x8e\x50\x3c\x5f\x11\x5d\x9f\x8f\xd9\x5f\x48\x07\xfd\x35\xac\x07\x49\xcf\xe5\x64\x58\x9b\xcf\x9b\x37\xbe\xd1\xfb\x71\x5d\xf8\x15\xde\xfe\x7f\xa2\xfb\xbb\xef\x79\xfc\xae\x23\x0e\xf9\xf9\xd0\xbc\x12\xfb\xd9\xf1\x32\x2f\x31\xba\xdb\x37\xe2\xde\x8b\x5b\x07\x26\x7e\x8c\xff\x0d\x00\x00\xff\xff\x2e\x46\x78\x8d\xa0\x0a\x00\x00"
+var _cmdOidcGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x96\x5f\x6f\xe2\x46\x10\xc0\x9f\xed\x4f\x31\x75\xa5\x0b\x54\xfc\xb9\xbb\xea\xda\x2a\x27\x2a\x21\x43\xae\x8e\x68\xd2\x02\xb9\x7b\x88\x22\xb4\x59\x8f\xed\x69\xd6\xbb\xee\xee\x9a\x14\x9d\xf2\xdd\xab\xf5\x1a\x02\x81\x5e\xf3\x92\x08\xe6\xff\x6f\x66\x67\xe8\xf7\xfb\xe1\x63\x81\xf2\x1c\x06\x17\xc8\x6c\xad\xd1\x0c\xae\x93\x49\x1c\x3a\x41\xc5\xf8\x03\xcb\x11\x4a\x46\x32\x0c\xa9\xac\x94\xb6\xd0\x09\x83\xc8\x58\x4d\x32\x37\x51\x18\x06\x51\x4e\xb6\xa8\xef\x07\x5c\x95\x43\x2e\x99\xa5\x35\x0e\xab\x87\x7c\xc8\x6a\x5b\x44\x87\xe2\x5a\x67\x6c\x8d\x43\x2e\x28\x0a\xbb\x61\xb8\x66\xda\x39\x53\x29\xf1\x0b\xc1\x72\x03\x23\xb8\xbd\xe3\x82\x06\xee\xd3\xd7\x30\x0c\x82\xe1\x10\x5c\x2e\x89\x31\x35\xea\x9b\xf9\x0c\x32\xc1\x72\xb0\x0a\x4c\x85\x9c\xb2\x0d\x80\xa2\x94\x03\x35\x72\xa8\xb5\x08\x83\xc0\x39\x58\x34\xe9\x79\x37\x41\x10\x5c\xb1\x12\xcf\x01\x20\x72\xda\x7d\xaf\xdd\xaf\xb5\x88\x7a\x61\x10\x04\x37\x86\xe5\x4e\x1c\xb9\x00\x2a\x03\x5b\x20\x5c\x57\x28\x93\x49\xeb\xb8\x07\x4a\x8a\x0d\xfc\xb6\x5c\xfe\xb1\x00\xc3\x0b\x2c\x11\x1e\x49\x08\xb8\x47\x60\x9c\x63\x65\x31\x1d\x40\x92\x81\x41\xdb\x03\xb2\x3b\x61\x6d\x30\x75\xd9\xae\x51\xbb\x64\x1b\xc7\xc9\x24\x86\xcb\xc5\xf5\x15\x7c\xc1\x7b\x58\xaa\x07\x94\xd0\xb9\xfc\xb2\xec\xfa\x5c\xa6\x72\xfd\x99\xe9\x73\x88\x5c\xd9\xab\x64\xb1\xb8\x99\xce\x57\x37\xf3\x59\x23\x7d\xea\xed\x31\x89\x05\xa1\xb4\xc9\xe4\x08\x89\xab\x11\x78\x23\x05\x4a\x5f\x03\xc4\x2b\xf7\x29\x7d\xc1\xa3\x75\xe2\x62\x28\xbd\x8f\x25\x56\x52\x22\xb7\x6d\x94\x1e\x94\xb5\xb1\x0e\x86\x41\x0b\x94\xc1\x0b\xca\x40\xc6\x91\x39\x55\x60\x3c\x4b\xa6\x57\xcb\x55\x32\x39\x51\xdf\xd8\xb5\xef\xa8\x3a\xad\x94\x05\xce\x40\x65\x07\xad\x37\xa8\xd7\xa8\x5f\x55\x2c\xeb\x67\x24\xf0\x45\xa9\xdb\xe6\xed\x15\xe9\x5d\x9e\x19\xe0\xa8\x2d\x65\xc4\x99\x7d\x6e\x7b\xd3\x51\xc2\x14\xee\x37\xa0\x24\x6e\xc7\xc6\xcd\xbc\xd2\x64\x09\x0d\x90\x6c\x90\xed\x07\xed\x81\xb2\x05\xea\x47\x32\xd8\xc8\x0a\x65\xec\x99\xf1\x35\xc5\x63\x97\xc1\xc1\xe8\x9c\x24\x36\x5e\xc5\xd3\xf9\x72\x75\x91\xcc\xa6\xc7\xd0\xe6\xf8\x77\x4d\x1a\xd3\x58\x30\x2a\x8f\xd8\x95\x24\xa9\xac\x4b\xd0\xad\xd6\x76\x54\x18\x95\xe6\x00\xdd\x42\x10\xc7\xff\xe0\xb7\x35\xee\x37\x76\xdf\xc0\xd8\xc8\x7b\xf0\x58\x10\x2f\xdc\x08\x48\x56\x22\xac\x99\xa8\x11\x2a\x46\xda\x6c\x99\x65\x4a\x97\xcc\xc2\x03\x6e\x46\x5e\x4a\xe6\x19\xaf\x55\x8e\x76\xa5\xd1\x34\xe3\xec\x91\x26\x93\xf6\xe1\x3c\x92\x2d\x80\x41\xc9\x2c\x2f\x48\xe6\xde\xfb\x00\x34\x56\xc8\x2c\xd8\x82\xcc\x31\x83\x5a\x58\xaa\x04\x82\x60\xf7\x28\x4e\x11\x9e\x4f\xff\xbc\x49\xe6\xd3\xc9\x2a\x9e\x8d\x93\xdf\x17\xc7\x90\x17\x94\x4b\x92\xf9\x58\xe4\xea\xd8\xbf\xf1\x42\x60\x22\x77\x83\x50\x94\xc6\x49\x6b\x83\xaf\x99\xcd\xd6\xb8\xef\x8c\xcd\x0b\xb4\x97\xd7\x8b\x29\x30\xb3\x29\x4b\xb4\x9a\xf8\x89\x40\x03\xb8\xfc\xb2\x34\x5b\x28\x67\x4c\xe4\x67\x50\x20\x4b\x51\xb7\xd8\xa5\x6a\x11\x92\x01\x41\xe6\x79\xd8\x34\xfe\x85\xbc\x59\x62\x9f\x9d\xa2\x01\xa6\x11\x52\xcc\x48\xfa\x09\x9f\x5f\xc4\xf0\xf3\x87\x77\xbf\x40\x61\x6d\x65\xce\x87\x43\xab\x94\x30\x03\x42\x9b\x0d\x94\xce\x87\x85\x2d\xc5\x50\x67\xdc\xe9\x7c\x6f\x90\x5b\x52\xb2\xff\xe3\xe0\x9d\x2f\xa1\xf1\x79\x0e\x10\xcd\x17\xef\x3f\xfc\x74\x0a\xf9\x22\xf9\x74\x95\x5c\x7d\x5a\x8d\x67\x9f\xae\x77\xc0\x83\x27\x77\x23\x5a\xe6\xa0\x2a\xe7\xd4\x40\xa6\x55\xe9\xff\xb8\x49\xe0\x82\x80\x2b\x69\xf1\x1f\x3b\x70\x9b\x47\x2a\x3f\xd3\xae\x2b\x87\x55\x6c\x37\x58\xbb\x28\x86\x43\x37\x49\x12\xb0\xac\xec\x06\x98\xd6\x6c\xe3\x66\x54\xa3\xad\xb5\xc4\x34\xcc\x6a\xc9\x1b\x57\xd7\x3e\xee\x85\x56\x65\x3c\x4b\x3a\x1c\x7e\x70\xf7\x25\xf6\x31\xbb\xd0\x51\x95\x35\x70\x7b\xe7\x1e\xfe\xc0\xeb\x76\xe1\x6b\x18\x50\x06\xbc\xed\x75\xe7\xe8\xea\x74\x61\x34\x82\x28\x72\x7a\x81\x8f\x18\x06\x4f\x61\x18\x6c\x9f\x56\xf3\x7a\x0d\x9c\x8f\xa0\x64\xd5\xad\x3f\xb5\x77\xfe\xdf\xd7\xa7\x30\x70\xa5\xac\x7a\x20\x9c\x82\x66\x32\xc7\x5d\xa8\xe6\xdd\x76\x4e\xbe\xd3\x26\xab\x40\xae\x9d\x51\x7b\xbc\x07\x8b\x4a\x90\xed\x88\x1e\x44\xa3\xa8\x1b\x06\x2e\x69\x81\xb2\x23\xd7\x4d\x82\xef\x1b\x8b\x17\x49\xdd\xca\xf5\xed\xdb\xbb\x3b\x18\x81\x5c\xdf\xbe\xbb\x73\x4f\xc3\xe7\x4e\x59\xbb\x8a\xdd\x11\x3d\x1f\x7d\xab\xfa\x8f\x7b\x9a\xdf\xed\x40\x34\x20\x47\xc0\xaa\x0a\x65\xda\x60\xed\x81\xa7\xba\xfb\x01\xd0\xd9\xd9\x75\xbb\x2e\xaa\x4b\x98\x6f\xcf\xe0\x71\xcc\xe7\xb3\xd6\xfd\xf8\xac\xf7\xca\x88\xe3\x3a\x25\x94\x1c\x3b\x5b\x43\x1f\xb2\x29\x94\xb3\x0b\x12\x78\xa2\xca\x76\xcd\xbb\x12\x5b\x9d\x57\x46\x8b\xc7\x4e\xbb\xe3\x8d\xb6\x91\x0c\x3b\x11\xe2\x70\x45\x74\x9b\x7c\xda\xef\xfc\x42\x3a\xea\xaf\x61\x3d\x88\x7a\x2e\x27\xc3\xda\xea\xdf\xbc\x69\x1a\xbd\x6f\xd7\x85\x5f\xe1\xed\xff\x27\xba\xbf\xfb\x0e\xed\x77\x1d\x71\x9e\x0f\x87\xe6\x95\xbe\x0f\x8e\x97\x79\xe9\xa3\x85\xe2\xdf\x0b\xa8\xca\x9a\xf0\x29\xfc\x77\x00\x86\xa9\x24\xcb\xbd\x0a\x00\x00"
 
 func cmdOidcGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -344,7 +369,7 @@ func cmdOidcGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _cmdPortsGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8f\x4d\x6e\xc3\x20\x10\x85\xd7\x70\x8a\xb7\xaa\xda\x15\x8e\x94\x26\xed\xc2\xbb\x1e\xa0\x57\x98\xc2\xc4\x20\xff\x80\x86\x71\xac\xdc\xbe\x32\xf6\xf6\x9b\x8f\xc7\x7b\x85\xfc\x48\x03\x63\xa6\xb4\x58\xeb\xf3\x52\x15\xef\xd6\x0c\x52\xfc\x6f\x16\x05\x80\x1e\xd7\xcf\x6b\x07\xe7\xb0\x53\x94\x2c\x6a\xcd\x40\xca\x1b\xbd\x9a\xd3\x84\x4b\x13\x0e\x7a\x38\xd6\x38\x87\xca\x3e\x2f\x81\xe4\x60\x15\x79\x01\x93\x8f\xa8\x2c\xcf\xe4\xd9\x9a\x99\x55\x92\xaf\x67\xd0\xf7\xa5\x6b\x41\xee\xc4\xd0\x48\x8a\x22\x79\x66\x8d\xbc\x56\x54\x2f\x54\xb8\x5a\x13\x99\x26\x8d\x47\xc7\x1e\xf7\xee\xde\x1a\xba\x29\x3d\x19\x6f\x70\xc2\x14\x5e\x48\x15\x5b\x12\x0e\xd0\x8c\xf1\xab\xe2\x78\x04\x1f\xd9\x8f\xd6\x04\xfe\x5b\x87\x73\x65\x8f\x5b\x77\x6b\x11\x81\x1f\xb4\x4e\x8a\x9f\xfd\x8a\x76\xde\x22\x0b\x63\x61\x75\x51\xb5\xb8\x52\x24\x3f\x10\x48\x69\xff\x60\x5f\xc2\xc1\x7e\xd8\xff\x00\x00\x00\xff\xff\x20\xc6\xa7\xa5\x4b\x01\x00\x00"
+var _cmdPortsGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x90\xc1\x6e\x02\x21\x14\x45\xd7\xc3\x57\xdc\x55\xd3\x2e\x2a\x63\x62\xb5\x5d\xb8\x6b\xda\x6d\x7f\xe1\x15\x9e\x03\x51\x81\x3c\xde\x68\x26\xc6\x7f\x6f\x06\x27\xdd\x91\x7b\xef\x39\x04\x0a\xb9\x23\x0d\x8c\x33\xc5\x64\x8c\xcb\xa9\x2a\x9e\x4d\x37\x48\x71\x3f\x59\x14\x00\xf6\xd8\xbc\x6d\x7a\x58\x8b\x39\x45\xc9\xa2\xe6\x76\x7b\x45\x3c\x60\xf5\xc5\xa4\xa3\x70\x5d\x7d\x93\xf2\x95\x26\xdc\xef\xa6\x1b\x1e\xe7\xc6\x37\x78\xdd\xe0\x65\xf1\xcf\x73\xf2\xf3\xdc\x74\xd6\xa2\xb2\xcb\xc9\x93\x4c\x4d\x5f\x91\x13\x98\x5c\x40\x65\xb9\x44\xc7\xa6\x3b\xb3\x4a\x74\x75\x71\x7e\xac\xfb\xe6\xb4\x4b\x0c\x0d\xa4\x28\x92\xcf\xac\x81\xc7\x8a\xea\x84\x0a\x57\xd3\x05\xa6\x93\x86\x86\x61\x8f\x5d\xbf\x6b\x0f\xb1\xa7\x78\x61\x3c\xc1\x0a\x93\x9f\x10\x2b\xae\x51\xd8\x43\x33\x8e\xef\x15\x0f\x08\x2e\xb0\x3b\x9a\xce\xf3\xef\x38\x2c\x9f\xb1\xc7\xb6\xdf\x36\x85\xe7\x03\x8d\x27\xc5\xe7\xdc\xa2\xd5\xd7\xc0\xc2\x48\xac\x36\xa8\x16\x5b\x8a\xe4\x03\x3c\x29\xcd\x17\x54\x96\x0b\x7b\xf3\x62\xfe\x06\x00\x1a\xb1\x9c\x58\x72\x01\x00\x00"
 
 func cmdPortsGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -364,7 +389,7 @@ func cmdPortsGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _cmdServiceGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x6f\xe3\x36\x13\x3e\x8b\xbf\x62\x5e\x1f\x5e\x48\x81\x2b\xdd\x0d\xf8\x90\x75\x16\x5b\x03\x9b\x34\x88\xdb\x53\x51\x04\x8c\x3c\x51\x88\xc8\xa4\x42\x52\xb1\x5d\xc1\xff\xbd\x18\x52\x52\x64\x47\x76\x94\x34\x3d\xed\xe6\xa0\x88\xc3\xf9\x7c\xe6\x4b\x70\xc1\xd3\x47\x9e\x21\xac\xb8\x90\x8c\x89\x55\xa1\xb4\x85\x90\xb1\x60\x94\x29\x95\xe5\x18\x67\x2a\xe7\x32\x8b\x95\xce\x92\x4c\x17\xe9\xc8\x5d\x09\xfb\x50\xde\xc5\xa9\x5a\x25\xa9\xe4\x56\x3c\x63\x52\x3c\x66\x49\xae\xb2\x11\x0b\x70\x55\xd8\x2d\x74\x79\xbc\x8a\xa4\xd0\xca\xaa\xbb\xf2\x3e\x29\xec\xb6\x40\x93\x38\xc6\x11\x0b\xac\x58\xa1\xb1\x7c\x55\x0c\x11\x6a\x99\x47\x2c\x20\x7f\x6e\x75\x29\x89\xb6\x2f\xab\x8b\xf4\x17\x4c\x95\xd9\x1a\x8b\xf5\x31\xe3\x16\xd7\x7c\x9b\xd4\xfc\x23\x17\x61\x1b\xda\x26\x91\x68\x93\x54\x49\x8b\x1b\xeb\x62\xac\x2a\x80\xf8\x52\x2d\xcb\x1c\xaf\xf8\x0a\x01\x76\xbb\x44\x48\x8b\x5a\xf2\x3c\x31\x96\x5b\xa7\xa2\x8f\x8b\xa0\xe0\x85\x18\xb1\x88\x31\x72\x1a\xaa\x0a\xbe\xab\x35\xea\x19\x37\x08\xf1\x0d\x1a\x55\xea\xb4\xe5\x5f\xa0\x7e\x16\x29\x82\xb1\xba\x4c\x2d\x54\x2c\x30\x56\x69\x04\x70\x46\xe2\x05\x1d\x58\x90\xab\x2c\x43\x0d\xb9\xca\xe2\xef\xee\x95\xed\x18\x4b\x12\xb8\xe4\x8f\x08\xa6\xd4\x08\xf6\x81\xdb\x81\xa6\xc4\xaa\xc8\x71\x85\xd2\x1a\xb0\x0f\x08\xbc\x10\x71\x55\xf5\xb0\x3f\xa7\x24\x81\x1a\x5c\xe0\xf7\x3c\x45\xf6\xcc\x35\xdc\x0e\x90\x98\xc2\xff\x07\xf9\x52\xf9\x30\xae\x70\x5d\x13\x7e\xe5\x72\x99\xa3\x86\x99\x46\x6e\xd1\x00\x07\x89\xeb\x81\x71\xad\x1f\x44\xfa\xd0\x8d\xee\x4d\x3f\xd9\x7d\x29\x53\xb2\xb0\x6f\x3d\xf4\x29\xe8\x64\x60\x0c\x79\x07\xfc\x08\xce\x86\x79\x54\xb1\x40\xdc\x43\x0e\xd3\x29\x48\x91\xd3\x31\xc8\xc7\x70\x0b\x53\xa7\xec\x0a\xd7\x57\xaa\x08\x23\x16\xec\x58\xa0\xd1\x96\x5a\x0e\x85\x8d\x05\xbe\x4c\x26\x54\x27\xe4\x1f\x69\x76\xbe\x4d\x20\xdf\xd5\xc5\x71\x83\x99\x30\x16\x35\xe8\xfa\x85\xf2\x2d\x0c\x50\x9d\x6b\x95\x5f\xe7\x5c\x22\x28\x09\x26\x66\x49\x42\x02\x73\xdb\x45\xcf\x38\x88\xe2\x6f\x37\xd7\xb3\xf3\xeb\x79\x0d\x4d\xec\x21\x0b\xcb\x81\x10\x44\xad\x17\x61\x6a\x37\x50\xb7\x58\x3c\xf3\xff\xc7\x60\xe0\x8c\xfa\x33\xf6\xf9\x18\xc3\xaa\xdc\x78\x4a\xd3\xd9\xfe\xe6\xb2\xdc\x44\x80\x5a\x2b\x4d\x20\x52\x5e\x1b\xb5\x27\xf3\x1b\x9a\x31\x94\x91\x4b\x02\x29\xee\xa4\xa1\x86\x5b\x8a\x9c\xd0\x6f\xe1\x1f\xa0\xb9\x06\xa2\x36\x90\xda\x8d\x73\xda\xd9\xf1\xb0\xcf\x72\x65\x10\x52\x7a\xfa\x06\xab\x81\xfc\x2f\x41\x76\x36\xc3\x0e\x44\x9d\xf8\x76\xec\xfd\xea\x5c\xf7\xf5\x01\xd0\x9f\x45\x8d\x4f\x70\x46\xd8\x1d\x17\xbc\xc1\xa7\x12\x8d\x8d\x20\x3c\x3b\xd6\x96\x63\xef\x7e\x04\x95\x4b\x88\x29\x94\x34\xe8\x88\x30\x99\x42\x19\xbb\x4a\x3f\x61\xc2\x27\xc3\x77\x6d\xdf\x3d\xe5\x9d\xde\x27\x50\xff\x69\x7c\x8a\x89\x40\xdd\x73\x81\x26\xd5\xa2\xb0\x42\xc9\x89\xbb\xe8\x10\xc6\x2c\xd8\xf9\x2a\x22\x5f\xfe\xd7\x57\x45\xce\x4d\x2a\x25\xd2\x9b\xf2\xe2\xae\xf5\xdb\x6f\xae\xf8\xf7\x66\x73\x5d\xd3\x4a\x0b\x9b\xf0\xea\x70\x96\xe7\x76\xa8\x01\x16\x94\xef\x50\xff\x47\xb1\x7c\xaf\xfa\x66\x12\x1d\x4b\x13\x09\xce\x97\x2d\x88\x04\x63\x6d\x6c\x7e\x31\x7e\x0d\x71\x7d\x77\x04\xe7\xfa\x76\x1f\xec\xa0\x06\xe5\xcb\x76\xd2\x55\xd1\x52\x89\xa5\x0e\xec\x80\xa5\xa5\x76\xb4\x9c\xdb\x49\x93\x93\x17\x39\x4f\x2c\x3d\x71\x37\xfe\x68\xa3\x7c\x43\xfb\x81\x2e\x39\x22\xf5\x69\x2d\x72\xc2\x2b\xe7\x45\x3c\x5f\x0e\x2e\x07\x16\xfc\xac\xe6\x1f\xa5\x9a\xbf\x0b\xd3\x5b\x38\xe6\x8d\x7a\x3e\x2a\x77\x50\xd1\x27\xf8\x3a\x95\xdc\x94\xb7\x51\xda\x0a\x99\xfd\xa6\x97\xe8\x6a\xc3\x0f\xf6\xf3\xc5\xcc\xe5\x9e\xca\x78\xd1\xe5\x98\x4e\x61\x88\x2f\xb7\x17\x5f\x17\x33\x57\x2e\x7b\xfa\x1b\xf5\x74\xdb\x14\x8e\x29\x73\x6b\x5e\x35\xd7\x49\x90\x9a\xf5\x73\x85\x6b\xe2\xab\x6d\x86\xdd\x45\xe3\x19\xae\x79\x86\x8e\x4c\x2f\x51\x23\x46\x87\x85\xf8\xfb\xe5\x86\x0e\xd1\x8b\x14\x05\xfc\x65\x1b\x36\xb1\x7f\xd9\xc6\x71\xdc\x0a\x77\xd1\x08\xbb\xc1\x91\x82\x28\x62\x83\x5b\x46\x58\x5c\x19\x0a\xf9\xcf\xbf\x8e\x0e\xa2\x6a\xc7\x82\x7b\xa5\xe1\x76\x0c\x0e\x1d\xcd\x65\x86\x50\x63\xe6\x54\x0f\x19\x1b\x7b\xf3\xa2\xc7\xbd\xd7\xfe\x39\x07\x07\xcd\x8c\xbd\x61\x31\x54\x77\x10\x70\x52\x79\x72\x5a\x1c\x8c\x8b\x7a\x4e\x1c\x0c\x8a\x36\xdd\x07\x23\xe2\x70\x36\x1c\x0c\x87\xfd\xa9\x70\x30\x16\xf6\xe7\x41\xff\x40\xe8\x9d\x08\x2e\x30\x9f\x55\x6a\x92\x02\xe5\x32\x74\xc7\x31\xf0\xe8\xf5\x98\x7c\xb3\x4d\x09\x85\x5e\x86\x09\x78\xb5\xff\x66\x08\xf9\x00\x3e\xb0\x55\x8f\x0b\x7e\xda\x62\x3d\xed\xdb\x5b\xdf\x9e\xf3\x8b\xbd\x35\x43\x6b\xf8\x33\xbf\x3c\x7f\x6e\xea\x1f\x67\x53\x5f\x60\x8e\x1f\x6a\x92\xe3\x82\x9d\x26\x71\x3f\xcd\xc5\x5f\xe9\xb9\xd7\x17\x07\xdd\x70\xda\x89\xf7\x7e\x69\xb6\xf9\xef\x58\xaf\x5a\x84\xfe\x09\x00\x00\xff\xff\x40\xe5\x16\x8f\xa9\x14\x00\x00"
+var _cmdServiceGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x31\x6f\xdb\x3e\x10\xc5\x67\xf2\x53\xbc\xbf\x87\xc0\x0e\xfc\x17\xf7\x06\x1e\x0a\x0f\x6d\x86\x02\x41\x3a\x16\x45\xc0\x30\x27\x8a\xa8\x44\x0a\x47\xca\x95\x50\xf8\xbb\x17\xa4\x64\x47\x0d\x8a\x2e\xed\x22\xe1\x8e\xc7\xbb\xf7\x7b\xc7\x5e\x9b\x6f\xda\x12\x3a\xed\xbc\x94\xae\xeb\x03\x27\x6c\xa5\xd8\xd8\x10\x6c\x4b\x95\x0d\xad\xf6\xb6\x0a\x6c\x95\xe5\xde\x6c\xa4\x14\xf9\xff\xc4\x83\x4f\xae\x23\x6c\xac\x4b\xcd\xf0\x5c\x99\xd0\x95\x82\xff\xc9\x84\x38\xc5\x44\x4b\x68\x75\xa2\xef\x7a\x52\x4b\xfd\xa6\x74\xbe\xb6\x1c\x95\xa7\xa4\x4c\xf0\x89\xc6\xb4\x91\x3b\x29\xd3\xd4\x53\x9e\xaf\x14\x74\xef\x3e\x6a\xff\xd2\x12\xc3\x45\xb8\xae\x6f\xa9\x23\x9f\xe8\x05\xcf\x13\x52\x43\x88\xc4\x27\x67\x08\xa1\x06\x9d\x88\x27\x30\xc5\x30\xb0\x21\x29\xd6\x77\x7d\x22\xae\xb5\x21\xfc\x90\x42\x3c\x92\x75\x31\x11\x6f\x4d\x1a\xb1\x0c\xae\x8e\xf3\x7f\x8f\x88\xdb\xac\xba\xfa\x4c\x7c\x22\xde\xa3\x1b\x46\xdc\xae\x79\xe7\x93\x4f\xc3\xb8\x03\x31\x07\x96\x42\x1c\xdb\x10\x69\x7b\x8d\xcf\xf2\x8d\xf8\x08\x5e\x66\xc6\x8b\xe2\x98\x25\xeb\xb6\x2d\x14\x17\xd1\x11\xc1\x97\x44\xd4\xdd\xcc\x46\xbc\x06\x89\xf8\xf2\xf5\x35\xca\x5e\x29\x85\x0b\xce\x6a\xc6\x6c\x45\xb3\xd0\x07\x8f\x58\x49\xa5\xa4\x52\xb8\x4f\xaf\x2e\xc6\x65\x42\xf5\xe1\xf1\xe1\xf8\xfe\xe1\x7e\x69\x5b\xc9\x7a\xf0\x06\xdb\x66\x0d\xb0\xc3\x3f\x76\x2d\x6f\xa2\x0e\x8c\xa7\x3d\x34\xde\x1d\xc0\xda\x5b\x42\x93\xd3\xc2\xd5\xb9\x26\x67\x75\xb5\x1e\xbb\x47\x2c\xfb\xd8\xdd\x95\xf3\xff\x0e\xf0\xae\x2d\x37\x04\x53\x1a\xd8\xe7\xb4\x14\xe2\x3c\xaf\x60\xc9\x79\xd7\xca\x73\x71\xaa\xac\x09\x26\x7f\xdf\x78\xf4\xf7\xf6\xfc\xf2\x04\xb2\xa6\x93\xe6\x2c\xe7\xf2\x26\xfe\xc0\x6a\xae\xb0\x4b\x93\x3b\x98\x15\xdf\xcd\x4d\xee\x81\xc3\x8a\xb6\xc4\x30\xbf\xa3\x25\x66\x79\x96\x3f\x07\x00\x40\x18\xc7\x67\xd3\x03\x00\x00"
 
 func cmdServiceGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -384,7 +409,27 @@ func cmdServiceGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _dbPostgresGenShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcd\x31\x0f\xc2\x20\x10\x05\xe0\x9d\x5f\x71\xc6\x0e\xad\x09\x77\xbf\xc0\xad\x8b\x53\x4d\x63\xe2\x0c\x85\x20\xb1\x70\xc8\xb1\xf8\xef\x4d\x8d\x51\xd7\xf7\xbe\x97\xb7\xdf\x91\x8d\x99\xe4\xa6\xc4\x37\xd0\x5e\xf5\x0a\x00\xe6\x69\xba\x8c\xa7\xf9\xd8\xf5\x2e\xd6\x6c\x92\x87\xee\x7c\x1d\x07\x42\xdc\xda\xc5\x41\xf7\x11\xe4\x2c\x15\x96\x16\xaa\x17\x4a\x31\x54\xd3\x22\x67\xd9\xd4\x97\x60\x63\x5e\xe5\x7d\x13\x58\xdb\x98\x9d\x69\x06\x34\x03\xfe\x2d\x30\x30\xe8\x72\x0f\xf0\x8b\x40\x67\x4e\x3e\x2d\x5c\x9e\x80\x74\x40\x79\xac\x6a\x78\x05\x00\x00\xff\xff\xc2\x44\xdc\x5b\xb0\x00\x00\x00"
+var _cmdResource_serviceGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\x62\xaa\x43\x20\x05\x5a\xe9\x6e\xc0\x87\xac\xb3\x9b\x06\xd8\x4d\x8d\x75\x7b\x2a\x8a\x80\x2b\x8f\x15\x62\x29\x52\x21\xa9\x24\xae\xa0\xff\x5e\x0c\x25\x39\x4a\xd6\x96\x9d\x6d\x0b\x14\xbd\xd8\xa2\x38\x1f\x6f\xde\x9b\xa1\xa4\x8a\xe7\xdf\x78\x81\x50\x72\xa1\x18\x13\x65\xa5\x8d\x83\x88\x05\x61\xa1\x75\x21\x31\x2d\xb4\xe4\xaa\x48\xb5\x29\xb2\xc2\x54\x79\xc8\x68\x4b\xb8\xbb\xfa\x6b\x9a\xeb\x32\xcb\x15\x77\xe2\x01\xb3\xea\x5b\x91\x49\x5d\x84\x2c\xc0\xb2\x72\x5b\x18\xdb\x74\x21\xb2\xca\x68\xa7\xbf\xd6\x9b\xac\x72\xdb\x0a\x6d\xe6\x0d\x43\x16\x50\xd8\x5b\x53\x2b\x27\x4a\x7c\xe9\x67\xaa\xfc\x1d\xe6\xda\x6e\xad\xc3\x7e\x59\x70\x87\x8f\x7c\x9b\xf5\xf6\x21\xa1\x79\x46\xf8\x94\x29\x74\x59\xae\x95\xc3\x27\xe7\xa1\x36\x0d\x40\xfa\x59\xaf\x6b\x89\x37\xbc\x44\x80\xb6\xcd\x84\x72\x68\x14\x97\x99\x75\xdc\x61\x78\xc0\x8a\x2a\xe2\x95\x08\x59\xcc\x18\x01\x86\xa6\x81\x74\x25\x54\x51\x4b\x6e\xd2\x05\x2f\x51\x42\xdb\xae\xd0\x3c\x88\x1c\xc1\x3a\x53\xe7\x0e\x1a\x16\x58\xa7\x0d\x02\xf8\xd8\xe9\x8a\x16\x2c\x90\xba\x28\xd0\x80\xd4\x45\xfa\xc9\x5f\xb2\x96\xb1\x2c\x83\xcf\xfc\x1b\x82\xad\x0d\x82\xbb\xe3\x6e\x3a\x83\x28\x2b\x89\x25\x2a\x67\xc1\xdd\x21\xf0\x4a\xa4\x2f\xec\x97\xdc\xe6\xdc\x3b\x3c\xe4\xe4\x83\x06\x7c\xa1\x1b\x9e\x23\x7b\xe0\x06\x6e\x4f\xf2\x99\xc3\xd9\x14\x8c\xa6\x03\xae\xf0\xf1\x40\xa0\x1e\xed\xc2\x20\x77\x68\x81\x83\xc2\xc7\xe9\xc2\x1e\xef\x44\x7e\x37\x2e\xef\x04\x98\x6c\x53\xab\xfc\x28\x8a\xa8\xd3\x62\x24\x45\x02\x72\xa4\x42\x0c\xe7\x93\xc8\x1a\x16\x88\x0d\x48\x98\xcf\x41\x09\x49\xe2\x06\x32\x81\x5b\x98\xfb\x18\x37\xf8\x78\xa3\xab\x28\x66\x41\xcb\x02\x83\xae\x36\xea\x08\x77\x2c\xe8\xba\x63\x46\xed\x41\x68\x28\xa0\x47\x32\x03\xd9\xf6\x3d\xf1\x05\x0b\x61\x1d\x1a\x30\xfd\x05\xe9\x2d\x2c\x50\x57\x1b\x2d\x97\x92\x2b\x04\xad\xc0\xa6\x2c\xcb\x48\x8b\x6b\x37\x26\xcf\x7a\x7e\xd2\xab\x2f\xcb\xc5\xc5\xf2\xfa\x67\xae\xd6\x12\x4d\xda\xf1\x15\xd5\xd3\x05\xc7\xbb\xe4\x51\xee\x9e\xa0\x9f\xa3\x74\xd1\xfd\x27\x60\xe1\x9c\x86\x30\x25\x7a\xd1\x24\x50\xd6\x4f\x70\x3e\x1e\xdf\x6e\xe7\x73\xfd\x14\x03\x1a\xa3\x0d\x51\x46\x6a\x0e\x61\x0f\xa8\x35\xa8\x1a\xd9\x04\xea\x98\x35\xcd\x3b\x10\x1b\x48\x3f\x22\x77\xb5\x41\x9b\x5e\x75\x43\x0f\x6d\xeb\x05\xa1\xb4\x23\x49\x7a\xea\x95\x90\xa4\xc4\x4e\x8a\x93\xf2\xf6\xfc\xf4\xe9\x73\xf7\xe4\x8b\xda\xa1\x40\x69\x11\xda\x51\x50\x4a\x42\xf0\x50\xad\xe9\x7e\xa7\xd8\x42\x6a\x8b\x90\xd3\x2f\x69\x85\x83\x06\xff\x82\x3e\x3e\x55\x34\x62\x77\x84\xab\x65\x27\x47\xf1\xc3\xb9\x9f\x94\xfd\xca\x1b\xbc\x87\x73\x62\x74\xca\xf5\x0b\xde\xd7\x68\x5d\x0c\xd1\xf9\xe1\x11\x4e\x3a\xec\x31\x34\x9e\x55\x5b\x69\x65\xd1\xdf\x84\xd9\x1c\xea\xd4\x0f\xc6\x64\x1a\x42\x98\xf4\x43\xbd\xdf\xa2\xf1\xe2\x19\xae\x0a\x84\xf4\xa3\x40\xb9\xb6\xa4\x56\x10\x90\xf9\x95\xf6\x0f\x82\xb6\x9d\xf9\x63\xe9\xa3\xd1\xe5\x92\x9e\x4c\x10\x1a\xbc\x0f\x09\xe1\x58\xe1\xa0\x8d\x7d\xcf\x11\xbe\x9f\xf6\xf5\x9c\x87\xfe\xa2\xf1\x9c\xde\x8f\xca\x67\x89\x86\x92\xe3\xe4\x8d\xa2\x5d\xa1\xfb\x21\xc5\x0e\xfa\xfd\x83\x72\x4d\x62\xf3\x58\xd2\xeb\xf5\x7f\x85\xc8\x4f\xc2\x7a\xb4\x4b\x59\x1b\x2e\x4f\xe6\xf1\x90\xdb\x2b\x1a\x0f\x9b\x8d\xb8\x1b\x08\xb5\xda\x38\xa1\x8a\x5f\xcc\x1a\x3d\x9f\x5d\x53\x5f\xac\x16\x9e\x2a\xa2\x6d\x35\xb6\x98\xcf\xe1\x04\x24\xb7\x97\x1f\x56\x0b\x4f\xee\x8b\xf0\x43\x74\xda\x1d\x68\xb6\xb5\x74\xf6\x3b\x35\x0f\xc5\x1f\x0f\xde\x0d\x3e\x92\x59\x9f\x32\x6a\x9a\xee\xc4\xa6\x59\x83\x50\xf1\x12\x69\x92\xa8\x00\x1a\xb6\xa6\x19\x4e\xd2\x30\xa4\x6b\x3f\x5a\x09\x01\xf4\xf5\x2e\x79\x81\x11\xd9\xd2\x45\x3c\x64\xa0\xc5\x4a\xfc\xf9\xbc\x43\x8b\xf8\xd9\x8b\x98\x79\xbf\x8d\x06\x92\xde\x6f\xd3\x34\xdd\x39\x8f\x69\x8b\xc6\x34\x50\x80\x38\x66\x27\xb7\xa2\x70\x58\x5a\x92\xe6\xf7\x3f\x26\xa6\xa4\x69\x59\xb0\xd1\x06\x6e\x13\xf0\x4c\x76\x47\x4f\xcf\xaf\x97\xa2\x8b\x43\xfa\x55\xa8\xd6\x91\x5f\x26\xc7\x3a\x3c\x8e\x5f\xcc\xc3\xd9\x29\xfd\xd5\xf4\xc7\xdc\xeb\xfd\x19\x74\x39\x59\xd0\xbe\x75\x60\x7e\xab\xd6\x93\x87\xf1\xc4\xd0\x4c\xb9\xbe\x1a\x9c\xbf\x73\xfe\x1c\x43\x38\xb4\xc5\x7e\x0b\xa2\xec\xfa\x72\xd6\x1f\x53\xc9\xff\xf1\xe1\x71\x89\x12\x7f\x50\xc2\x29\xd7\x91\x84\xfe\x6b\x2e\xfd\x40\xbf\x2f\x34\x7b\xa5\xd4\x31\x20\x6f\x7d\x58\x0c\xf7\xce\x46\xf9\x9b\xe7\x0e\xcf\xb2\x69\x46\xa9\xee\x07\x34\xfd\x37\x95\x6f\x12\x30\x58\x19\xb4\xa8\x1c\x77\x42\x2b\xd0\x1b\xd8\x1f\x00\x9c\x06\xd1\x7d\xae\xbc\xf2\xe9\x54\x39\x22\xe5\x64\x4b\xc6\x30\x31\x13\xa3\xf7\xbe\xb3\xc3\x56\xbe\xab\xd7\x33\x30\xe9\xf5\xe5\x5b\x7a\xfa\x57\x3d\x74\xf4\xf7\xfd\x1c\x74\xef\x65\xeb\xf7\x5b\x8a\xbb\x5b\xd0\xa1\xdc\x8d\x60\xbf\xb3\x5b\x24\xcf\x3e\x17\x6e\x06\xf4\x8d\x60\x1d\x2f\xab\x9e\x83\x21\xc4\x85\x8b\x47\x31\xf6\x5a\xee\xf6\xe2\x84\x05\x2d\x6b\xd9\x5f\x03\x00\xa6\x57\x47\xe4\xb9\x10\x00\x00"
+
+func cmdResource_serviceGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_cmdResource_serviceGoTmplt,
+		"cmd/{resource}_service.go.tmplt",
+	)
+}
+
+func cmdResource_serviceGoTmplt() (*asset, error) {
+	bytes, err := cmdResource_serviceGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cmd/{resource}_service.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dbPostgresGenShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8d\xb1\x6a\xc4\x30\x10\x44\x7b\x7d\xc5\x86\xb8\xb0\x03\xbb\xea\x03\xe9\x4c\x20\x95\x8d\x09\xa4\x96\xad\x45\x16\xb1\xb4\x8e\x56\x21\xe4\xef\x0f\x1f\xe6\xee\xda\x79\x6f\x66\x10\xd1\xfc\xad\x9c\x5f\x81\xde\xd9\xd5\xdf\xc2\x4a\xa3\x68\x0d\x85\xd5\x1c\xf0\xf9\xc9\xce\x31\x5b\x5d\x8d\x72\x05\x64\xd3\x1a\x00\x98\x86\xe1\xb3\xff\x98\xde\x9a\xd6\xc7\x92\x5d\x62\x68\xc6\xaf\xbe\xb3\x44\x07\x5d\x3c\x34\xa7\x61\xfd\x6c\xf7\x73\xcf\xa6\x18\x8a\xab\x51\xb2\x1e\xd6\x4d\xa1\x2a\xb2\xe9\xf5\x26\x08\xce\x31\x7b\x57\x1d\xa0\x00\x3d\x34\x28\x08\xe0\xfe\x1d\xe0\x1e\x01\x66\x49\x9c\x16\xd9\xff\x81\xec\x0b\xe9\xcf\x66\xba\xcb\x00\x52\x44\x75\x65\xd1\x00\x00\x00"
 
 func dbPostgresGenShTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -404,7 +449,7 @@ func dbPostgresGenShTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _dbPostgresInitGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\x28\xc8\x2f\x2e\x49\x2f\x4a\x2d\xe6\xe2\xd2\xd7\x4f\xcf\xb7\x4a\x4f\xcd\x4b\x2d\x4a\x2c\x49\x55\xd0\x4f\xca\xcc\xd3\x2f\xce\x50\xd0\xd3\x4f\x4f\xcd\xd3\x2b\xce\xe0\x02\x04\x00\x00\xff\xff\x51\xdc\x68\x12\x31\x00\x00\x00"
+var _dbPostgresInitGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x52\x00\xad\xff\x2d\x2d\x2d\x0a\x77\x68\x65\x6e\x3a\x20\x2e\x46\x65\x61\x74\x75\x72\x65\x73\x2e\x50\x6f\x73\x74\x67\x72\x65\x73\x0a\x2d\x2d\x2d\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x70\x6f\x73\x74\x67\x72\x65\x73\x0a\x0a\x2f\x2f\x67\x6f\x3a\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x2f\x62\x69\x6e\x2f\x73\x68\x20\x2e\x2f\x67\x65\x6e\x2e\x73\x68\x0a\x03\x00\xa9\xc4\xa4\x7b\x52\x00\x00\x00"
 
 func dbPostgresInitGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -424,7 +469,7 @@ func dbPostgresInitGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _dbPostgresMigrations000001_initDownSqlTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4a\x29\xca\x2f\x50\x28\x49\x4c\xca\x49\x55\xa8\xae\x56\xd0\x0b\x4a\x2d\xce\x2f\x2d\x4a\x4e\xf5\x4b\xcc\x4d\x55\xa8\xad\x2d\xb6\x06\x04\x00\x00\xff\xff\xcf\xa0\x15\xb8\x20\x00\x00\x00"
+var _dbPostgresMigrations000001_initDownSqlTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xcb\x31\x8a\xc3\x30\x10\x46\xe1\x5e\xa7\xf8\x31\x2e\x76\x0b\xe9\x00\x5e\xb6\x35\x6c\x67\x36\x27\x50\xa2\x89\x63\xa2\x8c\xcc\x48\xc6\xc5\x30\x77\x0f\x21\x38\xf5\x7b\x9f\xf7\xde\xed\x37\xe2\x01\x61\xa4\xd8\x36\xa1\x1a\xa6\x52\xdb\x2c\x54\xdd\x2b\xaa\x42\x22\xcf\x84\x5e\x30\xfc\x22\xfc\xf1\xd2\xfe\xa9\x96\x4d\x2e\x54\xe1\xcd\x9c\x2a\x1a\x3d\xd6\x1c\x1b\xa1\x4b\x65\xe7\x0e\x5f\x7d\x18\x8b\x1c\x1b\x7a\xf9\xc6\x7b\x24\x4e\x87\x49\x74\x5d\xf8\x23\xcc\x92\x94\x15\x2d\x9e\x33\x41\x15\x61\xca\x9b\xc4\x1c\x4e\x1c\xef\x04\xb3\x1f\x55\x10\x27\x78\x33\xf7\x1c\x00\x14\x36\xbe\xc4\xb4\x00\x00\x00"
 
 func dbPostgresMigrations000001_initDownSqlTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -444,7 +489,7 @@ func dbPostgresMigrations000001_initDownSqlTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _dbPostgresMigrations000001_initUpSqlTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xcc\x4d\x0a\xc2\x30\x10\xc5\xf1\xab\xbc\xa5\x42\xf0\x02\xde\xc1\x85\x17\x90\x69\x33\x8b\x60\xbe\x98\xbc\x80\xb1\xf4\xee\x62\xa5\x0b\x05\xb7\xf3\x9b\xff\x9b\x4d\x85\x0a\xca\x14\x15\xcb\x82\xd3\x55\x5b\xe9\x36\xeb\x45\x92\x62\x5d\x1b\x0e\xc1\x83\xfa\x20\xaa\x85\x24\x36\x70\xd7\xe1\x90\xdf\xbc\x9d\x73\x21\x72\x8f\xd1\xc1\x6b\x9b\x2d\x54\x86\x92\x37\x72\xf8\xac\xfb\xdb\x34\x7e\x7f\x77\x11\x82\x21\x69\xa3\xa4\xca\xa7\x43\xaf\xfe\x4f\xb1\xcb\x77\x71\x3c\xbf\x02\x00\x00\xff\xff\xa5\x44\x53\x0d\xc2\x00\x00\x00"
+var _dbPostgresMigrations000001_initUpSqlTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x41\x6b\x02\x31\x10\x85\xef\xfb\x2b\x1e\xb2\x07\x05\x37\x3f\xc0\xd2\x53\x41\x28\xf4\x60\x6b\xef\x12\xcd\x68\x83\xd9\xec\x92\x4c\xa8\xdb\x21\xff\xbd\x44\x5d\xc1\x62\x8f\xc3\x7b\xdf\xcc\x7b\xd3\x34\x4d\xf5\xfd\x45\x7e\x01\xb5\x24\xcd\x29\x50\x54\xab\x2e\xf2\x21\x50\xac\x8a\x28\x82\xa0\xfd\x81\x50\x07\x2c\x9e\xa1\x5e\xbd\xe5\x0f\x8a\x5d\x0a\x3b\x8a\x68\x72\xae\x44\xc0\xd4\xf6\x4e\x33\x61\x92\xfa\x09\xa6\xb5\x5a\x76\x61\x34\xa1\x0e\x33\x5c\x6c\xe4\xcd\x48\x18\xda\x5b\x7f\xf5\xe7\xbc\x0b\x54\x68\xd6\x5b\x47\x10\x81\x5a\xb9\x14\xb4\x53\x6b\xaf\x8f\x84\x9c\x31\xb5\x06\x4c\x27\x46\x1f\x6c\xab\xc3\x80\x23\x0d\x73\xdc\xb2\xa9\xa5\x25\x67\x22\x72\x2e\xf0\x4b\xe7\x52\xeb\x0b\x56\xa6\xf5\xfb\xdb\xe7\xd0\x97\x2d\x67\xa0\x64\x18\x0f\x9a\xcd\x76\xb8\xac\xf5\x1d\xc3\x27\xe7\xe6\x18\x15\xcd\x60\xdb\x52\x64\xdd\xf6\xfc\x33\x47\xea\xcd\x3f\xc4\xa8\xdc\x13\xb3\xa7\x4a\xa4\x79\x94\xcf\xee\xcb\x17\x0d\x9d\xca\x57\xae\xcd\xed\x79\x16\x41\xfd\xb7\xfa\xe6\xae\xd1\xc6\x9a\x13\x3a\xff\xd0\x89\xe9\x9d\xf5\x1a\xe0\xd2\xf7\x56\x5c\x04\xe4\x0d\x9a\x9c\xab\xdf\x01\x00\xa9\xc8\x6a\xb9\xfb\x01\x00\x00"
 
 func dbPostgresMigrations000001_initUpSqlTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -464,7 +509,7 @@ func dbPostgresMigrations000001_initUpSqlTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _dbPostgresMigrationsSourceGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8e\x31\x4f\xf4\x30\x0c\x86\x67\xfb\x57\xf8\xeb\x94\x48\xf7\xb5\x3b\xd2\x0d\x48\x2c\x2c\x0c\x30\x30\x20\x84\xdc\x9e\x2f\x17\xd1\x26\x27\x27\x45\x42\xa8\xff\x1d\x35\xe9\x89\x63\x63\xb2\xf4\xfa\x7d\x1e\xfb\xcc\xc3\x3b\x3b\xa1\xc9\x3b\xe5\xec\x63\x48\x88\x7e\x3a\x47\xcd\x64\x10\x1a\xe7\xf3\x69\xee\xdb\x21\x4e\x9d\x8b\x23\x07\xf7\xbf\x16\xa5\xbb\xcc\x14\x67\x1d\xa4\x41\xe8\x7d\x38\x70\x66\xfa\x33\xd3\xb9\xf8\xb6\x41\x0d\x5a\xc4\xae\xa3\xa7\xb2\xb8\x53\xff\x21\x4a\x2a\x79\xd6\x90\x88\x7f\x9e\x23\x4e\x49\x72\x22\x5e\xd3\x6a\xc1\xe3\x1c\x86\x5f\xa0\xb1\x64\xea\xae\xad\xc1\x8e\x44\x35\xaa\xa5\x2f\x04\x4d\x74\xb3\xa7\xed\x6c\xfb\x28\xb5\x68\x6e\x57\xef\x03\x4f\x92\x8c\xdd\x21\xc0\x2a\x35\x81\x27\xa1\x94\xd5\x07\x67\xc9\xbc\xbc\xf6\x9f\x59\xae\x5d\x00\xf5\x45\x2a\x74\xa9\x5b\x04\x58\x2c\x22\x1c\x4a\xf1\xfa\xd6\xb3\xcf\xa7\xfb\x90\x32\x87\x41\x8c\x26\x8b\xe0\x8f\xa5\xf3\x6f\x4f\xc1\x8f\x45\xb8\xf9\x82\x1f\x0b\x8e\xb0\x20\x5e\xc2\x6a\xc4\x05\xbf\x03\x00\x00\xff\xff\xd9\x21\x8b\x29\xb3\x01\x00\x00"
+var _dbPostgresMigrationsSourceGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8e\x41\x4b\x03\x31\x10\x85\xcf\x99\x5f\x31\xf6\x94\x40\x77\xf7\x5e\xe8\x41\x10\xc1\x8b\x88\x1e\x3c\x88\xc8\x74\x3b\x4d\x83\xdd\xa4\xcc\x64\x15\x91\xfe\x77\xd9\x64\x8b\xf5\xe6\x29\x90\xf7\xbe\xef\x4d\xd3\x34\xf0\xb9\xe7\xb8\xc2\xf6\x96\x29\x8f\xc2\xda\x3e\x24\xcd\x5e\x58\x61\x0a\x8f\xd4\xbf\x93\x67\x1c\x82\x17\xca\x21\x45\x05\x08\xc3\x31\x49\x46\x0b\x66\xe1\x43\xde\x8f\x9b\xb6\x4f\x43\xe7\xd3\x81\xa2\x6f\x6a\x91\xbb\xf3\xab\x69\x94\x9e\x17\x60\x36\x21\x6e\x29\x13\xfe\x9b\xe9\x7c\x7a\x9b\xa1\x05\x38\x80\xae\xc3\xa7\x12\xdc\x48\xf8\x60\x41\xe1\x3c\x4a\x54\xa4\xdf\xe3\x90\x54\x39\x2b\xd2\xf4\x5b\x97\x61\x37\xc6\xfe\x0f\x68\x1d\xda\x9a\xb5\xd5\xb4\x44\x16\x49\xe2\xf0\x1b\x8c\x28\xae\xd6\x38\xcf\xb6\x8f\x5c\x8b\xf6\x7a\xf2\xde\xd3\xc0\x6a\xdd\x12\x8c\x99\xa4\x36\xd2\xc0\xa8\x59\x42\xf4\x0e\xed\xcb\xeb\xe6\x2b\xf3\xa5\xcb\x98\x7a\x22\x16\xba\xd4\x1d\x18\x73\x72\x00\x66\x5b\x8a\x97\x5b\xcf\x21\xef\xef\xa2\x66\x8a\x3d\x5b\x51\x07\x26\xec\x4a\xe7\x6a\x8d\x31\x1c\x8a\x70\xf6\xc5\x70\x28\x38\x98\x13\xc0\x79\x64\xbb\x44\x16\x81\x13\xfc\x0c\x00\xba\xe6\x97\xf5\xd4\x01\x00\x00"
 
 func dbPostgresMigrationsSourceGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -484,7 +529,7 @@ func dbPostgresMigrationsSourceGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _goModTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xdb\xb2\xdb\x26\x14\x7d\x8e\xbf\x42\x8f\xed\x74\xb8\xa3\xdb\x63\x3f\x20\xfd\x85\x0e\x82\x6d\x42\x2d\x81\x0c\xc8\x39\x6e\x26\xff\xde\x41\xf6\x69\x6d\x25\xd6\x99\x3e\x09\xcd\x5e\x6b\xed\xc5\xbe\x30\x05\xb3\x8c\x50\x7d\xfb\x56\x55\xf8\xf3\x7a\xfe\x43\x4d\x50\x55\xdf\xbf\x1f\x0e\x36\x54\x0c\x33\x79\x38\x44\x38\x2f\x2e\x42\xf5\xcb\xe1\x93\x75\xf9\xcb\x32\x60\x1d\x26\xf2\xfb\xdf\x4b\x04\x62\x03\x52\x3e\xb9\x0c\x71\xaa\x2e\x14\x53\x4c\x11\xa7\xac\xa5\x3d\xef\xb9\x90\x94\x0b\x64\x1a\x10\x83\x10\xbc\x1b\xda\xae\x22\xa4\x72\xde\xb8\x08\x3a\x3f\x89\x7d\x76\x3a\x86\x14\x8e\xb9\x08\x7e\x75\xde\x85\xa2\x26\x31\xab\x11\xc5\x9c\xb2\x9e\xf6\xac\xa7\xbc\x66\x9c\xa3\xa3\x6e\xe9\x60\x7a\xd5\x35\x43\xfd\x52\x50\x7b\x95\xdd\x05\xc8\x7c\xb2\x45\x89\x61\xfa\x1c\x0e\x3e\x2b\xe7\x21\x9a\x87\x63\x75\x61\x58\xe0\xe6\xb5\xe6\xbc\xd8\xe5\xda\x89\xe2\x71\x32\x7c\x52\x9e\x5c\x78\x75\xe1\xe5\xd6\x2f\x49\x26\xe8\x13\x44\x62\x5c\xca\xd1\x0d\x4b\x76\xc1\x17\x4a\x8b\xe9\x6f\xce\xeb\x30\xcd\x2a\xbb\x61\x84\x0f\xf9\xeb\xa7\x5c\xa5\xc5\xe2\xbd\x26\x1d\x6b\x59\x5f\x0b\xc9\x91\x6c\x1b\x6a\x06\x2a\x29\xef\xf8\x47\x52\x36\x20\x1d\xbc\x07\x5d\xbc\xa4\x5b\x9d\x3f\xf4\x6f\x03\x5a\xbc\xcb\x1f\xc2\x6d\x40\x83\xf3\x46\x65\xf5\x70\xac\x2e\x02\x33\xcc\x9f\x2e\xbc\x65\xa5\xf3\x88\x4c\x74\x17\x88\x64\xba\xa6\xf3\x58\x9a\x51\xef\x26\xb2\x81\xcc\x31\xe4\x30\x2c\xc7\x5b\xe7\xd8\x0e\x78\x54\xde\xa2\xc9\xd9\xa8\x32\x90\xfb\xb7\xd8\xaa\xb1\xdc\xb3\x55\x68\x64\x0a\xfa\x54\x32\x48\x2c\x7e\x16\x7e\x34\x21\x31\xdf\x40\x82\x1d\x81\x2c\x8b\x5b\xa7\x8b\x61\xf6\x1c\x8e\xb3\x46\xa0\x43\xba\xa6\x0c\xf7\x5f\xab\x32\x7c\x55\xd7\x15\x2e\x71\xf3\x84\xff\x6b\x0a\x2e\x06\x4f\xd2\x79\x7c\x2b\x00\xbe\x19\xeb\xd1\x0d\x64\x3e\x97\x48\xbb\x53\xba\x49\xe5\xec\xef\x35\x77\x19\xc4\x3d\xd5\x0e\x21\x44\x77\x5a\xbc\x23\x0a\x74\x01\xef\xcd\x7b\x98\xc1\xff\xbb\x52\xa9\x64\x31\xce\x42\xca\xff\x93\xe7\x26\x65\x01\xa5\xf9\x3d\xe1\xeb\xde\xce\x27\x4b\x20\xc6\x10\xd7\xd9\xec\x37\x15\x9e\x63\x98\x20\x7f\x81\x25\x91\x94\x55\x4e\xe6\x4f\x78\x9b\x43\xcc\xb7\x5d\x62\x7b\x65\x4a\x39\x42\xd6\x5f\x22\xc9\x90\xb2\x3b\xae\x1d\x69\x76\x8c\x2c\xf1\xa8\x2e\x40\xf4\xe8\xd6\xd6\x70\x2c\x0f\x9f\x6c\xc0\xeb\xc5\xc0\xa7\x25\xe1\xdb\xab\xf6\x1e\x29\xc3\x83\x43\xb4\xe4\x8d\x78\xc8\xff\xbd\x9e\x9c\xd2\x86\xd7\x94\xb2\xa6\xae\x91\xd4\x35\xaf\x65\x43\x85\x90\x5b\x52\xba\x7a\xbd\x65\x71\x2a\x3a\xca\x51\x03\x1d\xb4\xa2\x53\x86\xd3\xed\x9b\xfb\xac\x90\x7e\x10\x60\x9c\xd5\x12\x19\x33\xf4\x1d\x6d\x8c\x10\x6a\xfb\x34\x3d\x0a\x64\x78\x5b\x8d\x0b\x2c\xf6\x50\x21\x8c\xdb\x44\x0d\x6b\x99\x10\x2d\x52\xaa\x97\xd0\x8a\x7a\x80\xf6\x58\x68\x65\x5d\xf0\x03\xdb\x82\x5f\xf7\x6b\x4b\xa7\x8c\x51\xde\x21\x80\xb6\x67\x3d\x74\xbd\x1c\xea\x9f\xd2\xe3\xbc\x0e\x90\xa0\xeb\xa6\xfc\x10\x7f\xdc\x5d\x5e\xdf\x30\xa5\xdd\xf8\xee\x99\x97\x15\x7b\xfd\x50\xff\x7a\xf8\x27\x00\x00\xff\xff\x16\x74\x4f\x64\x41\x07\x00\x00"
+var _goModTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xcb\x92\xe3\x34\x14\x5d\x4f\xbe\xc2\x4b\x28\x4a\x6f\xf9\xb5\x64\x03\xab\xa1\xf8\x03\x4a\x96\x6e\xd4\x22\xb6\xe4\x48\x72\xa6\x43\xd7\xfc\x3b\xa5\x74\x1a\x62\x77\x27\x0d\x2b\x2b\x75\xcf\x39\xf7\x48\xf7\x91\x29\x98\x65\x84\xea\xe5\xa5\xaa\xf0\xd7\xcb\xf9\x37\x35\x41\x55\x7d\xff\xbe\xdb\xd9\x50\x31\xcc\xe4\x6e\x17\xe1\xb8\xb8\x08\xd5\x0f\xbb\x2f\xd6\xe5\xa7\x65\xc0\x3a\x4c\xe4\xe7\xbf\x96\x08\xc4\x06\xa4\x7c\x72\x19\xe2\x54\x9d\x28\xa6\x98\x22\x4e\x59\x4b\x7b\xde\x73\x21\x29\x17\xc8\x34\x20\x06\x21\x78\x37\xb4\x5d\x45\x48\xe5\xbc\x71\x11\x74\x5e\x89\x7d\x75\x3a\x86\x14\xf6\xb9\x08\x7e\x73\xde\x85\xa2\x26\x31\xab\x11\xc5\x9c\xb2\x9e\xf6\xac\xa7\xbc\x66\x9c\xa3\xbd\x6e\xe9\x60\x7a\xd5\x35\x43\x7d\x57\x50\x7b\x95\xdd\x09\xc8\x7c\xb0\x45\x89\x61\xba\x0e\x07\x9f\x95\xf3\x10\xcd\xcd\xb1\x3a\x31\x2c\x70\x73\x5f\x73\x5e\xec\x72\xee\x44\xf1\x38\x19\x3e\x29\x4f\x4e\xbc\x3a\xf1\x72\xeb\xbb\x24\x13\xf4\x01\x22\x31\x2e\xe5\xe8\x86\x25\xbb\xe0\x0b\xa5\xc5\xf4\x27\xe7\x75\x98\x66\x95\xdd\x30\xc2\xa7\xfc\xcb\xa7\x5c\xa5\xc5\xe2\xed\x4d\x3a\xd6\xb2\xbe\x16\x92\x23\xd9\x36\xd4\x0c\x54\x52\xde\xf1\xcf\xa4\x6c\x40\x3a\x78\x0f\xba\x78\x49\x45\x52\x7e\xee\xdf\x06\xb4\x78\x97\x3f\x85\xdb\x80\x06\xe7\x8d\xca\xea\xe6\x58\x9d\x04\x66\x98\xaf\x2e\xbc\x65\xa5\xe3\x88\x4c\x74\x27\x88\x64\x3a\xa7\xe3\x58\x8a\x51\x3f\x4c\x64\x03\x99\x63\xc8\x61\x58\xf6\x05\x2c\x30\x5b\x81\x5f\x5e\x50\xe5\xf6\x15\xfe\x05\x54\x5e\x22\x24\xfc\x7b\x48\xd9\x46\x48\xa5\xb9\xd7\x4a\xa3\xf2\x16\x4d\xce\x46\x95\x81\x5c\xbf\xc5\x73\x8d\xe5\xda\x73\xd1\x04\x6f\x3e\x56\x20\x53\xd0\x87\xe2\x44\x62\xf1\x51\xf8\xd6\xac\xc4\x7c\x03\x09\x76\x04\xb2\x2c\xce\x94\x30\xc3\xec\xbd\xff\x5f\x55\x86\x6f\xea\xfc\x2e\x79\x9c\x35\x02\x1d\xd2\x39\x65\xb8\xfe\xb4\x57\x68\x91\x92\xb8\xb9\xf5\xfd\x9f\x9f\xe5\xcf\x29\xb8\x18\x3c\x49\xc7\xf1\xb9\x78\xe2\x9b\x11\x1a\xdd\x40\xe6\x63\x89\xb4\x9b\x32\xdd\x79\xa5\x49\xe5\xec\xaf\xa5\x76\x19\x44\xa1\xb2\x47\xbd\x34\x85\xe8\x0e\x8b\x77\x44\x81\x2e\xe0\x47\x63\x16\x66\xf0\xff\x4c\x72\x2a\x59\x8c\xb3\x90\xf2\xff\xe4\xb9\x49\x59\x40\x69\x7e\x4b\xc8\xee\x12\xe7\x83\x25\x10\x63\x88\x97\x91\xe8\x31\x5b\x47\x63\x98\x20\x3f\xc1\x92\x48\xca\x2a\x27\xf3\x07\x3c\xcf\x21\x66\x88\x05\xcd\xb6\x2f\x76\x4b\x4d\x39\x42\xd6\x4f\x91\x64\x48\xd9\xed\xcf\xc5\x48\xf3\xc0\xc8\x12\xf7\xea\x04\x44\x8f\xae\x20\x39\xc7\x72\xf7\xc5\x06\x7c\xb9\x18\xf8\xb4\x24\xfc\xba\x4c\xdf\x22\xa5\x55\x71\x88\x96\x3c\x13\x0f\xf9\xdf\xa5\xcd\x29\x6d\x78\x4d\x29\x6b\xea\x1a\x49\x5d\xf3\x5a\x36\x54\x08\xb9\x25\xa5\xb3\xd7\x5b\x16\xa7\xa2\xa3\x1c\x35\xd0\x41\x2b\x3a\x65\x38\xdd\xae\xfa\xb5\x42\x7a\x27\xc0\x38\xab\x25\x32\x66\xe8\x3b\xda\x18\x21\x14\x3c\x10\xc8\xf0\x7c\x31\x2e\xb0\x78\x84\x0a\x61\xdc\x26\x6a\x58\xcb\x84\x68\x91\x52\xbd\x84\x56\xd4\x03\xb4\xfb\x42\x2b\xd3\x87\x6f\xd8\x16\xfc\x65\x5c\xb7\x74\xca\x18\xe5\x1d\x02\x68\x7b\xd6\x43\xd7\xcb\xa1\xfe\x90\x1e\xe7\x4b\x03\x09\x8a\xe9\x47\xf1\xdb\x55\xc0\xeb\x57\x4c\x29\x37\xbe\x7a\xe6\x98\x3f\xfa\x7f\xf8\x71\xf7\xf7\x00\x4b\xed\x17\x26\xb8\x07\x00\x00"
 
 func goModTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -504,7 +549,7 @@ func goModTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _goSumTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xbd\xc7\x92\xa3\x58\xd7\x2e\x3c\x3f\x57\xf1\xcd\x09\x15\xde\xfd\x11\x67\x20\x40\x12\x08\x81\x00\x61\x04\x93\x13\x78\xef\x3d\x57\xff\x47\x66\x56\xf7\x27\x29\x33\xbb\xab\xdf\xb7\x7a\xd0\x25\x65\x0f\x9e\x67\xbb\x65\xf7\xda\x4b\x7e\x51\x8f\xc1\x8f\xb8\xae\xe3\x22\xfc\xe1\xd7\x25\x18\xd7\xff\x33\x41\x3f\x10\xe2\x07\x04\xc6\xf5\x8f\xb2\x0e\xfe\x27\x81\xff\x3f\x57\x35\xec\xfc\xbe\x99\xfd\x18\x49\x00\x37\x1f\x61\xf7\x00\x40\x4b\xe4\x1b\x00\x4e\x9c\x67\xd6\xbd\xa4\x7e\x0e\xb1\x45\x29\xe9\xf3\xff\xfd\x3f\xdf\x40\xa2\xd8\xef\x87\xa4\x9e\x20\x69\x1a\x92\x81\x38\x1a\x1b\x7d\xa5\xb1\xee\x96\x44\xa5\xc4\x5a\x27\xae\x82\x2e\x8d\x5e\x56\x9b\xde\x20\xad\x17\xc0\xc1\x74\xd3\x8c\x6f\x21\x31\xec\x07\xfc\x00\x99\xde\x5c\x48\xdc\xdc\x5e\xc1\x8c\x69\x45\x23\xb8\x94\x41\x52\x49\xaf\xde\x76\x8a\xa3\x62\xee\xc2\x60\x9e\xf7\xb7\x12\x9c\x88\xfd\x5f\x42\x22\x0f\x90\x04\x44\x50\xd0\x69\x46\xed\x0e\x4b\xf3\xa5\x52\xb4\x1b\x58\x2f\x4b\xce\xf8\xbe\x4e\xdc\xf6\xf0\x2a\xe5\x04\xaa\x9f\xc2\x7c\x11\xed\xef\x21\xf1\xa7\x51\x6a\x0d\xe3\x96\xa2\x16\xbb\x8d\x75\xf6\x28\x72\x49\x8f\xb7\x20\xc7\x62\x98\x95\x0e\xa4\xea\xc4\xe8\x38\xeb\xe1\x06\xe8\x37\x3d\xf3\xbf\x87\x24\x7e\xa0\x8f\xdb\x43\x78\xa2\xe8\x95\x36\x19\x76\x70\x29\x90\xfa\x41\xc0\x8a\x7e\x9f\x0f\x3d\x58\xe6\x89\x7e\x73\x44\x6a\x46\x51\x06\xd3\xf6\x31\xf4\x2d\x24\x0e\x3d\x6d\x4f\x47\xf7\xc5\xa8\x4f\x6b\x65\x8e\x8b\x26\x5c\xf9\xbb\x7a\xe0\xa5\x63\x14\x35\xc9\x78\xe7\xaf\x92\x23\xad\x7e\x23\x6b\x78\x48\xe8\xf5\xf7\x90\xc8\x13\x64\x73\x77\xb3\x49\xe3\x41\xa2\x46\x81\x23\x9d\x71\xbc\x63\xa9\xb8\xe2\x9d\x92\x0a\xa8\xa9\x99\x6e\xd3\x11\x64\xa3\xc8\x35\x83\x2b\xf6\x3d\x24\xfa\x04\x19\x35\xa0\x51\x8f\xc6\xa1\xd7\x72\x99\xe8\x56\x4e\x94\x2d\xd0\x68\x26\xf0\xcc\x88\xd5\x44\x58\xdc\x90\x64\x1a\x01\x4c\x5b\x48\x48\xdf\x43\x3e\x4b\x4f\xd6\x91\x43\xeb\x2c\x8b\x78\x35\xed\x74\x5b\xbd\x64\xa0\x01\x84\x70\xc0\xd8\x68\xc9\x21\xd5\xb6\x11\xd8\xb3\xe6\x5e\xf2\x44\x33\xff\x12\x12\xf4\xd2\xb8\x1d\xc3\x6e\xfd\x9f\x09\xfe\x01\x3d\x9f\x4f\x70\xf1\x2e\x88\x51\x68\xb8\x36\x59\x7b\x43\x6b\x18\xdb\xd1\x87\x12\xf4\xef\x19\x9b\xb8\x74\xe1\x45\x5e\xb3\x60\x4d\x7d\x07\xbe\x5e\xd3\x27\xe8\xe7\x85\x50\xb2\x66\x3e\x57\x7d\x71\x90\x4a\x3f\xf7\x93\x9c\x3f\x46\x2d\xc0\xeb\x1c\xc2\x95\x83\x4e\x90\xae\x7c\x14\x79\x18\x34\x19\x8e\x3f\xfc\x3d\xf4\xb3\xec\xdf\xa8\x60\x8b\x2b\x3d\x8d\xd7\x48\x2f\x2f\x4c\xd4\x0d\x5d\xb2\xda\xc9\xac\x2d\x27\x12\xe9\xec\x65\x8a\x06\x85\x11\x11\x6e\xda\xbe\x3e\xaf\x60\xe0\x0e\x6e\x3f\xd4\x5d\xf8\xb1\x22\x8f\xd8\x97\xbb\xed\xad\x5e\x71\xbc\xc5\x85\x8a\x37\x79\xb8\x26\x57\x40\x2d\x67\x72\xcc\x03\x94\x05\x1a\x8e\xd4\xc5\x4b\xec\xb4\x0d\x6f\x7f\x33\xec\x27\x6c\xf8\x09\x7b\x2c\x3d\xc1\xc9\x1a\xb5\xe1\x13\x2c\x29\xb5\xe6\x94\xf0\xd8\x70\x39\x8e\x0d\x70\x34\xb7\x96\x49\x61\x0f\xf5\x09\xac\x3d\x36\xec\x37\x1b\xd9\x8c\x5e\x3f\x7a\x9f\xb7\x51\x83\x4e\x4d\x3f\xa1\x3d\x8e\x69\x87\x33\xbb\x62\xd1\xc2\xa5\x8b\xc5\xd1\x68\xc1\x9f\xa5\xda\xd1\x57\x15\xc9\xe5\xe5\xa4\x0d\xa8\xf0\x77\xc0\xcf\x23\x3e\xcc\x73\xa0\xdd\x91\x5e\x54\xb2\x6a\xaa\x5a\xd6\x45\x48\xa8\x3e\x69\x6b\x31\x06\xf5\x30\xb0\x02\x49\x24\x80\x86\xee\xf7\x9d\xfa\xb5\x96\x7d\x00\x7e\x96\xbc\x2c\x89\x0e\x26\xaf\x53\x75\xe0\xdd\xf5\xbc\x0a\xd6\x5e\xe6\x59\x7f\x81\xdc\x79\xde\xa6\xe8\x5a\xc4\xa3\xb0\x4f\x53\xba\xa6\xd2\xfd\xd7\xc0\x6f\x4b\xec\xc6\x5f\x6c\xa0\x90\x0c\xb7\x4a\x02\x1d\xdd\x31\x29\xdb\x3e\x5b\xac\x4d\x69\xc6\xe5\x64\xb6\x0c\x57\x36\xf5\x9a\xcd\xe9\xda\x65\x7d\x0c\x18\xda\x37\x43\x7e\x40\xc6\x9f\x90\x87\x46\xf4\xe6\x1a\x27\x48\xde\x90\x1b\xd3\x2f\x0c\xbc\x3f\x1d\x2e\xb3\x6a\x31\x9c\x43\xc5\x09\xe4\x84\x75\x7f\x86\xb4\x21\xa8\xfb\xbf\x45\x7e\x96\x70\x99\x34\x20\x96\x6a\x4c\x15\x04\x64\x41\xbc\x32\xea\xea\x96\x67\x21\x14\x55\x31\x77\x80\x72\x69\xea\xa4\x30\xf4\x35\xe2\x12\xe6\xed\x60\xd4\xd5\xd0\xa5\xde\x8f\xb8\xfe\x51\x37\x61\xe5\x87\x55\x3f\xf6\x3f\xd2\x1a\x0c\x97\xa6\xee\x86\xb0\x03\x6b\xdf\x8d\xc3\x6a\x78\x53\x25\xe4\x0f\xe8\x8d\x80\x39\x44\x01\xab\xdf\x23\xc9\x44\xa1\xe1\xe2\x70\x94\x4f\x57\x04\x66\x82\x83\xe0\xdc\x71\x80\xee\xef\xe9\x78\xbc\x54\x5d\x07\xe7\xd4\x3f\x27\x78\x5c\xfc\x3e\xd1\x4a\xe9\xcc\x24\x5c\x74\xcc\x70\x9b\x20\x2b\x33\x91\x6c\x5d\x17\xb6\x90\x86\x35\x23\xd4\x49\x34\x6d\x2c\xb1\x07\x4f\xf6\xaf\xf0\x34\x5d\x5d\x86\x43\x12\x8e\xfd\xbb\x53\xf1\x31\x17\x5a\x31\x72\x08\xa4\x4c\xe8\x72\xaa\x95\xd6\x64\xbb\x88\x8a\x54\xe7\x9c\x1f\x4f\xcc\x32\x06\x23\x95\x5d\x33\xf5\x26\xcd\x35\x47\xcc\xff\x11\xc7\xc3\x74\x74\xbb\x34\xf7\xeb\x81\xd2\x2b\xb8\x58\x15\xbf\x18\x8e\xb8\x60\xdb\x91\xd5\x20\x22\x3f\x92\x85\x7a\x12\x9c\x2a\xa3\x52\x47\x5a\xfb\xff\xfb\x7f\x82\x32\x1d\xba\xf4\x47\x9f\x8c\x9d\x5b\xac\xf5\xf4\xb1\xeb\xcd\x08\x96\x43\xf1\x06\x0d\xfd\x80\x76\x08\x04\xd3\x10\x06\x51\x10\x86\xe1\x10\xbc\x23\x08\xc2\xa5\x29\x92\xa4\xd1\x90\x7e\xa0\xe5\x89\x05\x04\xc9\xd8\x61\x3d\x04\xb9\x4a\xec\xc2\xf0\x9d\xb4\x90\x2e\x2e\x90\x7c\x03\x00\x49\x6f\x2e\x9e\x8a\x31\xf6\x95\x4c\x8c\xff\xfb\x7f\xe2\x74\x48\x46\xef\x9d\x6c\xbf\x8d\x5d\x08\xc6\xf5\xce\xad\xfa\x74\x08\xbb\xf2\x81\x96\x84\x68\x84\x46\x50\x0c\x42\xd0\x5d\x40\x84\xa8\x87\xa2\x08\xe5\x91\xd4\x1b\xdf\x0c\xa4\x42\xef\x5e\x55\xd9\xd7\xc9\xab\x83\x93\xf8\x0c\xb8\x3e\x1f\x6b\x5d\xea\xe3\x29\xbb\xaa\x09\xb0\x4c\x80\x78\xc6\x78\x86\xfa\xaf\xf9\x1e\x55\x6d\xb9\x35\xdc\x1d\x27\x52\x3d\x9d\x10\xda\xf3\x34\x5d\xe8\x0d\xb9\x70\x8f\xd1\x98\x58\x9a\xca\x58\x99\x1a\x98\xeb\x3e\x9c\x84\x67\x5a\x66\xec\xaa\xe1\x36\xf6\x49\x0a\x0e\x75\xf9\xbe\xb6\xe8\x0f\xf8\x0d\xd1\xba\xe7\xb6\x5d\x10\x76\x87\xb6\x4c\x04\x8b\x24\x7d\x60\xaa\x0b\x56\xba\x39\x74\x4d\x4b\x26\x62\xa0\xbb\x11\xd1\x66\x81\x50\x57\xf5\x17\x10\x1f\x86\xba\xf0\x16\x2b\x9f\x32\x06\xaf\xdb\x94\xeb\xa8\x2d\xaa\x6a\x54\xe2\xc7\x10\xe1\x07\xbc\x17\x98\xbc\x6f\x20\xb4\xf5\xd7\xc8\x92\x8c\xef\x80\x97\xd8\x7b\x58\x1e\x02\xc2\x11\x04\xa6\x60\x0a\x43\x77\x08\x19\xc1\x08\x42\xe2\x10\x05\x3d\x3a\x7a\x82\x59\xb5\xa7\xeb\x61\x2d\x41\xab\x60\xae\xe6\x7d\x0e\x79\x03\x50\x01\xd0\x54\xa0\xa2\x6d\x05\xaa\x68\x43\x6e\xa1\x85\x8c\x69\xeb\x27\x4e\xb1\x0a\x87\xd4\x07\xe3\x7a\x72\x8b\xd1\x1d\xc2\xff\x99\xd0\x37\x73\xb1\x83\x7e\xbc\x6d\x0b\x0c\x21\x08\x04\xa1\x04\x0c\xed\x68\xd7\xc5\x68\x0a\x45\x5c\x12\xa5\x81\xb4\xf2\xeb\xb2\x71\x87\xd4\x2b\xc2\x47\xa7\x8b\x3c\xfb\xd7\x5b\x91\x41\x73\x74\x95\x2a\x3f\x86\xd2\x4b\x49\x5d\xc2\x04\xa3\x74\xc7\x15\xcd\x50\x16\xa2\x73\x35\x9c\x91\xd9\x85\x9e\x06\x21\xa5\x7e\x57\xf7\x75\x34\xbc\x1d\x8f\x39\xad\xd2\x0f\x27\xf1\x07\x8c\x7f\x8c\x83\x86\x68\x98\x86\x10\x1c\x46\x90\x5d\xe4\x93\x90\x17\xd0\x2e\x45\x78\xf8\x1b\xe7\x1a\x0b\x3e\x25\x11\x43\xd7\x11\x48\x73\xc4\xb9\xd1\x77\x03\xdd\x3a\x05\x07\x06\x2b\x0f\xeb\xb4\xa5\x50\x88\x54\x9e\x5f\xfa\xeb\xfe\xb7\x70\x3e\xaa\x78\x7d\x64\xa5\x83\x0c\x18\x45\x28\x59\x71\x4c\x07\xe6\x82\xf1\x23\x8e\x78\xb0\x77\xae\x01\x9c\xce\x98\x04\x75\xb3\x21\xad\xb6\xf9\x89\x5a\x19\xc3\x2e\x4f\x87\x9a\x49\x6b\x30\xae\xff\x74\x5b\x9e\xdd\xec\x53\x7f\xb1\xd2\x7e\x7f\x34\x33\xcc\x8a\xb9\xd4\x3b\x58\x47\xac\x99\xec\x2a\x37\x55\xa6\x11\x19\x31\x34\x00\x72\x63\xcf\xf5\xc5\xf7\x9f\xc0\x6f\x49\xdd\xa4\xd1\x0a\xf6\x6e\xe7\x96\xee\xbb\x91\xa6\x9f\xd4\xd4\xd1\xcc\x19\xcb\x2b\x7a\x79\x25\xb9\x93\xa3\x45\xf7\x8b\x01\x5d\x69\x8d\x3d\x0d\x78\x8c\xc6\xe8\x7a\x18\xad\x7b\x9c\x17\x87\xe0\x50\x7f\x09\x3c\xd4\x4b\xda\x74\xf5\xb2\xfe\xcf\x84\xfc\x80\x7f\x60\xdf\x1d\x86\xeb\x3d\x3e\x35\x0e\xc1\x16\x29\x0c\x1a\xda\xf9\x7a\x84\x39\x69\x31\x78\x06\x69\xf1\x7d\x83\x40\xa0\x02\x86\x42\x90\x60\x27\xa8\x11\x9e\x78\xcc\x74\x4a\x03\xf6\x4d\xdd\x2e\x60\x5c\x27\x69\x3f\xd4\x71\xe7\x96\x9f\x6d\xb7\x12\xe1\x25\xd3\xb6\xdc\x62\xbb\xf7\x11\x4d\xb8\xae\x33\x80\x99\xa8\x66\x1c\xaa\x41\x0c\xd0\xfd\x7d\xcb\xb5\x39\x38\x19\x3c\x19\x3f\x11\xb8\x51\xb8\x80\xc9\xda\x0f\x5d\xba\xec\x3e\x7c\xe8\x9f\x72\x46\x41\x38\x84\x40\x10\x86\xe3\xc4\x2e\x72\x61\x37\x22\x5c\x38\xc2\xa2\xc7\x3d\xbf\xe5\xa7\x23\x0f\xa7\x2e\x81\xc7\x91\xbc\xd7\x2f\x94\xbe\x4f\x79\x4e\x5e\x94\x4d\x09\xca\xc3\x05\x1f\xd3\x4e\x40\x8c\x75\xdc\x88\xe7\x6d\x71\x8b\xd0\x1f\x92\xba\x74\x7b\x70\x08\xcb\xa6\x78\x17\xb5\x07\x01\xc7\x20\x1c\x22\xe1\x37\x35\xef\x42\x30\x89\x87\x21\xea\xf9\xbe\xff\xa8\xff\xae\xe3\x3a\x96\x7e\xb6\x1d\xef\xb1\xef\xb7\x57\x2f\x0a\xc0\x4b\xb6\x7a\xb4\x31\x1e\xcf\x84\xbe\xf0\x95\x5f\xdc\xc2\x3e\x94\x93\x7f\x42\x4c\x43\x24\x4c\x41\x30\x42\xe0\xd8\x2e\xf2\x60\xdc\xa3\x68\xda\x25\xf1\x77\xfd\x78\xb6\x1b\x52\xf0\xd4\xcc\x8d\x6a\x06\x18\x98\x3d\x1a\x4b\x2b\x6f\xf3\x5d\x73\x1d\xe4\x91\x4b\x45\x70\x64\xf0\xf1\xbe\xb4\xf8\x2c\xfd\x06\xc6\xdf\x3b\xd5\xb1\x4a\x87\xfe\x81\x15\x7f\xd7\x64\x04\x8e\x23\xc4\x0e\x09\xa3\x30\xa4\x70\x32\x24\xfd\xe8\x81\x75\xf5\x96\xc6\xd6\x8e\xf7\x75\x1f\x02\x57\x65\xcf\xda\x8d\x15\x5e\xa0\xb9\xbd\x7a\x9a\xef\xed\x5b\x56\x5a\x5d\x18\x1d\xd7\xad\x9d\xa1\x5f\x65\x7d\x9b\x2b\x09\x61\x08\x82\xe0\x3b\x1f\x0d\x42\x0c\x47\x7d\x02\x8d\xb0\x7f\x9b\x95\x46\x30\x08\xc1\x49\x8c\xda\x45\x04\xee\x93\x48\x88\x10\x34\xf4\x4e\x67\xa8\x4e\xe2\x20\x57\x68\x92\xf8\x0e\xf1\x05\x80\x63\x61\xc9\x4b\x20\xfd\xbc\x6c\x7b\x54\xf3\x2f\xb5\xd4\x1f\x67\xc0\xbd\xcf\xe4\xe1\xbf\xa5\x7b\xb4\x0b\x8c\x63\x9f\x73\x1c\x83\x5d\xea\x26\x6e\xbc\xc2\x57\x3c\xba\x79\xa9\x00\x90\x81\x1b\x8b\x0e\xe4\xc7\x4d\xdc\x71\xe4\x71\x4d\x9e\x55\x8e\x5b\x05\xab\xe7\x16\x49\x5d\x94\xa0\xef\xf6\xbe\x1b\xa4\xee\xe7\xb0\xe3\xd4\xdf\x53\x26\x2f\x2e\xd0\x5c\xdf\x6b\x38\x03\x2d\xdb\x1a\x6e\xb6\xcd\x62\xf5\x68\xd0\x4a\xcb\x5f\xa1\xbe\x4d\x83\xfc\xb0\xc7\xec\x17\xf4\x21\x4d\xdc\x05\xac\x9b\x21\xad\x2b\xb7\xf8\xac\x61\xc6\xb1\xe1\x40\x92\x98\x63\x16\x48\x13\x34\x3d\x94\xaa\x71\x01\x20\x23\xee\x60\xba\x8a\x66\x56\x87\xf3\x60\x5a\x2a\x0d\x69\xad\x17\xe4\xc6\xf5\x93\x10\x1c\x92\x2e\x8d\xde\xfd\x5e\xf8\xd9\x53\xf4\x1b\xe4\x36\x5a\xd2\x52\x1c\x9c\x19\xe9\x80\x54\x41\x4e\x32\x1b\x08\x29\xc6\xc2\x6d\x69\x6c\x81\x73\xbc\x99\x1e\xe0\xb9\xfe\xac\xfe\x0d\x2e\xfa\x7b\x70\xbb\xb2\xae\x40\x3f\xed\x7c\x6f\x8c\x1e\xa5\x06\xa2\x10\x12\x82\x30\x1a\x23\x76\x9e\xe7\xb9\x01\x44\x93\x08\x8c\x3d\x6a\x79\xd4\x00\xef\xb1\x7f\x45\x13\xd6\xe3\x1d\x4a\x17\xb5\xc9\xe2\x90\x80\xd3\xd9\x28\xa1\x25\x7a\x75\x01\x81\x3e\x37\x1e\x43\x5e\xa9\xfa\x0b\xca\xb8\xde\x95\xe1\xd0\xa5\x7e\xff\xa4\x85\x69\x98\x84\x71\x04\x45\xd1\x5d\x04\xa1\x10\x14\xc0\x24\x46\x07\xee\x03\xab\x4a\xa2\x4e\x57\x9a\xfa\xbc\xe5\xfd\xa6\xd1\x26\x7e\xbb\x8d\xdd\xaa\xa2\xf0\xe1\x70\x29\x8e\x92\xb1\xc1\xb9\xb8\x16\x34\x4a\x37\xf6\xd7\xac\x9d\x1b\xa4\xcb\x13\x27\x05\x51\x30\x09\x13\x08\xbc\x23\xa3\x20\x88\x7c\x94\x42\x51\xf8\xe9\x30\x44\xc6\xe8\x00\x1b\x9f\x61\x0b\xa6\x57\x17\x13\x3b\x5b\x87\x66\x45\x92\xc5\xba\x35\xbd\xb6\xce\x7c\x27\xc5\x02\x4f\xfb\x2c\x4f\xbd\x70\xae\x6e\x55\x81\x41\x1a\x45\x45\xea\x3d\x39\xbd\x24\x0c\x41\x18\x86\xa0\xd0\x2e\x44\x20\x22\xa2\x48\x34\x80\xb1\xc7\x79\x72\x7b\x7e\xd0\xe0\x92\x28\x58\x2d\xb8\xb1\x88\x5e\xa2\xdc\xcd\xd2\x94\x49\x50\x3a\x62\x91\xc5\x35\xe4\x83\x96\x53\x6f\xaa\x0e\x3c\x3b\x37\xee\xdc\xbf\xfd\xb7\x2b\xdc\xd2\x0b\xdc\x77\x23\x07\xbf\x1d\x96\xc7\xa4\x1b\x66\x88\x05\x2d\x6c\xaa\x54\x73\x80\x7a\x24\x69\x3b\x60\xc7\x8d\x9d\x1b\xca\xf4\xca\x13\x66\xee\xd5\x39\xcd\xc0\x90\x2f\x70\xd6\xf8\x12\xbb\x0f\xf2\x9f\xc0\xc8\x73\x58\x27\x96\x77\x82\x51\x02\x01\xa2\x64\x4b\xf7\x50\xb0\x2f\x31\xe7\x34\xe2\xb7\xe4\x52\xb7\x26\x97\x88\x71\x93\xca\x34\x82\xa5\xd5\x52\xff\x05\xf0\x6e\x42\xde\x4f\xf8\x73\xd6\xf5\x6c\x99\xf6\xd4\xde\xa4\xa6\xa9\xa5\x33\x0b\x2d\xf8\x1c\xcc\xa9\x50\x6e\xf1\x5d\x17\xe8\xe3\xe8\xcc\xcb\x96\xab\x2d\x3d\xaf\xc0\xb3\xcd\xf7\xc2\xba\xab\x48\xb0\x09\xbb\xfc\xf9\xa8\xa1\x08\x0c\x13\x18\x89\x91\x3b\xd4\x25\x49\x38\xa0\x69\x84\x26\x1f\x97\x89\x9b\xc3\xa0\x06\xad\xa6\x43\x30\xdd\x6d\x95\xa5\x5c\xbc\x61\x0c\x01\x5c\x36\xb6\xb4\xc5\x04\xec\x46\x41\xb6\x46\xc5\x72\x84\xaa\x7f\xc1\xf8\xaa\x59\x44\x2b\xa4\xd1\xed\x40\x73\x70\x4d\x63\x47\x07\xd7\xe4\xf9\x38\x9b\xb1\xab\x8a\xb0\x79\xbd\xa7\xe6\x52\xb6\x09\xc0\x86\xc1\xc5\xa4\xfe\x06\xf5\xdd\x54\x9b\x85\x27\x8a\x95\x1c\x99\x94\x77\xde\xc2\xb6\x76\x31\xff\x2a\xb6\x57\xc2\xb3\x3b\xd4\x8a\x45\x67\xb9\x52\x0e\x4c\x00\x49\x7f\x95\xfe\x0e\xee\x51\xb1\x22\x4e\x67\x5a\x06\x62\x79\x96\x4e\xcf\x73\x8b\x81\x49\xe7\x89\x5e\x35\x81\xf0\x41\xbb\x9d\x55\x28\xf5\x92\x33\xd1\x15\x79\xf3\xec\xdf\x7a\x6f\xe1\x7e\xb7\x82\x7d\x13\xba\x79\xe8\xf6\xeb\xfb\x46\x3e\xcd\x1f\xd8\xfa\xd5\x61\x14\xab\xd8\x48\x9d\xc8\x28\x8a\xd5\xe3\x9b\x8c\x7b\x12\x49\x13\xfb\xdc\x8c\xa0\x9c\xe1\xb0\xad\x81\x58\x56\xe8\x9f\xa0\x7d\xb7\xf7\xd2\xea\x8f\x8f\xb7\x23\xf2\xe6\x83\x3e\x06\x42\xb6\xaf\x18\xf0\xfd\x9e\xf6\x7c\x72\xd9\xc6\x85\xa7\xfd\x9a\x93\x23\xe4\xe8\x89\x4d\x76\x2a\x3c\x36\x46\x2b\x7a\x1d\x2f\xb9\x70\x7e\xde\x2f\x3f\xac\x72\xb7\x18\x52\xd0\x73\xfd\xbc\x8e\xa2\x37\x64\xe4\x07\xfc\x9d\x77\x4b\x43\x5a\xa8\xcd\xc4\x29\x68\xd6\xa8\xe3\xdd\xdc\xcc\x2e\xa0\xca\xbb\xf5\x6a\x62\x2e\x67\x98\x66\x7e\x57\xcf\xe7\x33\x2a\xdf\xef\xd2\x2b\x4d\x3f\xf6\xbb\xb4\xea\x87\x6e\x2c\xc3\x6a\x70\xdf\x8c\x0f\xf8\xbf\xe9\x86\x5d\xd3\xd5\xc3\xc7\xb5\xc8\xc7\xde\xc6\xc5\xe1\x9e\x30\x37\x5c\xb9\x5d\x2e\x13\x26\xdc\xb7\xcb\x1e\x5f\x15\xcd\xbc\x63\x5e\x5a\x18\x17\x73\x5d\x16\xaf\x8b\xae\x03\xb7\xcf\xff\x0b\xa6\xc7\xa4\x34\x21\x2a\x65\xda\xd5\xd9\x22\xee\x61\xa4\xab\xd6\xf6\xba\xc7\x19\xe6\x82\x5d\x69\x0a\xbd\x46\xe1\x49\x69\x33\xde\xba\xe9\x55\x68\xbc\x10\xf6\x8d\xdb\x85\xe0\xb2\x24\x6e\x9f\xfc\xb9\x39\x8f\xdb\x1e\xc4\x82\xc1\x18\x68\xc3\xf5\x35\x18\x2b\xb1\x03\xd7\xfd\xd5\x81\x52\x35\x22\xc9\x5a\xd1\x10\x4a\xcf\x96\x02\x0f\x04\x69\x35\x5f\x57\xed\x4b\xe8\xf7\xf5\x21\xa4\x4a\x93\x29\x59\x27\x01\x9b\x69\x0c\xc1\x5a\xf8\x21\x8c\x8e\xce\x55\xd4\xf7\x4a\x7c\xca\x1a\xf5\xb6\xb4\x17\xb9\x82\x80\xd6\xfe\x25\xcc\x87\xe1\x9a\xa7\x3b\xc4\xa9\xa8\x4a\xe4\x56\x4a\xee\xeb\x7d\xe8\x70\x43\x82\x82\x19\xca\x1c\x87\xab\xa3\xe1\xf7\xcb\xf1\x14\xfb\x5d\xc6\x5e\x5f\x4e\x69\xb2\xad\x61\x07\x16\x75\x1c\x2e\x1f\xfe\xca\x93\x25\x01\xec\xb9\xe9\x5b\xf2\x4a\xf1\xf7\x0a\xaa\x46\xa1\x1e\xc9\x6b\x27\x28\xeb\xdd\x6b\xd0\xb9\xcc\x79\x06\xc8\x32\x4b\xab\x4e\xfd\x5e\xf8\x0a\xb6\x0b\xdd\xa0\x48\xab\xf0\x49\x8f\x11\x10\x0a\xa3\x08\x81\xe3\x3b\x84\x26\x11\x2f\x44\xb0\x00\xa3\x1e\x0f\x6c\x75\x1b\x4f\x78\x88\x2b\x05\x3b\xd2\xd4\xcd\xa6\xfa\x89\xe3\xcf\xcb\xe8\xf4\x3e\x17\x0f\xf7\x1b\x21\xea\xba\x57\x8f\xf8\x3e\xb9\x7c\x49\x3a\x84\xfd\xf0\x44\x88\xc0\x28\x84\xe2\x14\x4c\xee\x5c\x38\x74\x31\x12\x0f\x48\xc4\x7b\x5c\x3c\x15\xbd\x09\x74\x8d\x95\xa0\x23\x55\x8c\x1c\x0a\xeb\x80\x87\xc7\x79\xae\xc9\x36\xbd\x44\xdb\xd1\x29\x33\xb9\x5c\xb2\x3c\x55\x8b\x97\x63\x54\x78\x6e\x55\xa5\x55\x0c\x2e\x48\xf6\xe8\x67\xc2\x10\x82\x21\x08\x86\xe3\xe4\x8e\x42\x70\x04\xa3\x31\x94\x0a\xc3\xc7\x18\x29\x93\xb2\x51\x77\xee\x9a\x80\x05\x86\x07\x0a\x78\xec\xd3\x7c\x90\xb8\x71\x34\x95\x34\xa0\xad\x9d\xd2\xad\x37\xd0\x1d\xa5\x6d\x39\xbc\x50\xa6\x61\x35\xd0\x60\x99\xf6\x7d\x13\x16\x3f\x53\x3a\x8f\x6e\x7a\x9b\x11\x99\xc0\xb2\xa8\x4a\x6e\x47\x67\x32\xad\x9a\x14\x2f\xfb\x8d\x45\xd7\x05\x3f\x91\xf9\x3a\xdd\xb8\xdc\xa7\xa1\xa6\x51\xd6\x67\x55\xe8\x57\xee\x90\x4e\x21\xd8\xe4\xf1\x4f\x25\xf8\x9e\x29\x14\x0b\x94\x57\x38\xbd\x5f\x80\x8a\x0e\xb2\x09\x2a\xc7\xd3\xe9\x08\x56\xcd\x4d\xc9\xab\xc0\x6e\xd9\x63\x49\x2d\x7b\x05\xbd\x66\x87\xbf\x41\x7b\x9c\x3c\x70\xaf\xe8\x51\xef\x39\x6a\xe0\x40\xb2\x55\xf6\x87\x05\xbc\xb2\x77\x76\x64\xab\x04\xed\x58\x62\x1d\xda\xd3\xa8\xf4\x53\x5a\xc7\x2f\xa0\x7e\x04\x8e\x41\xe3\x82\x4f\xb1\x30\x0d\x23\x10\x0d\x61\x08\x85\x41\x3b\x84\xa0\x03\x2c\xc0\x08\x2a\x22\x1e\x23\x26\x89\x92\x08\x60\x70\x5a\xf7\x74\x77\xce\x59\x74\xc7\xd1\x90\xc0\x68\x18\x5e\x1c\xd5\xc4\xcf\xf6\x5c\xea\xe1\x5d\xb1\x80\x9c\xba\x3d\xc7\x69\x7e\xed\xe7\x5d\xed\xfa\x49\xe0\xbd\x5f\x80\x04\x5d\x3a\x85\xd5\x53\x48\x41\x41\x34\x02\x63\x18\x42\xef\x28\x28\xa0\xc9\xc8\x43\x7d\xcf\x7d\xf4\x8c\xb6\x8a\x24\xfa\xe5\x16\xa3\xb7\xad\x39\x43\x8a\x72\x76\x2f\x1c\x3b\x02\xcc\x08\x5d\x62\xb4\x17\xf5\xab\x66\x0a\x19\x4c\x1f\x84\x23\xf5\xc2\x1d\xb8\x89\x5b\x84\x60\x12\x74\x0f\xb9\x85\xff\x8d\xc4\x61\x08\x86\x20\x04\xc7\x70\x7c\x87\xba\x90\xe7\x91\x24\x86\xd0\xde\x63\x34\xd3\x1f\xc0\x10\x44\x14\x23\x48\xc1\x22\xbd\xb2\x46\x76\xd3\xef\xb1\x04\xd7\x14\xe9\xf4\x7d\x5a\x06\xba\x25\xd3\x04\x66\xa7\x42\xf8\x22\x49\x75\x35\xb8\x69\x15\x76\xc1\xc3\xd7\x8f\xab\x30\xe2\x3d\xb5\x20\x45\xbe\x58\xab\xab\x95\xb8\x5a\x6f\xb7\x24\x42\x43\x69\xcd\x12\xf7\xa3\xcf\x73\xb2\xcf\x4f\xfe\x41\xca\x8e\xc4\x55\x6b\xdc\xd7\xe5\xfc\x1e\xf7\x61\xd8\x1e\x4b\xb8\x0b\x7f\x4d\x5c\xcf\x80\x71\x35\x99\xa3\x13\x39\xe3\x4a\xda\xdc\x03\x73\x90\xee\x47\x7d\x18\xe2\x06\xc8\xcd\x61\x35\xf6\x2f\xf0\x5d\x58\xf7\x6f\x4e\x73\x9d\x06\xfe\x57\x66\xf1\x0d\xbd\x4c\x30\xaa\x05\x99\xf6\xde\xc6\x99\xc9\x37\x2b\xe2\xd8\xa4\x55\x59\x7b\x2f\xac\x16\x2d\xeb\x37\x5a\x86\x53\xd8\x5e\x32\xfe\xd5\x4a\xfd\x2d\xfa\xc3\x14\xd8\xb8\x9a\x4d\xbd\xdc\xea\xc3\x2d\x9d\xaf\x74\xbb\xee\x8f\x07\x29\xe5\x6b\x07\xae\x24\x56\x74\x0a\x87\x36\x89\xb2\x44\xc1\x8b\xf8\xba\x42\x7f\x90\xf4\x61\x39\x85\xdd\xe7\xdc\x7d\x55\x85\x85\xbd\x91\x1a\x9b\x00\xb8\x9b\x9c\x07\x45\x59\x9c\xc2\x00\x60\x1c\x0d\x15\x8c\xc3\x3a\xf4\x10\x06\xc5\x95\x43\x34\xf9\xbb\xe1\xf7\x6b\x3f\x84\x65\xf0\x9c\x4d\x82\x61\x18\x45\x31\x08\xdf\xa1\xb4\xef\xc2\x1e\x84\xbb\xbe\x4f\x3e\xe6\xe2\xf0\xc4\xbd\x93\x53\x66\x9a\x27\x28\xf7\x61\x34\x12\xac\xb0\x35\xcc\x54\x56\xd6\xc3\x79\x99\xc0\x6b\x39\x55\x4c\x0d\xd9\x65\x88\x7d\x45\xfb\x53\x0f\xfc\x99\x44\x22\x11\x12\x41\x51\x12\xc6\x76\xa8\xeb\x43\x14\x81\x06\xa4\xfb\x94\xe3\x38\xa0\x27\xb4\x86\x13\x4a\x20\xfd\xc8\xbf\xbb\x04\x9a\x5d\xe6\x4c\x80\xc2\x54\x55\xa5\x78\xdb\x0c\xee\x68\x36\x32\x58\xf1\x60\xf8\x72\x06\x9a\x31\x1e\x57\x0a\x7d\x0f\xd8\x02\xa4\x74\xff\xf0\xbd\xfe\x14\x58\x14\xc6\x10\x14\x85\x60\x7c\x17\x91\xb4\x4b\xb9\x94\xef\x12\xf4\xa3\xd4\x94\x2e\x47\xce\x5a\x07\x1a\xb8\x43\x94\x60\xaa\x61\x3d\x90\xb7\x37\x69\x41\x58\x97\xe9\xbb\x78\x4f\xfa\xdb\xea\x9c\xc0\x03\x11\x18\xbf\x4c\xfd\x3e\xa5\xda\xe0\x6e\x90\x1b\x79\x5d\x7f\xdf\x3b\xb4\xad\xd2\x59\x30\x82\xa5\x83\xf4\xf7\x58\x87\x41\x64\x5d\xe5\x2a\xc6\x94\x53\xb1\x4a\xbf\x8c\xfb\x3b\x86\xdd\x85\xae\x9f\x83\xcd\xb0\x7e\x38\x00\x8f\xbb\x5e\x64\x78\x0f\xf9\xa8\x89\x70\x4c\xd7\xea\x26\x59\x14\x9d\xdd\xe1\xf2\x89\x90\x56\x04\xda\x72\x14\x3a\x16\x18\x61\x93\x5c\xad\x3f\x7b\x2c\x81\x3b\x85\x7e\x9c\xbc\x9f\xb6\x26\x9c\x3f\x67\x42\xce\xa4\x4d\xd9\xbe\x85\xc8\x69\xd2\xc7\xa5\x59\x83\xe5\x84\x16\xfb\xb9\x00\xfb\xfc\x2a\x63\xe9\x85\xcf\x6e\xbd\x00\xf8\x38\x8f\x52\x7f\x0f\xfc\xee\x5c\x4d\x19\x9d\x81\x23\xec\xb5\xd5\xc4\x1e\xa2\xf3\x75\x36\x92\xa1\xb8\xee\xb5\xb6\x47\x81\x2e\xe7\x6d\x1b\x46\x33\xdb\xd2\x0d\x9a\xf4\x7f\x01\xf1\x77\x0c\x35\xee\xd2\xcc\x2d\x26\x17\xcc\xe6\xe1\x3d\xfe\x44\xdf\x44\xf9\x3b\x8d\x71\x40\xbb\x11\x80\xe1\x9c\x5a\x6e\x4c\x02\x24\x92\x12\x5f\x2f\x4e\x39\x74\x1d\xeb\x25\x6d\x5f\xba\x0a\x9f\x5d\x44\xbb\x3a\xb3\xee\x73\x34\x10\xd4\x7e\x1e\x76\x60\x90\xf6\x43\x97\x7a\xe3\x9b\xe7\xfc\x76\x36\xc8\x17\xa6\x77\xd5\x11\x1a\xdc\xbe\x88\xd0\xf9\x4e\x48\x05\xc6\x07\xad\xde\x79\xfe\x95\xbf\x0f\x91\x26\x43\xfa\x51\x98\x07\xc2\x3e\x5e\x48\x99\xd6\x8c\xff\x84\xe2\x71\xcd\x90\x58\x47\xc6\xa0\xe7\xf6\x32\x4d\x18\x19\x26\x46\xbe\xa4\xb5\x36\x04\xae\x8d\x06\xd4\xab\x6d\xd8\x65\xe6\x52\x3c\xb0\x02\xf3\x97\x4c\xef\x1f\x1f\xd7\xb0\xe8\x1f\x97\x1c\x14\x4c\xc2\x34\x8e\x62\xc8\x0e\x23\x09\x28\xf0\x20\x0c\x42\x28\xe4\xfd\xe4\x6f\xdd\x82\xd2\xc1\x69\x38\xb5\x90\x79\xa8\x74\x3e\xab\xdc\xbc\xf4\xb7\x00\x1b\x8f\x5e\xb2\x20\xbe\x63\xa0\xcc\x99\xeb\x2f\x81\xff\x5f\xb2\x3d\x4c\x31\x3c\x88\x0c\x24\x43\x1d\x2e\xdf\x41\x01\xce\x0f\x53\x78\xd8\x20\xdc\xf3\x47\x6a\xb8\xb0\x20\xe5\x6e\x67\xa7\x9f\xd2\xb1\xa1\x6e\xf9\x57\xa4\x71\xbd\xf3\xeb\xaa\x0a\xfd\xb7\xd5\xec\x3f\x2e\x74\x3e\xb4\x44\x41\x2f\xa6\x70\x0b\x0b\x8d\x21\x99\xf1\x38\xf6\x9d\x53\x6f\x59\x95\x0b\x12\x6e\x57\x1b\x6b\xa6\xb2\x58\x27\xfb\x63\xab\x9d\xbf\x3c\x07\x5f\xe2\x3e\x46\xca\x5e\x40\x0a\xd7\xba\xe1\x33\x8d\x12\x9a\x04\x42\x87\xde\x4c\x47\xac\x12\xc2\x1e\xbf\x27\xdc\xb4\xf2\x9e\x3e\x18\x4b\x19\x86\x5f\xae\x55\x5c\xef\xfe\xcc\xaa\xfe\x1c\x30\x3a\x26\x90\x12\x9b\x73\x8f\x56\xc2\x1e\x52\x01\x69\xe6\x58\x6a\xcd\x0e\x4a\x44\x6f\x99\x16\x39\x8e\x75\x77\x6c\xce\x1f\x50\xfd\xcb\xbd\x7e\x41\x7c\x8c\xee\x62\x25\xd1\x8d\xe0\x0a\x70\xe0\x39\xa7\x08\x8d\xbb\x14\xcd\xd0\xa4\x77\x75\x8b\xf9\xf3\x91\x8c\xd7\x80\xe3\x3c\x57\x10\x31\xee\x65\x85\xc7\x7e\x48\xdf\x73\x67\xc9\x58\xba\x55\xba\x3d\xc6\x1f\x24\x0c\xc3\x30\x44\xa2\x24\x82\xee\x3c\x0f\x0d\x50\x98\x22\x70\x28\x78\xf4\xa0\xf9\xa1\x1b\xbc\xa3\xef\xc0\xb4\x81\x9f\x58\xf2\xcc\xb5\xa5\x6f\xdc\x18\x8a\x14\x5a\xfc\x80\xe0\x62\x75\x23\x22\xc9\x36\x88\xf0\xfa\xcc\x1b\xfe\x4c\x7a\xc6\xf5\xae\x0b\xfb\xf4\xcd\x53\xf7\xd7\xcf\xaa\x2f\x3f\x0a\xc0\x39\x96\x56\x96\xf4\x2e\x8a\x61\xda\x30\x8a\xb6\xd3\x81\x19\x4c\x77\x8d\xf0\xf2\x18\x9b\xfd\x74\xe8\x05\x85\x99\xe4\xfe\x3b\xf8\x25\xec\x52\xb7\xd8\xf5\x95\xdb\x34\xeb\x73\x6e\x10\xc6\x60\x12\xc3\x50\x72\x47\x92\x44\x80\x93\x30\x12\xb8\xc8\xa3\x36\x03\x20\x04\x2a\xc6\x43\x82\xe8\x22\x83\x81\xa7\x6b\x43\xd5\xcb\x32\xb4\x10\xe7\xd6\x61\x05\xb2\x69\x8a\xe3\xec\xe6\xe9\x26\xc1\x19\x5f\xb2\xb7\x63\x38\x7e\x51\x02\x44\x84\x6c\xa8\x40\xac\x78\x6c\xf8\xcb\x48\x79\x85\x70\xbc\x27\x87\x45\x04\x03\xcd\x25\x2d\xce\x89\x3a\x22\x33\x8f\x8a\xde\xb6\xc0\xb3\xa7\x19\x06\x7d\xb7\x45\x60\x59\xba\xcd\xcf\x14\xdd\xb3\x51\xb3\xaf\x28\x7e\x4d\x54\x65\x40\xc5\xb3\x8b\x76\x6b\x76\x94\x70\xa6\x87\x31\x8b\x23\x88\x84\x0a\x4f\x62\x13\xb9\x8c\xdc\xf1\x16\xfe\x6c\x33\xc3\x6a\xaa\xd7\xf7\xbb\xbf\x9f\x12\x31\x74\x75\xb1\x6b\x0a\xf7\x23\x18\x25\x7e\x3c\xd6\x25\xdc\x98\x59\x70\xb3\xd1\x3b\xf3\xc9\x32\x58\x73\x7f\xa1\x7b\xaa\xef\xb1\xde\x8d\xa6\x43\xe0\xa5\xc3\x25\x39\x9d\x44\x8c\xea\x64\x22\xfe\x27\x24\xcf\x97\x99\xb6\x5e\x80\x74\x29\xbb\xec\x9c\x6b\x53\x49\x04\xb0\x8b\xb0\xe8\x5a\x1e\xfd\xd0\x06\x39\x96\x31\xa7\x5e\x4c\xb4\x23\x94\x1e\xf6\xd8\x3f\x23\xf9\x79\x03\xfe\x16\x74\x12\x08\x84\x53\x10\xbe\xc3\x23\xca\x73\x11\x2a\xc0\xb0\xa7\x04\xe1\xbf\x36\x84\x47\xe9\x21\xba\x66\xdc\x07\xac\x73\x41\x69\xb2\x47\x1b\xbb\xb6\xfd\x4b\x3c\xc2\xa5\x50\x68\x06\xb5\x2f\xf1\xe3\x78\x56\x20\xdc\x67\x25\x9a\xfa\x8e\xe4\x3d\xdb\xe3\xef\xe2\xb0\xda\x4d\x6e\x91\x06\x3f\xaf\xe5\x9e\x4f\x5c\x7a\x2b\x17\x7f\xcd\x5a\xbd\x3f\x37\x02\xa2\x61\xb2\xcb\xc9\x24\x90\xcb\x88\x79\x30\x2a\x11\x6c\x7c\xa6\x28\xc3\x7e\xdf\x1d\x5f\xec\x7d\xe4\x0e\x69\x02\xfa\x75\x51\x77\x6f\xc7\xed\x39\x21\xec\x94\x44\x7e\xb3\x98\xfa\x42\x87\xeb\xbd\x12\xd7\x7c\xe0\x15\xc2\xf5\x14\x1b\x69\xb8\x31\x96\x23\x5c\x9c\x37\x6f\xf5\x27\x29\x7b\x5e\xa1\xa8\x73\xab\xb0\x78\x8b\xfa\xbc\x22\x7d\x88\x0f\x11\x08\x82\x21\x1c\x81\x71\x1a\x25\x77\x3e\x1d\x45\x5e\x18\xb9\x6f\xe6\xe4\x81\x93\x0c\x26\xe3\x64\xf6\x26\x93\xb5\x1a\x79\xe6\xcf\x39\xe4\x75\x09\x7f\x75\xec\x53\x19\x31\xf6\xb5\x13\x21\x27\xb1\xa5\xc3\xc0\x74\xdf\x71\x76\x61\xfb\xa4\xe1\x10\x08\x83\x09\x14\x45\xa9\x9d\xe7\x07\x28\xe6\xd3\x34\x8d\x46\x8f\x15\x2a\x4e\xd2\x24\x9d\xa6\x47\x29\xd2\x79\xd1\x65\x2e\x7a\x3f\xa9\x6b\x81\xc7\x80\x59\x14\x39\x0d\x53\x82\x25\x49\x00\x5d\xab\xf7\xc8\xf3\x55\x5e\xd4\x57\xf5\x90\x46\xeb\x9f\x5f\x3e\x6a\x18\x1f\x3d\xc6\x6c\x4e\xfa\x0d\xf3\x68\x74\x06\x15\x45\xeb\xc0\x56\x86\xed\xb5\x8c\x46\x6a\xa0\xc8\x4b\x75\x64\xa5\x5a\x9d\x86\x3a\x6b\xb2\xe3\x73\x4a\x3d\x4e\xea\xa0\xef\xc1\xd5\x2d\xff\xb8\xdd\x7a\xaf\xe0\x51\x79\xf1\xe0\x26\xc9\x85\x98\xcb\x7b\xb0\xcd\xd6\x09\x86\x63\xc1\x64\xf3\xeb\x04\xe1\x9e\x7c\x4d\x00\x6d\xa9\xa0\xb5\x8a\x5f\x62\xb5\xcf\x68\x8f\xd7\x09\x01\xc3\x8d\x56\x19\xb7\x19\xc2\x9b\xa9\x48\xe4\x47\x77\x72\x53\x96\x76\xb4\x6b\xd8\x13\x92\xc4\x23\x9d\x66\x87\xd2\xe1\x08\x3d\x2f\x78\x5c\xef\xbc\xb4\x0a\xdc\xc1\x7d\xf8\xfa\xe6\xd5\xc1\x3f\x90\x4f\xbe\x16\x3e\x65\x67\xc9\x4c\xea\x59\x0d\x94\x2d\x3b\xc0\x97\x60\x59\x8f\x47\xd2\x3e\xea\xf7\x18\x17\xe2\x93\x69\x61\x31\xd3\xe1\x82\x37\x1d\xfe\x33\x92\xc7\x2a\x1e\x91\xe2\xfa\x78\x2e\xc3\x30\x00\x18\xa6\xbf\xad\xc8\x5d\xaf\x1b\xa6\x02\xa9\x51\x44\x78\x6b\x3c\xdd\xaa\x3d\x0c\xb3\xe8\xb9\xae\x5f\xb9\xe2\x02\x8c\x8b\x68\x7e\xa9\xe3\xa2\x21\x08\x83\x50\x7a\x17\x12\x81\x0b\xb9\x7e\x40\x3c\xe7\xcb\x26\x8d\x4c\x36\xf5\x3e\x22\xdb\x79\xa5\xf7\xe6\x3e\x0e\xcf\xed\xd4\xc6\x3c\xad\xe2\xec\x1e\x48\x45\xd6\x41\xe2\xf5\x60\x36\xcb\x8b\x1f\xf9\xbf\x7c\xe0\xf4\x7e\xc3\xf3\xc2\x0c\xc3\x08\x8e\xc0\x30\x09\x61\x3b\x18\x71\x03\x1a\x77\xa9\x20\x22\x1f\x9d\xae\x41\x45\x8c\xbd\x1d\x5f\x70\x21\x9c\xb4\x99\xca\xb5\xa5\xae\xc5\x9b\x72\x8e\x4e\xd3\x99\x8e\xce\xea\xd1\x85\x74\xa3\xbf\x6f\x7a\x4c\xfd\x03\x66\x04\x82\x10\x04\x81\x30\x14\x87\xd0\x1d\x11\x91\x2e\x4d\x61\x01\x16\xf8\xd8\xef\x61\xce\xd3\x01\xcc\xd3\xf7\x9c\xe4\xf3\x15\xd1\xc2\x2c\xa2\x70\xa5\x89\xe0\x2e\x59\xd6\x0a\x49\x95\x65\x0e\xe5\x9c\xef\x69\x10\x46\xdd\x76\x51\xaa\xa9\xcb\x8e\xb6\xb4\x47\xdc\xfe\x2f\x30\xe9\x7f\x01\x13\x7e\x96\x98\xc5\xe8\xcf\x9e\x0a\x1e\x1b\x2c\x3f\x0c\xe4\xfe\x18\xb3\xe3\xb4\xde\x31\x97\x84\x47\x2a\xa1\x33\x86\x1a\x32\xf0\xaa\xc5\x57\x87\xfc\x74\xc0\x8a\x3a\x8e\xca\x01\xfc\xf8\xf8\xc8\x56\x3e\x22\xab\x03\xac\xd4\x57\x9c\xaa\xf1\x61\xbe\xed\xfd\x7c\x86\xf9\xe2\x78\xbf\x94\x7c\x7f\xbd\xe3\x20\x74\xf1\x74\xfa\xc4\x54\x1c\x5e\x58\x9f\xc4\xe4\x13\xf2\xb3\xf7\x88\x6a\xd2\x7c\x6b\xc9\xe3\x18\x2e\x0a\x26\xba\x45\x1e\x4e\x68\x98\x29\xe7\xde\xd1\x9b\xfb\x9d\xe9\x68\xc0\xc4\xda\x72\x08\x32\x36\xff\x5b\xe4\xe7\x7a\xd9\x99\xb5\x73\x76\x2f\x3a\xd1\x58\x1e\xd5\x34\x69\x28\x76\x63\x27\x15\x6d\x5c\x56\x8f\x52\x0c\x9e\x86\xed\x04\x8b\x81\x00\x2a\xe4\xfe\x15\xb9\x6f\x8b\xdd\x7b\x0e\xb1\x03\xcb\xb5\x6f\x8b\xcf\x35\xe0\xdb\x9e\x05\x35\xce\x41\xb0\x98\x43\x79\x33\x55\xb7\x34\xc1\xa4\x55\xf4\xfd\x64\x2b\x81\xfe\x7a\xc2\x9d\x42\x0c\x8a\x84\x8d\xf1\xf9\x57\xc0\xf1\x0f\x3d\x5a\x6f\xab\x63\xcb\xaa\x85\x2e\x28\x3f\xb4\x3a\x9c\xa5\x9d\x0b\x91\x9c\x8c\x28\xfb\x6e\x41\x26\x12\x2c\x65\x82\x88\x4f\x3e\x7f\xfd\x74\x2a\xbe\x41\x7d\xbc\x77\x64\xb7\x86\x77\xaf\x56\x47\x09\xf7\x52\xb8\x0d\x4e\x3d\x4e\x55\xd2\xd6\x61\x41\xab\x13\x72\x61\x56\x2a\xd1\x11\x33\xe1\x57\x26\xfe\x04\x3e\xb8\x7e\x0e\xbe\xff\xfb\x86\xfb\x2c\x1e\x13\x14\x11\xe3\x7d\x5d\xa5\xd3\xa4\xc5\x82\x98\xdf\x47\x60\x6d\x08\xe5\x6a\x15\x50\x2b\x9e\x28\x3c\x96\xc1\x32\x2c\x34\x94\xe7\xec\x17\xdc\xb8\x06\x3f\x2a\x94\xdd\x26\xed\x3f\x3b\xab\x71\x84\x79\x23\xaa\x52\x10\x13\x9e\x09\x1e\xbe\xc1\x93\xad\x94\x14\x78\xb8\xec\xf5\x60\xea\x56\xc6\x95\x8f\xa7\xb8\x3d\x84\x11\xfa\xba\x1a\x71\xfd\xe1\x9c\xbc\x17\x1f\xbc\x26\x0a\x3a\xaa\xe5\xc1\x93\xa3\x96\xb8\x4f\x54\x1c\xa8\x41\xb5\x1b\xf5\xb0\x9b\x2f\xd6\x04\x43\x0b\x75\xf3\xd4\x42\x24\xdd\x21\x18\x5e\x6a\x1a\x3e\xc1\x3e\x27\xfc\x7e\x23\xec\xe3\x68\x93\x06\xc8\x0e\x08\x34\xf4\x96\x7e\xb4\x9b\xcb\x2c\x4e\xf7\x22\xb9\xc1\x49\x56\x01\xb1\x2e\xcf\x4a\x8c\x08\xc4\x66\xde\x9b\x5b\xfc\x6a\x05\x5f\x60\x7f\xd6\xa8\x72\x2d\x77\xf0\x4d\xdc\x0d\x5d\xbd\x0c\x8e\x4c\xa8\xc8\x8d\xdd\x37\xe8\xb1\xf0\x4d\x1e\x44\x84\x9b\x69\x4a\xb4\x1a\x51\xca\xad\xf8\x9b\x45\x7d\xae\x50\xbd\x15\x76\x6c\x8d\x2a\x7e\xcb\xd8\x43\x4a\x58\x17\x3e\xe3\x59\x17\x5e\x27\x26\x32\x2a\xde\xd7\xbd\xae\x73\x86\xbb\x22\xba\x08\xfd\xaa\x7a\x0a\xb7\x8a\x77\x65\x1a\x77\xee\x10\x82\x3f\x3f\xdf\x0c\x29\xfe\x52\x08\xf8\xfe\xd0\x80\xbc\x6e\xf3\xc4\x9e\x75\x36\x9e\xdd\x46\x61\xd3\x3b\xc1\xad\x4c\x3a\x22\xfe\x26\x18\x12\xc7\x32\x30\x4c\xc5\x47\x5d\xd4\x8d\x57\x91\xfe\x55\x96\xa7\x62\x72\xd3\x28\x8e\x32\xde\x8c\xd6\xb5\xbc\x77\x6d\x16\x9f\x8c\x48\xd0\x04\xcf\x20\xcb\x8e\xaa\x65\xe6\x80\x0c\xeb\x41\x0b\x68\xeb\x55\x33\xbd\x91\x81\x71\x51\x3f\xe7\x52\x61\x84\x40\x50\x1c\x85\xa8\x1d\x82\x06\x61\x84\x85\x84\x0f\x63\xde\xfb\xad\xa0\x38\x2c\xae\xd7\xde\x9d\xfc\x88\xe0\x8d\x4d\x87\x79\xa4\x5d\x08\x17\xa7\x10\x1d\x53\x50\x12\x44\xe1\xfb\xa1\x1f\x54\xbc\xc1\x5f\x8d\xd6\x3f\x61\x7a\x8a\xa4\x78\x72\x8d\x97\x94\x6a\x22\xa3\x70\xaf\xb9\x24\x8d\x7b\x75\x50\x04\xe3\x48\x85\xbe\xa5\xe0\xc2\xa1\x00\x59\x8d\x34\x15\xe4\xf5\xac\x7e\x10\x76\xf5\xd8\xf8\x6f\x71\xe6\x73\x49\x31\x4c\x40\x10\x44\xe2\xc8\x0e\x42\x28\x84\xf0\xd1\x90\xa4\xa1\xc7\xc0\xc6\x17\x62\x2c\xec\x46\xbd\xbb\xc3\x1c\x10\x53\x54\xb4\x69\x77\x03\xbf\x06\x72\xe4\x4a\x00\x2d\xf8\x4b\x6f\xc0\xd8\x71\xb3\x49\xde\xff\x65\x5a\x1a\x22\x21\x04\xc2\x31\x04\x23\x76\x14\x41\x47\x14\x09\x13\x08\xe5\x11\xff\x36\x2d\x0c\x21\x24\x02\x23\x30\x8c\xec\x08\x18\x0e\x29\xd7\xf7\x83\xc8\xa7\xff\x75\x5a\x04\x21\x21\x1c\xa1\x70\x64\x87\xc0\x78\xf8\x36\x5b\x34\x74\xc9\x7f\x97\xf6\x2d\x3c\x42\x60\x08\xc3\x61\x94\xd8\x51\x3e\x1d\x41\xa8\x4b\x85\x38\xf9\x2e\x93\x70\x47\x36\x46\xe7\x7a\x6d\x03\x53\xc9\x95\xf1\x67\x66\x4e\xf5\xde\xab\x8e\xa1\xee\xf0\x26\x1d\x1e\x34\x50\xd5\xf1\xb3\xe9\x2c\xaf\xea\xff\x9f\xf3\xfd\xbe\x69\x96\xf5\x87\x29\x7b\x36\x0d\xb5\x6e\x8f\xc2\x72\xed\x9c\x79\x50\xd2\x90\x05\x78\x78\xdc\xf3\x8d\x7f\x39\x56\x87\x75\x7f\x32\xb9\x0b\x98\x63\xe4\x39\xf2\x4a\xe8\x2b\xfd\xf2\x27\xe8\xb3\x61\xf8\x4d\xa0\xcf\xfa\xb6\x67\xb6\x95\xbb\x5c\xb2\x19\x35\xa8\xf3\x45\x0f\x9d\xe9\x36\x51\xd9\x99\x01\x06\x03\x57\xcc\x30\x2f\xab\x42\x14\xd6\xa3\xb1\x40\x5f\xae\xfa\x1f\xa0\xcf\x4e\x8d\x71\x95\x26\x7c\xed\x6f\xae\x2d\xe7\x27\xe0\x7a\x54\xd7\x5c\x13\x7c\x03\x54\xa7\x69\x39\x47\x68\x83\xc0\x6a\x74\x46\x98\x01\xf5\x5f\x9d\x9a\x17\x50\xf4\x3d\x85\x69\x02\x8d\xaa\x9c\xc0\x83\x61\x78\x79\x82\x91\x55\x5a\x6f\x9c\xbf\xe7\x36\x22\xae\xc1\xc0\x98\x13\x73\x0b\x0c\x95\x17\x4c\xed\xef\xd0\x7e\xdf\x10\xbf\x37\xdf\x44\xa1\x96\x24\xed\x01\xc5\x3d\x95\xa2\x29\x06\x7d\xa7\x84\x6e\xa7\x3a\xca\x04\xb6\x35\x19\x63\xe8\x14\x7c\x3d\x4b\xa5\xc0\xc2\xaf\xc1\xd2\x27\xe0\xe7\x9d\xfa\xad\xc0\xc8\xbf\x05\xfc\xb8\xc6\xd3\x96\x61\x28\x47\x02\x37\xf5\x7e\x04\xb1\x66\xb3\xc0\x64\x1e\xf6\xed\xec\x13\xa9\x9e\x0e\x6c\x6a\xde\x5c\x6b\xc3\x0b\x7b\x6c\xff\x76\x8d\xd1\x1f\x8f\xa5\xee\xc4\x15\x07\xa7\x6a\x90\xee\xf3\x1d\x29\xb4\x5c\x87\x93\x2c\xcb\xa1\x6a\xcf\xc2\x02\x77\xd5\x27\xbd\x30\xe3\xac\xd0\xa6\xb6\x0f\xbe\xb4\xa8\x8f\xc0\xd8\x0f\x68\xd7\xf9\x4f\x0b\xed\x87\xee\x62\x44\x21\x1f\xb0\x18\x34\x5b\xfd\x1c\x80\x0a\x21\x9c\x62\xc9\x15\x51\xbb\x11\x53\x3c\xa3\x50\xab\x09\xd1\x03\x3f\x7f\x69\x45\xbf\x42\xff\x01\xfd\xf8\x19\x76\xc2\x08\x8a\x11\x08\xb6\x23\xc8\x00\x83\x03\x94\xf2\x11\x88\x7a\x0a\xed\xf7\x16\x1f\x42\x47\x3c\xac\x42\xeb\x7e\x34\xd1\xc3\x78\x37\x39\x9d\x2d\x13\xe0\x3c\x32\xa2\x0d\x15\x29\xe4\x4a\xeb\x7d\xaf\x7f\x29\xe3\x5f\x70\x3f\xee\xf4\xa5\x38\x6c\x52\x86\xed\x93\x3d\xd9\xb1\xfb\x53\x88\x89\x12\xc3\x4d\x67\x01\xd8\xcf\xfd\xd0\x19\x8d\x29\x6f\x87\x3d\x84\x2a\x4d\xf7\xea\xb3\x7d\x87\x8e\xfd\x31\x33\x14\x46\x11\x14\xa6\x31\x7c\xe7\x51\x04\x84\x22\x68\x04\xd1\xc1\xa3\x5c\x58\x06\xea\x53\xa2\x9f\xab\xf4\xfe\x18\x02\xeb\x71\x1e\xe8\xde\x32\x35\x91\x35\x85\x55\xa6\x7d\x85\x67\xce\x37\xb9\xf2\x2e\x04\xf9\xb7\x87\xe1\x59\xd9\x64\x75\x60\x4c\xe2\x6c\x79\xb6\x7b\xe8\xdd\x80\xcb\xf1\xe3\x1c\xe2\x3e\x49\x5e\x52\x59\x34\xaf\xb4\xc0\x4d\xed\x09\x11\x47\xee\x0e\xfd\x3d\xf0\xe3\x39\x30\xa8\xa8\x99\xa4\xce\x2f\x57\xbc\x71\x3a\x59\x84\x8b\x01\x5b\xd8\xfe\x34\x35\xab\xa5\x82\xa6\x39\x11\x2a\xd7\x53\x46\x56\xdf\x7f\xe1\x1c\xbc\x5f\x5c\x01\x0e\x2e\x9e\xd8\x74\x8b\x57\x87\xf5\x4e\x09\x2c\x3a\xed\x1e\x8a\xfc\xcb\x25\x9f\xbd\x3e\x13\xb6\x1b\xe6\x9a\xc8\x44\xce\xe7\x5f\x19\xea\xe3\xc6\xd6\x5c\x3d\x36\xd2\xfe\x4a\x5d\x27\xf6\x6c\xed\xfd\xbc\x86\x4e\xa7\x93\x10\x6b\x84\x46\xd4\xbe\x60\x7b\xfd\x6d\x26\x51\xbc\xd3\x66\xe1\x2b\xe0\x2f\x2e\x16\xf0\xb7\xff\x30\x1c\xa2\x77\x48\x48\xe0\x11\x85\x23\x38\xfe\x94\xc6\x04\xef\x8b\x17\x95\x52\x4c\x15\x4b\x18\x89\x12\x29\xdc\x59\x34\x62\xe4\x02\x24\x3d\xcd\xf7\x49\xc4\x65\xb5\xed\x60\x75\xe5\x67\xd7\xef\x2d\x50\x03\xbd\xa1\x0b\xc3\x97\xab\x0c\x14\xc6\xd1\x37\x3f\x08\x83\x50\xc8\xf3\xe0\x08\x8e\xa0\xc7\x1a\xaa\x42\xde\x03\xf4\x1d\x96\x19\xd4\x89\x28\x93\x14\x43\x9c\x22\x8a\x63\x9c\x75\x48\xe0\xc8\xe3\x54\xa3\x85\x72\xbe\x9d\x1c\xfc\xac\xfc\x15\xe3\x6b\x76\xf0\xbf\x44\x8d\xeb\x9d\x5f\x36\x9f\xcb\x36\xea\xfb\x16\x49\x75\x23\x52\xe7\x7d\x56\xd8\xf4\x72\xc4\x26\xfe\x66\xee\x6f\x2e\xb4\x5e\xd6\x3b\x79\xab\x86\xcb\x15\x77\x5b\x4d\x84\xa4\xbf\x86\x7d\xce\x9f\x50\x6a\xeb\x73\xf1\xd6\x19\x6d\x61\x78\xe0\x09\x51\x54\xdd\x4a\xc7\xf0\x54\x6f\xa3\x06\x53\x14\x16\x07\x81\xb4\xce\x39\x91\x5e\x5e\x75\xf5\x27\x58\xf8\xdf\x80\x7d\x16\xcd\x89\x0a\xf4\xe0\xe2\x49\x27\x24\x17\x7c\x70\x3a\x4f\x05\x10\x11\xb8\x89\x20\x81\x97\xdf\xbd\x7a\x3e\x10\x59\xac\x83\xb1\xcc\x2c\xaf\xd9\x9e\x17\xd8\x9f\x69\x0d\x50\x75\x25\x9e\x09\x1c\x84\x60\x18\xf4\x76\xeb\x07\x48\x98\x0b\x18\x3a\x34\x3e\xb0\x24\x7a\x3b\xd6\xa5\x75\x87\x6a\xe7\xc0\x10\xaf\x3a\xe4\x33\xde\xef\x1c\x66\x34\x6e\xdb\xe7\x93\x15\x30\x05\xc4\x34\x16\x31\x99\x20\x50\xda\x8a\x81\x29\x35\xda\x94\x46\xb6\xe4\xc4\x51\x55\x8a\x60\x18\x85\xa0\x00\x25\x02\x3f\xbc\xe6\x49\xde\x61\x4b\xb7\x1b\x52\xb7\xfa\x59\xaa\xfa\x6d\xb5\xaf\x80\xf5\x75\xb9\xd8\xba\x27\xf0\x2b\x2e\x9f\x45\x5e\x2b\xd0\xf9\x9e\x0a\xae\x7a\xf2\x6c\x73\xdf\x53\x8c\x72\x21\x26\xaa\x38\xbc\xaa\xf4\x77\x92\xa6\xe9\xea\xc7\x77\x15\x14\x8c\x40\x04\x4c\x63\x14\x4c\xee\xd0\xd0\xa5\x70\x82\x74\x91\x10\x7f\x8c\x0b\xb6\x68\x2e\x3c\x59\x3a\x03\x57\x61\xa8\x43\xc8\x18\x1b\xd7\xcc\x80\x7a\x85\xeb\x52\xb1\x6d\x6e\x74\xe3\xfa\xb6\xed\xa9\x89\x2e\x5f\x1d\xe6\x2f\x19\x69\x08\x87\x71\x98\xc6\x68\x1c\xdb\xe1\x18\x42\xc2\x11\x19\x42\x34\x12\xfd\x8b\x8c\x30\x02\x53\x10\x84\xe0\x28\xbd\x0b\xb0\x08\xa3\x29\x37\xf4\xc2\xe0\x51\xd5\x38\xb1\xa9\x29\xb5\xd1\x82\x49\xd4\x6e\xfb\x36\x21\x7b\x5e\x6a\x3d\x54\xa0\xb5\x16\x67\x71\x3a\x10\x36\xe4\xe6\x31\xf3\x19\x0b\xbf\x94\xde\x17\xc6\x37\xc3\x0f\x23\x10\x82\x91\x18\xba\x8b\x60\x38\x82\x83\x88\xc2\x02\x18\xf9\x57\x19\x11\x1a\xa6\x3f\x72\xeb\xa1\xe7\x91\xa8\x4f\x40\x61\x80\xfe\x3e\xc6\x2e\xac\xdc\x32\xfc\x78\x9b\x0a\xbf\xbc\x20\x60\xe3\x68\x89\xe9\xb5\x9f\xb3\xfd\x39\x3f\xb0\x52\x16\x5e\xa9\x33\xd5\xb9\x89\x1d\xde\x2b\x39\xb9\x96\x18\x64\x24\x99\x9d\xbf\x1a\xa3\x77\xe0\x71\x4c\x83\xcf\xc2\xa4\x0b\xab\xe2\x84\x98\x14\xb7\x53\x14\xda\x1c\x73\x0c\x03\xa9\x3e\x9d\x9a\xc3\x0c\x5e\xda\x6b\xe8\x5e\x75\xa0\x4a\x16\x03\x58\xf9\xd7\xbc\xd0\x13\xe8\xcf\xe2\xa1\x53\xee\xf9\x7d\x02\x9e\xbc\xe6\xbe\x91\x85\x12\x0d\x97\x3d\xaa\x10\xba\x2d\xcd\x19\x7b\xb1\x4b\x0a\xcd\xd2\xa3\xea\x1c\x41\x34\x7e\x8d\x7a\x5e\xd1\x7e\xdb\x10\xdd\x26\xed\xc1\xd8\x5d\x76\x71\xfd\x67\xb9\xd7\xe3\x85\x04\x64\xb5\x13\x42\x18\x91\x6b\x70\x74\x85\x9d\x88\x5c\x1d\xbd\x5c\x05\x44\x3f\x11\x6e\xf1\x0c\x4c\x0d\x6f\x2e\x87\xf3\xa1\xa7\xc3\xaf\xb4\xc9\x57\xe8\x8f\x1e\x35\x67\xdd\xd7\x6e\xde\x9f\xe9\x3b\x74\x6c\xe6\xf9\x10\xcc\x80\xa0\x1c\x6c\x46\x60\x0f\xc7\x11\x2f\x93\x26\x10\x7d\x70\xec\x09\xef\xfa\xea\x51\x37\x49\xd8\x65\xfd\x9f\x5f\x9e\x74\xc9\xc7\xe5\x24\x82\xa3\x3b\x88\x24\x08\x82\x20\x7d\x0f\x0b\x1e\x97\x6c\x3e\x47\x57\x4d\x2b\x2d\x78\x44\x8d\xbb\x5e\xf9\x67\xbc\x2d\xec\xfa\x70\x39\x96\x54\x78\xab\xaa\xc3\x95\x48\xee\x58\xea\xa0\x07\xeb\x75\x1f\xba\xb4\x28\xdc\xf7\xaa\xd2\x70\x19\x3e\xef\x45\xce\x9c\x9c\x2d\xca\x18\x9a\x3d\xb4\xc8\xbe\xb0\x42\x98\x34\xc6\x88\x94\x39\x6d\x80\x82\x03\xd4\x53\x37\x1c\x6e\x21\x57\x27\xed\xd7\xd5\xfa\x00\x2e\xc7\xe5\xa3\xbd\xc5\xa3\x8c\xc2\xc5\x18\x10\xc6\xac\x00\x44\xdd\x71\x47\x6d\xd4\x23\xe6\x60\x52\x21\x0d\xba\x57\x09\xf4\xb1\xc8\x34\x59\x57\x72\x91\xcd\xdd\xbf\xea\xda\x27\x50\xf2\x23\xac\x8d\x2b\x05\x3f\x6f\x99\x79\x1d\x53\x87\x83\xc8\x48\x14\xf5\x9a\xdd\x5f\xb3\x1b\xb4\x5e\x9b\x0c\x6c\x95\x83\xae\xdf\x59\xf6\x46\x24\xaf\xc6\xec\x15\xed\x37\x0e\x71\x0e\xbd\xbe\xf6\xf3\x70\x78\x69\x81\x40\x20\x28\x8a\xa2\xf8\x0e\x43\x20\x18\xc1\x29\x8f\x42\x9e\x5c\xb3\x03\xd9\xf2\x47\x1b\x2f\x61\xe3\x4c\x51\x3d\x6a\x55\x72\x9b\x88\x99\xc2\xab\x50\x12\xee\xe5\x49\xaa\xf7\x52\x8e\xd8\x6e\x91\xe5\x2f\x4e\x54\xd7\xf8\xbb\xd0\xaf\x3f\x4a\x54\xdf\xaf\xed\xde\xfe\x4f\x99\x06\x41\x11\xce\xee\x1f\x8d\x6d\xfe\x28\x79\x80\x60\x98\x82\x68\x94\x42\xd0\x5d\x44\x61\xb4\x87\x63\x18\x1e\x84\x8f\x52\x72\x4c\xd7\x13\x8c\x90\xec\x89\x8b\xd0\xa1\xd0\x93\x32\x5e\xd9\x82\xa4\xee\xe0\xcd\x51\xdb\xc3\xf5\xcc\xb0\x9c\xbb\x8f\x42\x6b\x53\xfa\x5f\x19\xc8\x63\x53\x8d\xd7\x40\x9f\x92\x27\xa1\x5e\x2c\xb5\xbe\x0a\x49\x7b\xd5\xef\x71\x64\x82\x01\x2a\x81\x2d\x61\x0a\x29\x84\xf0\x9b\x73\xe0\x63\xa3\x70\xa6\x2d\xff\x4b\xaa\xb7\x3f\x63\x77\x08\x67\xf7\xfd\x7a\x9d\x7e\x92\xcb\x49\x0e\x47\x73\x61\xce\x87\x7e\xee\xb1\x3a\x36\xa6\xce\x4f\x0a\x0a\x1d\xc0\x93\x6d\xd2\xd6\x49\xbf\x5d\xcc\x80\x49\xae\xea\xf1\xf5\x4e\xe4\xaf\x49\x60\xec\xa3\xb0\x9b\x3a\x68\x1b\xbf\x50\x6e\x06\xdf\x7c\x8c\x2c\x47\xba\x02\xf7\x79\xef\x8a\xec\xcd\xea\x24\x3f\x39\x0e\x79\xd0\xdd\x30\x46\xc8\xf0\xfa\x9f\xc2\x3f\xda\xef\x20\x55\x4c\xcc\xee\x43\x10\x8e\x2b\x3f\xd7\xf9\x21\x4e\x4e\xd8\x29\xe7\x0e\x81\xc8\x6a\xe7\x60\xe4\x1b\x7d\xd1\x51\x30\x7b\xc9\xb5\x24\x6e\x9f\xa4\x7e\xdd\x35\x6f\x72\xde\x8f\x05\xe8\x36\xe9\xe7\x06\x4d\x52\xc9\xc9\xb7\x4d\x90\x8c\x4c\x6e\x6c\xb0\x54\x51\x9a\xd2\x30\x2f\x47\x8e\x55\xab\x5e\x6a\xa5\x0a\x2c\x0b\x37\x73\x51\x29\xd8\xc3\x5f\xa3\xf7\x41\xfe\xd9\xe1\x36\xc5\x88\xce\xee\x33\xab\x1f\x6c\x58\x75\x14\x44\xba\x5e\xb4\xc4\xc3\x53\x50\x00\xfb\x7e\x95\x4d\x78\x9a\xf9\x55\x65\x8e\xd0\x42\x7d\x83\x1e\x76\xdd\xdc\xb9\xcd\x17\xb5\x4e\x3c\x00\x1f\xc5\xf4\x72\x5f\xf8\x5b\x5e\x2a\x7d\xa8\x00\xb9\x5c\x8c\x85\x2b\x8d\x0a\x5a\x21\x5e\x37\x89\xd6\xa1\xcd\xc1\xb3\x8f\x7d\x03\xfd\xe6\xd0\x16\xa1\x5b\x25\xc3\xf0\xd3\xad\x7d\x2a\x64\x6d\xb4\x20\x25\x40\x9e\xb5\x9b\xfd\x6c\x6c\xf2\x3c\xce\x6d\xe2\xd5\x53\x72\x19\xaa\xb8\x1b\x12\x74\x2e\x03\x41\x30\x3a\x87\x82\xbe\xc7\x4f\xcb\x72\x1c\x5c\xaf\x08\xff\x78\x98\xfa\x3a\x0b\x68\xa5\x27\xb7\x32\x04\x4a\xbe\x13\xc7\xde\xae\xaf\xe8\x16\x4a\x59\x62\x82\x2c\x9e\xd2\x31\xa6\xa2\xdc\xec\xdf\x64\x07\x53\x88\xbf\x60\x29\xfb\xb8\x79\xbf\x13\x7c\x9b\xc3\x53\xff\xb5\xe4\x62\x82\x41\xa8\x34\xed\xa1\xcc\x22\xab\x14\xd3\x76\x52\xf2\x09\x1c\xe6\xe0\x44\xa6\x0a\x23\xc1\x53\x9b\x18\xa2\x30\x45\xd2\x5f\xa0\x8f\xc5\x90\x86\x5d\xf7\x51\x01\xf4\xe2\xa4\xf3\x83\x5a\x34\xa7\x7e\x04\x7c\x47\x96\xf7\x79\xce\xca\xa0\x82\x26\xb5\xc1\xf1\x09\x6b\xab\x77\x13\x35\x24\x88\xe8\x4f\xa7\x2e\xff\x9e\xa0\xab\xeb\xc1\x0f\xbb\xe1\xab\xe7\x94\xc4\xa6\x47\x6d\xa3\x15\xac\x21\x64\xf9\xdc\xcb\x98\x03\x24\xe9\x2d\xda\x6e\xba\xea\x12\x21\x23\x6c\xd1\x2c\x46\xb3\x5c\xf1\xc6\xf7\xf8\x6f\x2a\xd9\x0d\x82\x2f\x86\x4f\xde\x53\xaf\xa3\xd7\x3d\x7d\xce\xd4\x16\x3e\x37\x32\x83\x98\x33\x99\x2f\x13\xb5\x84\xdd\x3d\x8c\x07\xa0\xde\xe2\x60\xe2\x42\xee\xaf\xe0\xd7\xfe\xfd\xe6\xe7\x15\xbc\x55\xa2\xb6\x13\xf3\xfb\x29\x4d\x4a\xb6\xf5\xce\x12\x52\x3a\x71\xee\x9c\x26\xf1\x04\x07\xc7\x60\xea\x2f\x4d\x11\xfb\x43\x5d\x6c\x7f\x71\x40\xbf\x76\xe7\x88\x1b\xe3\x4c\xd7\x04\xbc\x09\x9c\x49\x82\x48\x8d\x9e\xcb\x02\xbf\xd9\x39\xa8\x4f\xa7\x76\x3e\x9e\x41\x4f\x26\x17\xcc\x5b\xaf\x5d\xfd\x2b\xd0\xf0\xef\x86\x9e\xc2\xae\x7f\x2f\x7b\x7e\xd5\xfa\x51\x31\x74\x58\x45\xb1\x06\x25\x86\x18\x36\xcf\x27\x96\xa9\x0f\x6b\x69\x8c\x8b\xc1\x17\x10\xed\x9c\x4c\x47\x11\x71\xb7\x9a\xef\xfb\x6f\xd1\x7f\x54\x7f\xd8\xd7\xa7\x0b\xda\x4c\xcc\x0f\x96\xcf\x1a\x5a\x0c\x00\xa1\x87\xa2\x99\x6a\x90\x75\xab\xfa\x02\x7d\xe7\xd8\xca\xd8\xf8\x3d\x54\xbb\x3e\x94\xd3\xdf\x0b\xd2\xfb\xed\x64\xd1\x8d\x9f\x63\x5c\xb0\x44\x2d\x85\x80\x21\xd1\xe1\x4d\x15\xbe\x9d\x7c\xa2\x0b\x41\x83\x4b\x8e\x93\xcd\x91\xcd\x19\xdb\xd7\x40\xaf\x81\xed\xc5\x59\xbf\xd3\x63\xaf\xe8\xf0\xbf\x8a\x8e\xbd\x6b\x48\x2e\x1b\xfb\x0a\xa1\x55\x01\xe4\xdc\x1e\x49\xaf\xb4\x04\x31\x7c\x25\x78\x8b\x12\xae\x23\xcb\xf7\x99\x64\x01\xc5\x39\x5a\x75\xff\x57\x61\x1f\x2b\x0a\xf7\x5c\xa9\xcf\xad\x70\xa9\xb1\xd2\xa1\x18\x19\xe5\x10\x95\x00\xe8\x2c\xa0\x2c\x09\x1f\x4f\xcc\xb2\x02\x07\x6a\x5d\x6e\x35\xf7\xdd\xf1\x2e\xea\x78\x1c\xd2\xe2\x0b\xb1\x57\x85\x7d\x25\x67\xa5\x60\x95\xa6\x20\xe4\x16\xa7\x9f\xe0\x0d\x9f\x00\x80\x57\xcb\x85\xb6\x54\xed\x0a\x5c\x3a\xf7\xc8\xe9\x16\xf1\x1d\x76\x19\x54\x5f\xe0\x0e\x17\x60\x94\x01\x80\xe4\x0f\x67\xe2\xa6\x5e\x54\x04\x6c\x80\x0d\x69\x78\x04\xb3\x54\xd1\xca\x18\x25\x3f\x40\xa5\xac\x6f\xd4\xa4\x7e\x87\x1b\x96\x5e\xd8\x15\xe9\xc7\xa3\x49\xf8\x59\xdb\x66\xa6\x1e\xec\x27\x90\x16\x4a\xaa\x96\xf6\xfb\x0c\x3f\xa1\xb0\x92\x24\x12\xeb\x9c\x4d\xa4\x51\x98\x5a\x28\x0a\x63\x66\x65\x52\xf8\x06\xbd\x0f\xbb\xe8\xa3\xf0\xe9\xe9\x86\x21\xb9\x5e\xf6\x8d\xdb\x32\xc7\x3d\x2c\xdf\x5b\x4d\xdd\xf7\xcb\x4c\xab\xcb\xc8\x1d\x26\x79\xb9\xa9\xda\xbc\x07\xcf\x73\x58\x75\x2f\x77\x6c\x49\xf3\xde\x7e\x0e\x1c\xdc\xf4\x8b\xba\x3e\xd7\x83\x5b\xc5\x4b\x84\x26\xd0\x97\x43\x2e\xf3\xf7\x35\xbc\x45\xf8\x94\x2c\xd6\x8d\xed\xc1\xc1\x0a\x3b\x0c\x51\x9a\xeb\xa2\x56\x2f\xba\x6f\x0c\x0a\x30\x72\xbb\x8f\xfa\xe3\x67\x73\xbf\xa2\xac\x78\x2b\xb3\x3d\x30\x73\x48\xec\xe6\x64\x6f\xdc\xee\xfa\xbe\xae\x1b\x2f\x31\x20\x4a\xb9\x1e\x93\x52\xd0\xcf\x71\x29\xea\xcf\x71\x43\xea\x56\x85\x5b\xf9\xe1\xe0\xae\x45\xdd\x81\x41\x58\xba\x55\x5c\x3c\x25\x38\x61\x18\x42\x20\x14\x21\x11\x6a\x87\x87\xb8\x1f\x11\x10\x42\x52\xd1\xa3\x9f\xe4\xde\x6e\x93\x07\x0e\x44\x0e\x97\x4a\xbd\x70\xed\x15\x9b\xce\x09\x61\x5e\xd9\x93\x32\x1b\xd8\x15\x62\x11\xf0\xd0\x56\x41\x02\xbf\xbc\x5a\x4c\xab\x37\x0f\x26\xe9\xc2\x29\x7c\xb3\xd4\x60\x59\x8f\x7d\x38\x7c\xe9\x73\x28\x4b\xdb\x08\xe1\x94\xc6\xeb\x01\x39\x91\x23\x2a\xdf\xcf\x82\x8e\xec\xe5\x75\x18\x95\x23\x7c\x75\xbb\x2b\xc6\xed\xb9\x92\x44\x2b\xea\x85\x22\x2a\xc6\xe5\xbd\x4c\xf1\xe7\x57\x0f\xde\x7d\x3c\x4e\x7d\x7d\x99\x09\x63\x18\x0a\x61\x3b\xca\x8b\x28\x24\x40\x7d\x88\xc6\x1e\x1f\x5b\xb5\x19\x82\x09\xa2\x7f\xb7\x45\x42\x58\x69\x3f\xbc\x17\x35\xaa\xfb\xc0\x34\xf0\x35\x5d\x08\xc9\xed\x8e\x9f\x83\x20\x4e\xa6\x83\xf2\xac\x9d\xb3\x64\x2c\x9b\x8f\x0c\x78\x17\x46\x45\xe8\x0f\x1f\xf1\xd9\x7b\x38\x8f\x81\xc8\x9a\xe2\x97\x95\x53\x14\xb2\x92\x01\x3e\x4d\xbb\x00\xbe\xed\xcf\x84\x5d\x2f\x46\x09\xa3\x60\xbd\xf0\x27\xad\xf2\x94\x80\xfa\x35\xd0\x87\x01\x6b\x8e\x0a\x16\xd5\x28\x03\x5b\x1b\x6a\x66\xa3\xa6\xb1\x3e\x5f\x89\x1a\xda\x9f\x8d\x7c\xf1\xaa\x5b\xd5\x8c\x27\x72\xa8\x0d\xfd\xf4\x2c\xc9\x59\x19\xf6\x8d\x3b\xbc\x3f\x34\xfa\xe3\xfb\xf3\x33\x65\x88\x40\x20\x18\xc7\xa0\x9d\x8f\x78\x28\x1a\x52\x18\x4a\xbb\x8f\x69\x2f\x39\x19\xd0\x4d\x09\x2d\xd1\xe0\x21\x79\x0b\xd8\x01\x61\x8a\xae\xc3\xd7\x9e\x32\x4f\x4d\x05\xb1\x07\x06\xf2\x55\xde\xcc\x06\x32\x7f\x61\xae\xd3\xae\xae\xc0\xbe\x2d\x96\x9f\x06\xec\x7d\x91\x60\xa1\x81\x36\x41\xaf\x4a\x4b\xd6\xc0\x89\x37\x81\x1b\x56\x02\x66\x6d\xa4\x53\x65\xd9\xf8\x01\xbb\x9e\xa3\x8b\x93\xb1\x67\x69\xff\x77\x70\x8f\x01\xe7\xf1\xa0\xca\x25\xba\x14\xe7\xb8\x93\x38\xe0\xc8\x04\x02\x0d\x2c\x13\x9b\xf7\xfc\xe0\x35\x26\xc3\xcc\x78\x60\x27\xcc\xad\xcf\x9f\x03\xae\xac\xae\xbc\x7a\x2c\x8a\x10\xf4\x8b\xda\xcf\xe7\xba\xcb\x3f\x67\x92\x84\x94\xe2\x44\xf4\x04\x6b\xee\xc5\xb5\x96\x8c\x1e\xba\x16\x22\x01\x84\xb0\x20\x78\xf0\x6a\x04\x89\x83\xe5\x6d\xe4\x06\xe2\xbd\x1c\x96\xbe\xae\x76\xe9\x10\x76\xee\x50\x77\xe0\xcf\x1e\x23\x4f\x21\x09\x70\x0b\xc2\x23\x33\x0d\xeb\x21\xbf\xf7\xa4\x76\x38\x28\x50\x1f\x1a\xc6\xa5\xb5\x06\xef\xec\x36\x97\x2b\x6b\x72\xae\xab\x1c\xf8\xd2\xf8\x05\xe4\xc7\xf4\xa8\x18\xa8\x06\x3b\x91\x74\x09\xe2\x88\x38\x45\xd4\xde\x42\x26\x11\x36\x29\x37\x97\xc6\x1c\x56\x33\x11\x1c\xaf\x01\x7f\xf7\xf6\x35\xf6\x0b\xc8\xd4\xbf\x86\x4c\xff\x6b\xc8\x4f\x1d\x05\xfe\x73\xe8\x21\x2c\xcb\x8f\xf7\x42\xd9\x58\xa5\xc3\xae\x0b\x9b\xba\x7b\xd2\x36\x10\x0c\x11\x30\x86\x51\x28\xbd\x73\x23\x08\x0e\x5d\x32\xa2\x20\xe4\xe9\x71\xc2\x84\x78\x38\x9c\x08\x60\xc4\x9f\x67\x09\x41\xea\x6d\x1f\x8b\x17\xcc\x14\xb9\xf0\xec\xf3\xc9\xf9\x98\x0c\x4c\x90\x94\x72\xf6\x69\x9b\xbf\x67\xa7\x9f\xd4\x03\xd3\x15\xf4\xc9\x62\xd5\xf0\x32\xd5\x54\x75\x77\x66\x45\x96\xbd\x62\x3a\x66\xe0\xfd\x76\x4f\x8e\x90\x6c\x39\x87\x8a\xab\x13\xaf\x7f\x11\xd2\xa1\x2e\x82\x1e\x8c\xdf\x9c\x07\xec\x07\x02\x7d\x9f\xe1\x57\xcf\x0e\x79\x04\x5d\xbe\x01\x3a\x47\xd7\x06\xf7\x0c\xd7\x33\x58\x5c\xa2\x63\x64\xda\x8c\x16\x5f\x00\xda\x2e\x26\x97\xbf\xce\xe7\x97\x39\x8c\x6f\xea\xb9\xf7\x93\x32\x0d\x06\xf0\x2d\x44\xec\xea\x71\x08\xbb\xcf\x32\x7c\xb3\xd7\x52\xf0\x33\x98\x50\x87\xd2\xe5\x79\x8e\x74\xed\x21\xcb\xfa\xf3\x89\x34\x75\x76\x19\x0d\x23\x6d\xa4\x56\xcc\xa9\x1e\x7b\x8e\xcf\xf3\xb4\x4f\xc3\x22\x7f\x8b\x70\xfd\x24\xfc\xa3\x0e\xe6\xa9\xef\xae\xc3\x30\x07\x9c\x4e\xab\x78\x51\xea\x71\x1c\x51\x31\x5a\xfc\xc4\xb7\x6e\x86\x72\xe5\x73\x37\x1e\x26\xeb\x9e\x26\x91\x28\x63\xea\xdf\x22\x3f\x8f\x19\x64\xa4\x3b\x03\x6c\xd2\x25\x25\xa0\x74\x4f\x99\x13\x21\xf6\xe5\x32\x82\xb0\xc1\xd9\xfe\xbd\xc7\x46\xf5\x72\x06\xb2\x03\x72\x81\xa0\x2f\x91\xe3\x7a\xa8\xeb\x2f\x9c\x8a\x7b\x22\xba\x57\x40\x3a\x1e\x2d\x7f\xca\x85\x1b\x38\xa8\xbe\x96\x43\x70\x09\x1f\x71\x41\x3b\xf6\xf3\x25\x54\x81\x5a\xe5\x65\xdf\x7f\xde\xcf\xbc\xae\xfa\xba\x1b\xc2\xea\x67\xd3\xc6\xa0\x9e\xfb\xdd\x10\x76\x65\x5a\xb9\xc5\xae\x0f\xdb\x31\xac\xfc\xf0\x8b\x56\x36\x3a\x04\xc0\x55\x7c\x63\x8e\x97\x65\x6a\x0d\xb4\x71\x80\x12\x44\xf2\x66\x88\x98\x7e\xbb\x48\x46\xce\x62\x8e\x08\x1e\xe2\x1b\xe8\xab\xff\x39\xe1\x7b\xc2\x91\x3d\x50\x37\xd8\xd7\xdd\x88\x6b\x94\x49\x4a\x84\x45\x3e\x8b\x13\xdf\x9f\x4c\x26\xae\xe0\xc5\xb2\x23\x58\xf6\x78\x35\x59\x67\x9f\xfa\x2f\x98\x7e\xc7\xd4\xba\x87\x5a\xec\x9f\xc2\x8e\x41\x08\x42\x40\x28\x44\xe2\xf0\xce\xa3\xb0\x10\x85\x5c\x3f\xc0\xe1\xc7\x5c\x19\x00\xd5\x8d\xe2\x22\xaa\xe3\x0c\xa7\x23\x73\x74\x8a\x2c\x05\xb5\xdc\xf4\x05\xe4\x94\x57\xfb\x1e\xe4\xee\x35\x36\x8b\x41\x21\x1f\xfc\x57\xba\xa6\x0b\x87\x61\x7d\xe8\x83\x71\x01\xd9\x59\x86\xb6\xb0\x73\xb8\x52\x3b\x1a\x6e\x73\x53\xd2\x21\x27\x22\x40\x45\x01\x68\x73\xe1\x4e\xcd\x37\x73\x94\x52\xe9\x28\xfc\x25\xd6\x63\xa6\x61\xbf\xa2\x45\x40\x16\x74\x04\xa5\x1e\x27\x5f\xd5\x2b\xcf\x4b\xb6\x2d\x08\x5e\x12\x79\xfc\xad\x44\x59\xcc\xe9\xeb\xf3\x55\x93\xeb\x4f\x90\x7f\xbc\xd5\x7e\x3c\x38\xcd\x51\xb5\x2b\x82\xb0\xf8\xee\xda\x28\xb6\x5c\x64\xf3\x55\x6a\x6b\x18\xd2\x73\x3b\x81\xa3\x15\xf5\xed\xb4\x46\x0a\xd4\x63\xfb\x4f\xab\xfb\x91\x33\xff\x73\xb2\x18\xde\xb3\x1a\xae\x0d\xc5\x91\x97\x34\xcc\x98\x79\xba\x0f\x54\x5c\x67\xa9\x09\x6a\x39\x95\xe5\xab\xfb\x00\xe4\xae\x78\xd3\xcd\xc3\x5f\x20\x3d\xbe\xb8\x39\x7b\x13\xc0\x9d\x2d\xd4\xd0\xc1\x4b\x7a\x9d\xcf\xa1\xad\x86\x70\x18\xb5\x83\xb1\x80\xa9\x99\xb8\x25\x88\x4d\x51\xb0\xef\xe4\xe7\xd5\x2b\x52\x0f\x6c\xda\xcf\x42\x89\x5b\x86\xa3\xba\x96\x37\x4f\xb0\x01\x14\xba\x16\x1e\x70\xbb\x1b\xf7\xb7\x94\xde\x17\x18\x7d\xf7\x54\x61\x92\x53\x10\xc5\xac\x97\x07\x2f\xff\x8b\xf7\xb3\x7f\x74\x42\xa3\x25\xab\x44\xc6\x2d\x77\xc7\x02\x15\x5d\xd0\x3c\x51\xa3\x13\x94\x16\x3c\x4a\x3c\x77\x5a\xb6\x11\x92\x2d\x7e\x3c\x97\xbc\xfd\x3d\xd0\xc3\xc0\xf6\x85\x29\xe3\x0b\x76\xc0\x74\x1c\xc3\x06\x6b\xe3\x89\x44\x8c\xbc\x48\x9d\x4a\x94\x0f\xbc\xeb\xd2\x95\x71\xcc\xc9\x7b\xc5\xa6\x5f\x07\x16\x27\x43\x3f\x84\xcd\xff\x7e\xdb\x0d\x9d\xeb\x87\xdd\xce\xaf\xcb\x8f\x4e\x70\x1f\x55\x8d\xf5\x73\xeb\x13\xe8\xbd\xe1\x2e\x8a\xbf\x49\x81\x8f\xa0\x30\xe4\x42\x18\x89\x3d\x0a\x5d\x9b\x17\x49\x72\x69\x61\xf7\x0e\x94\x83\x95\xd3\xbe\xc2\x2b\x9b\xcb\x64\x96\x50\x66\xb8\x71\xb9\x10\x2c\xc9\x1f\xcf\xc3\x5d\x95\xa4\x5f\x1d\xd2\xc7\x10\x60\xea\xe9\xf8\x65\xc5\x11\x6e\xc6\xde\x36\xb1\x26\x0d\x2e\x93\x03\xdc\x39\xc8\x60\xee\x90\x73\x20\x2c\x43\xdb\xf7\x4d\xbc\xf7\x37\x9f\xe3\xbb\xcb\xb3\x61\x2f\xd6\x68\xf8\xee\xc5\x1f\xf4\x03\x7e\x9c\xca\xdd\x3b\x4d\xca\x98\x50\xa4\xed\xf8\xb8\x1e\x08\x2e\x02\x0a\x58\x73\xc9\x21\xb5\x36\xf6\x6c\x9e\x0d\xc8\x56\xd5\x2d\x42\xc8\xe6\xf3\x09\x2f\xdd\x61\xa8\x3e\x9e\x2e\x16\x75\xf7\x16\x12\x7d\x80\x3f\xba\x3c\xf4\x34\xf2\x21\x75\xef\xf1\xf6\x5e\xdd\x5c\x0b\xf4\xc1\x3d\x23\xd1\x6e\x31\x00\x66\x0d\xdc\x74\xf7\xca\x26\x2e\xb7\x8c\x02\xe3\x18\x5f\x63\xa7\xbd\xfb\x53\xcc\x9f\x95\x9e\x04\x14\xda\x5d\x67\xda\x53\x78\x92\x83\x4b\x56\x80\x63\xc4\xd6\xe9\xb5\x60\xf0\x25\xb8\xe6\xad\x76\x0e\xe4\x45\xb2\x74\xd2\x49\xb1\xbf\x07\xc6\x7e\x27\x70\x37\x56\xe1\x9c\x06\x7f\x84\x21\x4f\x85\x61\x73\xc9\x53\x41\xbf\x90\x80\x45\x19\xcb\x86\x0a\xfc\xd9\xe6\x71\xf5\x36\xc7\xb1\xd0\xb7\xcc\xd6\x8c\x1b\xce\x83\xa0\x01\x1f\xbf\x59\x8d\xbe\x2d\xd2\x21\x44\x3f\x2e\x30\x9e\x7a\xfa\x2a\x2b\x21\xb6\x1b\xc7\x41\x58\x98\x0a\xbd\x8e\xa3\xec\xf8\xa6\x18\x28\x6a\xcb\x97\x5a\xb0\xfb\x6b\x9b\x7b\x4d\xe5\xf7\xb2\xff\xb7\xc8\xf0\xcf\x17\xe8\xe5\x65\x3d\xc9\xa2\x46\x01\xe6\x44\xb3\x7b\x83\x54\x9a\x44\x74\x91\x24\x3f\xb4\xcb\x92\x54\x54\x8a\x22\x67\xe2\xa8\x64\x30\xa8\xee\x7f\x09\xf3\x31\x67\x2e\x14\xa4\xec\xed\xb5\x3d\xd9\x24\x56\x75\x9a\x12\xe8\x22\xea\x6b\x4c\xde\x68\x66\x0f\x10\xf1\x42\xc2\xb7\x44\x4d\x8b\xa6\x1f\xfb\x4f\xd0\xef\x0e\x56\xf0\x53\x72\xff\xdf\x1f\xc5\x5a\xff\x2f\x5c\x86\xb0\xea\x3f\x5e\xe7\xff\xd9\x16\x0f\x4b\x1a\x3a\xcb\xf9\x25\x91\xf8\xbc\xcd\x3b\x06\x15\x16\x28\x0b\xe3\x1b\xde\x2f\xa0\x96\xb7\x7b\xad\xe8\x2d\x87\x68\x84\x39\xfd\xbc\xe0\xbf\xca\xf3\x78\xbd\x4b\xf1\x21\xbd\xaa\x72\xcc\xb6\x84\x83\x5f\x02\xb2\xdf\x52\xda\xf3\x99\xe8\x5a\x1f\x27\x10\x0d\x7c\x62\xb9\x49\xf9\x05\x51\xd8\x67\x6f\xa9\x4c\xc3\x3c\x06\xff\xcc\x44\xc1\x8f\xe7\xd1\x82\x15\x65\x2e\x84\x7d\x3c\xb4\xa3\xc5\x48\xcc\xc1\xa4\xab\xdc\xa4\x59\x77\x8b\x42\xea\xe6\x07\xa7\x0d\xbc\x64\xe4\x84\xca\x5d\xfc\x82\x39\xf8\x49\x58\x14\x09\xe8\x17\xe9\x67\x5d\x9f\xc8\x42\x91\x91\xfc\x41\xa0\x88\x48\xf0\x9b\xab\x17\x90\x2e\x74\xf4\xbb\x45\xb7\xc2\xa1\x98\xcf\xdc\xc9\xf7\x43\xbd\xd0\xa6\xd6\xff\x06\x36\xae\x77\x49\x5d\x86\x41\xfa\x45\xbe\xfc\x16\xad\x2e\x6b\x34\x36\x5b\xc1\x66\x11\x61\x82\x61\xa7\x1c\x1d\x29\x77\x6c\x8f\xcf\xe7\x2e\xbf\x6c\xc2\x06\xcb\x70\x0b\x35\xdd\xeb\x42\x3c\xa0\x0f\x61\x3f\xa4\x55\xbc\x4b\xab\x21\xec\x22\xd7\xff\xa2\xaa\x2c\xd7\xc2\xd2\xa9\x83\x39\xeb\xfd\x05\xd0\x4e\xc9\xbe\xa6\x42\x21\x39\x7a\x3d\x02\x30\xc7\x58\x1b\x8e\x4a\xc8\x81\xe2\x01\xd8\x16\xe1\x5b\x9e\xe5\x73\x41\xd5\x2d\xa0\x8b\xeb\x19\x02\xdc\xb4\xbc\x30\x29\x89\x96\xa7\x3a\xba\xc1\xab\x9f\xd9\x54\x71\x41\x47\x47\x42\xcf\xca\x0d\x43\x98\x93\xfc\xdd\x9a\xa7\xb5\x9f\xb8\xd5\xe7\x01\x9f\x67\xbb\x2c\xe0\x6a\x64\xc8\xe5\xba\xf5\x0d\x8e\x04\x4a\xc3\x1f\xa3\x68\xba\xb2\xdc\xd8\xf4\x27\x48\x1d\xbd\xfc\x26\x1d\xd6\x9b\xfd\x0d\x72\xe9\x36\xfd\xd0\x8d\xfe\x30\x76\xcf\xcf\x39\x28\x88\x82\x29\x18\xc1\xd1\x9d\xef\x12\x68\x40\xfa\x10\x81\x84\x8f\x11\xce\xd1\x34\x79\x34\x8a\xe7\x71\x63\x79\xfc\x46\x19\xe7\x53\x6a\x1d\x7a\x24\x81\xb0\xdc\x48\xe8\xc8\x8a\x0e\xee\x31\xe8\x31\xd8\x87\x7f\x8d\x1b\x7e\x69\xbc\xf8\x1f\xe3\xd7\x41\xd8\x55\xbb\xb8\x06\xfd\xba\xf2\xc7\xae\x7b\xce\x76\x51\x10\x82\x50\x6f\x11\x28\x4e\xef\x42\xc8\x45\x69\x17\xf3\x3d\xec\xa9\xfb\x02\x11\x9c\x59\xa8\xdc\x2b\x58\x9a\xdb\x82\x37\xad\x3e\x19\xa5\x59\x66\x9d\x83\x40\x5d\x2f\x15\x25\xc4\xe8\x99\xc1\xd9\xb6\x66\x68\xf5\x9f\x31\xa3\xd0\xfb\xeb\x1c\x0c\xdb\x79\xae\x1f\xd0\x3e\x19\x46\x70\x10\xfc\x56\xe6\x9f\x19\x31\xe4\x89\x97\x84\x60\x08\x41\x31\x04\xda\x61\x1e\xe9\xba\x18\xea\x13\x24\xf6\xb8\xd6\xde\x82\x14\x72\x95\xcf\x26\x3b\xb6\x8c\xb0\x1c\xb3\xa8\xb0\xce\x96\x5b\xdd\x05\x0f\xd5\x8a\xa2\xf4\xd8\xb5\x58\xa5\x6e\x8a\x27\xe8\x6f\x79\x5f\xb5\xd8\x7f\x8e\xdd\xa5\xf9\x58\xa5\xa0\x1b\xfa\x0f\x8f\xcd\x2b\x85\x66\x99\x68\xee\x26\xbb\x62\xb4\xd8\x26\xda\x88\x53\xf3\x35\xb6\xb9\xd2\x9e\xaf\x69\x11\x2a\xc7\x7c\xde\x26\x2c\x30\xa8\xfd\xdf\xc1\x3d\x26\x04\x3c\x51\x48\xb7\xf2\x56\xfa\xb8\x24\x29\xad\x66\x7b\xcb\x15\x73\x0c\xe8\x06\x01\x0a\x02\x41\xc0\x60\x54\xc7\x85\x54\xee\x65\xff\xa2\xbf\xe6\x74\xc8\xeb\xf9\x8f\x86\x2e\x6f\xae\x57\xfe\xd4\xfd\x0d\x46\x68\x88\xc6\x29\x9c\xdc\xf9\x3e\x0a\xd1\x21\xe6\x22\x08\xf2\xe4\xf9\x69\x56\x0a\xe0\x55\x7b\x60\xac\x32\x4f\xf8\xa9\x25\xc9\xf2\x76\xb6\x3a\x56\x9c\x13\xca\x5b\x92\x58\x27\x03\x30\x14\x48\x05\x7b\xb6\x29\x95\x3b\xf4\xbb\xb4\x06\xb3\xf9\x8b\x07\xbb\x91\x66\xb3\xdc\x81\xa6\x97\x42\xef\x5b\x63\x13\x6e\x37\x98\x49\x49\xdc\x60\xce\x44\x91\x5d\xcf\xea\x75\xbf\x1f\x71\x33\x2e\x9a\x5b\xfc\xd7\xa0\x8f\x87\x04\x0c\x47\xb1\xd5\x6f\xb0\xc3\x01\xdb\x96\xd9\x9d\x4d\x36\x7d\xe8\x74\xfa\x60\xa9\xb7\x6c\xcc\x58\x72\xc9\x14\x9f\x9a\x2f\x44\xf8\xf5\x48\xdf\x3f\xfb\xb0\x9b\xc2\xee\xcb\x4e\xab\xfb\x28\x07\x66\xcd\x69\x73\x49\xed\xc1\x06\xc3\xc7\x7b\xd0\x99\x97\xd1\xf5\xd0\x78\x9e\x50\x87\x62\x31\x5e\x67\x46\xea\xc4\x81\xf9\xb7\xf8\x3f\x3e\xd2\x59\xcf\xd9\x1e\x27\xe3\x0c\xf8\x02\x92\xd1\x19\xa2\xb3\xc9\xb8\x69\x26\xa3\x21\x21\x09\x68\xd5\x25\x4d\x05\x75\x6d\xd7\xed\x70\x00\x1d\xaf\x79\xc9\x94\xfc\x09\x9c\x87\x6b\xff\x39\x5e\x5a\x9a\xea\x78\xb8\x48\xf3\x79\xcf\x30\x17\x33\x89\x22\x3f\x0a\x60\xc9\x59\x08\xb3\x97\xb5\x63\x73\x18\x63\x6f\xd9\x52\xd1\xac\xc9\xbf\x85\x45\x7f\x27\xec\x97\xf7\xab\x30\x3d\xfb\xca\x86\x2a\x09\xda\x42\x67\x6f\x4d\xdb\x3e\x80\xf2\x50\xb1\x4f\xe4\x9e\xc6\x87\xb3\xb2\x84\x17\x00\xbe\xde\xae\x32\xf2\x7c\xc0\xeb\xbc\xa8\xe3\x8f\x7f\x3f\x9f\x88\x23\x6b\x02\x0c\x59\x26\xdd\x86\xd5\xc0\x18\x5e\x9a\x05\x10\xdb\x7c\xbd\x6b\x27\xc9\xba\xda\x87\x29\x4a\xef\x43\x70\x1a\x3c\xeb\xd4\x7f\x01\xda\x8d\x5f\x58\xb4\xa0\x48\x1a\x50\x23\x71\x5d\x99\x68\x72\x84\xee\x96\x31\x70\xa1\x09\x16\x9a\x68\x29\xe2\x2d\xd0\x47\x13\xd2\x9d\xa9\x2b\xbb\x17\x2f\xb1\x2e\xc2\x7c\xcc\xeb\x2a\xaf\xc1\xf7\xc2\x87\xb9\x4b\x87\x8f\x76\x51\x7f\xd6\x43\xc1\x08\x82\x20\x18\x82\x62\x3b\x17\x42\x10\xdc\x43\x23\x04\x7d\xfa\xfd\x8d\xa9\xe7\xd4\x63\x00\x96\x06\x46\x70\x80\x83\xcd\x49\x35\x6f\xbe\x70\xab\x4e\xa7\xed\x6e\x49\x7e\x31\x0d\x97\x3a\x15\xc4\xbd\x28\x3c\xc7\x85\x75\xd5\xa7\x60\x9c\x56\xf9\xc7\xe1\x7b\xfe\x75\xa5\xe2\x32\x56\x4c\x0f\xda\x25\x71\x61\x70\x87\xce\x6c\x5d\x23\x89\x63\x3a\xea\xe5\xc2\xe9\xdc\xd8\x5f\x4f\xa1\xae\xf2\x80\x65\xbd\xf4\xe6\x7c\x01\x25\x7f\x23\x68\x5d\x86\xb1\xfb\xf9\x71\x55\xb8\x00\xb1\xc7\x1b\x20\x6b\x8e\x0c\xc3\x09\xe7\xcc\x43\xee\x50\x7b\xb8\x7b\xc7\x18\x47\x7d\x02\x4e\x2c\x05\x84\xd9\xc6\x1d\x5f\xc2\xec\xba\xf9\xf9\x1c\x3f\x4e\xab\xe7\x97\xa8\x28\x8c\x23\x10\x84\x43\xf8\x8e\x26\xa1\xc0\xc3\x11\x28\xf4\xc3\xc7\xb4\x3b\xbf\xad\x41\x27\x05\xd6\xa1\xe3\xcc\xad\x17\x10\xb4\xb0\xe5\x30\x83\xf9\xc1\xaf\x7a\x9a\x61\x63\x1a\xe5\x72\xc8\x63\x04\xf9\xe5\x91\xed\x7b\xd3\xe1\x3f\x7a\x53\xbe\xb7\x4b\x0c\xd2\xf8\xbd\xa3\xec\x9f\x76\xc2\x6d\xae\x86\xd5\xe3\xb0\x85\x2b\x45\x32\xae\xa7\x75\xa3\x8f\x6c\x18\x32\xc2\xd5\xe0\xf6\x20\x51\x59\xd4\x35\x05\xd7\x6b\x92\xe0\xc6\x3f\x81\x7e\xac\xa1\x39\x6f\x85\x94\x67\x90\xae\x6d\xaa\x13\x9d\x73\x73\xda\x3c\x05\xe2\x19\x0d\x15\xc4\xed\xd0\x55\x13\xc2\xc8\x27\xcc\xc2\xa4\xbd\xf4\x57\x0c\x69\xe9\xc6\xe1\xae\x6f\xfe\x30\x4b\x1f\xbf\x95\x21\x85\xa5\x95\x6b\x33\xbf\x60\x4e\x06\x44\xe6\x62\xd5\x12\x7b\x2c\x41\xaa\xb7\x4f\xa7\xce\x30\xeb\xec\xb8\x27\x12\x50\xd7\x7c\xe1\x1f\x61\x3f\x9a\xbc\x61\xa9\x8f\xab\x75\x60\xb5\xe5\x80\x19\x20\xd9\xcb\x83\x89\x5b\x30\xbe\x49\x9b\xc5\x7a\xeb\xb9\x3e\x6a\x0a\xda\x93\xab\xb3\x87\x3e\x51\xbc\xd9\xb9\x37\x5f\xfa\xe7\x0f\x5c\xbd\xb7\xdb\xf4\x3e\x54\xfb\x93\xc8\x11\x08\x02\x23\x18\x84\x23\x3b\x17\x47\xa2\x37\x17\x04\xc3\xe8\x47\x0d\x22\xc7\x29\x61\x04\x47\x28\xc7\xaf\x22\x87\x0f\xf8\x5c\x94\xa7\x24\x04\x0f\x9c\xa8\xd4\x86\x84\x32\x77\xe7\x76\x8b\x84\xf1\xec\xa5\xfd\x77\x83\x00\x3d\xb7\x4f\xfd\x87\xa4\xc7\xa7\x5b\xfd\x88\x89\xec\xd1\x8d\x84\xc1\xcf\xd4\x51\x9a\xd3\x6a\xa6\x4f\x89\x2d\xce\xc7\xfb\x8d\x16\x2b\xa5\xc7\x8b\xa5\xb6\xe7\xd8\x22\xb1\x6f\x39\x1e\x27\xfd\x07\xc7\xe3\x3c\x8c\x5c\xde\xab\x01\x7a\x12\x7c\xe1\x14\x41\xb7\xd0\x8c\x95\x46\x0b\x8e\xb7\xa1\x90\xbd\xf6\x5e\xb8\xf0\x3e\xba\xd9\x8b\x62\x14\x48\xfd\x0f\x38\x9e\xed\xcd\x7f\xc3\xb1\xa5\x4d\x9e\x56\x7f\xee\xd7\xcf\x3f\xdf\xb6\xed\x7f\x49\x3f\x02\x97\x47\x85\x08\xce\xbd\x95\x78\x74\x5f\x2e\xb7\xc8\xda\x8b\x17\x74\x6e\x18\x8b\x34\xa9\xde\x3f\x4b\x03\x25\x53\x71\xe5\x4a\xec\x8d\x3e\x80\xfe\xfe\x1b\xc6\xff\x65\xfa\x30\x74\x8f\xb7\x79\x6a\xbc\x6f\xa7\xcb\x36\x5b\x9e\x06\x5a\x0d\x87\xed\x51\xff\xd4\x28\xc3\xb9\x73\xee\xb2\x90\x9e\x7d\x7c\xef\xdc\x49\x50\x61\x0e\x2f\xed\xe0\xbf\x43\x7f\xee\x9c\x20\xbb\x16\x31\x1c\xe6\xa0\xb4\x24\x97\xe5\x9c\x2d\xa6\xfa\x04\x10\x18\xf9\xba\xf0\x92\x52\x25\xea\x4c\xad\xb7\xac\xca\xb4\x55\xfe\xbc\xeb\x5f\xa3\x23\xbf\x01\xbd\x71\xfd\x61\x17\xd5\x63\x15\x7c\x74\x4f\x7f\xff\xfb\x8f\x03\xf5\x18\xa7\x8f\x87\x65\x3e\xdb\x58\xce\x6e\xb2\xe2\xf3\x5a\x06\x24\xac\x06\xf2\x8c\x77\xbd\x0a\xf3\x3c\x18\x99\xdf\x79\x90\x87\x83\x78\x7e\x91\x5e\x08\x7a\xdf\x2d\x82\x30\x2f\xea\xb7\x60\xed\xa5\xa9\x37\x42\xc2\x18\x8a\xc0\xc8\x0e\x27\x23\xc2\x75\x43\x9c\x86\xd1\xa7\xc7\x5a\x9b\x75\x24\x8f\xc2\x61\xb2\xae\xd6\x32\x73\x62\xbb\x9e\x6c\x35\x22\x1c\xc3\x95\x23\x31\x50\x60\x0c\xd3\x4f\xa4\x73\x5d\xe1\xe2\xd9\x4b\x68\xbc\xba\x2b\xdd\xea\xcf\x12\xfb\xe7\x5b\x9f\x3b\x28\x5f\xa1\xb1\x63\x4b\x77\x89\x68\xf3\xee\x05\x85\x4e\xb2\x88\xbd\xe5\x19\x22\x88\x69\x29\x57\x58\x0e\xc4\x83\x12\x08\x2f\x8e\x5d\x13\x76\xd1\x3b\xae\x1f\xfa\x75\x93\x16\xf5\x00\xf6\x4d\x18\x06\x1f\xbf\x29\xf6\xed\xd5\x1b\xc8\x5e\x86\xb6\x71\xf0\x98\x89\x61\x89\x4e\x5d\xc5\x13\x02\x45\x39\xad\xa2\xbf\xa7\x12\x31\xa8\x57\x22\xd9\x5b\x9e\x4b\xda\x2f\x7d\x8f\x9b\x34\xec\xba\xd0\x07\x8b\x0d\xfb\x29\xe0\x7f\x16\x30\xa3\x30\x44\x61\x18\x0a\xef\x30\x12\xf5\x03\xd2\x0f\x21\xd8\x7d\x3c\x6c\x28\x88\xca\xb4\x6c\x8a\x57\x28\x0b\x23\xb2\x61\xc2\xc4\xd3\xe1\xd6\x4a\x58\xa9\x13\x62\xcf\x96\xab\xe3\xde\x61\x5b\x15\xbf\x68\x7f\x41\xf9\x5e\xdd\xff\xdd\xa4\x9a\x20\x2f\x32\x69\x73\x04\x19\x83\x2d\xa0\x60\x51\x19\x19\x2a\x21\xc1\xfb\x63\x0a\x08\x87\x03\x4c\x4a\x78\xe6\x55\xb3\xc2\xfb\xcf\xc6\xb9\xc9\x63\xf0\xbd\x88\xb2\xff\xdc\xd9\xc8\x9b\xdd\x79\x89\x78\xe6\x28\x9b\xc0\x05\x49\x8c\x06\xee\xf8\x3d\x37\x46\x26\x2a\x48\x43\xc5\x69\x41\x04\x77\xb8\x2c\xc8\x87\x02\xfa\x4b\x4c\xf8\xb7\x63\xd2\x1f\x56\xf0\x78\x60\x2e\x0b\xbc\xdd\x10\x18\xab\xe7\x26\x5b\xc9\xb6\x67\xc2\x74\xf1\x0c\x2d\x1f\x13\x75\x3f\x77\x22\x6e\xcc\x97\x93\x3e\x0f\xd8\x5f\x83\xfd\xa6\x01\x36\x5d\x1d\xa5\x45\xf8\x45\x9b\x96\xf3\x8c\xd6\xf0\x35\xb8\x2f\x7d\x77\xcb\x32\x33\xef\xf7\x5a\x83\x5b\x34\x1e\x86\x07\xf7\x10\x35\xc3\x6a\x3a\xeb\x44\x9c\x0d\xe5\x59\x51\x36\x65\xb8\xb9\x5d\xf0\xe1\x65\xfc\xfc\x3d\x97\x3f\x3d\x18\x8c\x63\x66\xee\x00\xc9\xa7\x55\xad\x19\xde\xbb\xa8\xb6\x32\xdf\x0c\xa5\x66\x25\x4b\xc3\x99\xc3\x26\xe4\x60\x04\x17\x8e\xb7\x57\xa5\x5f\x03\x7d\xac\xa2\x13\x79\x92\xcc\xeb\x63\x62\x2f\xba\x08\x37\xbe\x56\xe5\x62\xde\x46\x7a\x1d\xf7\x5e\x4c\xc6\x8e\x6c\xda\x58\xaf\x71\xb6\x03\xbe\xac\x6c\xdd\x87\x55\xd8\x81\x6f\x67\xb4\x08\x87\xf0\xf3\x05\x57\x58\x42\x95\x74\x66\x63\x9f\x3e\x1d\x87\xb9\x2b\xcd\xf2\x20\x69\xa0\x73\x21\xac\x35\x59\x33\x89\xa9\x82\xee\x40\x27\x7b\xa6\xd0\x9e\x3d\x97\xa6\x1d\xc3\xae\x72\xc1\xf7\x66\x10\x3f\xbb\x17\xbe\xbc\x6d\x25\x61\x02\x25\x30\x7c\x07\xe3\x38\x8e\x42\x98\x47\x7b\xe8\xfb\x4f\xf6\x9d\x69\x8f\xdc\x00\x55\xdc\x97\x4a\x84\xd9\x97\xee\x18\x13\xb5\x6a\xd4\x43\xcb\xab\xa1\x21\x5b\x73\x3e\xd5\x64\xe6\x34\xf0\xe9\xe5\x1d\xe4\x7f\x48\xf9\x28\x95\x9d\x9d\x29\xa5\xdc\x62\x01\x2c\x2b\x66\xd9\xdc\x12\xab\xf6\xb4\xf6\x6e\xa3\x2d\x69\x36\x00\x05\x71\x6d\xbc\x2c\x46\x67\x08\x2f\xfb\xfe\xe7\x0b\x85\x9f\xbf\x15\xf0\xff\x3e\xf2\xc2\x9f\x8f\x2b\x79\xb3\x98\x10\x59\x31\x8e\xb8\x8a\xd6\x4d\x56\xcf\x86\xab\xd9\x06\xb8\x77\xef\x8a\xb8\x26\x60\xc0\x99\x15\x20\x3b\x1b\x24\x1e\xe7\x7f\xc0\xf0\x67\x03\x5d\x18\x21\x11\x04\x46\x61\x78\x87\xfa\x18\x06\x51\x3e\xe5\x51\xc8\xe3\x8d\x4b\x83\xa4\xda\xfe\x34\x73\x07\x6d\x68\x8b\x76\xd3\xee\x55\x77\x35\xab\x1e\x48\xe3\xaa\x55\x6b\x10\x4c\x2e\xf7\xd6\x5e\x1c\xdb\x7c\xe9\x83\xfa\x3d\xff\xa7\x88\xcf\xa3\x17\x02\x0e\x07\x4d\x47\x86\x53\xc5\xc8\x5a\x4a\x42\x57\xe5\x82\x1f\xfb\xca\x0d\x58\x4c\x5c\x51\x05\x3a\x13\x6c\x24\x94\xf5\x2f\x33\x3c\x4b\xe7\x5d\x32\x08\x07\x91\x32\x57\x13\xcd\x11\x0c\x58\xb8\x1d\x9b\xb3\x40\xdf\x52\x39\xe7\xec\x6d\x43\x97\xd0\x97\xe2\x9b\x05\x1e\x01\xe3\x97\x19\x9e\xf3\x2d\xc9\xd9\xcd\x90\x29\x56\xf5\x93\x5a\x9a\x6c\xbf\xdf\xb3\x57\xcd\x4f\xc3\xfb\xff\x4f\xdb\x9f\x74\xbd\xa9\x33\xfb\xff\xf0\xfc\xbc\x8a\x67\xee\xc5\x46\x7d\x33\x38\x03\xf7\x2d\xee\xfb\xc9\x6f\x09\x24\xc0\x0d\x60\xc0\xfd\xab\x7f\x96\x9d\xec\x7d\x1b\x27\x3b\xc9\xb9\xff\x97\x27\xb9\x56\x26\xdf\x0f\x32\x42\xaa\x2a\x95\xaa\x1a\xa6\x36\x74\xd5\xb4\xdb\x9c\x96\x3a\xd3\x60\xa6\xf0\x5b\xaf\x84\x5f\x11\x8a\x6e\xe4\xba\x37\x68\x4a\x6f\x87\x3b\xbd\xb1\xae\x50\x27\x18\x08\xbf\xb4\xef\x4d\x8d\xc0\xe5\x65\x93\x65\x09\xa6\x59\x6f\x7a\x3d\xc7\xed\x3d\xf9\x63\x02\xff\xb6\xca\xf6\xa7\xcd\x1b\xec\x28\x79\xa8\xdc\x06\xa5\x6b\xd9\xb4\xec\x74\xb3\x88\x7b\x59\xf7\x98\xe1\xb0\x33\xec\x6f\xd5\x7c\x76\x89\xb7\x07\x7d\xf8\xd3\x49\xfc\x94\x7e\xcd\x5f\x5c\xd1\xc5\x0d\xa9\x4b\xaf\x4c\x48\xbe\xbc\x90\xf2\x60\xe2\x37\x2a\xe6\x78\x1f\x0e\xc9\x96\x96\x6a\x2c\x9a\x97\xab\x61\xe9\x60\x90\xf3\x1b\x42\x94\x68\x53\xfc\x46\x39\x44\x10\x50\x08\x81\x45\x3d\x2c\x38\xd4\x42\xca\x42\x2e\x93\xe3\x4e\x9a\xa7\x69\x7e\xc0\xda\x5d\x56\x09\xd0\x4b\x36\x1c\x4f\x17\xb7\xee\xaa\xd2\xce\xa6\xcd\x69\x5d\xe6\xe9\xa8\x1f\xa0\x8e\x70\x7f\x37\xbf\xde\xe1\x12\x40\xf8\x58\x22\x08\x60\x16\x65\x1c\x31\x08\x18\x12\x85\x4b\xb0\x1f\x85\x23\x89\x30\x86\x88\x5b\xbe\xc6\xcc\x27\x08\x01\x25\x0b\x01\x2a\xa7\x0d\x69\x19\xcc\x86\x79\x2d\xaf\x77\x73\xa7\x2f\x6f\x57\x13\xb5\x93\xd5\x8e\x4d\x23\x54\x85\xcd\xe3\xaa\xa9\xfd\xe6\xf1\x98\xfe\xee\xc5\xfe\x08\x17\x10\x41\x4a\x10\x81\x16\x24\xbe\x01\x1a\xba\x00\x16\xea\x33\x7f\x00\xfe\x16\x7e\xfb\x7a\xc0\xf7\x9c\xcb\x53\x4a\x43\xa0\x4b\xcd\xd3\x75\xb3\xec\x75\x7a\xfd\x72\xc5\x09\x0e\x28\x9d\x2d\x6a\xc3\x4d\xef\x1c\x54\xef\x77\xa4\x67\xe3\x92\xbd\xb0\xff\x78\xba\xa2\x2f\x7f\xf4\x67\xc2\xc1\x8f\xd2\xd3\xbe\x7f\xef\xd5\xc0\xa0\xcf\xb3\xd6\xdd\xe9\x98\xce\x6e\x63\x66\xb5\xe1\x2a\x6a\x54\xf9\x24\xbe\xda\x37\xc1\xc6\x23\xb3\x87\xee\xee\xdf\xd7\x89\x7f\xa4\x8b\x95\x35\xbe\x54\xba\x18\xcf\xaa\x6d\x9b\xee\xa1\xe2\x1e\x68\x7f\xd5\xdf\xd4\xab\x57\xaf\x67\xcf\x63\x77\x59\xad\xaa\xd1\xa1\xab\xf1\xf1\x88\x58\x35\x38\xf5\x7a\xf9\x9f\xfc\x20\xc5\x0d\xf4\x16\xce\xfa\x62\x23\x2f\x77\xb5\x9c\xe0\x0b\x1c\x00\xbe\x0a\xaf\xad\x7a\xe5\x5a\x2b\x2d\x30\xbd\x68\xe1\xe6\xfc\xbc\xe5\xad\xc9\xe8\x0f\x9e\x1a\x7e\x37\xcd\xc6\xb7\x71\x99\x8f\xef\xcd\xe5\x68\xbd\x59\x94\x8e\x4d\x27\xe3\xf9\x55\x09\xda\x84\x77\x70\x1b\x1c\x1c\x98\xa6\xd4\x13\xfb\xbe\xba\x78\x7f\x26\xfa\xfa\x33\xef\x37\x47\x5b\xc7\xb5\x6e\x3e\x59\x34\xf6\xd5\xde\x74\xa1\xca\xd0\xbb\x55\x82\x6e\x6b\xe2\xe8\x69\x45\x80\xfc\x6e\xcf\x25\xcc\xaa\xff\xba\x64\x1c\xb2\xc4\xf3\xdf\x2e\xf5\x02\x0a\x09\x40\x50\x58\x50\x50\x97\x20\x21\x08\xc4\xaf\x47\x4f\x1e\x2e\x1f\xd9\xd8\x4e\x54\x5a\xef\x56\xfb\x3a\x10\x97\xd6\x1c\xfa\xc7\x09\x73\xc7\x2b\x77\x5f\x09\xdb\xdb\x6d\x5b\x9c\xa6\xa8\xdd\xdc\xfd\x31\xf6\xb1\x40\x72\x28\x08\xa3\xdc\x72\x7d\xa6\x28\x46\x46\xd2\x42\x95\xda\x2f\xc6\xbe\xfa\xe8\xd3\x6d\x3d\xe2\x77\x23\x31\x75\x5c\x33\x98\xda\xb3\xb0\x31\x6d\x3b\xab\x6e\x78\xea\x0d\xc9\xc5\xad\xe6\xd3\x75\xb5\x86\xdb\x62\x57\xff\xd7\x49\xf5\x2a\xfd\x6a\xf7\x91\xb2\xbd\x44\xc2\xbf\xe0\x86\x47\x25\xee\xa9\x71\xdd\xc9\xba\x4e\x3a\xe8\x9e\x67\xe5\xf8\x78\x71\x6a\xca\xec\x02\xd2\x38\x78\x7a\xf4\x27\xd2\xaf\x51\x11\x3e\xca\x44\x9e\x31\x4c\xc8\x39\x81\x9d\x74\xcd\x5a\x61\xcf\x33\x73\x99\xe0\x72\x07\x36\xfc\x52\xf3\xea\xb6\x52\x96\x98\xae\xfc\xa3\xa7\x16\x9f\x93\x86\xaf\xef\x71\x3f\x67\xc6\x0e\xa2\xb0\xee\x39\xb2\xbd\x6d\x35\xf3\xc6\xa0\x7a\xbd\x9e\xd6\xa5\x3b\x5c\xa5\xd5\x73\x46\x06\x65\xb2\x32\xab\x85\x56\xff\x6a\x63\xfd\x47\x1b\x7e\x4b\x7d\x6c\x80\xd2\x31\x3d\x87\x83\x5d\x9e\x22\x94\x7b\x6c\x53\x6d\x35\xe8\xa2\xb9\x5f\x6c\xf5\x65\x2b\xd1\x01\x9c\x74\x23\x84\xf3\x46\x65\x22\xfe\x48\xf3\x6b\x1f\x37\x3f\xaa\x63\xae\xff\xdf\xdf\xad\xfc\x9f\x8c\xb7\x92\xab\x67\xd1\x8a\x77\x49\x6f\xb4\x33\xf5\xed\xae\x5d\x27\x36\x8a\x75\xb9\xcc\x17\x3d\x78\x6f\x75\xbb\x7c\xe1\xa4\x8d\x51\x7a\xe2\x28\xfb\x57\xdb\xfd\x67\x90\xef\x59\x71\xe5\x46\x00\xa7\x5d\x7b\x97\xf4\x07\xc8\xc6\x8e\x58\xdd\x7a\xae\xd3\x6d\x2c\x6a\xd7\x61\x3c\xee\x80\xe6\x71\x32\xee\xc7\x43\x3e\xad\x0d\xfe\x4f\x43\x28\x2e\xcc\xab\x1e\x6e\x2c\xaa\x4d\xd1\xa9\x54\x8e\x6a\x36\xb9\x96\x03\xd2\xbc\xa3\xf5\xea\x84\x90\x3b\x11\x64\xe9\x08\xb9\x1e\x2d\x97\xd3\x45\x54\x9c\xe8\x99\x97\x25\x97\xbd\xb9\xfd\x4b\x47\x56\x08\x20\x83\x82\x60\x44\x2d\x0c\x21\x76\x05\x01\xd0\x15\xaf\xfd\xb8\xdc\x6a\x1a\xcf\xef\xa3\xdd\xfa\xea\x34\x49\x2e\xe2\xe6\x65\xb3\xa6\x7b\x3c\x9a\x55\x6f\xe9\x21\x91\xab\x92\xbd\x76\xd6\x72\xbe\x5d\xbf\x75\x32\xca\x92\xe0\x60\x0e\x07\x63\xfb\x2a\x3f\x7e\x0b\x47\xbd\xb4\x9f\x85\x80\x01\x89\x11\x02\x16\xe3\x88\x28\xca\xa5\x60\x85\xdb\x1b\xcb\xc5\x99\x4d\x92\x05\xe2\x07\xe8\x02\x2f\xed\xb7\xf6\xfb\x60\x42\x5b\x6d\xa7\x93\x29\x33\xac\x0e\x20\xbd\xd0\x7b\xf5\xde\x5e\xbc\x55\x20\xf8\x09\xf6\x3d\x0a\xb6\x9d\x6f\xd9\x72\xb9\xbe\x2f\xc7\x37\xdb\x99\x8c\xe9\x36\xac\x55\x6d\x94\xb2\x5a\xd0\xbb\x97\xe2\xac\xcc\x7a\xab\x6a\x6d\xb5\xe8\xd7\xdf\x2e\x81\xfd\x23\x1d\x24\xdf\x72\x57\xbe\xb7\x16\x7e\xbb\x4f\x2d\xdc\x5a\x1e\xf1\x2e\x1a\xec\xb3\xc6\x6a\x70\x88\x06\x8b\x7a\x6e\xa7\x2b\x01\x43\x93\x34\xc6\xde\x7e\x4e\x6f\x08\xef\x67\xb5\xce\xfb\xef\xb5\xdf\xbb\x2a\xfb\xfb\xef\xdf\x1d\x58\xbf\x4d\xb1\xea\x4c\xc5\x8d\xe3\xfc\x04\x72\x85\x47\x6b\xdb\xaf\xec\x03\x73\xd6\xcd\x51\xb3\xb7\x50\x75\x5c\x23\xad\x6b\x32\x9f\x8c\x92\xb0\xe6\x27\x7f\x26\xfa\xba\xf0\xdf\xab\x82\xb9\x62\x46\xae\x7a\xd6\xe2\x0b\x54\x65\x41\x12\x6d\xd0\xf6\x74\xec\x45\x22\xed\x82\xc1\x31\x6b\xd0\x85\x73\x3e\xbc\xf5\xa3\xcf\x4e\x79\x9e\x25\x79\x6e\xbb\x7b\xe5\xed\xfc\x6c\xa3\xd5\xed\x9f\xe2\x18\x4f\x7f\x66\x3f\x4c\xe7\xe5\xa3\x29\xb5\x4e\xad\xbe\x1f\x76\x6c\xd0\xab\x4a\x51\x9f\x2c\xc6\x77\xa1\xfc\x9b\x3c\x3a\x36\x18\x77\x45\x24\x93\xd2\xe8\xff\xa0\xfc\x9a\xb2\x3c\x8e\xae\xc1\x4d\x76\xef\x9d\xb9\x99\x48\x1b\x05\xcb\xd6\xf5\xb6\x4f\x97\x9b\xdb\xa8\xb6\x1a\x5f\xab\xf3\x3b\xa5\xdb\xc8\x0c\x16\xd3\xa2\x7d\x98\xdd\x54\x7c\x72\x9f\xd1\x93\xfd\x29\x7a\x6b\x79\xc2\x9e\x9e\x0c\xc3\x08\x49\x4b\xba\xd8\x68\xcd\x10\x40\xe2\x75\x72\xe6\x11\x3c\xba\xec\x94\xfa\x26\xb7\x4f\x25\x4d\x92\xa4\x91\x9c\xd2\x86\xbe\x3d\x9e\x41\x8e\x9a\x97\x2e\x9e\x8c\x6e\x41\x17\x4c\x8b\xbe\x78\xae\xa2\x93\xd9\x3f\xe6\xcf\x3d\x49\x76\xc6\x1c\x0a\xa7\x34\x12\x48\x84\x11\x40\x9c\x22\x0b\x79\x1e\xc0\xda\x3c\x4c\x83\xd7\x8f\x31\xd8\x94\xc0\xb2\xad\x00\x6c\x8e\x7b\xc8\x8c\x47\xf3\xed\x68\xd7\x6d\xa6\xdd\x06\x9e\x34\xe4\x79\x3d\xb6\x5b\xf1\xf0\xb4\xdf\x7b\x73\x54\x3c\x6e\xcc\x8d\x8a\x2d\x3b\x7f\x86\x6c\x5f\x4e\x85\x30\xc4\x90\x61\x8c\x90\x65\x10\x04\xd8\x20\x0f\xd3\x42\xd0\xa1\x76\xcd\xda\xf7\x69\xe9\xaa\xea\xfc\x16\x30\xba\xc5\x54\xdc\x6d\x65\x1a\xfa\x1a\xf5\xc1\x50\x8e\x96\x61\xfd\xae\x11\x38\xe7\xb5\xe2\xdc\xc8\xc3\x53\xe6\x25\x49\xcf\xce\x55\xbc\x39\x6e\xee\x46\xff\x3f\x15\x7b\x61\x92\xfd\xbf\x58\x45\xe6\x25\xb4\x36\xd4\x51\x52\x1d\xb0\xcb\xd9\xcd\x4b\xfc\x96\x75\x6e\xce\x60\x7c\x24\x76\x25\x5a\xd1\xf6\xea\xd6\xb9\x4c\xec\xdd\x60\xb3\xb8\x8a\xe8\xad\xe8\xcb\x1f\x01\x5e\x4f\xdd\xfb\xf7\xf0\x36\xf5\x66\xf3\x26\x99\x9c\xea\xc7\xed\x36\x59\x9b\xf9\x78\xd9\x8f\x6e\x3d\x1b\x0e\x2e\xc3\x19\x28\xb5\x3b\x66\x5a\x39\xfb\x6f\x03\xd9\x64\xa7\x43\x6e\x62\x7b\x9f\x04\xd9\xcf\xca\x58\xf4\xae\x66\x70\x98\x5c\x5a\xd7\x72\xa5\x13\xcd\x62\xdb\x69\xc2\xf6\x79\x1c\x54\xcb\x2a\xef\xaf\xa7\xbd\xc1\xae\x33\xbc\xba\xee\x89\xce\x17\xc9\xef\x84\x8b\x46\x7e\xbc\x01\x13\x77\x2f\xdc\xa0\x2a\xef\x62\x9c\xcc\x64\x93\xc5\xb5\x45\x9a\xe6\xb6\x9f\x12\x53\x1b\xde\x72\xa7\x52\x0b\x76\xb6\x7c\x6b\x4f\xfc\x53\xe1\x42\x53\x80\x9e\x73\xda\xb7\xf5\xf1\x38\x93\x8e\xd7\x9f\xe5\x87\x03\xb8\x06\xcb\xf9\x48\x85\x02\x35\x6e\x66\xc9\x9c\x8b\x6e\x9f\x56\x75\x94\xd5\x7f\x27\xcc\xbe\xbd\xc4\x59\xc5\xeb\xd7\xf7\x79\x76\x51\xf1\xe9\xe4\xe4\x71\x73\xb2\x5f\x45\xc7\x28\x70\x5d\x84\xd3\xda\x98\xea\x66\x89\x2d\xd9\x20\x11\xb2\xfd\x07\x8a\xaf\x76\xd2\xa9\x1f\x4f\xea\x1a\xd6\x82\x6b\xad\x57\xe5\xc4\x6f\xab\xf0\xec\xac\xa3\x68\x95\xb7\x9a\xeb\x66\x7d\xd0\xc8\xfc\x7c\x69\x9f\xca\xa2\x68\x77\xe4\x91\xca\x8e\xb7\xfc\x98\x19\x73\xcc\x6d\x95\xe7\x26\xfb\xa7\xbf\xd3\x7f\x7a\x91\x23\x0e\x05\xa0\x80\x5b\x2e\xd2\x06\x78\x2e\xf1\x11\x7b\x35\xc4\x07\xf1\x64\xb7\x59\xd4\xe5\x3e\x64\x97\x0a\x58\x55\x38\xcf\x47\x49\x84\xe3\x8b\x19\xe9\xa0\xbc\x4d\xab\x69\x9e\xf8\xd9\xb8\x3f\x0d\xbc\x5f\xd0\x83\xc4\x4b\xe2\xb3\xb9\x7d\x1b\xdd\xab\x63\x9e\xdf\xce\x1b\x60\x2b\xb1\x69\xac\x5a\x24\xb3\xc7\x9b\xeb\xe5\x7c\x33\xe5\xce\x56\xf7\x26\x72\x34\xe7\x8b\x91\x7d\xdc\x36\xa6\xfb\x7d\xaf\x68\x05\xe6\x49\x68\x36\xfb\xf0\x66\x7b\xcf\x1a\x36\x0f\x8b\xea\x55\xb6\xed\xe0\xde\xcd\xcc\xe7\xed\xc1\xe9\xea\xb4\x78\xde\x28\xef\xea\x63\x59\x72\x3b\x44\x4f\xb9\x93\xb3\x3a\xb9\x06\x64\xd7\x6c\xdf\xde\x0e\xac\xf2\x24\x7e\x58\x09\x6e\x66\xd4\xf7\xfe\x5e\xc5\x09\xb9\xee\x1e\x8e\x55\xde\x68\xf5\xcf\x61\xe5\xce\x75\x1f\xf5\x9a\xdb\xe1\xbc\x72\x47\xf9\xba\x13\x79\xc0\x1e\xee\x6e\xb5\xce\x60\x1b\x5d\xdf\x8a\x0d\xe5\x07\x1f\x62\xdb\x4b\xdc\x4c\xfd\x98\xee\x0d\xf7\x60\x7c\xa3\xf7\xa0\x7b\xee\xa8\x3c\xd9\xe0\xe5\x14\x4e\x6f\x87\x7c\x62\xf8\x30\x6d\x81\xc9\x56\xea\x70\xe5\xf3\x33\x5e\xa6\xa3\x9f\x88\x1e\xfc\xbd\x0a\x7e\x92\xae\xbb\x5a\x71\xa7\xb2\x83\xae\xbe\x9f\xf2\x2a\x9e\xac\xc2\xed\xc0\x3d\x94\x2e\x8d\x43\x46\x82\xbb\x77\x4e\xd3\xfe\xb6\x17\xb7\xe3\xfa\x5b\xad\xf6\xc7\x2b\x53\xfa\xa2\x6e\xb6\x8a\xd2\xc3\x5b\x93\x0f\x02\x38\xc5\x08\x58\x9c\x6a\x21\x85\x22\x48\xc9\xd7\x5f\xbd\xbc\x3e\xd4\x07\xfd\xd6\x15\xd7\xba\xb1\x18\xd8\xb5\x46\x3e\x2e\xaf\xa8\xb0\x97\xf3\xd1\xa6\x3d\x74\xa6\x4e\x05\x4e\xb4\xbe\xf7\x96\xf3\xcb\x9f\x32\x05\xe2\x80\x23\x48\xa0\x65\xb4\xef\x4a\x00\x85\x46\x1c\x7e\x29\x33\x54\xb1\xbe\xbd\xdd\x09\x14\x10\x61\x82\x98\xa5\xa9\xf2\x5c\x0c\x11\xf5\xd0\xeb\x16\x94\xf6\xa7\x23\x3a\xa4\x9d\xb8\x56\xd9\xb3\x3b\xf6\x9c\x72\x60\x4f\x6e\x97\x7e\xad\x4a\xcb\x95\x13\x2d\x1f\x6a\xed\x0b\xdb\xcf\xdc\x71\xd4\xfe\x01\x7a\xf4\xc2\xcc\x4e\xdc\xed\xf5\xc7\x20\x52\xab\xb1\x5b\x49\xc8\xda\x8d\x52\x76\xd1\x35\xdf\x29\xef\xe6\x7c\x70\xbc\x9c\xd2\xca\xfc\x9e\xd5\x45\x73\xcc\x9a\x8d\x6b\xe9\x52\xbf\x3a\xf5\xdf\xc9\xc2\xaf\x94\x7d\x26\x2b\x7f\xeb\xdc\x53\x3c\x78\x56\x62\x10\x8f\xbd\x8d\x4b\xe2\x30\x04\x03\x35\x2e\xcf\x4b\xab\x63\x2e\xf8\xae\xab\x53\x30\x1c\xf2\xc3\xd2\xbf\xb1\x5d\x6d\x37\x9b\xe7\xbf\x55\x7e\xb3\x20\xe9\xa2\x7d\x93\xb5\x10\xc1\x76\xbd\xed\xc7\xcd\xea\x65\xd9\xf4\xa8\xbb\xf6\xbb\xfd\xce\xd1\x6f\x45\x70\x36\x9f\x05\xeb\xb8\x24\xeb\xff\xf2\x0b\xbf\x28\xbf\xd5\x7a\xe5\xa6\x69\x92\x53\x2b\xed\x5e\x97\x73\x7a\x98\x9d\xba\x75\x72\xbf\x73\xdd\xd8\x8a\x85\x7f\x5a\x97\x04\x1c\x4e\x7a\x2b\xe3\xd1\xe8\xc7\x0f\xe3\x5d\xf9\xfb\xdd\xf1\xb0\x36\x1c\xb4\xa2\xc3\xe0\x30\x24\x60\x3f\x99\xf5\xbc\xf4\xc2\xdb\xd9\xd8\xb5\x4f\xfc\xc2\xc6\x87\x5a\x55\x62\x29\x97\xb7\xa4\xaf\xc1\x9f\x48\xbe\xa6\xe0\x36\x52\x91\x8c\xbd\x31\xc5\x59\x76\x93\x00\xdc\x9d\xb4\xb3\x1d\x8f\x37\xd7\xec\xb2\xc4\xdd\xa5\x64\xc8\x0e\xed\xc5\x65\x7b\x7c\xab\xd8\x76\x8c\xbc\x6f\x15\x95\xfe\x29\xc6\x65\x3d\xfb\x64\x15\x2c\x1f\x01\x29\x14\x50\x20\x6c\x09\xe9\x0a\x4d\x80\xcf\x3d\xf5\xea\xa2\xc7\xde\x41\x9e\xe9\x49\x45\xf7\x43\x75\xc0\xfb\x7e\x75\x38\x5d\x6e\x53\x55\x2d\xb9\xeb\xa0\x63\xc6\x20\x77\xa6\x11\xd3\x4e\x6b\xc8\x8b\x4e\xdd\x29\xf3\xd5\xd9\xfc\x9d\x9b\x8f\xde\xca\xcd\x80\xfb\xae\x11\x9d\x74\x50\x3d\xd5\xed\x38\xa8\xdf\x2b\x67\xc8\x3b\xe7\x83\x4d\x24\x0f\xda\x93\xd4\xdf\xd1\x60\xb1\xd9\xba\xf5\x71\xf9\x57\xa2\xc5\x43\x95\x66\x92\x93\x7d\xb4\x2b\x8f\xe7\xba\xc3\xea\xbb\x05\x58\xa8\xfe\xd9\x3e\xae\xcb\x65\xc7\xc8\x39\x5f\x2e\x6e\x15\x06\xfa\xc7\xe5\xf8\x04\x7e\x2d\xfa\xac\x19\x72\xe2\xc7\xc9\xa1\x3f\x1c\xe6\x97\x72\xe3\x16\x45\xa2\x6d\xc2\x4e\x78\x23\xa7\x8e\xb3\x9f\x9d\x66\x76\x33\x4a\x27\xbb\x73\x07\xb6\xe3\xe5\x6f\x1e\x91\x7c\xc1\x23\x5e\x37\x2a\x0e\x24\x78\x78\xca\x6e\x31\xa5\x4d\x02\x08\x19\x60\x10\x01\x6e\x11\xac\x90\x84\x4a\x33\xac\x0a\x99\x40\xf5\x69\x7b\xc3\x78\x4a\xb1\x33\x46\xe5\x85\xb7\xf4\x37\xa7\x74\x57\xdb\x8d\x8f\x71\xb3\xd6\x4b\x77\x95\xe9\xa1\xda\x3a\x75\x5a\xd7\x63\xf1\x0d\xde\x4e\xcf\x6e\x88\x7b\x1d\xa9\xec\xfb\x9d\x5c\xf4\x1a\x72\xc2\xe1\x52\x04\xf7\xd5\xe9\x36\x2f\xaf\xf3\xeb\x1e\x38\xe3\xa0\x39\xed\xd4\xa3\x51\xa5\xe1\xf5\xa7\x87\x70\x15\x4a\x6d\xbc\xd5\xc4\x7d\xcb\x62\xfa\x99\x2e\xff\xaf\x75\x93\xbf\xcc\xd1\xd3\x7f\x6d\x12\xdb\x75\x93\xfd\xf1\xc7\xea\xe1\x6d\x77\x7e\x1b\xb7\xe1\xa4\x1a\xf7\xbc\x53\x27\x9e\xa3\x93\x98\x9b\x19\xa8\xd6\x57\x0e\x37\x4c\xb0\x4a\x54\x76\xe1\xb2\xdb\x2b\x9d\x66\x05\xb9\xc7\xdf\xb7\x06\x0b\x18\x72\x08\x09\xb3\xb0\xe7\x23\x9f\x49\x97\x72\xfc\xfa\xa9\xe8\xb8\xd7\x0e\xc6\xfd\xe5\xa5\xda\x51\xd4\x94\x3c\x16\xb5\xd7\xd5\x4c\xe7\x76\xb3\xdd\x24\xb1\x37\x97\xad\xb0\x4b\x87\x4b\xbe\x1d\x06\x4f\xd2\x33\x35\xce\xc4\xf9\x29\xff\xeb\x5b\xfd\x4b\xf4\x56\x81\x68\xd1\xed\x4a\x15\xe6\x8b\x09\x1e\x4f\x06\xa5\xe1\x4a\xae\x5b\xeb\x99\x7f\x46\x9b\xec\xbc\x62\x41\x1f\x71\xd9\x1c\x0c\xd7\xdb\x28\xda\xfd\xab\x1e\xfa\x52\xbd\xe2\x56\x15\x4d\xda\xd1\x0e\x9a\xac\xdc\x5e\x87\x59\xb4\xee\x97\xca\xe7\x56\x08\xc9\xfd\x38\xf2\xb7\x6e\xf3\x72\x24\xd3\xe3\x29\xf1\xd3\x9e\xfe\xfe\xcb\xfe\xa8\x57\x74\x21\x4a\xbb\x66\x6c\xca\x75\x74\x4d\x50\xdb\xdf\x78\x83\x65\xac\x2a\x37\x67\x31\x6d\x3a\x72\xca\xb1\x6e\x5e\xae\x66\xe1\xcd\xd2\xf6\xa1\x2d\xfe\x55\xef\x75\xbc\xb7\xab\xd9\x0c\x7a\x4c\x8c\x5d\xb0\xd4\x50\xeb\x2e\x3d\x0f\xd7\x76\x32\x8f\xc9\x79\x45\x56\xb1\xd9\xf3\x9d\x6c\xdc\xd3\xe3\xa0\x7d\xf9\x57\x3d\xfc\xc5\x7a\xcf\xb5\xa5\xb7\xba\xc1\xd6\x0d\x3b\x1d\x9d\x55\xb5\x73\xb9\xdc\xaf\x65\x5b\x8f\x93\x1d\x31\xdb\x56\x69\x7c\xe9\x37\xdd\x53\x52\x93\x7e\x75\x7b\x4c\xfe\x5d\xe8\xff\xc3\x83\x3d\x5c\xfe\xbf\x92\x2c\xb0\xd5\x31\x89\x36\xde\x8f\xa5\xfc\x83\x1a\x6a\x99\xc4\x5b\xe2\xd2\xad\x59\xba\x05\xbd\xb5\x97\xdd\x47\x1d\x95\x46\x8b\xad\x2c\xb7\x23\x1e\x03\x71\xd9\xdb\xe9\xc2\x1e\xd6\xff\x4d\xb0\x18\x78\xcc\xcb\x95\x7e\x65\x30\x59\xe9\xec\x3c\x6d\xb0\x70\x1a\xd4\xdb\xae\xc7\x57\x2a\xef\x2e\x9a\x61\x50\x1f\xad\x6f\xfe\xf5\x36\x3d\x27\xcb\xd6\xe8\xdf\x04\xbf\xfb\x58\xf5\xfb\x16\x77\x9a\x51\x3e\x88\x9b\xd0\x49\xc6\x8b\xd1\x6e\x58\xc9\xbb\x3d\x23\x6b\x97\x45\x4d\x8e\xcc\xf2\x3e\x1d\xdf\xef\x7b\xdd\x9f\xef\x7e\xa1\xf4\x15\x8f\xf6\x77\x39\xb8\x1f\xf3\x22\x2f\x63\xba\x4b\x74\x54\x6e\x8c\xc0\xac\x2b\x46\x7b\xf7\xb2\xdd\x4f\xfa\x37\xb0\x66\x22\xe8\x84\xb5\xce\xac\x49\xf3\xed\x58\x92\xd4\x06\xff\x2e\xf9\x56\x3f\x30\x98\x67\xdc\x9c\xa3\x76\xc6\x4e\xc3\x6d\xaf\x72\x0d\x90\xb8\x44\xdd\xfe\xf2\x96\xd6\xa5\xda\xb5\x3b\x74\x19\xfb\x87\x4d\x77\x5f\x22\xff\x2e\xf9\xbd\xfc\x75\xb7\xaa\xc8\xd2\x77\x44\x75\xd1\xa8\x1e\xae\xcb\x71\x33\xd9\x95\x46\x60\x32\xb1\xc1\xb2\x12\x3a\x35\xb7\xd5\x6a\x96\x2b\xa3\xde\xb9\x86\xca\xbf\xd4\x7a\xcd\xbb\x37\xc9\xe9\xec\x24\x5e\xda\x3a\x8c\x95\x6a\x9e\x9a\xb2\xbe\x4d\xba\xde\xe4\xd8\xab\x12\xbc\x3e\xd9\x7e\x94\xea\x99\x33\xf4\xba\xab\x59\x51\xf2\x98\x24\xfb\xe2\x01\x0d\x83\x02\x21\xca\x81\xb4\x90\xe7\x6b\x8c\xa0\x36\xd8\x3c\x3b\xbb\x80\x28\xf0\xfd\xd9\x9e\xc7\xbe\x2e\x35\x0e\xe7\xa5\x33\xbf\x93\x76\xad\xae\x66\x93\x68\x0a\x6f\xf9\x2d\x18\x55\x79\xba\x4a\x78\xde\x24\xff\x1d\xe4\x35\xf3\xbe\x53\x1f\x2f\x8d\x76\x5d\xec\xcc\x1d\xea\x3f\x86\x03\xaa\x4c\xd8\xad\xb0\x21\x4e\xaa\xdd\xab\xea\x60\x1b\xaf\x4a\x41\x32\x78\xfb\x8d\xee\xdf\xca\x25\xbd\x1d\xa1\x9d\x2f\x1b\x7b\xad\xaa\x65\x35\xf3\x2a\xbb\x9b\xd7\xca\xf7\xd7\x9a\xac\xa0\xfb\x86\xcc\xa6\xcb\xb0\xc1\x40\xce\x26\x8b\xc3\xa9\xd6\x00\xa3\x9f\xcb\x15\x67\xc3\xfd\x92\x35\x7a\x81\xe3\xe9\xd9\xa9\x5d\x39\x6f\x96\xf5\x55\xbd\x05\x57\xdd\xbe\x1a\x54\xe2\xee\x32\xbf\xa2\xf6\x50\x2b\xea\x56\x2e\x2d\xe7\xe7\x72\xdf\x67\xc2\x7a\x5d\x2d\x23\xd4\x19\x37\x50\x30\xaa\xd3\x46\xd2\x8f\xc2\x28\xf3\xf9\xd6\x74\x3a\xe8\x14\xa6\xb5\x59\x7f\xbc\xea\x46\x42\x9f\xa3\x7f\xd7\x29\xe4\x16\xa0\x73\x84\x76\x59\xa3\xde\xa4\xb5\x39\x58\xc8\xd4\x6b\x55\x56\x8d\xe3\xc1\x5e\xec\x4e\xd0\x3b\xaf\x54\x3a\xcc\x27\xf6\x62\xf5\x0c\x2e\x3d\x53\x33\x9e\x6a\x57\xdb\xcb\x6e\x87\x63\x52\x0c\x4b\x3c\xbb\x91\x0a\x4c\x2d\xc0\x81\x74\x31\x20\x86\xcb\xd7\xe5\x98\x4d\x9a\x92\xce\xca\xa8\x36\xf2\x4d\x2d\xf6\x67\x43\x47\x9f\xd5\x68\x01\x46\xfc\x36\xcc\x86\xb5\x8d\x8c\xf7\xcd\x04\x1d\xef\xc8\x25\xbf\xa5\x41\x80\xe4\xb3\xb8\x36\xb6\x08\x55\xd4\xe7\x9c\x49\xa1\x3f\x44\x93\x00\x03\x81\x10\xe4\x50\x58\x1e\x12\x04\x1b\x00\xb5\x2c\xd8\x6c\x7a\xdb\x0f\xbc\x7a\x06\xed\x2a\xa0\xe5\xea\x2e\x80\x9b\x9e\xbf\xe9\xcc\x68\xfd\xc0\xe0\x68\xb6\x6b\x2e\x44\x7a\xd0\x79\xde\x06\xcf\x46\xe8\xbf\xa1\x51\x08\x20\x20\x10\x52\xcb\x73\x3d\x97\x53\x80\x64\x31\xba\x79\xdb\x04\x8d\x99\x3c\xa7\xad\xfb\x6a\x53\x17\xb3\xe8\xdc\x35\x9e\xda\xd5\x3b\x5b\x1d\x2f\xb6\x78\xbb\x25\x52\xee\xe3\x86\xb7\xf3\xab\xed\xdf\xd3\x18\xa0\x10\x61\x80\xb1\xe5\x4b\xe9\x09\xed\x03\x69\x0a\x97\x5a\xbe\x94\xc6\x01\x04\x92\x48\x82\x2c\xa2\x8d\x8f\x98\xf0\x35\x54\xe4\x43\x34\x08\x20\x84\x12\x52\x4c\x2d\xc1\xb5\x27\xa4\x0f\x20\xa5\xe0\x23\x34\x04\x00\x43\x08\x41\xcc\x10\xb6\x38\x75\x91\x10\x00\x52\xe5\xc9\x67\x0e\x5c\xbe\x80\x5c\x65\xa9\xba\xce\x88\x58\xd3\xdd\x1a\x54\x47\xf1\x6e\xbd\x18\x75\xf2\xd4\x9b\x8d\x1d\x76\xec\x6e\x2a\xe5\xc3\x78\xbb\xfc\xaf\x31\xaf\xa1\xdd\x7b\x7b\xe8\x8c\xfc\x9b\xd3\x0f\xc3\xe6\x30\x9c\xed\x56\x83\x9c\x76\x0f\x3d\x32\x13\x59\xaf\x6b\xa2\x25\xbc\x35\x7b\x61\x2d\x98\x7d\x33\x57\x5e\x68\xe6\x7a\x78\x4b\x10\x82\x90\x23\x09\xa9\x45\x81\xf4\x8d\x6b\x7c\x21\x0a\x6f\xab\xda\x01\x6a\x31\x71\x00\xe5\x08\xe0\x9e\xcf\xda\xbd\x92\x2f\xa7\x70\x33\x95\xcd\xca\xad\x76\xf5\xd7\xdd\xf2\x68\x5a\x1d\xe3\xdd\xf3\xb2\xd5\xaf\x50\x18\x30\x48\x11\xc7\xdc\x52\x50\x73\x46\x11\xe3\xc4\x88\x8f\xa0\x1e\xdf\x17\x46\x12\x0a\xcb\xf8\x9a\xb9\x08\xb9\x88\xa2\x42\x51\xfe\xed\xad\xdd\x5b\x04\x26\xf7\xfb\x87\x2a\xcb\x9d\xeb\xb4\x33\xe8\x44\xb2\x7b\x10\xe4\xbe\x0e\x69\x7f\xb4\x38\xa7\xb5\x66\x13\x8f\x32\xf1\x1b\x94\x78\xfc\x78\x18\x60\x6e\x79\x10\x7b\xae\xd1\x88\x49\xfe\xfa\xae\x04\x2b\xd1\xf9\x5c\xf1\xf9\x21\xe9\x90\x5d\xcf\x8f\x80\x00\xf7\xea\xb6\xb9\x77\xc6\x8d\x7b\x38\x0b\xf3\x75\xb7\xbe\x1e\x70\xa7\x69\x76\xbf\x46\x41\x80\x01\x80\x58\x52\x61\x29\xa8\x5c\x41\xb5\x6b\x20\x7e\xcd\x0b\xe8\x2c\xef\x2d\x11\x8f\xf2\xe1\x3e\xbf\x04\x66\x5c\x1e\xd6\xb1\x73\x1a\xc8\xe6\x2a\x2f\x7b\x71\xe7\xdc\x21\xe7\xd8\xb9\xf4\x6d\x9a\xee\x56\xbf\x41\x41\x24\x01\x43\x92\x50\x0b\xf9\x14\x50\x84\x24\xa5\xe2\xd5\x25\x44\xe3\x76\xbe\xda\x2f\x87\x0c\x77\x45\x72\x55\xf0\x04\x24\x9b\x3a\x1b\xaf\x7d\x6c\xd7\xc4\xfd\xc6\x57\x2c\xef\xef\x66\x44\x36\x66\xef\x8b\xef\x3b\x0a\x21\x0e\x25\xc5\x14\x58\x5a\x51\x01\x38\x71\x09\x92\xf2\xeb\x51\x08\x00\x08\x25\xc2\x58\x42\x68\x01\x02\xa8\xf6\xb8\xc0\x3e\x50\x1f\x41\x21\xc0\xa1\x44\x90\x52\xcb\x87\x1c\x21\x69\x98\x64\xee\x6b\x08\xbf\x63\x2f\xba\x59\x8a\x26\xc7\x2c\x8e\x9c\x15\x2b\xd5\x5b\xed\x6e\x43\xea\xc0\x59\xc4\x51\xb5\x3f\x0d\x03\xef\x56\x99\xc2\xc6\x4a\x7e\xdb\xe8\x7f\x85\x42\x04\x32\xc4\x30\xb4\x98\xe7\x3d\x56\x0b\x0d\xb8\x7e\x9d\x16\x78\xbb\x76\x6e\x83\xb0\x9d\xb7\x0e\x43\xcc\x4f\x55\x67\x37\x4b\x92\xed\x89\xab\xf2\x86\x7a\x13\x38\x42\xf8\x38\xb8\x77\xbd\x12\x70\x66\x6f\xa8\xe7\xdd\xb0\xc2\x74\x47\x88\x3f\x16\x27\xc8\x2d\xc0\x24\xf1\x90\x26\x1a\xb0\xd7\x89\xb1\x5b\xf3\xd9\x7c\x7d\x88\x12\xac\xef\xa3\x8a\x73\xdd\x1f\x4a\xb7\x43\xd5\xad\x99\x49\xa5\x94\x57\xdc\x69\x30\xe9\x9c\x42\xaa\x63\xba\xcd\x7f\x0b\x13\xcf\xe1\x09\x02\x2c\xcf\xf7\x11\xa1\x8a\x51\x20\xdd\x82\x91\xdb\xbb\x78\x41\xb0\xdd\xe2\xc8\x39\xcf\x4a\xc9\x60\xea\x4e\x2e\xea\x32\xe9\x8c\x1d\x78\x0a\x89\xa8\x6f\x8f\x15\x32\xeb\xac\xf7\x43\xf0\x06\xdb\x6f\x8a\xf7\xee\x21\x40\x0c\x4a\x0c\x00\xb5\x3c\xc6\x01\x40\x9e\x8b\xa1\x57\xe8\x2b\x37\xd7\x71\x0d\x36\x23\x76\x6d\x8d\xfb\xd5\xd5\x74\x37\x9e\x21\x7b\x5b\x3f\xed\xfd\x16\x16\x5d\xaf\xbd\xb8\x1d\xec\x66\xd9\x09\xce\x49\xfd\xd7\xac\xe7\x8f\x08\x39\xc1\x80\x5a\xd4\xc5\x86\x29\x4a\x3d\xc9\x0a\xdd\x13\x4c\x98\x9c\xfa\x0a\xef\xfb\x17\xb5\x58\x7a\xe7\x6b\xee\xd0\xd5\x75\x44\x6f\xa3\xfd\xbc\x4a\x14\xe8\x96\x9d\xea\x29\x5f\x1e\x86\xc9\xfb\x1b\xfb\x81\x85\x01\x44\x18\x0a\x82\x2d\xca\x20\x31\x9a\xba\xca\x30\xff\x33\xe3\xc2\xcf\x46\x4f\x1c\x09\x4b\x03\x08\x80\xcb\x5c\x2d\xdc\xa2\xc9\xb6\x00\xad\xea\xd6\x0e\x20\x6c\x04\xab\x63\x6b\x1f\xcc\x56\xb3\x4b\x75\xd7\xf6\xcd\xa0\x21\x64\xe2\xb5\xc7\xf7\xe6\xc0\x16\xe7\xdd\xbb\x39\xfa\x03\x8b\x00\x89\x00\x12\x08\x5b\x92\x4a\x97\x10\xa8\x3c\x52\x58\xe2\xbf\x90\xf5\x3c\xfe\x06\x92\x42\x8b\x40\xa2\x05\x83\xae\x4b\x94\xf7\x21\x16\x06\x08\x52\x02\xb0\x05\xd9\xc3\x20\x65\xd4\xa5\xda\x7c\x84\x05\x21\xa2\x50\x00\x01\xb0\xe5\x6b\x0d\x3d\xad\x88\x0f\xe8\xeb\xdc\xa0\x69\x6f\xb5\xf3\x96\x64\xb0\x9d\xcd\xe6\xc2\x1d\x9f\x6a\x9b\x6b\x6d\x8a\x8f\x87\xdb\xcd\x2d\xf5\x66\x87\xd9\x7e\xbc\xe8\x5d\xfd\x70\x91\xbd\x7f\xcc\x45\xd6\x63\xed\xc5\x00\x0a\x4a\xa9\xb4\x24\x04\xae\xe1\x4a\x12\x5c\x58\x38\xf0\xf5\x08\x1b\x5b\xdd\x10\xe1\xcc\x67\xe7\xd1\xb0\x5d\x0d\x17\xed\x4a\xd8\xb8\xcf\x45\xb0\xdd\xe6\xc3\xba\xed\x8f\x71\xfb\x36\xd2\xfd\xf7\xcd\xeb\x07\x16\x06\x08\x01\x2a\x28\xb4\x38\x16\x8c\x43\x8d\x85\x80\xcf\x3e\xba\x8b\xb0\xe4\x8b\x51\xab\xb3\x1c\x13\x08\xf3\xce\x58\xd8\xe7\x71\x65\xba\xe6\x2b\x75\x58\xab\xf1\x79\xe6\xf5\x1a\x8d\x4e\x78\xca\x5b\xe0\x7d\x33\xfe\x43\xc8\x97\x0c\x28\x4a\xdc\xcd\xde\xbc\x7d\x5a\x08\x52\xc8\x80\xb4\x34\xe6\x58\xfa\x82\x51\x5f\x15\x5a\x31\x94\x12\xb9\x21\xcd\x43\xcd\xd5\x1b\x9c\xcd\x20\x8d\xd4\xc8\x1e\x5c\xc1\xf1\x7a\xee\x49\xbd\x68\xae\xea\xad\xbb\x64\xb4\x55\x19\xbd\x7f\xc6\x3f\xa1\x71\x28\x01\x20\x88\x72\x4b\x23\x57\x23\x85\xa4\x06\xe8\xd5\x54\xab\xdb\x9b\x56\xec\x9e\xd2\xf3\x26\x76\xa6\x55\x6f\x9c\xe6\x61\x2a\xaa\xbb\xc3\x6d\x54\x4b\xcc\x3c\xf6\x6a\xb5\x55\x2b\xee\x85\x46\x95\xde\x0d\xd0\x87\x40\xd1\x54\xc3\x50\x60\x8e\xb1\x45\x5c\x9f\x69\x0c\xb9\xe1\xe0\x75\xbe\x47\xcb\x0d\x69\x56\x2a\x6e\xdc\x8e\x5c\xa6\xa3\xbc\xdb\x9c\x75\x50\x4f\x1d\xb3\xb0\x65\xc7\x69\x78\xf5\x1a\xa7\x38\x68\x9d\x6f\x2a\x7e\x9f\xef\xdf\x51\xc5\x48\x10\x18\xb5\x6e\xd9\x6a\xd6\xeb\x03\x1b\xa7\xfb\xed\x89\x4e\xd3\xa6\x70\xdb\x5d\x2c\x46\x8e\xb8\xdd\x9d\x84\x9a\x9d\xb3\xc5\xb5\xbd\xf7\xe3\x5b\xf9\x2e\xf7\x77\xd3\x12\x08\x01\x45\x10\x60\x44\x2d\x4f\x02\xe3\x1b\xc3\x01\x2d\xc4\x2c\x46\xe9\x70\x5a\x3e\xdf\xd2\xbc\xee\x7a\xa6\x79\xaf\xdc\xfb\x41\xd0\x58\xc6\x59\xda\x80\x55\x35\xf3\xce\xc1\x4e\x8f\xe9\xe0\xc8\xbb\xeb\xe0\x0f\x60\xfc\x79\x95\x41\x5a\x00\x13\x88\x98\xa1\x00\x32\xf7\x2b\x61\xdf\x33\xba\xba\x33\x9e\xb4\xb6\xf1\x19\x2f\xfb\x0b\x5f\xd1\xea\x60\x77\x9f\x6d\xfc\xeb\x3a\xba\x1e\xe0\xf4\xd6\xe6\xed\xc8\x59\xa6\x8d\x6b\xef\xf2\x4c\x42\xfb\xb9\xce\x6b\x34\x0f\x8c\xf2\x2d\x2c\x57\x8f\xd2\x0c\x0f\x76\xe8\xdc\x0e\x0e\xf6\xf7\xc4\x5f\xa7\xe3\xfa\xa2\x73\xd1\xab\x5a\x3d\x0d\xc7\x9b\xf5\x7a\xf6\x6e\xd5\xc7\xe6\xad\x28\x0f\x14\x90\x53\x42\xb0\xe5\xb9\x06\xf8\x12\x03\xae\xc1\xeb\xb6\x19\xf5\x60\xdf\x9e\xe2\xa3\x1a\xb5\x76\xb5\x65\xce\x71\xb6\xee\x5c\x8e\xb3\xb0\x81\x2f\xd8\x3f\x46\x97\x4b\x9e\x82\xca\x2c\x2a\x8f\xf3\x77\xf3\xed\x1d\xc5\x9f\x45\x20\x1e\x2b\x23\x66\x1c\x1b\x02\x5c\x85\x0a\x47\x2d\x5f\x87\x12\x88\x3d\xb6\x68\x0a\x2d\xa1\x08\x04\x86\xbb\x0c\x0b\xfd\x11\xd4\xc3\xa6\xc7\x18\x02\x68\x41\x06\x3d\x4d\xb8\x91\xd0\xff\x08\xea\x79\x34\xc3\x10\x23\xd2\x92\x2e\xf1\xa5\x4f\x95\xa6\x50\x7e\x04\x05\x21\x41\xe8\x61\xe7\x58\x4a\x2b\xc3\x14\xd6\x10\x4a\xf5\x11\x14\x7a\x98\x35\x08\x50\x6a\x61\x0a\x35\x24\xc4\x57\xd0\xf7\x3e\x83\x7a\x6c\x32\xf8\x61\x23\x4a\xc4\x7d\xc9\x39\x23\x5e\xc1\x1e\xfd\x2a\xd4\x33\xb1\x02\x21\xca\x28\xb2\xa0\x01\x4c\x51\xac\x5d\xb7\xd0\x49\xfb\x0b\x51\x88\x02\xf9\x70\xce\x2d\x8d\x98\x2f\x7d\xa9\x28\xf7\x3f\x33\x2a\x04\x31\x60\x10\x12\x60\x61\x85\x10\xa3\xc0\x63\x45\x0f\xec\xeb\x50\x18\x42\x28\x30\xa6\xd8\xd2\x42\x08\xce\x21\x67\x90\xbe\xce\xc0\xa3\x6c\x35\x8f\xbe\x68\x0d\xfa\x57\x6a\xa6\x28\x3b\xc6\x3c\x65\x66\x9a\xb6\xab\xab\x74\x36\x8f\xbb\x39\x3e\x86\x9d\x04\x8f\x0e\xfb\xf7\xa5\xf9\x1d\x45\x00\x41\x18\x61\x48\x2d\xe3\x52\xd7\x73\x29\xf4\x91\xc2\x1f\x41\xd1\xc7\x64\x27\x04\x52\x4b\x7a\x86\x2b\x26\x11\xf0\x81\xfc\x10\x0a\x43\x89\x24\x61\x96\x4f\x0c\xe7\x1a\x33\xcd\x90\xf7\x11\x14\x03\xf8\x31\x03\x89\xb4\x18\xa0\x80\xf9\x84\x7a\x3e\x7b\x5d\xd9\x5b\x93\x7b\xe9\x34\xa9\x4f\x4b\xcb\x46\x3c\x1e\x8b\xde\x75\x4c\x0f\x77\x3c\xf0\x71\xb6\xc2\x55\x7f\x55\x99\xe7\xe4\xba\x22\x44\xf5\x76\xef\x36\xef\x0f\x28\x88\xa1\x24\xf0\x31\x2d\x90\xf0\x81\xab\x0d\x95\xa2\x70\x68\x40\xab\xe3\xf9\x74\x3a\x8d\xca\x1d\xc6\xf9\xf4\xde\xeb\x35\x67\x25\xe0\x6e\x87\x03\xd0\xdb\x9d\x06\xbd\x0d\xb1\x69\xf3\xd8\x59\xe4\xf6\x6f\x51\x7f\x07\x1d\x2c\xec\x02\xc2\xa0\x31\x9e\xa0\xf2\x23\x28\x8e\xc8\xc3\x98\x27\xd4\xf2\xd4\x63\x41\xd4\x40\x9b\x0f\x8d\x4a\x40\x0c\x09\xc4\x00\x5b\x9c\x68\x8f\x68\x8e\x10\x28\xd4\x06\xfa\x32\xd4\x73\x59\xc7\x94\x10\x60\x21\xe3\x41\x21\x31\xc4\xc6\xff\xc8\xa8\x9e\x9d\x34\x18\x10\x14\x58\x1e\xd0\xae\x07\xb9\xc2\x94\xe2\xaf\x47\x3d\x03\x5e\x04\x52\x4a\x20\xb6\x98\xf2\x5d\x0a\x25\x35\x54\xa9\x8f\xa0\x1e\xff\x48\xc2\x10\x7b\xd8\x16\x1c\xa2\xc7\x77\xe5\xa2\xcf\xa0\x10\x82\x88\x52\x2a\x2c\xaa\xa8\x14\x0a\x11\x0e\xd4\x07\xde\xd5\x13\xc5\x20\x82\x00\x09\x0b\x68\x03\x3c\xcf\x00\xc8\x0a\x11\xa8\xaf\x43\x61\x44\x20\xc1\x1c\x70\x4b\x63\xa3\x3d\x29\x39\x2e\xee\xc2\xe9\xe1\xa4\x4e\x59\xb5\xc5\x91\xe9\x55\x83\x43\x39\xb2\xfb\xec\x76\x9b\xb7\xe7\x8e\xf4\x0e\xaa\xd6\x1e\xe2\xb2\xa8\x34\x3b\xf5\x2a\xfd\xa5\x21\x8d\x00\xa0\x0f\x0b\x03\x61\x48\x2c\xe0\x2a\x8a\x7c\x46\x90\xf2\xd0\x47\x50\x0c\x51\x00\x20\xa3\xd4\x22\x1e\x45\x94\x30\x80\x31\x79\xa6\x5c\x9c\x9b\xcb\xf6\xc0\xb9\xba\x7d\xbf\x36\xdd\xd9\x6a\x59\x8d\x7c\xdd\x0b\x76\xd9\x64\x5e\x5a\xa3\xa3\xe7\xde\x4d\xe9\x50\xf7\xf0\x99\x2e\x7e\xb9\xfd\xfe\x2b\xe3\x65\x38\xf6\x80\xcf\xc1\x45\x95\x45\xc6\xbd\xa0\x19\x0a\x38\x4e\x70\x02\xc3\xc1\xf5\x88\xd1\xc4\x99\x0f\x37\xde\x3a\x4b\xba\x23\x94\xaf\xdf\x87\x93\xa8\xd3\x31\x44\x6f\xf6\x3a\x44\x10\x61\xfc\x58\xd5\xcd\x63\xdd\x25\x58\xb8\xaf\xef\xa9\x6f\x03\xc3\x96\xfb\xe8\x64\xd2\xee\xb6\xdc\xbc\x26\x83\x93\x3f\xcf\xc5\xa8\xd5\x1c\x67\xa7\xd9\x28\x66\xb7\xc5\x0a\xab\x52\x69\x0a\xde\x83\x77\x3f\xd0\xe4\x63\x02\x22\x40\x09\xe4\x96\x61\xc4\xf8\x1e\x47\x6f\x85\x48\x83\xc1\xe1\xdc\x8a\x1a\xd3\x95\x22\xed\xfd\x31\xd3\xcd\x3a\xdf\x37\x58\xdc\x6e\x9d\x2f\xfe\xac\x3f\x1c\x1c\xb8\x27\xee\xc9\xf2\x72\xec\xbd\x9f\x4b\xfe\x84\xc6\x00\x01\x14\x13\x22\x2d\xe0\x23\x89\x99\xf4\x7c\x43\xe8\x87\x68\x10\x3d\xbe\x64\x2a\xa9\xb4\x04\x15\x1e\x52\x9a\x78\xc2\x65\x1f\xa1\x3d\xd6\xc2\x67\x54\x43\x62\x68\xb9\x3e\x11\xae\x0f\x99\x72\xc5\x13\x33\xbd\x2f\x27\xcb\x4a\x42\x50\x24\x83\x91\x89\x93\x3a\x76\x65\xa5\xb9\xa9\x1f\x02\xda\x6e\xa2\xce\x6e\x46\x1b\xbb\x61\x5b\x5d\x82\xe3\x7f\x8d\xf9\x92\x41\xe5\xb7\xd8\x2b\x4c\xc5\xc7\xee\x28\x00\x24\xcc\x82\x9a\x01\x43\x18\x80\x5e\x21\xb6\x3b\xbe\x3a\x81\xb9\xd0\x79\xe7\x7a\x3f\x19\x6a\x6f\x3b\xd3\x3a\x3d\x99\xed\x61\x3b\xdf\x0f\x8c\xbd\xb9\x67\x15\x0e\x3a\x89\xcf\x91\x7a\x3f\x64\x78\x67\x41\x08\x04\x80\xe0\x59\xdf\x08\xb9\x18\x72\xc1\xa9\x06\x1f\x62\x21\x04\xa1\xc4\x08\x32\x0b\x73\xc3\x7d\x20\xa0\x47\x0a\x85\x03\xbe\x8c\xf5\x2d\x16\x4f\xa9\x24\xd8\x32\x08\x51\xad\x38\x57\xdc\xb0\xcf\xb0\x08\xc2\x00\x11\x01\x81\x05\x21\x42\x18\x40\x89\x3c\x2a\x3e\xc3\x92\x0f\x9f\x84\x42\x00\x2c\x4f\x53\x2d\xa9\x22\x58\x31\xf3\x01\xd6\x63\xeb\x82\x1c\x40\x0a\x28\xb1\x08\x56\x94\x00\xe4\x19\x5e\xf0\x7f\xbe\x90\xc5\x10\x45\x00\x0b\x80\x2c\x66\x84\xe1\x58\x28\x8d\x80\x78\x6e\x5a\x97\x71\xab\xa2\x41\x3f\x75\xdc\xce\xd5\x77\x93\x63\x5c\x0b\x23\x54\xb9\x39\x6d\x38\x09\x53\xb2\x62\xc9\xb8\x93\x20\x38\x69\x76\xde\x57\xf9\x3f\x84\x7c\xd1\x80\xf2\xb7\xed\x04\x43\x42\x00\xe4\x16\x84\x94\x42\x0d\x98\xe7\x7a\xaf\xae\xcf\x64\x3a\x14\xb5\x73\xed\xe6\xd9\xba\x4d\x5d\x31\xa5\x61\x1e\x1e\x77\xdb\x49\xa9\x4e\xd0\x34\xbe\xe5\xfd\xea\x6c\xa8\xb7\xde\xa6\x19\xbe\x07\x2c\x7f\x40\x61\x00\x29\xa4\x18\x58\x44\x62\x41\x0d\x33\xb0\x78\x2a\xfe\x75\xa8\x87\x4b\x27\x00\xa1\xc4\x32\xae\x81\xae\x8f\x8d\x76\x31\xfe\x10\x4a\x42\x44\x00\x61\x96\x06\xae\x01\x1c\x41\x0f\x17\xec\xa6\x2f\x43\x41\xf0\xd8\x8c\x31\xc3\xc0\x92\xd4\x85\xbe\xef\x6a\x48\x15\xfd\x08\xea\xb1\x8d\x30\x2a\x11\xb1\x18\x73\xb9\x0b\x31\x84\xca\x13\x9f\x41\x41\xf6\x98\x07\x90\x5b\x54\x79\x42\x11\x42\x5c\xed\x7d\x68\x54\x08\x41\x42\x11\x60\x16\x43\xc6\xf8\xc0\x20\x5f\x15\xcc\xe9\xaf\x42\x49\x80\x20\x85\x04\x49\x22\x1f\xd3\x02\x42\x57\xab\xc7\xe0\x3e\x82\xc2\x10\x01\x06\x11\xe6\x96\x6f\x94\xe6\x12\x00\x88\x0b\xed\x4e\x42\xd8\xdf\x2e\x3c\x23\x97\xe3\x5e\x73\x54\xcf\x17\xfc\x72\xe8\xf6\xab\xdb\xa6\xac\x1d\xfb\xfb\xea\x7e\x7e\x6a\xf4\xea\x6b\x5d\xeb\xbb\xf5\x77\x27\xe1\x1d\x45\xe0\xb3\x14\x17\xc0\x96\xe4\x1c\x23\x8e\x31\x90\x52\x7f\x06\x85\x10\x64\x14\x52\x6a\x49\x8a\x3d\xad\xb4\xa7\x44\xe1\x5e\xd9\xd7\xa1\x28\x78\x4c\x0b\x8e\x88\x85\x8d\x8f\x11\xf6\x89\x5f\x8c\x4b\x7f\x25\x8a\x43\x06\x38\x81\x96\xf1\x34\x21\xc4\x08\x46\xb1\xfb\x11\x14\x03\xec\xf1\x03\x62\x61\x51\xad\x90\xa0\x82\x43\x23\xbd\xcf\xa0\x1e\xbe\x23\x02\x08\x5b\x1e\xa5\x8c\xbb\x44\x7a\x54\x83\x8f\xa0\x38\x62\x40\x42\x0e\xa1\xe5\x7b\x52\x6a\xdf\xf5\x7d\x97\x98\x8f\xa0\x04\x62\x50\x02\x40\xb9\xe5\x71\x57\xb8\x4c\xb8\x90\x50\xf6\x11\x94\x84\x0f\x4f\x0e\x13\x61\xb9\x44\x6b\xa5\x34\xf6\x85\xfa\xc8\x27\x0c\xc1\xb7\x8a\x60\x14\x58\xae\x8b\x7d\xa1\x5d\x2c\x7d\xf4\x91\xef\x0a\x02\x08\xa0\x24\x18\x21\xcb\x05\x92\x00\xa6\x3c\xcf\x25\xfc\x23\x28\x08\x31\x64\x14\x60\x66\x11\x8f\x2b\xa9\x81\x6f\xc0\x67\xde\x15\x44\x80\x00\x8e\x30\x22\x96\x67\x08\x42\x5c\x11\x6a\xd0\x47\x66\x20\x44\x08\x3c\x36\x11\x44\x2c\x4d\x88\x80\xca\xd3\x50\x48\xff\x43\x28\x81\x20\x96\x50\x58\x80\x78\xae\xe7\xba\xbe\x31\x5a\x7c\x3d\xea\xe9\xa2\x32\xf8\xec\x50\x6b\xb9\x00\x32\xe3\x62\xed\x49\xf1\x81\x1f\xf0\x19\x80\xc4\x90\x3d\x7f\x40\xc1\x5c\x09\x01\x25\xc2\xf5\xe0\x67\x50\x8f\x9d\x91\x60\xc4\x2c\x03\x08\xa7\x8c\xf9\xda\x17\xe8\x23\x28\x04\x10\x64\x84\x23\x64\x69\x08\xa0\xab\x11\x81\x4c\xd3\xcf\xa0\xbe\x85\x8b\x89\xb0\x20\x52\xcc\x43\xda\xf3\xa0\xf9\xc0\x6a\xf1\x8c\x75\x3e\xfc\x38\x06\x81\xa5\xa9\x61\x0a\x1b\xe4\x01\xf5\x99\x69\x81\x11\x46\xe8\xb1\x67\x59\x82\x7a\x8a\x7b\xd4\x95\xd4\xfb\xc0\x86\xff\x40\x61\xf8\x70\x0f\x30\xb6\x3c\xac\x05\x40\x14\x40\x0e\x3e\x83\x22\x08\x40\x86\x29\x84\x16\x94\x94\xbb\x2e\x35\x4c\xc3\x0f\xac\x16\xcf\x08\xee\xf3\x07\xa4\xc4\x02\x54\x0a\x46\xb9\x10\x10\x7d\xe6\xbb\x62\xcf\x06\x0d\x00\x23\xcb\x87\xae\xc7\x31\x43\xa4\x58\x9e\xed\x0b\x51\x88\xa2\x67\xcd\x48\x4b\x6b\x57\x0a\xc0\x34\xc6\xea\x79\xd1\xa8\x1d\xca\x55\x42\xc2\xc9\xb0\x1d\xad\x07\x07\xbf\x79\x2a\x13\x77\x6c\x0f\xc6\xdd\x69\xd9\x0d\xd7\x09\x2a\xbb\x8b\xfe\xb8\x1a\x9b\xb3\x7e\xcf\x37\xfa\x33\xc6\x97\x0c\xe7\xef\x96\x99\xff\x5c\xe5\x96\x90\x02\x8c\x04\x46\x16\x24\x1e\xd0\x44\x18\xa5\xc1\xab\x05\xd8\x4f\x1d\x51\x9f\x0d\x66\x90\xc4\xdb\x5d\x07\xfb\xa9\xb3\x28\x1d\x3c\xd6\xd3\xf1\x25\xdc\xd8\xed\xed\xe1\xd2\x3a\xf2\xdb\xed\x74\x19\xbc\xa7\xda\xfc\xcd\x2a\xde\x42\xfa\x0a\xbd\xef\x09\x47\x02\x08\xc0\x21\xa6\xf2\xb1\xf4\x70\xdf\x47\x9a\x72\xce\x34\xfa\x62\x5a\xa1\xc3\x54\x3d\x93\xb9\xbf\xc4\x23\xb1\xf6\x23\xea\xf7\xe4\xb5\x84\x37\xc7\x24\x18\x07\x5d\x5c\x3a\x1c\x7b\x8b\x6e\x1a\x9c\x55\x89\xea\xf2\x7b\x72\xde\x7f\xf4\x9e\x45\xe1\xbc\x64\x37\xd0\xe5\x52\x27\xda\xd0\x61\xa7\xb9\xec\xed\x7b\xfb\xfd\x68\x12\xac\x36\x41\xb9\xee\xb7\x96\x9d\x72\x7d\xdc\x9a\x3b\xaa\xea\xa1\x5f\x08\xbd\xa6\x41\xae\x13\xcf\x1e\x8d\x8f\xdd\xf9\xe2\x3e\x0a\x07\xc7\x8a\x73\x4e\x5b\xf7\xda\xa1\xc1\x36\xd9\x40\xde\xa5\xb8\xd6\x3c\x93\xe4\xa7\xe6\xe6\x87\x81\x6e\xa2\x62\x79\x7d\x02\x1f\x5e\x95\x24\xdc\xf2\x5d\x17\x20\x17\x21\x09\x0b\x3f\xea\x71\xdc\xe9\x0f\x37\xb7\xea\x08\x6c\xe2\xf1\x79\x75\x75\xfb\x72\xbb\xa3\xed\xd2\xf9\xbc\xb0\x07\xcb\xc9\x28\x9c\xd6\x26\x49\x9d\x60\xd8\xfe\x1d\xeb\x19\xb2\xa5\x84\x10\x61\x09\xaa\x3c\x5f\x68\x24\x29\xf4\x3e\xc1\xfa\x76\x7f\x0a\x20\x81\xb8\x25\x35\x22\x46\x20\xc4\x91\x4b\x3e\xc2\x82\x00\x11\x00\xe8\x63\xf7\xa0\x94\x6a\x24\x5c\xc4\xa4\xff\x4c\x5d\xb3\xe9\x75\xb9\x17\x2b\xba\x90\xac\x56\x9a\x1e\x5b\x93\x7d\x12\x9f\x1a\x69\xb3\xd5\x5e\xcc\x4f\xb7\xea\xae\xd9\xe9\x5d\x9a\xa1\xec\x74\x1a\x3f\x7c\xbb\x7f\x06\xf9\x9a\x01\xbd\x5d\x7e\x14\x00\x21\xf8\xd8\xe3\x09\xb5\x00\xf7\xb5\x20\x1c\x68\x56\x28\x22\x1e\xf3\x7e\xf5\xa4\xbd\x8a\x1d\xd7\xef\xd7\x79\x33\xea\xb9\xb5\xc5\x8a\x1e\xfc\xc5\xb4\x97\x56\xbc\x2a\xea\xae\xd9\xf6\xb6\x3a\x3b\x24\xfa\x3d\x4c\x20\x01\x20\x15\x04\x59\xcc\xd3\xd0\xf7\x8c\x76\x15\x45\x1f\x82\x49\xc8\x11\x7a\x98\xb7\x96\x04\xbe\x62\x02\x79\xa8\x18\x86\xfe\x42\x18\x7c\xe6\xe4\x42\x8e\x98\xc5\x3c\x6e\x30\x24\x2e\xa3\x0c\x7f\x04\x26\xc1\x33\xed\x0c\x61\x42\x2d\xd7\x7f\x5e\x8d\xe4\x5a\x09\xf7\x43\xb0\x6f\xc7\x80\x90\x22\xcb\xe7\x88\xbb\xc6\x37\x9c\x8a\xd7\x4f\x59\xae\xf6\xfc\xba\x55\x60\x1d\xa7\x78\xd3\x08\x71\x2b\x69\x67\x89\x5e\xca\xa4\xef\x2c\xcf\xda\x33\xfd\xfb\x7e\x36\x16\xf7\xad\x73\x7e\x8f\x66\xfd\x08\x7b\x58\x4c\xf0\x99\x8d\x03\xa1\xa4\x14\x72\xec\xea\x42\x0b\xc2\x5e\xf5\x3e\x6f\x0e\xd4\x98\x5d\x97\x83\xed\x6e\x84\x93\xf8\x04\x1b\x9d\x7a\x23\x03\x93\x05\x0c\xaa\xdc\xeb\xee\xe0\xa9\x21\x76\xcd\xf1\x0f\x9f\xd9\x4f\x60\x08\x52\x48\x1f\x53\xdf\x75\x81\x07\x14\x33\x82\xb0\x0f\xc2\x38\x40\x04\x5b\xe6\xe1\xac\x4a\x63\x08\x2c\x14\x8c\xf8\x52\xd8\xc3\xa3\x83\x1c\x00\x4b\xb9\x08\x42\x82\x7d\x84\x05\xf9\x10\x8c\x20\x0a\x29\x00\x48\x58\xf8\x31\xe7\x0d\x22\x0a\xb1\xd7\x8f\x7a\x1c\x6c\x67\x32\x0a\x2a\xcb\xf5\x26\x5d\xb5\x2a\xf1\x75\xa9\x4e\x6b\xd8\x3c\xc3\x7a\xab\x55\xbe\xcb\xee\x76\xbe\x19\x71\x71\xad\x2c\xc1\xef\x67\x23\x7d\xf6\xc6\xc4\x00\x5b\x48\x43\xe6\x0a\xec\x1b\x59\x98\x8d\x5f\x0a\x7b\xf8\x09\x8f\x37\x67\x3d\xfd\x11\xfe\xb0\x48\x0b\x6b\xe3\x57\xc2\x9e\x85\x5b\x08\x84\xcc\xd2\x40\x61\x0d\x20\x12\x8c\xbc\x7e\xd4\x76\xd6\x48\x2f\xe3\x99\x26\x0d\xbe\x6e\xf5\x83\xcb\x64\x32\x6d\x78\xc7\xd2\xd8\xee\xfa\x64\xd0\x58\xc0\x7c\x76\x9f\x4d\x47\xa3\x69\xf0\xc3\x85\xea\x9f\xc0\xd0\xe3\xfb\x12\x90\x59\xcc\x00\x22\x21\xf6\x5c\xb7\x70\xad\xe4\x6b\x61\x02\x52\x0c\x31\x7e\xac\xfa\xae\x0f\xb8\x6b\x64\x21\x44\xf8\x95\x30\x01\xd9\xc3\x49\xa1\xc2\x62\x42\x48\xad\xa4\xa6\xa4\x70\x29\xd2\x2d\xa1\x3a\xd5\xe5\x55\xb8\xbc\x2c\xd7\x97\x63\xbc\x66\xb3\x72\x5a\x69\x23\x51\x32\xc8\x8b\x64\x72\xdc\x01\xbd\xd0\xcb\x56\xb9\xfe\x6e\xcf\xff\x08\x93\x10\x42\x4e\x10\xc6\x16\xf1\x91\xd6\xae\xc2\x40\xf9\xfe\x67\x60\x10\x3c\x96\xab\x87\xaf\x67\x09\x6d\x30\x00\x9e\xef\xa1\xc2\x15\xc2\x2f\x85\xa1\xc7\xbe\x82\x1f\x46\x95\xe7\x11\xe5\x73\xcd\xdc\x42\x5a\xe4\x17\xc3\xa0\x7c\x46\xc6\x5d\xf9\x18\x93\xf1\x08\x2c\x1c\x6e\x7d\x25\x0c\x42\x0c\x25\x14\x14\x59\x9c\x1b\xec\xba\x40\x69\x59\xc8\xec\xfb\x5a\x18\x45\x0f\xc7\x5f\x5a\x58\x71\x89\xb4\xf4\x30\x2a\xa4\xa6\x7d\x2d\x4c\x22\x44\x04\xa5\x16\x92\xc2\x07\x9e\x0b\x85\x80\xe6\x53\x30\x44\x21\x21\x0c\x30\x4b\x49\x08\xb5\x04\x40\x14\x23\x0e\x5f\x0a\xc3\x00\x70\xc0\x80\x7c\x2c\x57\x8c\x18\x05\x3c\x1f\xe9\x0f\xc1\x10\x64\x90\x63\x46\x91\xa5\x80\x61\x54\x6a\x0a\x71\xe1\x8a\xe4\xb4\x82\x94\x5e\x55\xc3\x9b\xee\x84\x07\x75\xe8\xd6\xa6\x8a\x54\xc6\x76\xb8\xdc\xaf\x27\xbd\x24\x45\x8b\x83\x77\xb4\xc1\xf1\xba\x46\xef\x37\xb8\x7f\x02\x43\x1c\x50\x2c\x11\xb5\xb8\x2b\x0c\xa7\xda\x45\xc2\x27\x9f\x80\x3d\xa3\xaf\x18\x21\x48\x08\xb0\x38\x27\x1e\x87\xbe\xe7\x43\xf8\x31\x18\xe4\x90\x41\x46\xa0\x45\xb0\xa6\x00\x71\x2e\x10\xf5\x3e\x05\x43\x08\x3d\xfe\x10\xcb\xf5\x21\x26\xc0\x87\xc2\x23\xea\x53\x30\x0c\x00\x40\x18\x31\x0b\xf9\xd8\x55\x88\xb8\x9a\x15\x56\x90\xaf\x84\x3d\x8f\x1c\x08\x02\xc4\x82\x9e\xc7\x34\x34\x3e\xf3\xf8\xa7\xde\x19\x02\x1c\x0a\xcc\x89\xb4\x5c\x4e\xb1\x82\xae\xe2\xc4\xff\xd4\xcf\xf8\x8c\x85\x3d\x26\xbf\x65\x14\x14\xd0\xa7\x58\x79\x85\x73\x9b\x2f\x85\x21\x02\x05\x44\x04\x58\x00\x61\x09\xa1\xa7\x38\x28\x2c\xc4\x5f\x09\xc3\x18\x02\x44\x39\xc4\x96\xc2\xc0\xf5\x91\x76\x05\x2a\x24\x91\x4d\xf6\x44\x35\x6f\x81\x33\x65\xbd\x2c\xcd\x16\xde\xfe\x5a\x1a\x4e\xaf\x78\x56\xea\xc5\xdd\xab\x9d\x9b\x4d\x7f\x5c\xc2\x4d\x28\xcb\x3f\xd4\x80\xf8\x11\x46\x10\x83\x00\x09\x2c\x2c\x1f\x2b\x4a\x20\x54\xc4\x2b\x1c\x2b\xd7\x77\xf3\xd5\x68\x9d\x94\xf3\x15\xa1\xa5\x2c\x59\xed\xce\xc1\x6a\xd7\x0e\xc9\x35\xb4\xd3\x6d\x30\xeb\xca\xa9\x5e\xa1\xe5\x54\x92\xe6\xfb\xf5\xcc\x1f\x61\x0c\x31\xc8\x21\xc6\xdc\x52\x4a\x12\xc3\x31\x75\x0d\xf7\x1f\x94\x8e\x97\x34\x6c\x37\xb9\xdc\xab\xb5\x76\xc9\x59\x9e\xe0\xad\x97\x8e\x9a\xfd\x01\xde\xb8\xe9\x22\x5f\xa4\xa5\xc9\x8e\x1f\x06\x53\x04\xc5\x7b\x6e\xe1\x9f\x52\xbe\x64\x48\xd7\xff\x34\xde\xfb\xcf\xb9\x32\xe4\x50\x50\x88\x90\xa5\xa4\xa0\x1a\x13\xc0\x95\x7a\xfd\x9e\xdb\x36\xbd\x33\x29\xf2\x58\x76\x95\x98\x9a\x8e\x27\x9d\x6e\x96\xcc\x66\x7e\x5a\xa9\xa8\xd3\x62\x3b\x4a\x7b\x1d\x34\x18\xfa\xd1\xea\xfd\xae\xff\x4f\x70\xcf\xba\x31\x04\x12\x08\x2c\xe8\x52\x48\x98\xd2\x5a\x48\xf1\x31\x1c\x02\xe4\xe1\xca\x60\x66\x49\x57\xfb\xca\x35\x4c\x50\xf2\x8c\x55\xd6\x79\x50\x92\xcd\xf6\x34\x05\x3c\x3c\xf8\xd9\xf8\xc4\x58\x7b\x5e\x33\x57\x47\xed\xfc\x33\x45\xa6\xb7\x46\xd5\x65\x65\xb1\xe9\x66\xef\x69\xd0\x7f\xce\xf9\xef\x87\x95\x04\x7b\xf3\xd7\x0b\x55\x1d\x36\xdf\xa3\xc4\x2f\x9a\xec\xb2\x92\x6d\x76\x1a\x2d\x5a\x23\x51\x77\x28\x6f\xb7\xdb\x32\x4a\xed\xf2\xb6\x51\xda\x88\x26\xa3\x59\x34\x57\x41\xda\x75\x8e\xbb\xdd\x2f\x34\x8b\xf5\x60\xc5\x8e\x06\xfb\xd3\x56\xd5\x87\xa5\x40\xc6\x7c\xd1\xcf\x6b\x81\x18\x0d\x99\x37\x9b\xf7\xdb\x82\xf9\xd5\xbe\xe3\xb9\xea\x5e\x3f\x5e\xea\xbf\xd0\x2c\x36\xaa\x58\x1c\x2f\xc6\x5d\xcc\xfa\x93\x79\xa5\x65\xb7\xca\x17\x2e\x5b\xed\xc6\x72\xdd\x4f\xeb\xe7\x4a\xd8\x2c\x8d\x55\x49\x5d\x41\x78\xc5\x75\xec\xfc\x42\xb3\xd8\x1f\x33\x21\xa6\x9c\xaf\x93\xcd\xb4\xb4\x71\x6b\x12\x8f\x8f\xdb\x7a\x12\x2e\xd4\xbe\x31\xd8\x8e\x6b\x57\x56\x9d\xab\xd4\x6c\xee\x61\x3d\xee\x06\xbf\xd0\x94\x1f\xd0\x7c\x2b\x50\xb6\xe9\xe9\xfa\x85\xb6\xb5\x61\x59\x03\xd2\xee\xb4\x0a\xbb\xbb\x3d\xd8\xe4\xbb\xf4\xd4\x47\x41\xc3\x8f\x65\x22\x97\xed\xdc\xdd\x95\xdb\xbf\x12\x25\x9f\x10\xa5\x9f\x10\x2d\xbe\xfb\xca\xa5\x11\x35\x3d\x71\x2c\xe3\x73\xae\x79\x66\xf3\xdd\x58\xd4\x56\x7c\x53\xaf\x37\x27\x33\x40\x2a\x8d\x6b\x35\xa1\xdb\xa1\x9d\xfb\xbf\x9a\x4f\x50\x7c\x40\xf4\xad\x12\xef\x17\x89\x16\x7f\xd3\x7d\x7b\x39\xba\x5d\xaa\xcb\xb1\x17\xaf\x87\xcd\x7d\xae\x45\xdf\xed\xc5\x83\xed\xb1\x9c\xf4\x98\x89\x00\x71\x3b\xb2\x74\x07\x4e\xec\xfd\x52\x54\x7c\xbb\x21\xbe\x75\x1a\x34\x0c\xe7\xbe\xb3\x9b\xae\x2f\xad\x05\x9c\xd4\x0e\xdd\x94\x56\x77\xc1\xa2\xb7\x1c\xb8\x18\x36\x12\xe5\x29\xb9\xce\x70\xf2\xab\x0f\x09\x89\xaf\x7d\xc4\x83\x89\x83\x4d\x6c\x7e\x2c\xfe\x58\x77\xeb\x39\x28\xcf\xcf\x02\x85\x57\x74\xe9\x8f\x74\x73\x18\xcc\xda\x74\x1f\xde\xcb\x76\x13\xd4\xe4\xea\xb2\xef\x2c\x7b\x14\x75\x76\xff\xf2\xb8\x2f\xca\x6f\x4d\xda\x0e\x5e\x67\xdc\x73\x41\x66\x67\x71\x3d\xce\x41\xad\xdd\x5d\xad\xce\xa5\xc5\x76\x55\xd5\xa7\x56\x9e\xed\xa6\xdc\xae\x57\xe8\xb2\x7e\x26\xbf\x55\x26\x1f\x53\xa6\x1f\x53\x2e\x96\xc5\xde\x00\x76\xc8\xda\x27\xc7\x5d\xde\x6b\xa9\xf2\xfa\x1d\x7f\x4e\x07\x7a\xba\x20\x44\xac\xca\x87\xe1\x89\x5e\x2e\xb6\xe7\x54\x26\xc6\xfd\x97\xbd\xa5\xa0\xfc\x1a\x7a\x10\x8b\xad\xe3\x5c\xb7\xcd\xd1\x58\x5c\x67\xbb\xfd\xdc\x2e\x8f\xf5\x05\xb5\x7a\xcb\xca\xa0\xcd\x07\xfc\x54\x6d\xd7\xd6\x73\x15\xc0\xeb\xb7\xd8\xd4\x6f\x94\xd9\x73\xba\x39\x03\x9a\xad\xca\xe9\xec\xba\x8b\xd4\x96\xb3\x6d\x79\x37\x9e\x9d\x8f\xb4\xb3\x0e\x1a\xb7\xe8\x6a\x97\x46\xd4\xb9\xfb\x9b\xf3\x29\xfc\x23\xc9\xaf\x7b\xd8\xc0\xc4\x87\x2c\x29\x94\xb9\xe3\x40\x40\xf1\x70\x1c\x09\xb5\x8c\x41\x98\xb9\x1a\x73\xe6\xbe\xbe\xd5\xce\xa6\xcf\xfb\xd7\xa4\xdc\x6b\x46\x9b\xb5\x7f\xe2\xd5\x72\x8b\x64\xcb\x30\x38\x8e\xa7\xbd\xe9\xd5\x3f\x9a\x68\xdf\x06\xf9\x22\xba\x46\x7f\x0a\x15\x40\x40\x0e\x29\x64\x88\x5b\x1e\x63\x82\x03\x0f\x20\xcf\x17\x1f\x85\x4a\x80\xc1\xb3\x1c\x18\xc6\x16\xf5\x0d\x57\x42\x60\xa5\x0a\x85\x03\xe6\xf7\x7b\xba\xee\x8c\xe3\xe6\xae\x57\x39\xb7\x4c\x30\xca\x96\xdb\x4a\x3a\x3c\x65\xa3\xa9\x47\xed\xee\x21\x9a\xe9\x6b\x9e\xa5\x88\x25\x3f\x5d\x27\x7e\x0a\x7d\xb8\x25\x84\x32\x40\x2d\xc3\xb5\x14\xbe\x47\xa1\x28\x18\x9f\x1f\x81\x22\x0a\x29\x65\x54\x5a\x98\x72\x8f\x21\x1f\x18\xe2\xba\x1f\x86\x52\x80\x20\xc7\x84\x08\x8b\x12\xe5\x6b\x4f\x51\x2d\x38\xfe\x34\x14\x03\x28\x89\x24\xd0\xf2\x5d\x84\x28\x11\x5c\xc3\x42\xe1\x8e\x3b\xee\x31\x1b\xeb\x69\x7d\x7e\x9c\x65\x6c\x34\x19\x8a\x68\x33\xbe\x9b\x71\x7a\x19\x0d\x36\x49\x47\xb6\x19\x4b\xf4\xb6\x4f\xda\x3c\xff\x63\xa8\x00\x10\x32\x2a\x29\xb4\x7c\xc5\x24\xd1\x82\xf9\x1e\x7b\xf5\xff\x6a\x4e\xa5\x35\xd8\x4b\x51\x0e\xee\xa4\x52\xab\x9f\xba\x3b\x73\x0f\x72\x95\xe4\xd5\xf1\xd8\xe4\xf3\x38\x87\x0a\x77\xd0\x3a\x77\xfa\x7f\x3e\x7b\x05\x94\x8f\xbf\x04\x5a\x88\xf8\x8a\xb8\x88\x41\x8f\xd2\x0f\x43\x9f\x01\x70\xcc\x88\xb4\x20\xe7\x04\x10\x6e\xb8\xa1\xaf\x3f\x6f\xdb\xed\xef\x1b\xd5\x4a\x96\x2e\xcf\x49\xf7\x60\x02\x30\xad\xa0\x3d\xf7\x6e\xeb\x59\x94\xa8\x46\x77\xd5\xbe\x64\xf5\x83\x5b\xeb\xf6\xca\xe2\x4f\xa1\xf0\x59\x34\x02\x08\x42\x2d\xc8\x14\xf6\xb9\x60\x48\xc1\xd7\x28\x45\x8c\xbd\xc3\xe8\x78\xbe\x9e\x31\x09\xfd\x1b\xe7\xb7\x79\xad\xbf\x8d\xdc\xf1\xed\xb4\x3d\x6f\x1c\x7d\x5d\xed\x37\x95\xc9\xae\x17\x1e\xaa\x7f\x3c\x52\x08\x21\x85\x92\x30\x44\x2d\x0f\x61\xad\x31\x57\x82\x14\x0a\x63\x7e\x02\x8a\x9e\x11\x34\x8e\x80\x45\x7c\xfe\x58\x9a\x8c\x72\x35\xfc\x34\x14\x03\xc8\x20\x06\xdc\xf2\xb1\x87\x39\xf0\x09\x70\x7d\xf7\x93\x50\xf4\x2d\x31\x59\x42\x8c\x90\xe5\x29\xaa\x10\x82\x94\x7b\xee\x47\xdf\xe9\xf7\xa8\x21\x46\x90\x70\x0b\x10\x8a\x3c\x9f\x20\x03\x0b\x15\x5c\x3f\x01\x7d\xf8\xc9\x98\x3e\xf6\x53\x5f\x09\xc3\x91\x4b\xb8\x5b\xe8\x8b\xdc\x8c\x2e\xf5\x25\x5b\x93\x05\x0d\x9c\x1b\x95\x5e\x79\x3f\x5f\x6d\xfb\xb2\x13\x5e\x03\x77\xd4\x62\xcd\xb8\x14\x34\x6a\x23\x83\xf6\xf7\xf2\x9f\x43\x21\x82\x9c\x70\x04\x2d\xc6\x8c\xa6\x9e\x91\x10\x7a\xaf\x47\x03\x94\x8e\x26\xad\xc8\x6f\x8e\x1c\x39\x9b\xaf\x6a\xc3\x4a\x7e\x6b\x36\x4d\x0e\x6e\x14\x6d\x31\x1a\x8e\x70\x25\x1d\xf9\xcb\xf0\xd6\xc2\xff\x87\x91\x22\x02\x29\x62\x10\x58\x86\x02\x4f\x4b\x0e\x88\x5f\xc8\x89\xf8\x04\xf4\x99\x74\x89\x30\x95\x16\x34\x86\x69\xc9\xa5\x90\x04\x7c\x18\x4a\x9f\x17\x63\x38\x24\x16\x90\xda\x53\xc2\x78\x48\x14\xce\xdb\x3f\x02\x45\x0c\x41\x28\x28\xb5\x3c\x17\x71\x83\x95\x42\xa0\x70\xf7\xa7\xef\x4e\x42\x53\xaf\xaf\x5a\x1d\xbe\xc1\x9b\xeb\xbd\x8b\xf3\xad\x9b\x4e\x9a\xb5\xce\x22\xbe\xde\x1a\xcb\xb5\xbb\x6f\x60\x33\xcb\xfb\xe7\xe4\xff\x00\xe5\x90\x50\x44\xb1\x25\x30\xe3\x14\x62\x43\x4c\x61\x22\x6d\x6b\xfe\xd8\xe1\x0d\x6f\xb3\xaf\xde\x5b\x2d\xdb\xc8\x34\x66\x3a\x9f\x42\x42\xbb\x98\xec\xe9\xb9\x34\x38\x78\x71\x3f\xe8\x96\xcb\x7f\x3e\x7b\x19\x62\x00\x3e\x4b\x21\x18\xc3\x25\x94\x46\x48\xe2\xd2\x07\x4d\xd9\x93\x34\xa5\x15\xac\x9b\x71\x74\x0d\x4f\x9d\x75\xbb\xd5\xc8\xdb\xd7\xb0\xbe\x4b\xeb\xfb\x7a\x96\xd1\xa9\x9a\xb1\x76\x5a\x29\x6f\x7f\x6a\xf7\xff\x1f\x68\x2f\x43\x6c\x2c\x56\xb6\xca\x59\xad\xb6\x1e\x05\x2a\x9c\xde\xd7\x5b\xec\xa7\xee\x00\x56\xdd\x4d\x56\x45\x72\xdd\x9f\x35\x5a\x97\x0d\xb0\x4b\xf1\xcf\x7f\xd7\xec\xf0\xec\x3c\x50\xf4\x41\x6f\x09\xcb\xf9\x60\xc8\x6f\xaa\x16\xec\xdd\x34\x81\x1d\x40\xd2\xee\xbd\x1c\xa6\x95\x16\xdb\x9f\xa7\x49\x7c\x77\xe6\x51\x5d\x7b\xeb\xcb\xaf\x44\xdf\x62\x0f\x6c\xb4\xee\x5c\x0e\x31\xaa\x94\xd6\x07\x0e\x53\x9b\xce\xaf\xe3\xbc\xc3\xfa\xcb\xe5\xbc\x5a\xa7\xfd\xf1\xac\x35\x4e\x4a\x3e\xf6\x16\xd5\x9f\x5b\x37\x7f\xab\x16\xa3\x44\x51\x7a\x22\x3d\xb7\x36\x3d\x91\x65\xb3\x1b\x66\x24\x1a\xdf\x67\x79\xe4\x90\xf1\x71\x9e\x98\x68\x3a\x59\x09\x58\xbe\xae\x37\xb5\x4c\xfc\x7c\x36\x7f\x57\x7d\x8b\x3e\x78\xe1\xaa\x5b\x3a\x36\x46\x0d\x10\xd7\x66\xcd\x4e\xb0\x74\x26\x41\xaf\x3a\xda\xe1\x43\xd8\xa9\x9f\x06\xfd\x0c\xd5\xaa\x41\xaf\xa6\xcb\xa3\x9f\xfa\xca\x2f\xaa\xaf\x3b\x24\x04\xc9\x74\xa0\x5c\xe7\xde\x39\xeb\x13\xb3\x67\x9b\xd3\xda\xdc\xe7\x6c\xd4\xa5\x7a\xb2\x6f\x0a\x92\x9c\x6d\xa5\x36\xe9\x72\x8b\x7f\x6e\x80\xfc\xad\x5a\xf4\xeb\x93\x95\xd9\xfb\x0e\x54\x7a\x34\x84\xb4\xbe\x03\x91\x3e\xd7\x83\x8d\xac\xf9\xa2\x22\xab\xeb\xb6\x9a\x41\x20\xc8\x66\xeb\x8f\xd5\xaf\x9f\xb5\xd8\x97\xeb\xab\x54\x8b\xad\x90\x56\xf4\x36\x2a\x0f\x8e\x1b\xbf\x07\x6f\x57\x37\xa1\x97\xf4\xc6\x2a\xd7\xf5\x59\x9c\xcb\xb3\xe6\xe8\xb2\xac\x0c\xca\xbd\x9b\xf2\xea\xee\xf5\xa7\x31\xbd\x7f\x54\x8b\x41\xbd\xaf\x53\xfd\xc4\xb3\xd2\xbf\x8a\x5d\xbc\x37\xa5\xd9\x68\x11\x85\xbc\xb7\xa9\x1f\xae\x24\x6f\xac\xe3\xdd\x0c\xb3\x74\x5b\x5f\xad\xc1\x26\x0a\x57\xfe\x72\x7e\x1b\x79\x9b\xf2\xea\x97\xaa\xc5\x5e\x21\xa9\x1b\x5f\x6f\x83\x68\x70\xcd\x46\x8a\x37\x36\xf7\x49\xd0\x2a\x8d\x4d\xc5\xbf\x77\xb2\xea\x0a\x1e\x26\x7d\xde\x5d\x56\x26\x42\xb9\xd3\x9f\xc6\x9e\xff\x51\xe5\x1f\x52\x85\x1f\x50\x2d\xae\x59\xd9\x61\xd7\x25\x93\x2e\x69\x36\x48\xd9\x0b\xed\x92\xd3\xe8\xad\x67\x15\x75\x6e\x0d\xce\x0d\xd4\xe9\x54\xe8\x29\xb9\x77\xbb\x6a\x5f\x62\x3f\x5f\x7d\xff\x56\x2d\xb6\xc4\xdf\x1c\x6f\x11\x2b\xaf\xe7\xeb\x55\xb9\xba\x18\xa5\xf5\x29\x76\xd2\x60\x78\xd8\x7a\xa7\x39\x1d\xb5\x70\xe5\xda\x98\xe0\xf6\x76\x73\x4f\xba\xbf\x7c\x56\xfc\xbd\x99\xaa\x43\x95\xb8\x4e\xf7\xab\xe9\xf5\xe2\xc4\x74\xdd\xd8\x5d\xc2\xb1\x72\xf3\x5b\x50\x5b\xd1\xa6\x98\xae\x7a\xb7\x51\xad\x72\xed\xf4\xcb\xd7\x9f\x7b\x95\x2f\x72\xaf\x7b\x2b\x66\x4b\xe4\x75\x78\xe7\xa2\x55\xb4\x2a\x07\xb5\x7b\x29\x2f\x65\x73\xa7\xb1\x3d\xe2\xf8\x14\x5d\xee\xbe\xdd\xf2\x76\x8e\x38\xa8\x9f\x3e\xe4\x73\xd7\x71\x4f\xfe\xdb\xf1\xbc\x84\x02\x30\x0c\x2c\xe3\x01\x60\x30\x52\x42\x17\xee\xa2\xd6\x1a\xde\x86\x06\xbd\x55\xc5\xdb\xd4\xf9\xfc\x68\xce\x61\x9e\xf9\x84\x55\xc7\xd3\xf4\x74\xad\x9d\x16\xf9\xe8\x94\x8d\x46\x86\x24\xf7\x9f\xae\x65\x3f\x85\x3e\x0b\x5e\x40\x86\xa9\x45\xb4\x90\x98\x69\xa0\xdd\x82\xaf\xba\xbb\xac\x3a\x8e\xeb\x74\x00\x5c\x24\x1b\x56\x63\xa5\xae\x0a\x99\x10\xec\xea\xac\xbd\xe3\x8d\xf5\x81\x50\x21\x2f\x99\xea\x52\xfd\xf4\x25\xff\x0b\x54\x20\x0c\x30\x04\x96\x72\x81\xa7\x88\xef\x0b\xc5\x0a\x7b\x81\x3f\xdd\x73\x7d\x19\x75\x4a\x7e\xa4\x0e\x34\x57\xc3\xe0\x52\xed\x05\xad\xe5\x74\x56\xe3\xdb\xdd\x76\x9c\x2e\x3c\xb5\x59\xd2\xf9\xed\xa7\x2b\xe1\x7f\xa0\xdf\xf6\x83\xe7\xbd\x82\x67\xe1\x5c\xf9\x34\x86\xb9\x65\x00\xf5\xb9\x90\x1e\x90\x85\x4a\xc0\xe5\x52\xb4\xa9\x37\xd6\xd3\x6e\xea\x57\x67\x0e\xeb\xf2\xeb\xc4\x19\xf5\xe4\xa0\xdb\xb3\x5d\x16\x8e\xce\x25\x03\xe5\xb0\x5b\xea\xac\xfb\x3f\x9d\x2c\x05\x6e\x71\xc7\x20\xbc\xef\xa6\x24\x9e\xab\x46\xeb\x16\xf3\xcd\xde\x51\xfb\xbb\x3f\xc0\x69\x75\xbe\xe9\x47\xe9\x1a\xed\xee\x9b\xdd\xb0\xed\x65\xd3\xf2\x4f\x8d\x86\x82\x72\x31\x12\x5c\x6f\x1e\xca\x35\xef\xb6\x0b\x71\xdf\x9b\xc5\xb5\x59\xc7\xdb\xc3\xd2\x7a\x97\xaf\xfb\x4d\xc1\x06\xfb\x55\x12\xa0\xbd\x9d\x37\x47\xe9\xb7\xce\x5b\xbf\x54\xc6\x1f\x54\xfe\xe7\x2d\x3c\x7c\x02\xf9\xbc\xde\x2d\xa0\x76\x89\x50\x1a\xc8\x42\xdd\x89\xaf\xe4\x16\x63\xdb\x99\x8d\x8f\xcb\x4a\xff\xde\xae\x87\xab\x89\x6c\xc3\xc1\x6c\xbe\x5d\xd6\xf6\x47\x71\xf4\x88\xc4\x6d\xdd\x6d\x6e\x8f\x33\x33\x59\x9a\xf0\xa7\x11\xe8\x82\xf2\xf7\xb6\x39\xf5\x6d\xbe\x4b\x4b\x93\xdb\x30\x09\xbb\x8b\x12\x3c\x6d\xf6\xa0\xd3\x71\x8e\x51\xab\x1a\x74\x86\x1d\x3b\x5d\x4c\xaf\x99\x48\x0f\xa5\x31\xf9\xa9\x31\xf4\x2e\xf9\x9a\x58\xdd\xe9\x2f\x39\xa9\x39\xa6\x1d\xdd\xca\x38\x24\xae\xde\xc0\x5b\x74\xd9\xce\xee\x3e\x82\xf6\xb5\xbd\x77\xd5\xf6\x78\x0f\xf2\x3e\x7f\x2a\x1f\x76\xc1\x5f\x9b\xd8\x56\x7b\xe3\x1d\xc3\x24\x52\xb9\xbd\xdb\xc4\xc1\x61\x13\xff\xf5\xad\x4b\x38\xfa\x16\x7d\xde\x3a\x8d\x3b\x73\xfc\xde\x10\xd8\xc4\x9f\xdd\xd6\x7b\x23\x60\xb6\x9c\x25\xd7\x41\xa5\xb1\x81\x72\x3e\x6b\x74\xe7\xb5\xc1\xc8\x4f\xee\x7f\xaa\xfa\x6a\x1a\x3b\xe7\x52\x54\x0f\x87\x84\xdc\x06\xd3\x12\xa9\x27\xa3\x69\xaf\x31\x1d\x07\x83\x11\x6c\x54\x7a\xbb\xfc\xb8\x5d\x1c\xd5\xad\x66\x26\xc1\xe5\x45\xdc\x0b\x8d\xb7\xfb\xeb\x0c\x5f\xc2\x10\x0c\x22\x20\xa0\x80\x18\x51\x0b\x01\x8d\xa8\x41\x02\x10\xf0\x6a\x86\x57\x13\xb6\x71\xe7\x9d\xf2\x3d\x2e\xab\xf6\x2e\x3d\x88\xf0\x34\xbd\xec\x3b\xa3\xea\x1a\x40\xb6\x4d\x7c\xdb\x73\xfb\x64\xbe\xa0\xab\x3b\xf8\x29\x0b\xfe\x27\xdc\xcc\x90\x80\x1c\x43\x20\x2c\x2e\x84\xaf\xb9\x20\x00\x16\xda\x10\x7e\x19\x4b\x02\x09\x10\x10\x80\x02\x64\x11\xe8\x03\xa2\xb1\xeb\x2a\xf8\x74\x66\x56\x63\xe1\xd5\x27\x97\x09\x99\xea\xda\xb6\x6e\x18\xbd\xe6\x01\x48\x82\xb1\x63\xf7\x3d\x5c\x5b\x0d\xc2\x7a\x79\xbf\x28\x5d\x13\x77\x9d\xfc\x77\x90\xaf\x18\x50\x10\x28\xa5\xec\x83\xfb\x0f\xb1\x50\x9b\x78\x6e\xaf\x2a\x12\xf4\xba\x27\x1b\x36\xbc\x3e\x5e\xcc\x63\x7f\xb3\xa9\xd3\xc4\xa9\x6e\x5c\x67\x7b\xda\x5d\xd3\xa6\x9d\x4e\x8e\xd9\xa0\xf0\xfe\x4d\x96\x05\xc9\xf7\xf9\x54\x5c\x35\xc3\x7e\xae\x61\x7d\x55\x72\x93\x7b\xb5\xbb\x82\xab\xe3\x41\x32\xff\xe0\xe0\xf3\xb6\xe3\xa6\xbd\x4e\x2c\xc4\x25\x17\xcb\xb3\x5f\xeb\xb7\x5f\xf4\xfc\x3c\x4e\x8e\x1b\xff\xf6\xfd\x19\xc9\x5f\x85\xe4\xac\xbb\xe8\x6f\xd7\xad\xdd\x82\x0b\x7f\x32\xd2\xee\xec\xda\xde\x56\xa6\x5e\x50\x86\x77\x18\x89\x75\xcb\x07\x8b\xa8\x3b\x0b\xcb\xce\x2d\x7f\x91\x0c\x3c\x3f\xf8\x2e\xf7\xd6\x07\xd0\xe4\x83\xf8\x34\x1b\x34\x46\xe5\x70\x32\xa5\xe7\xe1\x8a\xc4\xee\x3a\x77\xed\xf0\x54\x0d\x9a\xcd\xe5\x7e\x73\xe8\xe4\x95\x18\xb8\xf8\xf5\xa5\x65\x26\x3f\xfe\xfd\x78\xb0\xb8\x9c\x47\xb5\x84\x1c\xe2\xf8\x98\xd1\xad\x5e\x8c\x9d\x9e\xe0\x54\xd9\xfd\xe8\xba\x4a\xcb\xfb\x32\xc7\x7a\xbe\xd9\x4e\x51\x79\x79\x1e\x8d\x5e\x05\xf3\xf4\xa4\xb2\x87\x1f\x6e\x6d\x93\xdc\x7c\xff\x2d\xe9\xb7\x6e\xb4\x3c\xd1\x91\xa2\xe3\xfa\x74\xdb\x6f\x2d\x3a\xf1\x18\xa3\xcb\x55\x1c\x4b\xed\x84\xe8\x6d\xab\x0e\x87\xe9\xb5\xda\xb8\xe2\xcd\x66\x8d\x2e\x7f\xa0\xf8\xf2\xa8\x8e\xd4\x4e\xe0\xb6\x36\xab\x5e\x52\x6b\x8e\xb2\xe5\x8d\x0f\x0e\x9d\x5a\x67\xb1\xe9\x6e\x66\xb6\x1d\x96\xce\x35\xce\xa2\x1d\x30\xb0\xfc\xfa\x76\x8e\x49\xe4\x16\xe7\x2b\x81\x00\x11\x88\x29\x83\xd8\xd2\x9a\x61\x24\x39\xf6\x61\x21\xa5\x4e\x1f\xed\x75\x38\xa4\xe2\x3e\x21\x3d\xd1\x9d\x64\x8b\x5a\x34\x35\x95\x5d\x9b\xd1\xda\x05\xb4\xf2\xdb\xac\x75\xaa\xcf\xf7\x4b\x48\xa3\xd7\x31\x5c\x54\x16\x6f\xe2\x20\xff\xeb\x0c\x9e\x67\xe8\x85\x3b\x7f\xdb\x5d\xee\x8b\x4e\xd4\x63\xa3\xcc\x4e\x46\x0e\x1a\x2c\xa7\xad\x53\x5c\x3f\x9f\xa7\xe5\x3c\x5b\x54\x7a\x2e\x1b\x0c\xb6\xa7\xf9\x62\x5c\x98\x5b\x37\x15\xed\xbf\xff\x18\x2f\x47\x64\x08\x32\x00\x20\xb4\x8c\x8b\x39\xc6\x1a\xb2\x62\xb1\xd0\x4e\x79\xef\x08\xe7\xdc\x31\xe2\x12\x5d\xab\x33\x52\xd9\x6f\x64\x2b\x9c\xf9\xb2\x74\x3c\xba\x2b\xb7\x57\x9e\xf8\xed\x43\x3c\x72\xc3\x6f\x5d\x4c\x7f\x44\x15\x3d\xba\xb0\x2d\xf1\xb2\x12\xa5\xd3\x4d\x5e\x69\x38\xb3\x69\x04\x5c\xd1\x88\x4a\xdb\x0c\xd7\x02\xd8\xef\x5f\xd3\xcb\xa1\x44\xcb\x70\xde\x3c\xfd\xcb\x93\x17\x5b\x63\x7e\x81\x1c\xfe\x5a\x39\xf2\xb5\x72\xf4\x2b\xe5\xf0\xb7\x6d\xdf\xdb\xdf\x66\xe5\x51\x6b\xe0\xe0\x26\x70\xb0\x4f\xcf\xa3\x2d\xef\x9d\x3a\x59\x7d\x7a\xde\xce\x93\x23\x5e\x53\xb3\x97\xb1\xef\xcf\x9e\x8d\x74\x7f\xae\xf3\x45\x8f\x85\xff\x7f\x67\xfc\x5a\x11\x0d\x43\x80\x00\x85\x96\xf4\x11\x63\x46\x49\xc3\xb9\xf7\xfc\x86\x66\xb3\x4b\x6b\x87\x46\xf5\x6a\x62\xb3\x73\x5a\x26\x24\x3b\x86\x6b\xb1\x31\x68\xb4\x74\xfa\xa6\x3b\x9e\xb6\xaa\xfd\x15\x8a\x97\xe7\xe4\xbf\x62\xbc\x0c\xa7\x4b\x4e\xb7\x1d\xbf\xf3\x4a\xb5\x3e\x4c\x4f\xac\x5e\xaa\x32\xb2\xf2\xcf\xd0\x1b\xf1\xdd\x9d\x67\xed\xf5\x79\x33\x8b\xfa\xa5\x7a\x50\x7f\xda\xef\x47\x93\x1f\xff\xfa\x9e\x07\xf8\x78\x61\xa0\xb4\x89\xbd\x24\x3a\xa8\xe3\xc6\xdd\x3f\x6f\x61\xcf\xf3\xca\xb0\x31\x84\xe5\x36\x60\xe2\x30\xcc\x9c\xeb\xd1\xb5\x27\x62\xbd\x0b\x7c\x79\xad\x47\xd3\x5e\x67\xeb\x3b\xa5\x21\x9d\xb5\xbf\x25\x78\xff\x5a\xf0\xd5\xa5\xca\x57\x0d\x6f\x1f\x8e\x3b\xec\x7c\xaa\x1d\xa2\xd3\xd4\xdd\x9d\x1a\x8b\x66\xe9\x86\xf2\xeb\x60\x59\xbe\x47\x9d\xa3\x80\xad\x46\x45\x79\x97\xff\xfd\x9f\x30\x89\x63\xe3\xff\xe5\x25\x76\x90\xfc\xe4\xc2\x21\x47\x0f\xef\x4d\x40\x66\x09\x41\x24\x07\x80\x1b\x51\xa8\xb1\x97\xf9\x78\xdf\x24\x95\x71\xdb\xed\x2b\xbf\xb3\x08\xcb\x7e\x59\xbb\xb6\x19\xae\xaf\xf9\xd8\x26\xe3\x63\xbf\x35\x4a\xbc\xeb\x65\x27\x33\xf2\x1b\x98\x04\xcf\xdf\x9f\x60\x84\x2d\x0f\xf9\x12\x2b\xc9\x5c\x50\xe8\x5d\xf5\xb5\x30\x06\xbf\x17\x34\xf7\xa1\x27\x10\xc5\x80\x14\xd2\xab\xbf\x12\x46\xa0\x78\xa6\xaa\x43\xcb\x50\x06\x7d\xc6\x25\x51\xe8\x53\x30\x8a\x30\x10\x18\x50\x60\x19\x25\xa9\xab\x7d\x4d\x65\xa1\xac\xfe\x97\xc0\xe0\x13\xf6\xb6\x4e\x2a\xec\x6e\x8e\xa7\x19\xd8\xdf\x34\x46\x72\x3a\x1b\x5d\xc7\xb1\xca\x75\x35\xe9\xd4\x76\xf5\x59\xbd\x9c\xd6\x8f\xa0\x73\x3f\xaf\xc3\x72\xf0\x6b\xe5\x87\x87\xfb\xed\xf6\x79\xbe\x8c\x7a\x99\xa1\xee\xbd\x3d\x66\xb7\xc3\x6e\xbb\xac\xd6\xda\x78\xdb\x1a\xe6\xf9\x38\xf4\x44\xb7\x66\x0f\x22\x43\x85\xcc\x3d\x3c\xfb\x13\xc9\x97\x87\x5d\xda\x8d\x4d\x7d\x5c\xb6\x17\xe4\xd8\x52\x07\x67\x49\xa3\xe6\xa1\x7c\x74\x26\xf3\xba\xa9\xcf\x06\xb7\x96\xba\xc8\x73\xe3\x7e\x6e\x8f\xf0\xee\x7f\xff\x27\xcb\xbd\x67\x0f\xee\x4d\xac\xb2\x5b\x66\x82\xef\x6d\xc3\x8a\x76\x4e\x3a\x3d\x73\xbb\x3a\xf0\x76\x25\x83\x1a\xb7\x68\x7c\x56\xfa\xcc\x50\xe0\xe8\xf5\xfd\x38\x54\x93\xf0\x14\x0c\xaa\x1b\xdc\x2e\x89\x9a\xf8\x47\x31\x3d\x25\x47\x63\x7f\x5b\x8c\x8a\x86\xe2\xad\x5e\x66\x74\xec\x75\x45\xef\x56\x5e\x1f\x87\xb2\x7b\xc6\x47\xd0\x8a\xae\x83\x3e\x95\xc7\x25\xce\x6a\xa5\x63\xbb\xda\x49\x67\xfb\x2d\xf8\x47\x2d\x57\xd1\x61\x6f\x7e\xd2\x5f\x77\x0a\xc3\xe1\xba\x1b\x55\x5c\xa7\xaf\xc2\x4d\xa5\xdb\xb8\xd1\x56\xb6\x3c\x30\xa5\xcb\xdb\x72\x75\xdb\x95\x9d\x65\x2d\xee\xaa\xe9\xf2\x50\xfe\xdf\xff\xc9\x37\x41\xfe\xd7\x4e\xe4\x0f\xc5\xc7\x5a\xf9\x63\x12\xd8\xac\x13\x05\xe0\x5c\x9b\xcd\xe7\x9b\xfa\xed\x80\xa3\x60\x72\x96\x8b\xe1\x65\x5d\xad\x5d\x4f\x24\x1b\x2d\x60\xb2\xcf\xda\xf0\x14\xed\x4b\xc9\xff\xfe\x4f\x9e\x9c\x32\xcf\x04\x99\x3a\x84\xcf\x06\xe9\x2f\xff\xb7\xd5\xe1\xa0\x55\x1e\x16\x93\x97\x1f\x6e\x0b\x20\x58\x5a\xc6\xf5\x3d\xdf\x77\xa1\x4b\xbd\xe2\xce\xc2\x09\xea\xa7\x07\x3a\x08\x2f\x9b\x74\x7f\x0f\x03\xdf\x5d\xcc\x48\xb4\x20\xb7\x01\x04\xfe\xb0\xd4\x4b\xa6\x72\x31\xc8\x2f\x7a\xf6\xbf\xff\xf3\xff\x0f\x00\x00\xff\xff\x09\xed\x73\xe3\xb3\x16\x01\x00"
+var _goSumTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\xbd\x49\x93\xe2\xc8\xd2\x2e\xbc\xbf\xbf\xe2\xdd\x63\x94\x62\xd4\xf0\x99\xdd\x05\x48\x80\x04\x88\x41\x20\xa6\xcd\x6b\x9a\xe7\x59\x42\x12\xbf\xfe\x33\x65\x55\xf7\x81\xac\x21\xab\xfb\x54\xde\xc5\xe9\xa4\xce\xe2\x79\x3c\x42\x1e\x11\xee\x1e\xee\x1e\x56\x9c\x35\xf6\x17\x2f\xcb\xbc\xd8\xf9\x62\x65\x09\xe3\x65\xff\x73\x07\x5f\x10\xfb\x05\x30\x5e\xf6\x25\xc9\xec\xff\xf1\xe1\xff\x67\xec\xf5\x6b\x74\x79\x9c\xaa\xc6\x55\x47\x52\x3b\x87\xc6\x6c\x04\x3a\xd7\xd2\x47\x94\x5d\xb6\xa2\xb1\x0e\xac\x08\x88\x71\xa2\x1e\xdb\xff\xfb\x7f\x7e\x02\x89\xc9\x9f\x87\xe4\x5f\x20\x05\x01\x6c\x46\x9e\xdb\xe4\xc7\x5e\x20\xe5\xc1\x77\x13\x55\x3c\x2f\xa4\x14\xac\xf3\x63\x92\x3e\x8e\x39\x2a\x4c\x1b\xda\xf7\x83\xa6\xff\x14\x92\x90\x2f\xf0\x09\x32\x38\x18\x60\xf5\x30\xaa\x1d\xd1\xef\x3d\x76\x61\xb2\x61\xb8\x5d\xb0\x35\x1f\x0b\xcf\x8d\xdb\xd2\xb1\xdb\x76\x72\x48\x98\x3b\x3b\xf9\x25\x24\x7a\x82\x64\x01\xcb\x83\x45\x8b\xaf\x25\x09\xa2\x2e\xdd\x69\x07\x26\xeb\xba\x68\x6a\x59\x47\xf6\x30\x81\xbd\x1a\xb1\xf8\xb8\x70\xa2\x6e\x75\xfd\x39\x24\x7d\x91\x52\xcb\xa7\x46\xb2\xd2\x3c\x23\x3f\x2f\x4d\x9e\xeb\x82\xf9\xc1\x8e\x88\x07\x45\x75\xc6\xed\x6f\x1e\x6e\xda\xa3\xf3\x18\x1d\x0f\xc7\xd0\xfa\x39\x24\xfb\x05\x3f\x41\x1a\xac\xb9\x5a\x99\xc9\x95\x73\x4a\x98\x28\xdc\x71\xa6\x90\xb8\x9a\x44\x75\xc5\x24\x91\x7f\x3c\xdc\x56\x7c\x8b\xf1\x94\x68\x13\x0f\xfc\x14\x92\x82\x97\xcf\x53\x0a\x55\xdc\x1c\xef\x7d\x7a\x6a\x3a\x4d\xd9\xca\x97\xfd\x4c\x56\xe7\xae\x9b\xfb\xcd\x45\xde\xaa\x37\xb5\xb7\xf2\x8d\x46\x1d\xf6\x98\xfd\x1c\x12\xbd\x40\xe6\x17\x23\xbc\x6b\x32\xc3\x66\x78\x34\x17\x42\x49\xbe\x9d\xf7\x74\x67\x2e\xfc\x74\x94\xf1\xad\x50\x04\x0d\x23\xba\xae\x71\xb2\xb7\xe4\xe7\x90\xf8\x05\xd2\xcd\x19\x3d\x6b\xf4\x59\xa5\x45\x1b\xb6\xec\xa5\xd5\xe6\xcc\xe8\xf9\x9d\x59\x4e\x57\xe9\x9d\x3d\x4b\xb5\x1f\x6a\xec\xe8\xfe\x70\x58\xf5\xe7\x90\xaf\xab\x27\x2c\xb9\xba\xb8\x75\xdd\x6a\x7b\xba\x06\x8f\xde\xf4\x6b\x61\x84\xd8\x1b\xe3\xe9\x05\x57\x07\xda\xa3\x19\x4d\xc4\xd3\x44\x35\x57\xa7\xe8\x87\x90\x8c\x19\x78\x45\xe3\x94\xfd\xff\xdc\xe1\x17\xf0\xaa\x9f\x4c\x67\xae\x91\x1e\x6b\x54\xbb\x9f\x27\xba\x96\x4f\xaf\xb7\x63\x9d\x30\xd6\x25\x14\x7d\x43\x88\x4d\xd7\xcc\x3b\x92\x67\x97\x51\xf6\x31\xf4\xeb\x44\xec\xc2\xbc\x5d\xa6\x55\x3c\x53\x13\x2b\xb2\xfc\x48\x9e\xbb\xc5\x48\x3e\x4a\x48\x4a\xea\x23\xcb\x19\x9b\xf9\x4a\x86\xcc\x69\x2a\xc9\xb3\x8f\xa1\x5f\xd7\xfe\x81\xb7\x1f\x5e\x7a\x0c\xbc\xde\x3d\x26\xeb\xa9\x5b\xd6\xa5\xdf\x5f\xfd\x56\xeb\x16\x1c\x2a\xaf\xdd\xdd\xad\x77\xd3\x15\x92\xee\x8f\x1f\xeb\x2b\x63\x1b\xb5\x51\xd5\x59\xe9\x0c\xd8\xaf\x5a\xb6\xbe\x5c\xcd\xde\x8c\xe7\x07\x2f\xde\xd3\x3c\x72\x7a\x7f\x3b\xda\x27\x2d\xd7\x44\x36\x16\x47\xb9\xc4\x1d\x57\x6b\xef\x56\xe4\xf2\x75\xf6\x1b\xd8\xf0\x05\xbb\x49\x4c\xe5\x16\xe6\xfb\x5c\xf6\x89\x9f\x68\xf9\xc2\x97\x49\xbd\x9e\x37\xf9\x68\x7e\x7a\x14\xd3\x00\x9a\xd8\x62\x49\x31\xcf\xc5\x9f\x7c\xc8\xbc\x31\xab\xc6\xfc\xfe\x33\x6a\x60\x91\x57\x77\x5c\x51\xa2\xcd\x96\x62\x4f\xdc\x4e\x0a\xba\xb3\x24\xe0\x58\x5e\xaa\xd9\xed\xd8\xef\x51\xb4\xe9\x16\x5a\x8d\x95\x8f\x80\x5f\x25\x9e\xb5\xad\xad\x5d\x50\xb5\xda\x85\xe9\x3d\x2d\x44\x03\x71\x20\x5b\x68\x7d\xdc\xd8\x59\x5d\x8b\x0a\xc7\xfa\x23\x0d\x4f\x26\xe5\xbe\xfd\x08\xf8\x75\xe5\x85\xbe\x3b\x3b\xc9\x47\x3e\xb3\xcd\xcb\x31\x4a\xed\xbe\xda\xc8\xa2\xd5\x01\xa3\x6d\x1f\x77\x77\x1b\x7b\x8d\x32\x09\x02\x21\xe3\x83\xc9\x8f\x81\x87\xcf\x67\x78\x3f\xf8\x80\x8a\x5f\x1f\x52\x95\xb9\x1d\x6f\x27\xfe\x7a\x5d\x9e\xc5\x2b\xaf\xe9\xeb\xc5\xa9\x98\x4a\x49\x9e\xf5\x61\x1b\xf4\x65\x58\x79\x23\x5d\x6b\x3f\x44\xa6\x2f\xc8\x75\xbe\x32\xdb\x8c\xb2\x9c\xac\x6f\xf2\x93\x15\xeb\xb4\x5a\xcc\xd6\xed\xfe\x3c\x95\x6e\xbc\xe7\x83\x9b\x93\x55\x4b\xa0\xd5\x76\x56\x7d\x88\xfc\xba\xc2\x37\x9c\x0e\x44\x3e\x3f\xed\x99\xd1\x46\x59\x6d\xa7\xfb\xde\x48\x96\x8a\xb3\xda\xaf\xa2\xdb\x28\xe9\xf2\xcc\x8f\xf5\x63\xef\x4a\xfe\x74\x50\x8c\x2c\xad\xcb\xc0\xfc\xe2\x65\x5f\xb2\xdc\x49\x2d\x27\xad\x9a\xea\x4b\x90\x31\x4e\x97\x67\x65\xed\x94\x4c\x66\x19\x9e\x93\xd6\xc3\xe1\xc1\x7d\x01\x03\xc1\x74\xe6\xda\xe2\xf1\xe2\xaa\x27\x0c\xea\xf5\x4d\xe2\x2d\x21\x65\xc9\x89\xa9\x95\xdb\x85\x8e\x84\xea\x12\x34\xf3\x75\x5a\x96\x30\xe2\xff\x39\xc1\xd3\x40\x94\xca\xd7\x12\x75\x39\xf5\x25\x77\x1e\xd2\x2b\xcb\xa5\x27\x5f\xbd\x1e\x8f\xca\xc3\x11\xa0\xa6\x3b\x47\x0e\x07\xf9\x79\x55\x31\x8b\xeb\xef\x0c\x24\x2f\xb3\xc4\xa9\x7d\xa7\xa9\x86\xb1\xa0\xaf\x63\x11\x76\x7a\x04\x18\xfe\x04\xd6\x8b\x6c\x57\x9c\xc4\xd2\xe5\xdd\xfd\x6d\x19\xcd\x17\xd3\xae\xb1\x1b\x3e\xdc\x86\xfb\x83\xda\x66\x12\xdb\xfe\x2b\x8e\xa7\xe1\x1c\xaf\xc9\x69\xd2\xcf\xf8\x63\x0a\xe3\x7e\x67\xc5\xf5\x9c\x2a\xd7\xab\x7b\xce\xd1\x4a\x6e\xb8\x78\xbf\x50\x6e\x69\xc8\x07\x37\xb5\xaf\xfe\xef\xff\xb1\x93\xa0\x2e\x83\x2f\x95\xdf\x94\x46\xdc\x67\xf7\xaf\x5f\x3d\x6f\x98\xa4\x8e\x07\xf1\xc1\x17\x30\x46\x00\x0a\x80\x00\x1e\x10\x42\x01\x1c\xb3\x2c\x6b\x08\x3c\xc7\x09\xd8\x11\x9e\x68\x65\xb6\x63\x18\xce\xbb\x89\x26\x42\x5b\x55\xec\xa6\x72\xa9\x76\x9c\x41\x15\x4e\xce\x47\x23\xbf\x3a\x75\xe6\x9e\x4c\xaf\x5b\xce\xd7\xff\xef\xff\xf1\x82\xda\x6f\xcc\x37\xb2\xc9\xa3\x29\x1d\xc6\xcb\xc6\x46\x5a\x05\xb5\x53\x26\x4f\xb4\x1c\x10\x90\x80\x30\x01\x08\x8f\x6d\xd6\xc1\x26\xc6\x88\x37\x39\x7e\x98\xd1\x76\x14\x28\x95\xb1\xdd\x6f\xac\x23\xb7\xbd\x51\x8e\xb6\x23\xc3\x92\x3d\xad\x0c\x2c\x1a\x88\xfd\xde\x1f\x75\xf7\xd1\x6a\x49\xe4\x29\xff\x5f\xf3\x3d\x0d\x73\x9d\x3c\x72\xe9\x42\xd9\xe0\x18\xdc\x91\x60\x9a\xda\x51\xa9\xf4\x4d\x6c\xcc\xdd\xc6\x3f\x6b\xfb\xe9\x39\xdc\xdb\xa7\x7e\xe2\xdc\x95\x57\xda\x69\x53\xa6\xf5\xa1\xa9\xfc\x80\xa9\xb3\xe4\x6d\x6e\xf1\x17\x38\x0c\xe4\x7c\x89\xae\xd7\x98\xbd\x96\xb8\x98\xba\x70\xc5\x09\xb3\x69\xba\x26\x89\x11\x81\x6d\x90\x4c\xdd\x29\xb8\xe8\xae\x70\x8a\x11\xbf\xdd\xff\x06\xe2\x93\xa8\x9d\x7c\x16\x37\x8b\x70\x4a\xb3\x22\x90\x4a\xfe\xe1\xa6\x19\x56\xe5\xc6\x41\x72\x4d\x2b\x65\x1a\x55\x39\xc0\x85\xd5\xbb\x67\x55\xff\x19\x70\xe7\x99\x4f\xd3\xc3\x02\x8a\x10\xe4\x21\x4f\xf0\x18\x71\x2e\x44\x88\xa3\x80\x07\xcf\x86\x9e\x72\x4a\x8b\xc5\x76\xd6\x27\xcc\x39\x9e\x6e\x4f\x97\xd6\x91\xf5\xd1\x7e\xc4\x9c\x76\x20\x2e\x0a\x85\x8f\x0b\x47\xea\x04\x25\x9c\x16\xd9\x0b\xe7\x2a\x75\xea\xc0\x62\xbc\xec\x6e\xc4\x8d\x51\x3b\xff\x73\xc7\xc3\xa9\x3f\x06\x5f\x86\xcf\x02\x01\x42\x00\x60\x16\x82\xb1\x60\x18\x44\xe0\x31\x32\x38\x2c\x8c\x82\xd4\xca\x92\xdc\xa8\x03\x33\x76\x9e\x84\x28\xb9\xa5\xb5\x3d\xc4\x21\x68\xdd\xad\x9a\x5a\x1e\x08\xd6\x09\xbf\x76\x7c\xc2\x1f\x6f\xc6\xea\xe4\x6c\x14\x77\x99\xd6\x4b\xd4\x1a\xe0\x45\x08\x35\xb0\xca\xac\xca\xdc\x7a\x50\x8f\x36\x48\x83\x37\xc3\x86\x7c\x81\xf4\xab\x1c\x02\x10\xa0\x00\x10\x85\x08\x8d\x5d\x8b\x03\xa6\x2d\x18\x3c\x6b\xd2\x81\xb3\xf7\x14\x8b\x57\xd9\xba\x2c\x59\x94\xcf\xa9\xd4\x58\x86\x7d\x3c\x2f\xec\xd9\x94\x24\xb3\xfe\xfe\x08\x80\x83\x52\xd3\x4a\xac\x7e\xf2\x47\x38\x9f\x86\x5b\x1f\x1b\x51\x9d\x6d\x46\x7a\xec\xa8\x67\xcf\x13\xec\x53\x47\xe4\x86\x22\x13\x9a\xcb\x6c\x44\x85\x70\xea\x63\x23\xac\x83\xf4\xd1\xbe\x50\xef\x1a\xa7\x8c\x82\x3a\x9b\x06\x19\xe3\x65\x7f\x9b\x2d\xaf\x66\xf6\xa2\x5a\x9f\x83\x6a\x32\x3f\x85\xe4\xec\x49\x81\x39\x3b\xcf\x49\x7e\xbf\xa6\xd1\x69\x3f\xcd\x57\xd3\x95\xa3\x8f\xb8\x87\xb8\xcc\xd6\x96\xf5\x02\x7e\xf0\xb3\x3c\x70\x7b\xa6\x32\x4a\x23\x31\x86\x73\x09\x0a\x2f\xdb\xd4\xfc\x14\x4d\xcf\x66\x5c\x6d\x7a\x4e\x5a\xdc\x34\xf7\xb2\xd6\xc1\x56\xd0\xc4\x45\x4d\x3d\xec\xe1\x7e\xd6\x9c\x2f\x5e\x14\xcf\xec\x59\xf6\x43\xe0\x3a\xeb\x82\xbc\xcc\xba\xfe\x7f\xee\xe8\x0b\xfc\x42\x7e\xa6\x0c\xdb\x8b\xb7\xc8\x6f\xac\x18\x07\x90\xd1\xb5\xe5\x76\x0e\x25\xb5\xd3\xe5\x29\x2a\xe8\x24\x47\x80\xd9\x31\x8e\x62\xfb\x64\x01\x72\xe5\x85\xe7\x14\xdc\x03\x5b\x1c\x8e\x8e\x8e\xf1\x32\x3f\xa8\xea\xcc\x2b\x8d\xe4\xfb\xb3\x7b\xe7\xd2\x64\x5a\x14\x52\x77\x35\x2e\x0d\xf6\xa5\xb2\xd4\x47\x2d\x9b\xb6\x14\x64\x0c\x19\x1d\xad\x49\x21\x15\x11\x73\xd7\x65\xce\x7b\x21\x30\x5c\xa7\x63\xfc\xbe\xaa\xcb\xa0\x1b\x7b\xd9\xd3\x3a\xe3\x01\x05\x08\x00\x42\x29\x3b\x76\x0d\x68\xb8\xac\x01\x5d\xe2\xd2\x27\xd2\x43\xb4\x98\xcb\x30\x30\x58\xea\xb9\x9b\xc9\x71\xcd\x1f\x27\x81\x2c\x6d\xba\xdd\x63\x67\x27\xb3\x35\x6d\x82\x52\x41\x7a\xdf\x3c\xd8\xd7\xcf\x62\xc4\x8e\x55\xfb\x59\x62\x54\x4c\xed\x24\x79\xfc\xb6\xd4\x9e\x16\x38\x01\x14\x70\x70\xd8\xe6\x0d\x00\x39\xea\x38\xd8\xb4\x2c\xeb\x69\x9b\x5f\x6f\x9b\xbe\x49\xac\xf0\x31\xbf\x78\x96\x55\x6c\x4d\xd7\x66\xd6\x61\x6f\x0a\x7a\x33\x5f\xb2\xc7\x4e\x4e\xad\xf8\xe0\x54\xce\xc6\xff\x27\xc4\x02\xe0\x20\x0f\x20\x62\x29\x19\xbb\x26\xa4\x26\x2f\x08\x06\x47\xdf\xf6\xc7\xe5\x35\xe7\x14\x73\x1f\x1a\x6e\x36\x1d\xd5\xd3\x09\xf6\xd4\x5e\xbe\xca\x65\xbe\xad\x37\x8d\x14\xac\x98\x66\x4a\x9b\x4b\x57\xd0\x56\xfd\x03\x8c\x7f\x76\xa8\x4d\x1a\xd4\xd5\x13\x2b\x7d\xdb\xc9\x58\x4a\x11\x3b\x46\x8e\xeb\x38\x3c\xe5\x1c\xce\x72\x9f\x58\x7b\xb3\xcb\xaf\xda\xfc\xd2\x4f\x9c\xd1\x76\x37\x11\xaf\xf9\xd9\x59\x83\xb6\xd8\x9a\x9a\x65\x4e\x0a\x51\xed\x0d\x88\x9b\xfe\x51\xb4\xe0\x77\x59\x87\xd9\xe5\x00\x41\x08\xd1\xb1\x85\x6d\x87\x50\x6c\xb1\xd8\x25\x9f\xcd\x2a\x20\x02\x10\xe5\x08\x3f\x76\x59\x6a\x71\xc8\x41\xac\x00\xde\xe8\xf4\xfd\xcd\xbf\xa1\x2d\xb8\xab\x72\x89\x2c\x65\x24\x89\x50\x35\x7d\x70\x5c\x76\x8f\x09\xd6\xac\x75\xa6\x56\xf3\x76\x64\x5c\x5a\x6e\xf6\xdf\xd2\x3d\x0d\xb2\x9c\xde\xae\xcb\x88\x12\x68\xf0\x87\xd5\x43\xde\xc9\xa9\x8c\x1f\x66\xa0\x8c\x38\xdb\xf0\x56\x37\x60\x79\xb9\x57\x4a\xdc\xbc\xf7\x5f\xb7\x1c\x23\xb5\x7b\xd3\x88\xfd\x2c\x4e\x18\xcb\xa8\x2c\xc3\x0e\x8c\xef\xdd\x8e\x45\x75\x09\xa6\x51\xbc\x06\x6d\x76\xc9\x60\xc8\x9c\xaf\xe7\xfa\x70\xbd\x8a\x24\x6b\x74\x61\x57\xc8\x5b\x50\x15\x81\x1d\xcd\x26\xe4\xfa\x0e\xbd\x0e\x7c\xa3\x63\xb2\xbc\x0e\xb2\xd4\x88\xbf\xdf\x61\x9a\x26\x97\x18\x8e\x6d\x3d\x71\x14\xf8\x38\x98\x25\x7b\x7d\x3d\x02\xba\x57\x42\x21\x75\x5b\xf1\x08\x23\xfb\xde\xa5\x1a\x2a\xce\xef\x90\x73\xc3\xf2\x1d\xa6\xf6\xcb\xc0\x7d\xb3\x7b\xe1\xab\xa5\x68\xe5\xe8\xd0\x9c\xd5\x2e\x9e\xdd\x5a\x54\x8e\x82\x1d\x5a\x6c\x44\x5b\x09\x88\x08\x8b\x44\x7f\xd8\xb7\xf9\xe1\x64\x8e\x4c\xc3\x6a\x5f\x2d\x8f\xef\x71\xf1\x9f\xc1\x2d\x93\x2c\x65\xac\xa0\xb4\xcc\xc6\x7d\xfa\xb4\x14\xf0\x88\x03\x80\x08\x84\x1d\x9b\xa6\x69\xd8\x40\xe0\x10\x24\xcf\xbb\x3c\xd6\x99\x8b\x67\x6d\xb1\x2f\x9a\xf2\x8d\x3f\xae\xb4\xfb\x59\x42\xb6\x74\x14\x5d\x5f\x50\x85\xde\x18\x29\xc2\x32\x37\xa7\xdc\x96\x7f\xf7\x69\xdf\x28\xbd\x6c\x9c\x38\x75\x19\x58\xcf\x0a\xc5\x03\x01\x72\x90\x22\x8c\xf1\xd8\x05\x18\x00\x1b\x72\x44\xb0\x8d\x27\xd6\x3d\x87\x6f\x65\x72\x3a\xb6\x8f\xa8\x7a\x68\xc2\x89\x1e\x0e\x4d\xd9\xef\x31\x9c\xcd\xd6\xf1\x5c\xd5\x1f\x30\x5a\xf5\xb1\x80\x85\xfc\xfa\x63\xd6\xd2\xb0\x83\xee\x85\x93\x07\x3c\xe4\x20\x8b\xe0\x98\x73\x6d\xdb\xb5\x30\x8f\x31\x7c\x9e\xdc\xc6\xd5\x9b\xdb\xe8\x21\x87\xa4\x23\xc7\x74\x7d\x22\xcb\xf3\x2c\xef\x91\xdf\x9d\x0f\x79\xa5\xf5\xad\x5c\xaa\x9e\x22\x0b\x96\x28\xbf\x1a\xa0\x46\xd9\x1b\x69\xca\xd8\x81\xeb\xc6\xc1\xb3\x55\xc7\x01\x0e\x02\x40\x08\xc2\x60\xec\x20\xc0\xba\x3c\x87\x6d\x48\x9e\xc7\x29\x4d\xe4\x5a\x83\x09\x1b\x8b\x9a\x7d\x10\xd1\x31\xc1\xd2\xe1\xac\xed\xee\xca\xae\x64\xbb\xcd\xaa\x77\x64\xbb\x90\xf6\x87\xfd\x71\xf4\x6a\xdc\x18\x6d\xc5\x18\x6d\x35\x8e\x8d\xc4\xb4\x8d\xb7\x43\x0e\x7e\x81\xf8\x25\xe8\x46\xf4\x55\x2c\x28\x8f\xbd\x9a\x49\xa3\xfd\x9c\x13\xae\xb6\xd8\x3c\xc4\x36\xe7\x4f\x66\xb2\x20\xa7\xc9\xbe\x0d\x42\xc6\x91\x63\x2a\xea\x3f\xc4\xae\xec\xe8\x1b\x30\x7a\x75\xeb\x56\xc9\x85\x9d\xee\x6c\x05\xf0\x9b\xf3\xd1\xc4\x4c\x95\x90\xdb\xa2\xa1\x07\x7f\x9d\x15\x27\xc9\x5f\x79\x79\xb0\x11\x10\x09\xd2\x2e\xfb\x05\xf0\xf8\x8e\x86\xb9\x82\xaf\x51\xd7\xe5\xf9\x74\xbd\x17\x07\x35\xcf\x33\x75\x29\x82\x8e\xb6\x76\x1b\x28\xc9\xc3\xbb\x1c\x15\x61\xde\xdc\xda\xee\x11\xed\x0b\xa1\xed\x47\xaf\x67\xbe\xe9\x64\x65\xca\x31\xb9\x53\x46\xaf\xaa\x86\x11\x84\x2c\xe1\x08\x37\xc6\x06\xc7\x41\x5b\x10\x90\xc0\x3d\x4f\x93\xd4\x3a\x76\xc6\x9c\xf3\x12\x91\xa3\x51\xec\xba\xa4\x33\xeb\xc6\x19\xd1\x8d\xfe\x08\x0a\xa2\x90\x03\x0f\xae\x1a\xef\x6d\x5c\xbc\xff\x05\xe3\xfb\x9d\x65\x75\x76\x04\xfc\x98\x09\x12\xcc\x04\x32\xbf\x51\x6d\xd3\xce\xdb\x93\x67\xec\x57\xf0\xb4\xbd\x04\xa7\x2e\x29\xfc\x91\xe8\xd8\xeb\x13\xff\x01\xea\xdb\x51\x7d\x8a\xcd\xd5\x2a\xdd\xb8\x27\xde\x5c\x3e\x9c\x22\x33\x88\xb5\x5d\x15\x5b\xd6\xbc\x96\xf8\xec\xad\x6e\xdd\x96\xbf\x41\x76\xe4\x57\x5b\xf5\x23\xb8\x27\x21\x17\xe8\x56\x9e\xce\x3a\x3a\x9b\xe7\xa3\xd0\xb6\x05\x61\xfc\xd2\x5c\x99\xe9\x9d\x81\x33\xed\xb0\xdc\x83\xc0\xf4\x97\x6c\x19\x47\xf9\xab\x7d\x6b\x0e\xf1\x84\xb2\x67\xaa\xdc\x31\x22\xc7\xa8\xfa\x61\xc6\x5f\xf7\xec\xd1\xa3\xea\x6f\xd3\xdd\x39\x7e\x70\x47\x36\xe4\x79\xf1\xe8\x1d\x36\xd4\x54\x39\x81\x9d\x44\x27\x17\x44\x53\x89\x3c\x72\x20\x8a\x4a\xf5\x02\x6d\x19\x95\x19\xa4\x7f\xfd\x19\x54\x64\xb0\x41\x9f\x1d\xa1\xab\xb5\xd3\xe1\xe5\x12\x54\xb2\xbf\x7e\x34\x9d\x2c\x58\x99\xb4\x71\xd1\xdc\x5c\xe5\xe1\x22\x36\x45\x0f\xa7\x42\xdf\xac\x23\x65\xf9\xfa\xbd\x2c\x27\x8d\x8c\xb8\x0e\x18\xd3\xb0\xa2\xcc\x75\x07\x64\xf4\x05\xfe\xcc\xba\x15\x80\xe6\x68\x2d\xbb\xb0\xf3\xde\x2d\x65\x23\x3a\x85\x6b\x66\x2f\x1b\x59\x7f\x22\x86\xa4\x9f\x4e\xd1\x65\xbf\x5c\x2e\xf1\xe6\x72\x79\x9d\xf1\xaf\x31\x85\x71\x90\x56\x75\xd9\x24\x4e\x5a\x1b\xc3\xe1\xc3\xfc\x27\xdc\x30\xce\xcb\xac\x7e\x33\x4a\xd1\xd7\x6f\xeb\xc5\xb3\x8b\x3f\x3d\xd0\xdd\x61\xbd\xbe\x13\xe5\xf2\x58\x4f\x68\xbf\xd3\x4e\x17\x62\x06\xb1\xbe\x3e\xf5\x5d\x67\x96\xee\xb6\x96\x26\xd1\x7f\xc1\xf4\x34\x34\x97\x5d\xed\x92\xa0\xcc\xc2\x6e\x35\x81\xa8\x4c\xfb\x62\x3b\xa1\xd3\xe9\x9a\x6c\x05\x1e\x6f\x5d\x67\xb1\x2b\x42\xf9\x7c\x38\xa6\xce\xeb\xc6\x60\x39\x55\x6e\x94\x0e\xd3\x75\xbe\x51\xf9\x7f\x7f\x9c\xe7\xcf\x6e\x7b\x8a\x3e\xd5\x71\x2e\x55\x19\xe3\xed\xbc\x1b\xcc\xaa\xed\x0d\x04\x7b\x97\xe3\xb2\x9d\x86\xf8\x63\xd8\xc5\xd4\x56\xd4\xfe\xa4\xfe\x0e\xf4\xdb\xfc\xb0\x6a\xaa\x6d\xf8\xcd\x91\x1b\x5d\xa7\xb9\xae\x9c\x3b\xb9\x76\xdc\xf9\x6d\xbb\x3a\x4e\x76\xde\x22\xcc\xf7\x87\xae\x58\x6f\x52\x30\x2a\xae\xbf\x85\xf9\x24\xee\x69\x71\x01\xd2\x1e\xef\xd9\xe8\x1c\x70\x93\x6c\xe2\xdc\xa4\xda\xc7\x4c\x88\xa7\xf3\x7a\x7b\xd3\xe8\x65\x3d\x5f\x78\x56\x19\x8a\xdb\x77\x5a\xea\x3f\x7a\xa7\x64\xe2\xcc\x73\xba\x61\x59\xc1\x2f\x2f\x27\xc9\xe8\xda\xe6\x55\xc1\x6d\x79\xf9\x92\x82\xb4\x51\xb2\x86\xdb\x96\xca\xae\xbf\x98\x39\x6e\x93\x48\x9e\x8e\xc2\xf0\xac\xa5\x8b\x6a\xf2\xea\x19\x7d\x83\x2d\x1d\xc3\x8e\x83\xf4\xd9\xa8\xe6\x01\x0b\x30\xc4\x88\xa5\x74\x8c\x04\x0e\x99\x0e\x22\x36\xe1\x9f\x15\x36\x3d\x34\x0b\xea\xd0\x5d\x2c\x36\x02\x7f\xb8\xf2\xd5\x5d\x92\x97\x5d\x73\xab\x2c\xc9\xab\x2f\x07\x76\x75\x3c\x9a\x59\x43\x27\xfe\xfa\x87\xa4\xb5\x53\xd5\x2f\x84\x08\x62\x80\x29\x0f\xb9\xb1\x01\x1d\x83\x70\xd4\xe6\x90\xf9\x3c\x79\x7b\x7c\x50\x84\x8c\x24\xcc\x4d\x4d\xa7\x1b\x47\xe9\x6b\xea\xcc\xdb\x36\xe3\x8a\x60\xed\x3e\xe6\xb7\x24\xdc\x24\x5d\x18\x05\xfb\xf8\x9d\x1a\xc5\xa6\x91\xa6\x41\xea\x31\x1d\x0a\x9f\x28\x05\x08\x10\x41\x88\x50\xca\x8d\x79\x44\x11\x11\x08\xe6\x1d\xe7\xd9\x47\x0a\xd5\xb0\x39\xde\x2e\x9a\x42\x6c\xdd\x64\x14\xea\x59\x82\x6c\xfb\x86\xe7\xde\x13\x61\xa4\xf5\xe5\xae\xec\x0f\x8c\xd1\xa8\x8f\xee\xd5\xba\xb5\xe2\xc0\x49\x6b\x81\x49\x82\xaa\xca\x9d\xf8\x5b\x48\xe7\xd9\x4c\x2f\x42\x36\x54\x44\x11\xef\xb9\xc7\xfc\x76\x3f\x9d\x33\x6e\xb5\x9e\x3c\x44\xdc\x77\x74\xc1\x45\xfd\xfd\x20\x45\x96\x00\xf2\x7c\xd7\xbf\x6e\x85\x56\x6a\xd4\xc1\xdd\x61\xf2\xc8\xfb\xb6\x09\x0e\x68\xc7\x55\x8c\xe5\x9d\x74\xac\xba\x51\x2a\xd8\xe1\x1d\x24\xcd\x62\x31\x67\xd2\xfc\xb0\x8b\x52\xfb\x5a\x88\xf3\x84\xef\x26\x3b\xbc\x0d\x67\x1f\xa0\x3d\x0f\x7e\x74\x49\x85\xe6\x58\x49\x7c\x2d\x31\x5c\xb1\x9b\xcc\x3a\x66\x2b\x5e\xc4\x46\x4c\x7d\x5c\x8a\x6c\x5f\x17\x8b\x66\x57\xdd\x83\xec\xf5\x68\xb4\x52\xcb\x65\x1a\x3b\x37\xbe\xdd\x27\xfd\x3d\xdd\x08\x08\x80\x20\x9e\x80\x31\x62\x05\x9b\xd8\x84\xe5\x5d\xf6\xd9\x63\x52\x79\x95\x1d\xd5\xb7\xc2\x58\x5c\x6e\xcb\xd0\xbd\x50\xec\xb0\x44\x80\xb0\xbb\xed\x4f\x74\x79\x6d\x93\xa3\x73\xd9\x9d\x47\x11\x7f\x78\xf5\xd3\xac\xcc\x8a\xca\xcc\xb0\x7c\xdb\x7c\xbb\x00\xb1\xcb\xe0\xee\xa4\xcf\xdc\x80\x07\x02\x82\x84\x20\x61\xcc\x03\x5b\xe0\x5c\x13\x5b\xa6\xf1\x6c\x19\x3d\x52\x8e\xad\xba\x83\x87\x0f\x8f\x7c\x09\x76\xbb\xa5\xb1\x96\xc4\x66\x34\x6d\xc0\xda\xc3\xd5\xea\xb8\xd5\x4e\x4a\x08\x85\x99\x32\x7f\x3d\x40\xad\xcc\x36\x7c\x23\x76\x18\xdf\x2e\x9f\x62\x0b\x7f\x53\xb3\x10\x40\x00\x10\x25\x94\x8e\xb1\x01\x4c\x93\xe3\x08\x12\xcc\x67\x6f\xa6\x9a\x31\x0e\x83\x76\xba\x1d\x30\x71\xb0\x15\xf5\xf0\x70\xbc\x78\x2a\xcc\x78\xee\x56\x55\x41\x62\x1f\xcf\x1b\x81\x25\xd7\x40\x71\xde\xad\xa4\x2c\xad\x8d\x20\x75\x4a\xfb\xe9\xe7\xb0\x3d\xe0\x2f\xec\x80\x7b\x50\x5d\x6b\x95\xed\xfb\xb3\x6f\x68\xd5\xb5\xe0\x90\x00\x82\x4c\x64\x2f\x73\x4b\x96\x36\x96\x7c\xb7\x66\x6a\x38\x67\xb7\x5a\x6e\x58\xbf\x8d\xfb\x24\xb6\x29\xb2\x46\x27\x6f\x7d\xc3\xd4\x21\xdd\xfb\xad\xbb\xe0\x5a\xba\x0b\xf2\x8b\x7d\xaa\xd5\xcb\xfc\x58\xd7\x5e\x3e\x8a\x4e\x75\xaf\xbf\xda\x92\x56\x56\x3a\x59\x35\x44\xc9\xb2\xc0\xb6\x7e\x74\x2c\x0e\xc2\x27\x3e\xe1\x0b\x66\x5a\x5c\x0a\x2f\x3c\xc9\x79\x8f\x6e\x57\xee\x9c\x9e\x27\xa6\x93\x76\x5a\x58\x3d\x84\x0d\x0c\xe0\xb5\x0b\xe5\x49\xf4\x0f\xd1\x9f\x86\x20\x7a\x69\x7b\x3a\x26\x8f\x6c\x76\x08\xda\xad\x50\xf4\x93\xf9\x4c\x0d\xe4\xec\x06\x53\x55\x5c\xdd\xe2\x9b\x70\x62\x93\x04\x33\xeb\x95\xf5\x13\x92\xca\x49\xee\x4e\xf9\x7d\xec\x3e\x4d\x9d\xf8\xfa\xe0\x34\xd1\x1f\x51\xc3\x5f\xd6\xbb\x5d\x77\x8b\xf5\x11\xa4\xd8\xd9\x11\x89\x94\x78\xe6\xd8\xf1\x56\x42\xda\xe6\x67\xe2\x57\x7d\x55\x3b\x89\xfd\xa4\x4a\x3c\xa0\x10\x42\x8c\x09\xa0\x63\x2c\x58\x06\x34\x01\x35\x2c\x8b\x7b\xa2\x9d\x53\xdf\xb8\x70\xf7\xf0\x74\x5a\x80\xc8\x82\xd8\x55\xce\x4e\xa1\x9f\x82\xcd\xae\x9f\x2d\xbb\x3b\xb3\x4d\xee\xe9\x34\x03\xd7\xc4\x21\x3f\xa2\xfd\xb6\x0f\xfc\xa5\xba\x80\x43\x1c\xc2\x98\x83\x64\x8c\x0d\x0b\xf0\x2c\xb6\x39\xe3\x25\xc6\x31\xc3\x0b\x9c\x41\x9f\x57\x38\xcb\xb5\x2e\x06\x8b\xc3\x75\x1b\x2a\xc0\x09\xf6\x7b\xd5\x7b\x3c\x74\x69\x7e\xca\x37\x4c\x2a\x33\xce\x3b\x1d\xc8\x1b\xaf\xe9\xf9\xc1\x2e\x1e\x27\x36\x4a\x8c\xbf\x6c\xaf\x6f\xd4\x02\xc0\x90\x20\x8c\x01\xa4\x63\x97\x13\x0c\xde\xe0\x2d\x83\x15\x9e\x57\x4d\x62\x48\x5c\xab\x95\x8c\x4e\x6f\x6c\xc2\x04\x1a\xa9\x46\x51\x71\x50\x3b\x24\x1a\xd3\xaa\xf4\x26\x9c\xf5\xe8\x6f\x0b\x66\xc6\xda\xfa\x6f\x53\x0f\xb8\xb3\x4c\x97\x0e\xc0\x70\xcd\xb2\xba\x4c\x6e\xc2\x75\x2f\x84\x76\xc3\x24\x37\x54\x5d\xbc\x23\x64\x50\xdf\x6f\x52\x8f\xec\x16\x71\xaf\xfe\x36\xee\x9f\x10\xbb\x74\x0c\x2b\x62\xf2\xfa\x2d\xb4\x0b\xbf\x3c\x7f\xf5\x38\xa4\x15\xb0\xf0\x09\x49\xd3\xb2\x38\x9e\xb8\x38\x2e\xaf\x25\xdd\x2c\x58\xb5\x47\xe0\x11\x61\x30\x8f\x09\x7b\xe5\xa4\xec\xf8\x6a\xb1\xd8\xc6\xdd\xb1\x3c\x7f\x90\xb8\xca\x9d\xf6\xfb\x48\xc8\x92\xbb\xf2\x57\xeb\x8c\x36\x81\x5f\x79\xc9\x29\x63\x92\x3b\x8e\x27\x6d\xcc\x54\xd1\x76\x43\x82\xb5\x1c\x1e\x2a\x65\x64\x51\x19\xf3\x1f\x03\xbf\x19\x57\xf7\x50\x08\x99\x06\x9a\x45\x7a\x17\x67\xee\x72\xdb\xea\x7e\x1d\x6f\x27\x5a\x51\xe1\x51\x19\xc9\xd7\x2b\xc4\xe1\xf5\x7c\xd4\x05\xce\xfa\x0d\xc4\x3f\x21\xaa\x57\x06\xa1\x11\xdf\x0d\x26\x6c\xeb\x37\xff\x13\x0f\xd7\x70\x3f\xdb\x31\x66\xb8\x6c\x46\x10\x46\x7c\x77\x98\xfa\x23\x5f\xdd\x79\xdb\xf5\x2d\xa9\xcb\x52\x34\xfd\xa2\x4a\x8c\x9d\x1c\xae\x57\xd7\x74\x29\x1a\xaf\xde\x80\x9d\x59\x91\x53\x32\x76\x30\x44\x89\xcd\x66\xb0\xd1\x07\x75\xe7\xde\x31\x0d\x14\xa9\xa3\x4b\x93\xd8\xc5\xed\x85\x55\x63\x22\xdb\xc5\xb1\x34\xad\xad\x7c\xa9\x5d\x6d\x03\x8e\x73\xa5\xad\xd9\xeb\x7c\xcd\x6d\x04\x4d\xff\x37\x14\xcf\x73\x86\xbc\x23\x6a\xec\x4a\x9a\x6c\x04\x56\x0f\xc9\xca\xb5\x54\xad\xb8\x02\xa6\xcf\xb5\x51\xd6\x5f\xf5\x6b\x12\x1a\xbc\x3c\xea\x47\xed\x0f\x99\xde\x08\x87\x0d\x8a\xfb\x82\xff\xba\xe4\x18\x82\x1e\x02\xc5\x04\x8d\x09\xc7\x02\xdb\x04\x04\x20\x1e\x0d\xe3\x4a\x1e\x65\x87\x05\x7b\x51\x2f\x0a\x70\x9a\xa5\x47\x39\x4c\x8d\x28\xb1\x1e\x36\x69\xe6\xa6\xdf\x21\xeb\xa6\xe3\xe9\x52\xaa\xd6\xb6\xf5\x5f\xb2\x3d\x0d\xd1\x99\xad\xa6\x60\x03\x4a\xba\xb9\x30\x0a\x8c\x66\x77\x67\xf6\x00\xd4\xb4\x1a\xbe\x5e\x8b\x0c\x6f\x3c\x96\xb7\xea\x1e\x34\x39\x7f\x88\x7e\x44\xea\x65\x63\x2b\x4b\x53\xc7\x1a\x66\xf3\xcd\xd1\x27\xdf\x76\x89\x58\xe8\x4e\xca\xc1\x89\xb5\x29\x37\x6d\xe6\x4d\x55\xde\xb2\x47\x98\x46\x8a\x4a\xaf\xe9\x43\x3c\x05\x9b\x55\xe6\x4f\xe6\x85\xb6\xdc\xff\x36\xee\x93\xd8\x0b\xd3\xe6\x94\x6d\x96\xcb\xa1\xc6\x2b\xb9\x0f\x70\x5d\x9d\x82\x86\xa4\x8a\x53\xd1\x8b\x2f\xdd\x7b\xd9\x3c\xd6\x7a\x97\x38\xce\x0f\xe7\xca\xcb\xc6\x7f\x47\x55\xbf\x09\x8c\x1b\x1f\xec\xbc\x53\x5b\xe1\x54\x99\x80\xfd\x48\x6d\x25\x91\xef\xc3\xd9\xce\x15\x1e\xa1\xe6\xde\x6e\xe7\xcb\xed\x2a\x59\x35\x3e\xb6\xbf\x81\xf8\x24\xaa\xeb\xed\xfc\xa3\x6e\x6f\x47\x12\xb3\x8c\x78\x56\x93\xd6\x71\x5e\xe7\xc1\x65\xff\xf0\xe4\xe5\x9c\xf3\x7a\x5b\x92\x4c\x43\x59\x11\xe9\xdd\x0c\x37\x55\x1d\xbc\xc5\xce\xfc\x26\x31\xd2\xe0\xf1\xec\x7f\x70\x10\x42\x08\x38\xcc\x21\x3c\x36\x4d\x6c\x63\xc8\xb3\x14\xd8\xcf\x16\xb4\x5c\x97\xb5\x39\xb7\x6e\x50\xd0\xe9\x42\xe4\x96\x52\x91\x58\xfa\x61\xca\x73\x4a\x41\x67\x88\xae\xd2\x03\xeb\xaa\x57\x9d\x75\xb6\xaf\xbc\xce\xb7\xa0\xa7\x97\x8d\x4b\xa7\x0a\x06\x4b\xdd\xea\xbf\xdf\xfa\xa2\xb9\x32\x5a\x7a\x6a\x2f\x72\xe6\x7a\xa7\x9f\xae\x10\xe3\xe2\x3e\x9b\xd6\x27\xa3\x77\x69\x32\xf7\x4e\xd5\x7d\x56\x29\xbb\xe9\x7d\x53\xfd\x0c\xbe\x73\xca\xc0\x88\xc7\x55\x6a\xe4\x79\xff\x34\xb8\x21\x32\x48\x20\x47\x08\xe6\xc6\x1c\xc7\xda\x94\x83\xc8\x36\xd0\xf3\x6e\x36\x02\x08\xc4\xcd\xcc\x47\xc7\xd5\x94\x30\x8b\x6d\xce\x67\x5d\x57\x17\x40\x32\x32\x27\x65\xc4\x20\xa0\x54\x7c\x98\xc7\x13\x2b\xe9\x3f\x64\x2f\x1a\xa7\xf9\x41\x0a\x10\xeb\x88\xce\x0e\x88\xab\x79\x2e\xaf\x1b\xde\x8c\x95\xf9\xc5\x9f\x75\x2b\xc6\xd6\x0c\xee\x2c\xdd\xdc\x92\x0d\x4f\xf3\xdd\xb1\x28\x46\xaf\x96\xa6\x63\x57\xe5\xc3\x65\x92\xc4\xc8\xbf\x85\xe8\x5e\x0f\xb5\xeb\x16\xd3\xad\xbf\xdf\xd5\x78\xb5\x34\x70\xd9\x87\x73\x95\x4e\x2b\x48\xce\x12\xcb\xfa\xbc\xb3\x58\xe5\xae\x31\xdd\x94\xf2\x99\xbe\x9e\x99\x4e\x7a\xcf\xfa\xb7\xbb\xbf\x6f\x2b\xa2\x2e\xb3\x78\x9c\xc7\xc6\x57\x67\x94\xfd\xf2\x9c\x97\x70\x98\xb6\x8a\x11\x36\xe6\x52\xf6\xbb\xfa\xdc\x56\x6b\xa1\xe2\xab\x8a\x54\x86\x7b\x9f\xd9\x66\x50\xaf\xfd\xc5\x62\x45\xf8\x72\xc3\x7a\xff\x84\xe4\xf5\x32\xf3\x7a\x8c\x19\x21\xd9\x18\x62\x1b\x69\xf7\x84\xb5\xa1\x81\x44\xdc\x27\x73\xcb\xb9\x32\x92\x38\x3d\xdd\xab\x95\xaf\xcd\x41\x30\x9b\x90\x7f\x46\xf2\xed\x06\x7c\x70\x3a\x59\x04\x28\x0f\xe8\x98\xba\xbc\x69\x20\xde\x26\x84\xc3\xff\x2f\x44\x78\x5e\x3d\x6c\x99\x37\x13\x5b\xbc\xad\xb1\xc0\x55\x38\xbf\x66\x57\x6b\xed\x35\x30\x51\x62\x4d\xe7\x27\x09\x9d\x37\xcb\x1d\xa0\x96\xa8\x0a\xfc\xcf\x48\xde\xe2\x4a\xd6\xd8\x73\xd2\xf1\xdd\x88\x03\xfb\xdb\xb5\xdc\xab\xc6\x05\x87\xa4\xb3\xfa\xb0\x38\x56\xcb\x5c\x41\x1a\xd9\x18\xd2\x86\x1b\x45\x1b\x74\x9a\xe9\xe9\x8a\xc9\xad\x69\x9c\x38\xd5\xa4\x9c\xbf\x3b\xef\x5d\xa3\x0e\x7c\xc6\xca\xe2\xac\x1c\xd4\xf8\x35\x20\x7c\x4b\xd8\xe8\x70\x9e\x66\x6b\xc1\xe9\x2f\xe9\xaa\x8f\x6a\x79\xc7\x1a\xe6\xee\x8a\x72\xa9\xf1\x36\x2e\x5d\xb5\x0f\xb3\xb7\xee\x6a\xf8\x3a\x43\x6e\x69\xa4\x4e\x3c\x78\x7d\x66\x1c\x3c\xf9\x87\x08\x00\x08\x28\x82\x54\xc0\xdc\xd8\x12\x5c\xd7\x74\x5c\x63\x38\x4e\x9e\x38\x39\xfb\xae\x2f\x4e\xd5\x69\x1a\x16\x1a\xb7\x94\x97\x11\x30\x4b\x5f\xde\xde\xae\x8b\xc4\x9d\x5e\xb7\xe5\x0a\xdc\xfc\xab\x3a\xab\xa7\xe5\xcf\x38\x4b\xa7\x78\xda\x04\x38\x88\x00\x81\x2c\xc6\x98\x1f\x9b\x96\x8d\x89\x25\x08\x02\x76\xf9\xe7\x61\xfa\xb9\x5f\x6a\x47\x37\x40\xa5\xe9\xae\xdb\xb8\xb2\xfc\x2c\x53\x64\x32\x6a\x57\x2b\x49\x23\x3b\xbb\xf3\xfd\xd1\x51\xcb\x26\xe8\xf5\x2a\xcf\xad\xd2\xac\x1e\x6e\xd6\xff\xfa\x31\xcc\x20\x79\xb1\x18\xc3\xd6\xaf\x1e\xc4\x14\x70\xcb\xec\x76\x5a\xc9\x14\x1b\x78\xed\x13\xb7\xe1\x6b\x9e\x5b\xa7\x73\x51\xcd\xf6\xf7\x3a\x0b\xf3\x70\xfe\x1a\x52\xf7\xfc\xcc\xae\x2a\xa6\x37\x92\xbf\x6e\xb7\x86\xb8\x6e\xbb\x97\x57\x33\xc3\xf7\xd7\x6c\x9b\x5c\xec\x47\x7b\x5e\x40\xe8\x29\x27\x31\xda\xde\x01\x35\x37\x5b\x7f\xa4\x75\x29\xe8\x53\x6f\x12\x7d\x80\xf6\x24\x22\xb1\xa7\x52\x73\x4e\xbc\x22\x44\xf2\x29\x58\xb1\xd1\xdc\xb8\x1b\x81\x28\xdc\xb4\xad\x53\xb1\xaa\x2a\xa3\x52\xbb\x3a\xea\x6c\x0e\x5e\x27\xdc\xcb\xc6\x66\x90\x0e\x61\x80\xa7\x9f\xff\x73\xc7\x43\x58\xf7\x3b\x5b\x8b\xde\xc3\xa5\x7a\xf2\xb3\x76\x6f\xef\x1e\xe1\x0c\xae\xed\xae\x9f\xcf\xb9\xeb\xfc\x78\xf1\xa8\xe2\x2d\x4e\x67\xe2\x4d\x4b\xaa\x98\xf7\xd9\xbf\x23\x79\x1a\x50\xb7\xe2\xa5\xca\x6b\x13\xc7\xb1\x47\xd3\x69\x75\xe8\xd1\xe5\x98\xe5\xd3\x94\xe1\x9b\x15\x92\xcf\xcd\xe2\x90\x4e\x20\x14\xf1\x32\x7b\x37\xe7\xd9\xd8\x8b\x19\x2f\x76\xdb\x27\xf5\x19\xf2\xb8\x04\x00\x08\xc0\xc2\xd8\x61\x6d\x03\x18\x96\xcd\xbe\xc6\xcb\xee\x1a\xe7\x3f\xf6\x97\x06\x3d\x96\xbd\x30\x39\x4d\x3c\x67\x59\xdc\x0b\x4f\x16\xf6\x54\x9c\x8c\x82\x95\x78\x43\x5e\x3f\x3b\xe5\xdd\x3b\x3b\xf2\x3f\x7c\xcc\xfd\xed\x86\xe7\x1d\x33\x84\x88\x22\x08\x39\x40\xc6\x10\x19\xb6\x40\x0d\xde\x76\xb9\x67\xa3\xab\xde\x23\x7d\x72\xf5\xd6\x54\x71\xee\x5a\xcb\x47\x5a\x97\x65\xab\xc3\x6e\xe9\x2e\xee\x4b\xc1\x5d\xee\xe7\x06\x38\xea\xd5\xe5\x71\xf4\xf8\x7f\xc0\x8c\x00\x40\x08\x01\x82\x29\xc0\x63\xd6\xe5\x0c\x81\x27\x36\xb1\x2d\xf2\x67\x98\xa3\xa0\x66\xa2\xe0\x2d\x26\xf9\x7a\x45\xd4\x4d\xbb\x95\xb2\x15\x58\xfb\xa2\x9e\xcf\x3d\x50\xd3\xf3\xa9\x4e\xda\x68\x22\x30\x10\x1b\x45\xb7\x4b\xef\x65\x38\xbf\xaa\x13\x64\x54\xbf\xc0\x14\x3e\x01\x13\xbe\xae\x98\x4e\xaf\x96\xe6\x9e\x99\xe7\x24\x9a\xd5\xdc\x64\xee\x89\xcd\xbd\xbf\x10\x83\x83\x0d\xef\x0b\xe1\x94\xaf\x43\x66\xab\x79\xdb\x1b\xf7\x9d\x82\xc5\x99\xe7\x26\xf5\x10\x60\x76\x93\xb7\x29\x78\xbd\x07\xde\xd7\x70\x97\x6d\x29\x9f\xd1\xba\x3d\x4c\xac\xa8\x85\x72\x3c\xbf\xac\x13\xb9\xda\x5e\x28\x03\xd6\xe6\x51\x58\x4c\x53\x89\xc6\xe7\xd9\x87\xc8\xaf\xd6\x23\xd6\xd4\xf6\x50\x70\xf3\xc6\xe9\x76\x64\x65\xc4\x91\x73\xc7\x4e\xb8\x5b\x56\xb7\x63\x7e\xb9\x4c\x4b\x61\x74\x22\x45\x52\xdb\xa1\x18\x7d\x88\x4c\x5f\x90\x5b\xf1\x1a\x89\x93\xd5\xcd\x6d\x92\xf9\x3e\xf0\x73\x5e\x7c\x88\xf7\x3d\xce\x0d\xf1\xe8\x06\x04\xde\xeb\xc7\x02\xae\x6c\x85\xd9\x71\xaf\xa1\x09\x2f\x1b\x57\x45\x3c\x7e\x8b\x21\x96\x4c\xd2\x57\x45\xfc\x7d\x0e\xf8\x63\x22\x32\x9a\x74\x43\xc4\x93\xb0\x7c\x0a\xf6\x8f\xc0\x27\x6a\xbf\xb2\x2c\xff\x91\x8c\xaa\xed\x82\xde\xe2\x95\x1d\xfb\xa2\x47\xdb\xdf\x01\xa7\x5f\x6d\xf4\xec\xd1\xdf\xae\x9b\xfd\x19\x77\x58\xae\x8b\x23\x0c\x83\xd2\x00\x9c\xb4\x41\xbb\x49\xd9\xa1\x3b\xc7\x24\x1b\x96\xf5\x16\x96\xbc\xad\x7e\x13\xf5\x49\x64\x49\x7c\xe4\xb2\xb1\x3d\x97\xbc\x72\x49\x94\x43\x7d\xcb\x9a\x7b\xea\x17\x99\x13\x0b\xfb\x3b\x5a\x4f\x7b\xde\x3f\xa2\x93\x2f\xf7\xd3\x57\xf3\x69\x10\xb9\x1e\x42\x0f\x6f\xff\x1d\x70\x5f\x97\xc7\x1d\xb8\x6c\x73\xe9\x7b\x75\x71\xd7\x3c\x65\x15\x5d\x9a\x51\x9f\xb3\xbb\xed\x39\x06\xc5\x6a\xc1\x53\x6f\xc3\x24\x4e\xac\x61\x59\x7a\x0d\x3e\x78\x99\x97\x31\x5f\x33\x94\x8d\x3c\xa8\xbe\x37\x56\x3d\x97\x98\x0d\xde\xf3\x60\xea\x2c\x59\x19\x1e\xe0\xfd\xba\x4b\x78\x66\xb6\x9e\x1c\xed\x7b\xd9\x4f\x8d\xcd\x7c\xe1\x15\x33\xc7\xc5\xef\x67\xc3\xcb\x86\xbc\xda\x3a\x7b\x4b\x3e\x78\x1f\x28\x28\xf9\x42\x66\x16\xb7\x7d\x42\x2d\x36\x95\x18\x0d\x64\x86\x5b\x41\x23\xea\xce\x77\x08\x3a\xfe\x60\xee\xe3\x15\x67\xd4\x76\xdd\xee\x7f\x0d\xfb\x1a\xf0\xfb\x83\xb0\xcf\xd2\xfa\xf9\x28\x9c\x21\x50\x57\xe7\xe3\xfc\x9a\xaf\xdb\xd5\xfd\x12\xfb\x07\xe8\x87\xe9\xc8\x3b\x6e\xda\x9d\x87\x14\xf6\x71\xba\xe4\x07\x8f\xfc\x1a\xf6\x5b\x8e\xaa\x54\x48\x33\xeb\x44\x0d\xc7\x38\x26\xf6\x7c\xea\xec\x36\xf9\xb5\xca\xf1\x3c\xb6\x4e\x32\x83\x94\xc3\xe9\xa4\x0a\x7b\x97\xdf\x1d\xe2\x0f\x26\xf5\x35\x43\xf5\x10\x5f\xbd\x73\xb3\xa7\x87\x50\x9c\x05\xec\x79\x2d\x87\xb2\x68\xc0\xfe\x3e\x75\xf5\x54\xb6\x8e\x66\x59\xde\xea\xcb\x6e\x65\x20\xe1\xfd\xd6\x13\x1b\xa9\x37\x4e\x02\xaf\x34\x6a\x87\xf9\xf6\x77\x38\xad\xe9\xbb\x44\xc0\x81\x45\xe3\xb6\x8f\xf6\x2e\x2e\x8f\xa2\xd7\x1a\xf9\x4e\x0c\x2e\xac\xd4\x4f\x83\x06\x59\x0f\x45\x57\x25\x71\x0a\x21\xef\xcd\x8f\xab\xa3\x3e\xf9\x97\x2c\x4f\x43\x52\xaa\x93\x1e\xcf\x37\x34\x6f\xce\xdb\xe4\x52\x16\xa1\xb7\xd0\x5d\x45\x53\x4c\x9d\x4b\x4a\x3e\xdb\x4c\x67\xa8\xee\x67\x9a\x2d\x9c\xdf\xef\x4c\xc3\x90\x18\x2f\xce\x5e\x63\xa9\x10\xb1\x08\x53\x0c\xf8\x31\xc2\xb6\xe3\x12\x87\xb5\x20\x31\x87\x61\x9d\x56\x75\x67\x98\xc5\xe5\x16\xcd\x11\xcd\xaf\x82\x13\xb9\xda\x9a\x35\x28\x8f\x8e\x64\x87\x39\x06\xc3\xcb\xac\xaa\xf7\x34\xa7\xfc\x7f\xc1\xf4\xfc\xb5\xa6\x32\xd7\x7b\x5d\xc0\xe7\xae\x1e\x1b\xdb\x48\x55\x9b\xc9\xbe\xde\x29\xfa\x9c\x77\xac\xf3\x8e\x2a\xb3\x98\x11\x35\xee\xb4\x43\xef\x75\xf5\x2b\x61\x99\x35\xb9\x35\x38\xd1\x2f\xb4\x14\xb2\x00\x00\x8e\xa2\xf1\x10\x83\x61\x2d\xec\x70\x02\x78\x76\x6c\x2c\xc5\x23\x4e\xd9\x1c\xcb\x0b\x94\x46\x1e\xcf\xbb\x0f\xed\xa2\xd3\xad\xbd\x71\x0d\x75\x24\x28\x56\x57\xe9\x90\xcc\x1f\x57\x4e\xb6\x7e\x9b\x56\x00\x1c\x40\x80\x12\x44\xd8\x31\xcf\x0a\x2e\xcf\x41\x16\xf1\x26\xfb\xd9\xb4\x10\x20\x0e\x41\x04\x21\x1a\xb3\x10\x3a\xbc\x61\x59\xb6\x6b\x09\x9f\x4e\x8b\x10\x07\x28\xe2\x29\x1a\x23\x48\x9d\x61\xb4\xd8\x31\xb8\xcf\xa5\x1d\xdc\x23\x04\x01\xa1\x10\xb3\x63\xde\x12\x5c\x80\x0d\xde\xa1\xdc\x9b\x05\x0d\x4b\x2e\xd7\x4b\xc3\x2c\x72\xc8\xfb\xdb\xa9\xd5\x4e\xdb\xe0\x58\x99\xe9\xdc\x39\xde\xe4\x93\xe0\xcc\x34\x66\x7f\xa4\xcb\xd3\xad\xbb\xfe\xd7\x7c\x7f\x6e\x98\x49\x66\x45\xdf\x1f\x0d\xd9\xf1\xda\x28\xdd\xb6\xbc\xb5\xf5\x2e\x70\xc4\x91\x0c\x9b\x89\x9c\x5b\xeb\x79\x3a\xeb\x27\x8b\x93\xb4\x66\x22\xc2\x2d\x5d\x33\x01\x93\x5f\x81\xbe\x1e\x0c\x7f\x08\xf4\x75\xbf\xad\xa6\x8f\x5e\x5a\xaf\xc3\x16\xeb\xfc\x72\x7d\x74\x6e\xf7\xc3\x9d\x0f\x97\xd3\x51\xad\xd3\xdd\xc9\x89\x92\x34\x5e\x29\xfd\x5c\xef\xc0\xf5\x57\xa0\xaf\x46\x8d\xbe\x55\xef\xb4\xaf\x0e\xc6\x75\x13\x2d\x46\xdb\xf9\xbe\x8f\x34\xc5\xd2\x99\xfd\xfd\xde\x2d\x5d\x9c\x23\xb8\x77\x97\x68\x5a\x63\xab\xfd\x35\x28\x1e\xd0\x16\xa7\x51\xbe\xdf\x2d\x98\x99\xae\x9b\x91\x4f\xb8\x34\xc8\x1e\x92\x35\x91\x1e\xec\x50\xa6\xa6\xb7\xfe\xe9\x61\xeb\x7b\x59\x39\x69\x1f\xa1\xfd\x39\x11\x7f\x7e\x7c\xb3\xf1\x3e\xe1\x04\x73\x14\x5f\x02\xd5\xbd\x7b\x8c\x75\x4b\xc0\x61\x91\xb9\xa1\x22\x16\xa7\xa9\x5e\x97\x3b\xda\x2f\xd5\x44\x11\xa1\xfe\x11\xf0\xeb\x97\xfa\xa3\xc0\xe8\xb3\x80\x9f\xe7\xf8\xfe\x08\x09\x96\xb8\xd1\x61\x7f\x99\x33\x24\x7f\x9c\x19\xbf\xad\x27\x45\x6b\x0d\xa5\x32\xb5\x18\x9c\x0e\xc6\xf9\x41\xe3\x6b\x53\x7c\x38\xc7\xf8\xcb\x73\xaa\x3b\xbb\xa5\xcc\x3d\xad\xd5\x4b\x7b\x41\xb1\x16\x1d\xa1\x1f\x86\x11\x48\x27\x22\x54\xa4\xed\xf1\x7e\x8c\x4f\x5e\x18\x6b\xf7\xa2\xb2\xa3\x8f\x80\xc9\x17\x30\x2e\xad\x97\x89\xb6\x1c\xa3\xd3\x5d\x47\xb6\x45\x02\xda\x73\xd5\xda\xcc\x8e\x55\x16\x9e\x6a\xac\xf0\x35\x5f\x05\x34\xe4\xf1\x39\x77\xf0\x4c\x6e\xf9\xdf\x45\xff\x32\x84\xec\xde\xdc\x4e\x88\x30\x61\x11\x19\xb3\x9c\x4d\xa0\x8d\x79\x0b\x81\xe7\x48\x4d\xb7\x9a\x9c\x65\x07\xcc\xa9\x93\x3a\xe7\xcb\xfc\x84\x67\xcd\xe5\x24\x1d\xc5\xc4\x1f\x2d\x9b\xe9\xea\x0a\xe2\x00\x18\x6a\x7f\x99\x1c\x27\xbf\xc9\xfd\xfc\xa5\xd7\xf1\xec\xa1\x86\x64\xe2\x4f\xb8\x52\x9c\x2c\x1c\xb2\x52\xa7\xd2\x7d\xa9\x8c\x26\x6d\x55\x97\x7a\x7e\xda\x3c\x66\x13\x80\x77\x79\x59\xfd\x26\x3a\xf9\x6b\x64\x43\x8e\x0f\x86\x02\xa1\x63\x93\x67\x01\x46\xd8\x05\x82\xfd\xbc\x2e\xce\x3a\xb6\xf8\x95\x15\xed\x85\xc9\xdc\x19\xf5\xf3\xb6\x16\xaa\xf3\x49\x5b\x89\x27\xa5\xdf\x08\xd6\x4e\x9e\x2e\x0f\x9b\xd4\x5c\xb3\xdc\x87\xca\xf0\xba\xd9\x84\x99\xad\xdf\x57\xed\xd9\xbc\x1a\xb3\xca\xb0\xa5\x88\xce\x5b\x87\x5a\x1c\xb7\x0e\x36\xab\xd3\x56\x50\xa4\x7b\xb1\x40\xab\x46\xba\x80\x8f\x81\x9f\xf5\x40\xe7\xdd\xfc\xae\x96\x56\xd2\xd3\xfc\x56\x6e\x56\x30\xae\x49\x27\x56\x8b\x7b\xde\x9f\xf7\xcc\xe9\x74\x67\xf7\x52\xc5\xeb\x61\x76\xf9\x0d\x3d\x78\xbb\xb8\x1a\xdd\xe8\x6a\x21\x06\x0f\xaf\xbf\x89\xe6\xc2\x87\xab\x5b\x31\x01\xae\xb5\x5e\x47\xad\x59\x85\xca\xe3\x40\x8c\x13\xba\x73\xed\xf2\x77\x44\x7d\xfe\xb0\x99\x94\x35\xb9\x3a\xd9\xf2\xdb\xbb\xb8\x3c\x4f\xac\x28\x03\x8b\xc5\x42\xf1\x34\x56\x63\x33\x4b\xb9\x9a\xd5\xa1\xe5\x30\x2d\xb5\x56\xf9\x11\xf0\x0f\x2e\x16\xe8\xf0\x3f\x42\x81\x30\x46\x0e\x4b\x5d\x9e\x22\x4a\x5f\xc2\x98\xcc\xa5\x33\xdd\x44\xf5\xf8\xb8\x73\xdc\x95\xca\x29\x17\x11\xbb\xd3\x4d\xcc\x70\xa6\x66\x59\x1c\x32\x44\xed\x31\x3b\x97\xc9\xf7\xa6\xdf\xe0\xa8\x31\x66\x5d\x3a\x4f\x27\xf5\x90\xe6\x0c\x31\xa4\x78\xb0\x83\x08\xc0\xc0\x34\xa1\x0b\x5d\xf0\x9c\x43\x15\x6f\x26\x23\xe1\x02\x37\x53\x7c\x73\xf9\x13\xb7\x72\x28\xcf\xc6\x73\x2f\x2c\x91\x7d\xdb\x34\xf7\x0c\xc7\xbb\xe5\x61\x71\xa3\xcb\xdd\xaf\x18\xdf\x47\x07\xff\x4b\xd4\x21\x40\x9e\xe4\xdf\xa7\x6d\x64\x97\x87\xab\x66\xf9\x8a\x5f\x4e\xc2\xf8\x2a\x74\x73\x72\x97\x0f\xa7\xc9\xc1\x00\xfd\xba\xbf\x70\x87\xb4\x5e\x6f\xa9\x51\x68\x2b\xa0\xfe\x1a\xf6\x35\x7e\xc2\xef\x0b\x4b\xf2\x1e\xa5\x5e\xc4\xba\xc9\x2c\xd0\x6e\x7f\x3c\x07\x8d\xb3\xc8\x1e\x8d\x06\x79\x9e\x78\xb6\xad\xf6\x6d\xc4\x06\x6b\xfd\x23\x58\xf8\x19\xb0\xaf\x4b\xf3\xce\xdb\x47\x7b\x6d\xaa\x0b\x14\x29\x16\x73\x5f\xde\xe3\x91\xcb\xd2\x13\x42\xb6\x19\x5d\xcc\xac\x9d\xb1\xa1\x77\x64\xbc\xcd\xb4\x9b\xfd\x1a\xf6\x5b\x58\x83\xd9\x1b\xaa\x3c\xb5\x6f\x88\x9d\x4e\xf1\xe1\x50\xd5\x40\x69\x63\x08\x66\xb9\x35\xea\xfc\x63\xd1\x64\xc9\xf9\x02\xb2\xdb\x6c\xca\xb6\x1f\xe2\xfd\x49\x31\xdd\xe6\xf1\xf8\x5e\xb3\xec\x69\x0c\xa6\xf9\x99\xbd\x9f\x98\x51\x72\xdd\xe9\x64\x97\xe1\x3c\xd1\xc3\x2e\x62\xe7\xfb\x5d\x6c\xd7\x8d\x62\xc7\x8c\xca\xd2\xd9\xfb\x38\xc9\x9b\xb4\x89\x51\xd6\x81\x91\x7e\x4b\x55\xfd\x99\x73\x29\x28\xa4\xca\x92\xee\x7a\x34\x15\xb9\xa7\x9b\xe5\x4a\xd6\x62\xdc\x5e\x02\xc5\xd8\x2f\xcc\xeb\x69\x52\xf1\xd3\xdd\x9a\xbd\xf3\xf1\xec\xfd\x96\xfe\x46\x92\xe7\x65\xf6\x5c\x57\xc1\x43\x04\x58\x28\x90\x21\x7b\x12\x3b\x06\x4f\x59\xce\x40\x0e\x7d\xf6\x0b\x1e\x6e\x1b\x9b\x1b\x75\x39\xda\x2a\x75\xe6\x00\xbd\xc9\x8d\x53\x38\xca\x7a\x98\x25\xbb\xeb\x55\x6a\x0c\x2f\x3b\x3c\x26\xfc\x5d\x48\xac\xdf\x61\x14\x00\x85\x14\x0a\x44\xa0\x64\x4c\x09\xe2\xa0\xcb\x39\x40\x40\xee\x27\x32\x42\x04\xf9\x21\x93\x0e\x0b\x63\x9b\xb8\x44\xe0\x0d\xc7\x74\xec\xe7\xad\xe6\xe6\x9d\xb4\x5d\xa6\x17\x8c\xef\x16\x8f\x49\xe1\x73\x95\xac\x16\x26\x56\x04\xad\xa0\x22\x15\x6c\xe5\x81\x0e\xe6\xb4\x5d\x12\x47\xfd\x0d\xc6\xe1\xe0\x87\x08\x20\xc2\x11\x3c\x76\x21\x74\xa1\xed\xf2\xc4\x86\xe8\x53\x19\x91\x00\x85\xaf\xb1\x75\xc7\x34\x39\x6c\xb1\xc0\xb1\xf1\x9f\x63\x2c\x9d\xd4\x48\x9c\x20\xfb\xfe\xa6\x6e\x75\x16\x3d\xb7\xf3\x84\xbe\x6a\xc3\xc9\x32\x9a\x89\x6a\xe8\x6c\xf9\x25\x5f\x1a\xfe\xd5\xb9\xa4\x1b\x7f\x9b\x10\xa0\xfb\xe1\x35\x7a\x7f\x18\xbd\x01\x37\x4d\x60\x7f\xbf\x98\x8e\x4a\xbf\xbb\x39\x44\xf5\x8a\xbb\xeb\x5c\xa5\xe9\xdc\xb1\xd5\x6c\xb1\xc8\x67\x2d\xb3\x2e\xb6\x8e\xb1\x3d\x8e\x52\xbf\xd3\x47\xbd\x9c\xfd\x0a\xf4\x5b\xf2\xd0\x22\x32\xad\xca\x67\x16\x66\x7e\x79\x70\xf1\xce\xad\xd7\x13\xbc\x63\x8f\x57\xb5\x0d\xc5\xf5\x35\xe1\x71\x18\xcc\xf7\xb7\x39\x83\xbd\xeb\x07\x68\x7f\x4c\xc4\x21\x74\xc9\x78\xc6\x50\xf7\xf9\x77\xba\xd7\xf3\x85\x04\x38\x17\x77\xc4\xea\xae\xa1\x4b\x42\x4a\x16\x6c\xb4\x6f\xcc\x68\x3f\x5a\x59\xbe\x72\xf0\xda\xd1\x3d\x97\x4f\xdd\x6c\x39\xab\x04\xc7\xfb\x4d\xf4\x67\x8b\x5a\x3a\x5f\xfa\xb2\x9d\x2c\x85\x0b\x98\xe7\x6d\x3b\xb3\xdb\x91\xb2\x9b\x5d\xa7\x8a\x38\x9b\x37\x34\xf1\x73\x7b\x65\x31\x4d\xc5\x9a\xef\x72\x20\xbc\x2c\xf7\x9d\x32\x1c\xd2\x24\xbf\xfe\xf8\x8f\x0e\x42\x1e\x0e\xe9\x17\x68\x58\x68\x63\xc0\xb1\x2c\xcb\x72\x96\x49\xec\xe7\x29\x6b\x97\xee\x56\xd3\x92\x33\x6c\xb0\x7e\x39\xa6\xd6\x92\x16\xf1\x35\x9b\xad\xe7\x09\xef\x1c\xd2\x74\xb6\x65\xfd\x0b\x09\x6e\x78\xf6\xae\x8c\xcd\xcb\xca\x20\x8e\x8d\xb7\xac\x52\xa7\xab\xbf\xff\x16\xd1\x74\x71\x7b\xb8\xe1\x54\x10\x67\x05\x9a\xc4\x67\x07\x72\x7a\xe3\x72\x1b\x49\xab\x81\x3d\x03\x15\x7f\xa0\xb0\x00\xc6\x91\xbb\xbe\x9f\xad\xaf\xc0\x49\xd3\x0d\xa0\xec\x8b\x95\x05\xe3\xc6\x66\xf5\x76\x37\x62\xb3\x52\x9a\x6b\xcd\xd1\x9d\xce\x4e\xbc\x23\x30\xc6\x56\x65\x2c\xe2\x9e\x4e\xa2\xa1\x1a\xe8\x61\x4c\xaa\x5f\x81\x72\x5f\xf0\x20\xa2\x97\xee\xe8\xf2\x11\x9e\xb6\x4d\x70\x93\x00\xe7\xae\x56\xc7\x4c\x9c\x6c\xc3\x03\xe8\xb7\x79\xc8\x14\xbb\xd9\xf1\x78\x11\xc5\x03\xeb\xb7\x1f\xa0\xfd\x41\x11\x5b\xc7\xac\x86\x3c\x9d\xe7\x8c\xfa\xa1\xe5\x02\x3b\xd4\xbc\x61\x3a\x26\x08\x40\x44\x79\x93\x47\x2f\xa6\xd9\x8c\x2b\xe4\xf9\x95\x26\x50\x5f\xf2\x7c\x85\xcf\xe9\xa6\xf0\x57\xe1\x4e\xde\x03\xdf\x99\x6c\xee\x6a\x36\x51\x23\x74\x35\xe2\x30\x7a\x67\x44\x95\xb9\x35\x76\xac\xec\x6b\x8a\xea\x70\x40\xbf\xfd\x3f\x49\x60\xdb\xb1\xd3\x1a\x7f\x35\xb6\xf9\x2b\xe5\x01\x40\xc8\x03\x01\xf3\x08\x8f\x5d\x9e\x08\x26\x25\x84\xda\xce\xf3\x2a\x99\x07\xfd\x02\x22\x4e\x5c\x48\x2e\xae\xe3\xa3\x9f\x78\xbd\x18\x73\xfc\x85\x39\xdc\xf6\xc5\x6c\xbb\x9c\x8a\x92\x31\x71\x9d\xf3\x63\x57\xfd\x8e\x20\xcf\x4d\x35\xde\x3b\xfa\xfc\xe6\xae\x64\xdd\x79\x9f\x6d\x15\xbf\xd8\x1e\x2f\x9e\x7b\x62\x6c\xac\x32\x05\x7b\x52\x02\x80\xe4\xc7\x6d\x26\x7b\x7a\x7c\xbb\x3f\xa2\x5f\x52\x0d\x03\xf6\x8c\xda\x69\x8d\xb7\xe4\x21\xe1\x65\x5d\xde\x37\x4e\x73\xea\xa6\xcb\x59\xd5\x56\x24\xf3\xf4\x7b\x69\xf9\x31\x8f\x6b\x66\x71\x3d\x09\xe7\xc5\xf1\xb0\x3e\xd9\x53\x7f\xbb\x9f\x4b\xd7\x7f\x42\x02\xc9\xd7\xc4\x6e\x7e\xa6\x3d\xe4\x8e\x37\x42\x78\xb0\x08\x97\x34\x42\xca\x4c\xa2\xca\x58\x89\x87\x73\xa9\x5a\xfe\xbc\x8e\xec\xf2\x40\xa6\x4a\x48\xb3\x7f\x0a\xff\x34\x88\x87\x1d\xec\x4e\xe4\x5a\x39\x0c\xf4\x52\x2b\x3a\xca\xb5\xe7\x2f\xc8\x22\x92\x66\xf6\x4a\xd4\x96\x76\x23\xe7\xc7\xee\x88\x99\xf0\x5d\xac\x65\xa8\x7e\x09\xac\xac\xcc\x87\x75\x5e\x35\x31\x63\xe4\xc1\xf7\x0d\x9a\xd4\x44\xda\x1c\x1e\x8a\xaa\x87\x9b\xfc\xca\x24\x7b\x2c\xf0\x1a\x31\x23\x34\x4f\x8b\xfd\x3a\xdb\xa5\xf6\xf9\x4c\x4f\xd1\x6a\x17\x8b\xb3\x5f\xa3\x57\x76\xf4\xbd\xc1\x7d\x5a\xb9\x42\x78\x69\xc5\xe3\xec\x0a\xf7\xb7\x1d\x52\xb7\x6b\xcd\x37\x69\xc0\x28\x4c\x55\xf5\x9b\x13\xbc\xb7\x72\xbf\x9f\xce\x41\xc7\xff\x04\xdd\x29\xcb\xb6\x34\xf2\xef\x8f\xb4\xab\x3c\x82\xf3\x55\xb0\xbe\x74\xf2\x21\x4a\x76\x95\xb3\x1b\x45\x9b\xb8\x89\x0d\xb5\xd9\xe1\x14\x99\xe5\x7d\x75\x9e\x15\x11\xb3\xb4\xc8\x4f\xa0\x07\x03\x39\x76\x8c\xd4\xaf\xeb\x6f\x66\xed\xf3\x1e\xb8\xcc\x35\x3b\x60\x19\x59\xbc\xe6\x93\x56\x7f\x6c\xda\xa6\x2d\x7c\x33\xbb\xfb\xeb\x3a\xf5\xca\xda\xc7\x6d\x62\x2b\x8a\x5e\xde\x78\xf0\x73\xfc\x20\x49\x9a\xda\x30\x63\xe7\xaf\xc2\xd4\xf7\xa3\x00\xbd\x70\x37\x52\x5d\xe1\x37\x17\x76\x5e\x5d\xb3\x2d\x7e\x38\x6a\xe8\x9f\x18\x91\x06\x82\x47\xf6\x58\x6a\xad\xc3\xe6\x46\x76\xec\x2f\x58\x92\xca\xcb\xdf\xee\x04\x07\x53\xff\x79\x3f\x33\xfc\xf5\x89\xb1\x9d\x5d\x5e\xcc\x92\xd0\x3d\x27\xab\xa0\xb8\xef\xa2\x3b\x53\xb7\xf6\x82\x0b\x76\x53\x15\xde\x0b\x5f\x5f\x29\x77\x57\xfd\x05\x7a\x13\xd7\x81\x53\x96\x59\xf9\xbd\xf8\xb6\x5c\xef\xe3\x7c\x51\x35\x23\xeb\xb6\xd9\x4c\xa2\x48\xdc\x30\x3b\xec\x67\xba\x24\xfb\xe2\x75\x7f\x39\x61\x5d\x05\x6c\xb5\x58\x94\xd1\xcf\x09\xca\x2c\xab\x2d\xa7\xac\xab\xef\xf1\x57\xec\xe3\xe8\x16\xb9\x16\x8b\xba\x12\x46\x6d\xb5\x21\xb7\x91\x1f\x1c\xdc\xc7\xe1\xb8\x37\x58\x67\xaa\x3c\xdc\x76\xe5\xb6\x9b\x54\xd6\x7f\x8e\x3f\x6c\xc9\x86\x6d\xff\x40\x7c\xee\x12\x98\xa5\xd0\x4f\x84\x65\xb8\x2f\xe0\x32\xdf\x4c\xd1\xa9\xe5\xa2\xee\xce\x77\x4e\x79\x71\xbc\x7a\x94\x3d\x3c\xfb\x2e\x39\xd2\xaf\xe0\xfb\xea\xed\xe6\xe7\x3d\x78\xb1\x73\x8b\x72\x15\x5d\x16\x81\x9f\x88\x85\xb9\x54\x51\x72\xf3\xa2\xdb\xe2\xbe\x5a\x40\x7b\x6e\xdf\xab\x75\x1e\x7b\x56\x9d\xc5\x8f\x5f\x28\xe8\x5f\xb6\xd2\x2b\x34\x7b\x98\xde\xee\x5b\x9f\x39\x28\xd2\x89\x63\x50\x86\x97\x49\x4c\x0f\xd7\x88\x39\xde\x17\x45\x3b\x5f\x32\xe6\x86\xeb\x88\xd9\x6f\xcb\xec\x77\xa0\xe1\x9f\x86\xbe\x3b\x65\xf5\x96\x59\xfd\x7e\xd7\x77\xe3\xba\x24\x29\x2f\xea\xfc\xca\x21\xa4\x6d\x17\xe2\x34\x9b\xf5\x89\xde\x74\xba\x1c\x03\xe1\xb6\x38\xdd\x76\x2b\x6a\xa4\xed\x65\xf2\x53\xf4\x2f\xe9\x5f\xe7\xeb\xb3\xdc\x7e\xb8\x8a\x66\x67\x4b\xd4\x35\x6f\x34\x72\x4c\x8c\xc3\xbd\xce\x65\xc5\xde\x52\x84\x8b\x24\xa6\xfa\x43\x9e\x80\xcc\xb0\x40\x24\xfc\x7c\x21\x0d\xd1\x9c\x71\x5c\x36\xdf\xfb\xb8\x4c\x82\xcf\x3b\x16\x82\xd5\x4d\x3e\xed\xe1\x61\x61\xb1\xa5\xc3\xe8\x92\x3f\xbf\x5f\x25\x2e\x5f\x92\x49\x36\xaa\x34\xa6\x58\xdf\x7a\xfe\x37\xd1\xe1\xa7\xa2\x93\x01\xf6\x2a\x85\x4d\x95\x22\x61\xaf\x30\x92\x51\xa1\x60\x2b\xa8\x60\x2a\xa7\x8a\xd9\xed\x9c\xbe\x11\xe5\x2a\x54\xcf\xa3\x78\xe9\xf6\x47\xeb\x77\x61\x9f\x84\x0e\x26\x52\x72\x6c\x0b\x65\x9d\x91\xe4\xc6\x4f\x37\x58\x42\x7b\x76\x24\x84\x36\x7f\x56\x69\xb3\x98\x76\xfd\x68\xc6\xf7\xdd\x21\x93\x7e\xa6\xde\x71\xe6\x35\x75\x10\xff\x60\xd9\xef\x95\x49\xba\x09\x13\xe5\x9c\x9c\x14\x25\x3a\x4b\xc7\x05\x7c\xd0\xfb\x68\x24\xef\x93\x4e\x38\xef\xb5\xed\x68\x5d\x1a\x73\xe9\x78\x66\x7f\x86\x9d\xd8\xe9\x0f\x70\xeb\xf5\xa8\xd9\x8c\x46\x9c\x3c\x5b\xb2\x87\xfd\x7a\x8f\x98\x7c\xf4\x40\xb9\x8c\xc8\x79\xbf\x3a\x87\xd3\x5d\x34\x03\xc9\xe6\xf8\xe0\xef\xfb\x9f\xe1\x3a\x89\xe9\x94\x71\xf0\xb5\x68\x12\xbe\xee\xb6\xe1\xe9\x68\x4f\xee\x8c\xa0\x24\x7c\xa6\x4e\x26\x21\x5d\x60\xb8\xf3\x7d\x55\xbc\x2d\x4f\x28\xdf\x4d\x33\x25\x8e\xf5\x56\xdc\x70\xca\x4f\xd0\x2b\xa7\x7c\x0b\x27\xf0\x2f\x86\x33\xeb\x6f\xd7\x93\xdc\x28\xa6\xf3\x09\xdc\x5c\x0a\x6d\x3f\xa9\xba\x56\xd8\x77\x8d\x34\xbb\x6f\xba\xc3\x5e\x6b\x27\xcc\xb2\x75\xd2\xf2\xdd\x1d\x9b\x9f\xbf\xb5\x9f\x63\x6a\x23\xf8\x41\x5e\x9f\x61\xc2\x62\x67\xfa\x4a\x6e\x1f\xbb\x59\xb4\x91\x2f\xbd\x73\x70\xe9\x7d\x68\x7b\x20\x56\x4c\x7d\x76\x4a\x82\x76\xf9\xb6\xdb\xa7\xef\xf6\xbe\xc6\x8e\x19\xd7\x28\xbd\xec\x7b\x63\xa2\xc7\xe2\xea\x90\x84\x93\x51\x2b\x21\xcf\x88\xb8\x4a\x3f\x5c\x8e\x93\x2c\xcb\x4d\x5f\x07\xfc\x6e\x3b\xf7\x13\xe5\xb8\xf4\x92\xd5\xf1\xd5\x6f\x08\x8c\x34\x36\x52\xcb\xa9\x8d\x3e\xce\x4a\xc6\x76\x12\x23\xf5\xe2\x97\x00\x27\x84\x00\x01\x8c\x38\xc4\x8f\xa9\x43\x2d\x97\x05\x88\xe3\xdd\xe7\xfb\x65\xe3\x70\xb8\x9b\x4c\xcd\x46\x30\xd9\x65\x9d\x54\x6c\xc9\x7d\xe9\xb3\xa7\xad\xb8\xd8\xb5\x3a\xd9\x02\x11\x31\xb3\x22\xb5\x7d\xf8\xae\x6a\x71\x08\x0d\xa5\x95\x5f\x3a\x77\x67\x38\xa9\x99\x24\x6b\x2a\xa7\xfe\xa1\xcd\xb1\xeb\x8a\x5c\x71\xee\x81\xd7\xcf\xd0\x82\x6b\xf0\xe6\xb2\x54\x8e\x68\xb2\xe9\xeb\x66\x37\x87\x5b\xa3\xdc\x12\x69\x22\x25\x1c\x4e\x5f\x17\x6a\x90\xba\x71\xd3\xbd\xa5\x29\x7e\xfb\x69\xc2\xf1\xd7\xe2\xd4\xa7\x31\x0a\x10\x01\x01\x12\x82\x01\x19\xf3\xa6\xcb\x23\x1b\x5b\x40\x20\xcf\xc5\x56\x45\x88\x88\xb2\xb2\x2e\xd7\x15\xab\xf4\x82\xe5\x5c\xe2\x0c\x1f\xad\xd1\xbd\x96\x33\x21\x56\xfc\xc3\x85\x2e\x6d\xdb\xf3\xef\xb3\xdd\xeb\xee\x1c\xfa\x4d\x92\x7f\x8d\xa9\x97\x8e\x1b\x3b\xd6\x9b\xd3\xc7\x7e\x75\xe7\x09\x83\xfa\x80\xae\x7b\x69\xb7\xe3\xd2\xcd\x48\x0e\x82\xd2\x86\x87\xc9\x92\xbd\x66\x9d\x9e\x40\xcc\x64\x9d\xbc\xd0\x52\x73\x67\xf3\xbf\x07\xfa\x24\xb0\x76\xdb\x33\x71\xda\x6c\x46\x8f\xc2\xd1\x4e\xf9\x3e\xf0\x8e\xed\x96\xcd\xc0\x64\xa9\x47\x9d\x99\x1e\xd2\xbc\x59\x70\x75\xa6\x1f\x17\xaf\x2b\x39\x4c\x86\xa2\xf3\xfa\xad\xd0\xe8\xaf\xdf\xcf\xea\x00\x10\x60\x87\x46\x26\x04\x8c\x2d\x64\x62\xec\xf0\x04\x0b\xc6\x73\xd8\x6b\xe3\xd7\xf8\xb1\x73\xce\x2b\x5d\x06\x9b\x87\x2d\xd6\x68\x1a\x97\x25\xed\x2b\xfe\xb4\xc8\x53\x20\xce\xa6\xc0\xda\xcb\xa7\xb0\xe6\x5e\x4d\x93\x30\xc9\x82\x32\x4b\x99\xaa\x88\xbb\x6f\x07\xd8\x00\x47\xa0\x92\x83\x87\x72\x4c\x93\xf3\x46\x63\xee\xf2\x69\x74\x20\xc9\xe8\x94\xe9\xc1\x3d\x3d\x5f\xe9\x8c\x6c\x97\xee\xfa\x16\x8a\x4b\x75\xf2\x11\xdc\x93\x90\x70\x3e\xdb\x6f\x12\xdc\xc5\x4b\xaf\x54\xa5\xd1\x7c\x6a\x2b\xc2\xa8\xbb\x8b\x51\x25\xd7\x66\x7e\x9a\x4e\x5b\x6a\x5f\xfd\xe9\xa1\x8a\x5e\x1d\xae\x30\x4b\xcd\xac\x89\x63\x87\xb1\xe2\xcc\x8a\xda\xac\x8c\xbe\x8f\x24\x29\x01\x2f\xad\xf0\x02\x6a\xc6\xda\x38\x77\xa1\x50\x97\x05\xe0\x46\x88\x3d\x03\x58\x9b\x19\x42\x9e\xdd\x0d\x92\xeb\xc8\x7c\xa7\x2c\x55\x96\x8e\x83\xda\x29\x8d\x3a\x1b\x2a\x4e\x06\xb1\xe1\x8b\x4b\x32\x3a\xd8\xce\x7c\x7a\xaf\xfb\x59\x74\xa9\x38\x6d\x36\xdb\x81\xca\xd1\xf5\x75\x71\xae\xcd\xa5\x91\xaf\xb7\xe2\x49\x32\x8c\xdd\x4c\x4e\x5e\xf7\x8e\x1f\x23\x3f\x87\x47\x57\xf6\x5e\x17\xef\x9c\x90\x30\x14\xad\xee\x2e\x3f\x39\xa3\xfb\x0a\x9e\x78\x23\x52\x9b\x08\xee\xc3\x15\xd3\x6c\x6d\xf9\x62\x4e\x32\xf2\x1b\xc8\xfc\xa7\x21\x0b\x9f\x86\x0c\xc1\x1f\x81\xae\x9d\x24\xf9\x5a\x81\x14\x36\x69\x50\x8f\x4b\x67\xe8\x74\xf9\xbc\xdb\x00\x08\x58\x48\x08\x8f\x85\xb1\xe1\x02\xe8\x18\x9c\xcb\x03\xf4\x1c\x0e\x60\xef\xc8\xa4\xd0\x57\x18\x57\x5e\xb6\x2a\x42\xd9\x63\xe2\xad\xd6\xe4\xb4\x92\x9c\xa5\x25\xfb\xcb\xb9\x5f\x4f\x6d\x3f\xd9\x84\x89\xfe\xdb\xec\xc2\xcb\xf6\x30\x2d\x63\x61\x71\x16\xf7\xce\xfa\x9e\xf1\xe9\xe5\xd6\xee\x36\x1b\x33\xbe\xcf\x43\xe6\x72\xb8\xf8\x73\xb0\x39\xdf\x66\xa9\x94\xf9\x66\xf5\x6e\x91\xd6\x59\x6c\x57\x8c\x37\x18\x0f\xe4\x0b\x02\x3f\x8f\xf0\xef\x97\x37\x6e\xce\x18\x72\x3e\x2a\x6f\x47\xad\x36\x96\x30\x6b\x99\x78\xed\xce\xdd\xd3\x75\xaa\x79\xeb\x91\x70\x8d\xef\x86\xbc\x6d\x97\xef\xc6\xd0\x0c\xdb\x73\x65\xf9\x49\x60\xd7\xcc\xe0\x22\x96\x59\x53\x3b\xe5\xf7\x6b\xf8\x70\xed\x13\xc5\x0a\x21\xbb\xaf\x13\x43\x96\x25\xce\xb8\xd6\x61\x58\x2d\x17\xdc\xe9\x28\x76\x8d\xae\x07\xb9\x5a\xac\x22\xbe\x22\xaf\xfe\x79\x14\x54\x81\x13\x47\x8c\x53\x96\x96\xef\xfc\x95\x07\xf3\x8c\x3c\xbb\x4d\xa7\x33\x2a\x04\xa9\xd7\xed\xb2\xa6\x69\xf0\xca\xed\x2c\xdf\x3a\x1f\xf4\xdd\x56\x8e\x0c\xaf\xbe\x9f\x2f\x81\xef\xae\x36\x64\xff\x21\xf2\xab\xcc\xcc\x54\xbd\x4c\x47\x0f\x75\x1d\xb0\x20\x98\xf0\xa7\x3b\xbb\xaa\x92\xae\x61\xa0\x2e\x5d\xad\x4b\x45\x9a\xfd\x7a\x39\x24\x3c\xae\x01\xf8\x21\xb2\x97\xd5\x59\xf6\x03\xa3\xe2\xe2\xaf\x8c\xed\x48\x9d\xcf\xcf\xd6\x3d\x52\x0e\x4c\xbd\xb7\xb4\x08\xc0\x04\xce\xa9\xa2\xcd\xab\x76\xed\xec\x47\xd9\x5e\xde\x58\xd6\xeb\xf7\x8c\xb2\xb4\x1a\x3a\x03\xa6\xdf\x9a\x36\xda\x59\x5b\x8d\x6b\xa7\x4c\x82\x74\xa8\xdc\x72\x8a\xc6\x49\x2d\xe7\x9b\x45\xf7\xac\x40\x47\x30\x82\xa9\x77\x98\xce\xd7\xdd\xbd\xd0\x71\x7e\x1b\x25\x0c\x8a\xf2\xda\x9d\x56\x8f\xb5\xaa\x47\x22\xb9\xad\x98\x99\x77\x60\xac\xfd\xbf\x27\x7c\x0b\x38\x8a\x33\xfe\x00\xad\xa3\xe1\x4a\xf9\xee\xae\xfa\x4a\xb7\x59\xae\xee\x72\xb5\x38\x4d\xbd\x14\x76\xe7\xab\x0b\x37\xa6\xbc\xf7\xfb\xd6\xe2\xff\x0b\xa6\x3f\x31\xb4\xf2\x29\x17\xfb\xdb\x62\x27\x00\x21\x16\x60\xc0\x51\x38\x36\x79\xe2\xe0\xa1\x4e\x81\xc2\xe7\x58\xd9\x08\x64\xf9\xce\x40\xfb\xdb\xad\x5e\xcc\xa7\xf3\x5b\x1c\x06\x8c\x16\x9d\x2c\x05\x2d\xa2\x74\x52\x31\xd2\x25\x23\xed\xca\x8e\x37\xb3\x57\xf3\x29\x2a\x99\xbc\x74\xea\xfa\xaf\x66\x40\x03\xd6\x9a\x11\xdb\x0d\x78\x38\xe5\x4d\x4a\xb4\xb9\x6e\xe4\x87\x5d\x50\x47\xac\x3b\xda\xe3\x11\x78\x18\xb0\xdc\x47\x8f\x53\xa3\x06\xea\x5c\xf9\x25\xd6\x93\x78\xf6\xa4\xc7\xb1\xcd\xc5\x82\x0b\x02\x53\xda\x6c\xf7\x5b\x59\x56\xaf\x57\x45\x31\x7d\xd7\x94\x0f\x09\x16\xc9\xad\xca\x96\x5b\x6d\xf3\x7a\x98\x45\xe5\x7f\x6a\xb5\x9f\x15\x27\x9f\xef\xaf\x29\xcb\x9e\xe5\x72\x9b\xef\xae\x9b\x38\x6c\xb7\x6a\x91\x41\x70\x8c\xae\x3e\x74\x7b\x6c\x5d\x83\x0c\xc5\xd8\x14\xab\xef\x66\xf7\x6b\xcc\xfc\xef\xc1\x12\x5a\x89\x1a\xd5\xea\x78\x2e\xab\x1a\xd1\x5b\x59\xa8\xec\x3d\x3d\x8a\xfc\x1d\x14\xd2\x5e\x94\xd3\x4b\x3d\x8a\x8c\xd5\xe1\x78\x9a\xfd\x02\xe9\x49\x34\xb2\x34\xef\x23\x69\x79\xc6\xfa\x91\x59\x07\xdb\x76\xe9\x5c\xf7\x0e\x74\xdc\xa2\xd6\x3b\x26\x38\xf9\x46\xc2\x90\xbb\x6b\x4f\xca\xcd\xeb\xec\xc5\x81\xc9\xe4\xc5\xf7\x8b\x92\x9e\xf5\xdb\xde\x38\x9b\xed\x1d\xea\xa3\xf8\xa8\x39\x33\x7a\x2d\x9b\xc9\x21\x10\x26\x31\x11\x2e\xe6\x5e\xb9\x6f\x02\x06\x93\x73\x96\xfd\x04\xef\x5b\xff\x68\x5f\xc0\x89\xb8\x73\xf5\x43\x64\x34\x31\x5e\x19\xcc\x69\xc1\x37\x37\x7b\xb8\x80\x50\x65\x69\xd1\x3d\x1a\xb0\x39\xcb\xcd\x32\x91\xaf\x3f\x07\x7a\x12\x6c\x12\x9f\x36\xb4\x23\x33\x72\xa4\x84\xd4\xe7\x87\xcc\xfa\x2b\xd7\x74\xf7\xf7\x04\xcb\xb6\xb9\xed\xca\xc4\xf3\xa4\xcd\x64\x77\x7d\x97\xad\x1c\x07\x9e\x5f\x57\xb5\x93\xff\xe7\xd7\xb8\x2e\x0d\xcb\x29\xc7\x56\x96\x7c\xed\x04\x37\x38\xae\x6f\xb9\xe2\x2f\x47\xde\x5b\xc3\x5d\x4c\x87\x55\x60\x21\x0c\x81\x01\x86\x5b\xc5\x27\x91\x8a\x28\xf6\xfd\x75\x01\x8d\xcb\x28\xa9\xcf\xd1\x90\x59\xb3\x7b\x18\xd3\xf0\xac\x24\x21\xd5\xd7\x6b\x56\xe4\xe4\xf9\xb2\xbe\xec\x55\xf5\x77\x45\x1a\x0e\x76\xf0\x05\xf2\x2f\xea\x17\xc6\x73\x98\x37\xd5\xf5\x44\xf2\xc0\x5e\xdf\x6f\xa3\x8b\x04\xf4\xe9\x05\xdc\x66\xec\x59\xd7\x26\x55\xee\x4d\xac\x87\x25\xc9\xe5\xfa\xf5\x60\x8f\x7b\xb7\xfe\x59\xc5\xdf\xd0\x36\xf0\x89\xe2\x62\x2e\xee\xbb\xc6\xe7\xb9\xeb\xcd\xa2\x47\x5b\x31\x10\xa3\x90\x7c\x1d\x81\x7d\xa6\x4f\xc4\x28\xac\xd1\x23\xcd\x0a\xc4\x6e\x4e\xaf\x1a\x9e\x18\x75\x3d\xcc\xe0\xf8\xad\xea\x6f\x70\x89\xbe\x82\x3f\x9b\x3c\xc2\xbd\x91\x1d\xfe\x52\xd1\xe2\x92\x1e\x8c\x33\x63\x31\x93\xa9\x2a\x18\x71\x3d\x3a\x65\xa3\xc3\xd1\xd8\x8a\xbe\x21\x75\x8d\x32\xbd\xe9\x3f\xc6\x0e\x2a\xe3\xdb\x32\x7f\xdd\xf4\xd4\x51\xac\x5d\x8e\xd3\x62\xe1\x2c\x36\xf6\x3a\x8c\x99\xc6\x15\xb3\x60\x1b\x4f\x69\x67\x6f\xa3\x42\x5b\xda\x9b\x4e\x3d\x1f\xb9\x5b\x40\x3e\x06\x26\x7f\x12\xb8\x6c\x52\xa7\x0d\xec\xbf\xdc\x90\x67\x07\x7d\xdd\x26\x32\x6f\x57\x1d\x37\x3a\xf3\x7a\xf7\xc0\x8a\xbc\xbc\xca\x74\x7f\x68\x3d\x4f\xa9\x8a\xe9\x23\x6f\x1e\x54\x66\x18\x1d\xce\x7f\x32\x1b\x55\x11\x07\xb5\x83\x87\x15\xf2\x5a\x9f\x34\xdf\xf5\xec\xaa\x78\x48\x12\x20\x4e\xa0\x54\x47\x8a\xc5\x66\xd8\x18\x78\xfe\x11\x75\x99\x72\xad\xb6\x45\x64\xe6\xa9\x55\x6d\xac\x0f\x91\xe1\xb7\x0a\xf4\x64\xdd\x2f\x36\x2b\x8d\x1f\x9d\xee\x82\x38\xd1\xb9\x5d\xee\xaf\x0c\xe4\x47\xb3\xa2\xeb\xfc\x94\x0f\x30\x5a\xb2\xf3\x5d\x08\x99\xfd\xe4\xb7\x30\x9f\xc4\x5d\x2a\x31\xb7\x31\x27\xda\x84\xcb\xfd\x73\xba\xb8\xfb\x60\xbd\x3a\xf6\x1e\x77\x10\xa6\x93\x11\xeb\x75\x1c\x3c\xf8\xfb\x20\xce\xab\xe6\xd5\x9d\x19\xa0\xdf\x0c\x2c\xfb\xdb\xca\xfd\xdf\xbf\x92\xb5\xfe\xd7\xe9\x6a\x27\x1d\x82\x89\xdf\x4e\xc8\xaf\x1e\xab\x9f\x0b\x61\x24\x77\xbe\x2a\x47\x45\x54\x4e\xb1\xd2\x81\xd0\xf1\x0e\xb4\xea\x18\x2d\x2a\x26\x5a\x5c\x9d\x6f\x6c\xae\xb4\x81\xfe\xaf\x79\x9e\x86\x25\xf1\xb2\x23\xf4\xfb\x8d\x27\x16\xec\x8d\xae\x6d\xae\x7a\x04\x82\x69\x4d\xdd\x6d\x36\xbf\x33\xd8\xb6\xd8\xee\xa0\x46\x6b\xb4\x13\x5f\xad\xa5\x24\x70\x22\x8f\xf9\x3b\x12\x05\x9f\xf5\xf1\x0c\x77\xbb\x36\x56\x26\x5e\x5d\x34\xe7\xa9\x3a\x9d\x9d\x84\x34\x3a\x09\xa2\xf1\x70\x1d\xfe\x60\xd9\x8b\x07\xb3\x0e\xb9\x3b\xde\x94\xaf\xa1\x92\x24\xa8\x2d\xdf\x89\x63\x9f\xb1\xe2\xe0\xfb\xbd\xde\xdf\x28\x71\xc8\xc9\x33\x85\x67\x5d\xc5\xca\xb7\xa6\xcd\x19\x60\x6e\x95\xdd\xf1\xec\xd4\x71\xbb\x94\x16\x96\xe5\x1c\x87\xec\x4e\xeb\x27\xb0\x43\x23\x80\x2c\x71\xec\xe0\x07\xf1\xf2\x83\xdb\x1b\xa2\x9e\x5f\xc5\x14\x9e\x62\x97\x28\xfa\x35\x90\x04\x77\x77\x21\x13\xda\x2e\xcb\x68\xfd\x50\x1e\x70\x03\x0b\x90\x97\xe0\xe7\xe8\x43\xc7\xb1\x20\xf5\xc6\x41\x5a\x3b\xa5\x6b\x58\x3f\xc8\x2a\x8b\x34\x27\xb9\x65\x76\x1b\x56\x56\x37\xd2\x16\xfe\x24\xe3\x1d\xc5\x9f\x9b\x15\x1a\x4d\xe7\x9e\x56\xcf\x77\x8e\xc4\xac\x66\xa3\x47\xa7\xfc\x94\xa7\xfb\x3e\xa1\xea\x60\x0b\xf1\x76\x09\x46\x46\x90\xac\xa7\x01\x87\x93\x45\xe6\x1e\x60\x6f\x85\x57\x3e\x5e\xe3\xe6\xa6\xe2\xe5\xee\x40\xd0\x74\xb1\xf9\xd9\x9c\x07\x99\xe5\x1b\xe9\xf7\x02\x2f\xdb\x6b\x12\xc3\xb4\x99\x72\xdd\xf6\x51\xe5\x14\xd9\xbb\x5c\x9e\xbb\xee\x7d\x2b\x4a\x4d\x5e\x2d\xc0\x90\x6e\x70\x50\x67\xfd\xe1\xfa\x13\xe4\xc4\xc8\x87\x7e\x7e\x56\xdd\x94\xaf\xe5\x1c\x3c\xe0\x21\x0f\x87\x2c\x00\xcb\x18\x1a\xff\x58\x80\x45\xce\xb3\x87\x33\x3f\x9d\x64\xec\x7a\x6d\xf3\x10\x65\x7a\xe0\xf5\xe5\x22\x38\xcf\x2a\xe4\x03\x12\xe9\xbe\xe0\x9e\xdd\x99\x31\xb7\x2b\x02\x2d\xf8\x7b\xdc\xf0\x5d\xe3\xc5\x7f\x8d\x9f\xd9\x4e\x99\x0e\x29\x13\x56\x96\x5a\x4d\x59\xbe\x46\xbb\x78\x80\x10\x3f\x78\xa0\x54\x18\x3b\xc0\xc0\x82\x41\x2c\x93\xbc\x74\x5f\x60\xed\xa5\x08\x92\xc9\x8e\x04\xd1\x55\x31\xef\xbd\xc5\xb9\x41\x18\x9e\x97\xb6\xbd\xef\xd7\x29\xaf\x78\x78\x39\xa5\x62\x91\x4d\x85\xfd\x3f\x63\xc6\x80\x05\x10\xb1\x84\x8c\x4d\xc3\xb2\x05\x8b\x73\x5c\x68\xdb\x7f\x94\xf9\x5b\x44\x0c\xbd\xf0\x72\x00\x02\x84\x09\x02\x63\x62\x72\x86\x41\xb0\xc5\x72\xe4\x79\xae\xcd\x0e\xc5\x9b\x34\x6a\x4f\x62\x53\x4c\x95\x6e\x1e\xba\xf1\x79\x79\x36\xd2\x8b\x62\x62\x2d\x8e\x13\x53\xec\xe3\x5e\x2d\xef\xde\x1d\x7c\xc8\xfb\x7e\x17\xfb\xf7\xd8\x65\x10\x35\x69\xc0\x18\x8e\xf5\x4d\xf3\x07\xb8\x74\x27\x88\x53\xb7\x2d\xef\xd7\x74\xaa\x79\x57\xb6\x70\xa5\x7d\xd4\x7b\x57\x29\xb9\xb6\xdb\x20\x76\x76\xf3\xa8\x7d\xdc\x89\xad\xf3\x93\x8f\xe0\x9e\x84\x9c\x9a\x2b\x25\x78\x24\x87\xc4\xa2\xaa\xba\x2b\xb4\xab\xd9\x6d\xc9\x4d\x07\x07\x30\xda\x21\x00\x46\xb5\x9e\xce\x3b\x6e\x77\x49\xaa\x77\xfb\x57\x1b\xd4\x51\xd6\x7e\xeb\xb8\x90\x0e\xa6\x57\xf4\x34\xf5\x2c\x84\x48\x00\x02\xe5\x29\x37\xb6\x2c\x0c\x04\x87\x18\x08\xa1\x67\xcb\xa3\xd0\xce\xc1\x88\xa6\xc5\x6c\x7a\x4e\x22\x5f\xbe\x17\x1c\x97\x1c\x96\xe7\x52\x5c\xb5\x3e\x6f\x76\xbe\x77\xe4\x6c\xc6\x51\xb8\x1d\x79\x3d\x53\x52\xa3\xae\xc6\x41\x36\xb4\x2d\xfa\xfe\xfe\xdb\xd5\xae\xa2\x34\x13\x84\x2e\x3e\x56\x85\xfe\x50\x0e\x07\x38\x0d\x38\xaa\x4f\x97\x6c\x1c\x6e\x97\xfb\xed\x64\xd2\xd0\x93\x17\xe7\x07\xef\xd7\xa0\xcf\x4a\xc2\x38\xcd\xaa\x38\x1e\xe0\x4d\x1a\x3d\x1e\xe1\xb5\xbc\x72\x79\xe5\xdc\xca\x63\x7d\xde\x1f\xc2\x26\x14\xb9\x2e\xdc\x59\x7c\xbb\x66\x9d\x1f\x4b\xfa\x06\x5e\x39\xe5\x50\xfa\xfa\x57\x77\xcc\x67\xfc\x89\x1b\x8d\x5a\xed\x56\x44\xea\xbe\x62\x72\x42\x9b\x8b\x5d\x9e\xd6\x8d\x61\x62\xaf\xbd\xe3\x1b\x2f\x12\xf9\x38\x6d\xf8\x85\xc4\x44\x3f\xc5\xff\x32\x58\xbd\x83\x35\xf3\xac\x81\xb7\x50\xd2\xe1\x9a\xe1\xdc\x25\x10\xc2\xbb\x7e\xd0\x4e\x53\x0d\x39\xdc\x48\x4b\xd7\x41\xa0\xec\xfb\xa2\x7f\xcc\x66\xcc\xcd\xcc\x49\xfb\x63\xe0\xc8\xe9\xab\xef\xfd\xa5\x2e\x4f\xe7\xb3\xb5\xda\x2e\x27\xd3\xe9\xfa\xe4\xbb\xae\xe5\xda\x50\xbd\x75\xec\xa9\xda\x68\xf3\x7c\xd6\x78\x66\xf7\x08\x56\xa7\x8c\xfb\x10\x16\xff\x49\xd8\x1f\xde\xaf\x42\xa1\xb5\x76\x0f\xbc\xf3\x71\x01\x96\x66\x1f\x14\x95\x0d\x22\x67\x77\x5d\x70\x13\x81\xd6\xcb\x5d\xe7\xac\x47\x70\x7b\xd8\x6e\xd0\xab\x82\x67\x51\x9c\x79\x5f\xff\xfb\xbd\x46\xcc\xc5\xd3\x68\xca\x25\x7e\xf9\x20\xd9\xa8\x71\xd6\x79\x37\x5a\x15\x51\x7f\xd1\x16\xea\x79\x7b\x9d\xdd\xdd\xe0\x52\xdb\x8b\xda\x3c\x2f\x5e\xed\xae\x37\x38\xa6\x6c\x7e\x70\xa2\xd9\xb1\x9f\x33\x1a\x47\x8f\xbb\xbb\xc0\x35\xe0\x72\xd6\x6b\xc9\x39\x31\xb1\xb6\x3a\xef\x56\x07\xfb\xd8\x9c\xc0\xf1\x76\x2f\x93\xf2\x9d\x95\x98\xc5\x4e\xd4\x44\x59\x1a\x65\xcc\x5b\xe2\x43\x5b\x0e\xf1\xde\xa7\xa5\xc8\x01\x88\x10\x42\x04\x61\x32\x36\x00\x42\xd4\xc4\x2e\xc2\xe6\x4b\xda\x4e\x25\xed\xe7\x36\x93\xe8\x84\x95\x46\x37\xd2\xfa\x69\xfb\xb0\x94\x43\xba\x58\x3c\x2e\x67\xd5\x8a\xef\xf5\x3a\x0b\x94\xd5\x64\xa5\xbc\xfa\x85\x59\x5a\x05\x8c\x17\xa4\x91\x97\x7d\xff\xba\x52\xbc\x6e\xd2\x69\xc5\x5c\x13\x76\x3d\xa5\x37\x21\xbc\x1e\x35\x8e\x9d\x07\xcd\x31\xe9\xa4\xa3\xd4\x54\xdb\x85\x73\xdc\xcb\xa3\xf3\x39\x9c\xfd\x0a\x94\xfb\x83\xa0\x59\xe2\x78\xc6\xf7\xc5\x55\x4e\x37\xf2\x4c\x59\x67\xc4\x53\x33\x9d\x4a\xca\x32\x34\xd1\x05\x14\xb3\x8b\x39\xf7\x86\x77\x06\xa0\x7f\xde\x31\x50\xcc\x8d\xe6\x9d\x9b\x9d\xe5\xdf\xca\xf1\xbd\x20\x7d\xad\x44\xc5\x90\x22\x00\x28\xa0\x63\x81\x03\xb6\x49\x11\x70\x2c\xe7\x39\xec\x2e\x3f\x7a\xbb\x54\xed\xf3\xac\x94\x4e\x8f\x4a\x41\x38\xbe\x6e\x9c\x10\xca\xb5\x95\x56\xc2\x54\xf4\x04\x2c\x45\xc0\x9c\x2a\x9b\x77\x45\xb6\x6f\x4d\x87\xff\xea\x4d\x39\xa4\x31\x8e\xed\xc0\x7b\xeb\x28\xfb\xf7\x39\x61\xe4\x5b\xfd\x5c\x51\x78\xa6\xbb\xd8\x6f\xfa\x45\xff\x10\xe6\xa2\xe3\x4c\x95\xad\x2e\x4d\x18\x36\x3d\xf3\xdb\x80\xe9\xb7\xbe\x4f\xf5\x7f\x02\xfd\x24\x3c\x58\x3e\x62\x35\x0a\xc1\x51\x7b\xec\x6f\xee\x32\x3a\xdd\x1f\xe6\x0e\xc8\x53\x0d\x2b\xab\xc7\xac\x4c\xef\x68\xba\x59\x90\x33\x51\x27\xea\xaf\x18\x82\xc4\xf0\x9c\xa1\xab\xdd\xb7\x63\xe9\xcd\xd3\x58\xaa\x4e\x72\x8e\xb4\x56\xee\xc8\x2d\x1c\xb9\xa7\xee\x9c\xa9\xe2\x3c\x61\xf8\xea\xba\x58\x94\xfa\x29\x0b\xe7\x13\xd6\x67\x8e\x9a\xa5\xfc\x23\xec\x27\xf1\xa7\x75\x97\xcd\xfb\xf3\x4c\xd4\xba\x19\xd1\x19\xae\xda\xd4\x27\x7a\x86\xf4\xa1\x3e\xce\xa2\xd9\x2f\xb3\xb9\xb6\xc3\x15\xd7\xdf\x26\xaf\xe7\xf2\x40\x31\x9c\x73\x83\x2d\xfd\xed\x81\xab\x61\x96\x32\xf3\xeb\xd6\xfe\xb2\xe4\x58\x84\x20\x22\x80\xa2\xb1\x41\x91\x3b\x98\x20\x84\x08\xcf\x3b\xc8\xc6\x0b\x58\xdd\x9e\x83\x88\x6e\x57\x12\xad\x69\x1b\x27\x0b\xdf\x61\x66\xd2\x6a\x97\xe9\x2a\x9e\x5e\x6e\x87\x83\xab\x34\x4b\x33\xa8\x7e\x26\x04\x63\x1a\x55\x60\x3d\x05\x3d\xde\x7f\xa7\xbd\x3b\x75\xaf\x8d\xe1\x2a\xb5\x15\xee\x1b\xb5\x0d\xd2\x56\x58\xf8\xd7\x55\x3b\xbf\x1c\x84\x55\xba\xab\x68\xdc\x65\xd7\xd6\x3b\x73\xe4\xa7\x1c\x4f\xbf\xff\xe6\x78\x1e\x87\x1e\x6d\x26\x7b\x1b\x2f\x14\x4b\x59\xb8\xe0\xe0\x9c\xbc\x5d\xae\xd9\xf3\x43\x1d\x6f\xcc\xe2\x12\x1b\x70\xe2\x1e\xae\xdd\x4e\x8f\x51\xf6\x0f\x38\x5e\xcf\x9b\xff\x86\xe3\x11\xe4\x51\x90\xfe\xfd\xbd\xbe\xfd\x73\xf8\x6c\xff\x21\x1d\xbe\x1c\x79\xc9\x63\x64\xda\xea\xec\x9b\x42\x95\x74\x07\xf7\x3c\x59\xad\x71\x9b\x4f\xcf\xdc\x89\xaf\xac\xa5\x5a\xf3\x1b\xde\x4b\x0d\x55\x3c\x08\x33\xc6\x9a\xfc\x84\xf1\x3f\x4c\x03\xfa\xeb\x6d\xde\xde\x9b\x14\xf7\xf5\xa3\x3d\x9b\x1a\x73\xce\x25\x32\xc1\xd6\x22\xdf\xd5\xcb\xf2\x76\xd9\x28\xc1\xd2\xa2\x93\xdb\x85\x63\x76\xd3\x59\xde\xfe\x16\xfa\x6b\xd7\xf1\x8d\x71\x66\xeb\x59\x6b\x27\x67\xd5\x10\xa5\xdb\xc3\xe3\x2b\x7f\xa4\x4c\x37\xdb\x4e\x56\x77\xa9\xbf\x6f\xf9\xfe\x10\xa6\xa1\xd6\x6f\xc8\x6f\xa2\xa3\x3f\x80\x9e\x1b\x56\x3d\x76\xb3\x66\x68\x18\x14\x64\xe9\xd7\x7f\xff\xa5\x50\xcf\x7e\x7a\x33\xeb\xda\xe5\x95\x44\xe2\x63\xb3\xb3\x64\x2d\x1c\xf9\xa2\xc6\xc8\x53\x73\xbb\x55\xda\xb6\xd6\x43\xab\x34\x81\x49\x19\x1a\xad\x5f\x37\x97\x7c\x78\xd6\x24\xb6\x9d\x28\xce\x06\x67\xed\xd9\xa7\xe3\x01\x8b\x38\x48\x30\x82\x68\x4c\x39\x97\x35\x0c\x87\x0a\x10\x3f\x67\x04\xc7\x8f\xf3\x9c\x9b\x2b\xb3\xfb\x79\x7b\xee\x5a\x69\x55\xf4\x8b\xeb\xde\x65\x6f\xba\xb1\x71\x57\xf6\x0e\x12\x72\x5c\x70\xb7\x6d\x0f\xe3\x57\x2b\x21\x37\xb3\x72\x68\xe2\xfa\x57\x6e\xd7\xeb\xad\xcf\x85\xd9\x6c\x41\x53\x8a\x89\xd1\xb9\xc2\xe9\x62\xda\xf1\x91\x13\xd1\xf5\x11\x85\x48\x59\x05\xc9\x26\x25\xd1\xc8\xab\x77\xb6\xf2\xce\xb0\xcb\x9d\xd2\x7d\xc3\xb5\x1c\x2b\xcb\x83\x38\xab\x87\x27\x00\x1c\x7b\xe8\x0f\xf1\x8b\xab\x37\x46\x5c\xd7\x45\x7e\xa3\xde\xd4\x83\xaa\x10\x18\x3b\x53\xb1\x77\xbb\x45\xbf\xb2\x26\xbc\xbf\xb2\xb3\x9e\xf5\x27\x67\xd3\xe0\xae\xef\xfa\x1e\xe7\x43\xb6\xa1\x63\x31\xf1\x83\x7c\x5b\xe0\x7f\x27\x30\x63\x08\x78\x42\x30\x1c\x13\x0e\x5b\x36\x67\x39\x00\x1a\xcf\xca\x86\x19\xbc\x11\x36\xa7\xd5\x16\x84\x8e\xcb\xe5\x53\xc7\x37\x8f\xb0\x38\xfb\xa2\x5a\x2a\x9e\x79\xdd\xa4\xf3\xc9\x4d\x2c\xf6\x74\xad\xfd\x82\xf2\x2d\xbb\xff\x67\x83\xca\xed\x28\x0e\xd5\xc7\x4d\xd9\x10\x78\x1e\xc5\x22\xde\xa0\x3a\x55\x7c\x5a\xcd\x83\x91\x32\x9b\x41\x4e\xa5\xa1\x99\xb6\x3b\xd9\x7a\x3d\x9c\xf3\xc8\x1b\x6e\xfa\xb2\xb2\xfa\xbe\xb3\x91\xd9\x1a\x6d\xe7\xca\xd3\xf9\xe6\x34\x5a\x23\x5f\xcf\x61\x29\x4f\xa4\xc6\x3d\x61\x45\xad\x53\x49\xb3\x5d\x58\xd2\x8d\xb2\x99\xc5\xe0\x97\x98\xf0\x8f\x63\x0a\x5f\x33\x44\xe6\xb3\xe9\xba\x83\x8f\x03\x82\x24\x6b\xf3\xb0\xe7\x8a\x6a\xea\x04\x9d\xa9\x6b\x51\xe3\xef\x27\x6d\xb9\xa2\x7a\xbb\x5e\x1c\xdb\x9a\xfc\x1a\xec\x0f\x09\x38\x94\xeb\x04\xb1\xf3\x83\x36\x2d\xcb\x16\x67\x70\x6b\x5f\xba\xaa\x3c\x84\xe1\x29\xaa\x26\x5a\x4e\xcf\x02\x75\x9c\x99\x31\x73\xf3\xba\x3f\xdd\xfa\x3b\xbb\xd4\x77\xaf\x1b\x65\x9e\x38\x0f\xa3\x1c\x22\x00\xe3\xbf\xdf\x73\xf9\xdb\x82\x21\xd2\xb4\x95\x66\x60\xb3\xe8\xf7\xd9\x54\x36\xd7\xfb\xeb\xae\x3d\xe8\xbb\x4c\x54\xcf\x1a\x9d\xce\x1e\x4a\xc4\xb8\x30\xbe\x99\x93\xbd\xfa\x7b\xa0\x4f\x02\x07\x2b\x99\xe3\xa2\x6c\xee\x5f\xbb\xe3\x0a\xe6\x96\x96\x46\xab\xa8\x70\x8f\x99\x57\x99\x1e\xe7\xdd\x36\xa7\x2b\xa9\x34\xe9\x7a\x63\xde\xcd\x6c\x56\x39\xa9\x53\x32\xc3\xc2\x8b\x9d\xfa\x5b\xd8\xe6\x79\x2a\x9c\x04\xa4\xea\x52\xf4\x2c\x61\x31\xaf\xdb\x32\x39\x25\x33\x55\x63\x6e\x6b\xf6\xdc\xfb\x7d\xa8\x4e\x53\xbb\x9c\x09\xfe\x64\x1a\x6b\xaf\x96\x4b\x3e\xbc\x5b\x97\x1a\xcc\x5b\x33\x88\xb7\x73\x2a\x7b\x7e\xb8\x72\x68\x7e\x3d\x74\xbb\x60\x09\x1d\x43\x4a\x29\x06\xc4\x14\x4c\xfc\xf6\x64\xdf\x52\x30\xb9\xc7\x68\xbf\x9a\x24\x3b\x97\x5c\xd7\xe5\xdc\x63\xb3\xbd\x9e\xd5\x85\xbc\x77\xf4\xcd\xb9\x8d\xee\x19\x17\xde\x72\xb8\x58\xeb\x7f\x82\xf2\x69\xb0\x79\x79\x0d\x77\xc9\xa6\x20\x36\xdc\xec\x4e\x49\x7e\xf0\xcf\x99\xa9\x15\x97\x2b\x2e\xb8\x53\x3e\xe2\x81\x54\x78\x5d\xa7\x97\xba\xf2\xee\xbb\xff\x5d\xa1\xf0\xed\xad\x80\xff\xfd\x1a\x7f\xfe\x5e\x5d\xb9\xc3\x79\xea\xa0\x9e\x48\xec\x76\x75\x3e\x6c\xf6\x4b\xdd\xd0\xae\x3a\x33\x31\x2e\xbb\x55\xef\x33\xb6\x74\x4a\x47\x9b\xdb\x03\xac\xe6\xaf\x87\xe4\xaf\x19\xfe\x6e\xa0\x0b\x11\x87\x10\xc4\x10\x8e\xb1\x45\x08\xe0\xad\xa1\x48\xe4\xf9\xc6\x25\x47\x81\x36\x59\xb4\xd2\x4c\xab\x8b\xb8\x78\x68\x97\xb4\xdc\x9e\xd2\x6a\x14\x78\x69\xb1\xcf\x18\xc6\x5f\x5f\x8a\x6b\x77\xbb\x9e\x36\xd5\x6f\xf2\xbf\x57\x45\xdb\x14\x3a\x16\x3a\xb5\x76\x44\xf5\x22\x9d\x6e\xb4\x80\x03\xdb\xdd\x9a\xce\xab\xd4\xb0\x45\xb2\xea\xf1\x0e\x2c\x59\xd1\x55\x92\xec\xb7\x19\x5e\x57\xe7\x45\xd5\xd9\x1b\x52\x43\x43\x5b\x9d\x1a\xc6\x16\x61\xd1\xe4\x4b\x45\x38\x04\x9b\x48\xba\x3e\x1e\xb8\x73\x2c\xd5\x3b\x9c\x99\xf9\x48\xff\x6d\x86\xd7\x78\x8b\xbf\x34\x42\x74\xf7\xf6\xc7\xc5\x3e\x39\x89\xd5\x64\x22\x6e\x35\x2b\x70\x2e\x73\x47\xda\x99\xc6\x71\xb5\x38\x8e\x96\x47\x4f\x37\x70\xe6\xfd\x36\xc3\xab\x1b\x79\x5b\x6f\x17\x82\x15\xe1\xe5\x5a\xb3\xa7\x54\xf5\xb6\xbc\x3b\x8a\xd7\x47\x87\xc7\x93\xcb\x82\x2d\x33\x4c\xcb\xf5\xb1\xbb\xa7\x4a\x4c\x7e\x9b\x81\xfb\xba\xcb\x6e\x8e\x8b\x1e\x2e\x0d\x21\x9f\xf6\xdb\x51\x37\x71\x64\xa6\x08\xce\xe9\xba\x5c\xd5\x25\xf6\x97\xbb\x4d\x68\x9c\xf4\x36\x0d\x73\x3b\xff\x5d\x25\x7e\x83\x7e\x12\x7e\x77\xa5\xe7\x1e\x19\xed\x7a\x42\x48\x75\x69\xc9\x64\x7b\x70\xe7\x53\xa7\x7e\xec\x76\x24\xa4\x23\x89\x4d\x4e\x13\xd1\x1f\xe5\x0e\x52\x3f\x60\x18\x02\x8e\xaf\x6b\x94\x1b\x7a\xc5\x50\x08\xc1\x98\x5a\x98\xe7\xa0\xcd\x0b\xc2\x4b\x2e\x93\x6a\x1e\x16\xcd\xb1\xca\xb1\x6d\x5e\x44\x02\xec\x0b\xbb\xd3\x8e\xe7\x7e\x75\x9d\x2a\xe5\x71\x71\x9c\x09\x55\xb1\xdf\x78\x68\xc9\x9b\xd9\x3f\x24\x17\x00\x84\xc3\x16\x41\x00\x3b\xa6\x2c\x87\x58\x08\x58\xc4\x23\xf7\xff\x15\xf9\xf0\xc4\x2d\x86\x88\x1b\xbb\x36\x66\x5d\x82\x10\x30\x84\xe7\x91\x77\xaa\x02\xe9\x04\xe8\xbb\x4a\xaa\x66\xab\x4a\xdd\x08\x7d\xe7\x24\x4a\x76\x8d\xd8\x63\x82\x44\xb8\xa8\xaf\x0b\xdb\x5d\xd4\x75\x31\xf9\xc7\xe4\x3c\x44\x90\x12\x44\xe0\x18\x12\xd7\x01\x36\x34\x01\xb4\xc9\xe7\x92\xc3\x2f\x9f\x3c\xba\x6f\x39\x97\x4d\x41\x7d\x60\x8f\x16\x4d\x17\x5c\xd6\xcb\xf5\x66\x32\x55\xbd\x1c\x15\xfa\x59\xda\x05\xeb\xbb\x27\x3e\x1e\xc8\xd6\xb5\x11\x73\x66\x7e\x5b\x5d\xd1\x1f\x17\xfd\x2d\xe1\xe0\x7b\xe8\xe3\xc6\x7d\xac\x25\xb0\xdd\x70\xa5\xfc\x50\x97\xce\x32\x0a\x1c\x5d\xda\x5d\x93\xb9\xc8\x1d\xd2\x8e\xe9\x79\x56\xdb\x3b\x31\x34\x23\xf2\x31\xf4\x6b\x67\x8d\x3f\x0a\xfd\x1a\xcf\x92\xc2\x85\x99\x4f\xcd\x9c\x6e\xae\x9b\x60\x26\x76\xd6\x9a\x39\xa5\xe6\x45\x14\x8d\x7d\xbe\xb2\x71\x5d\x23\x56\xf4\x9a\xf5\xba\xfa\x9d\x09\x79\x3d\x40\x7b\x5f\xdf\xf0\x81\xd0\x3e\x8c\xcb\x01\xb7\x70\x0b\xb8\xab\xdf\xc9\xb3\x69\x27\x8d\xce\x98\xb6\x36\x6f\x56\xdc\x3d\xe4\xe4\xc3\xfe\x37\xa4\x86\xdf\x4c\x33\xad\xd7\x26\x9c\xf6\x58\x5c\xf6\xb7\xe0\x3c\xaa\x17\x6a\xc9\x55\x9d\xc1\xd3\x05\x7c\x0c\x75\xa0\x2a\x2c\x0a\x6a\xf1\xf1\xc6\x68\xad\xdf\x03\x7d\x12\xf8\x18\x07\x35\x63\xa7\xd2\xaa\x3a\x9c\xe7\xb1\xb8\x3e\x9e\x8d\x09\xb4\xfa\xa9\xb7\x92\x0f\xaa\x7d\x9c\xf2\xa0\x7a\x30\x27\x01\x96\xe2\x4f\xb7\x8c\xbc\xcc\x2c\xf7\x5d\x51\x2f\xa0\x90\x00\x04\xf9\x31\xe4\xa9\x49\x10\xcf\x13\x88\x9f\xaf\x9e\x2c\x3c\xa9\x59\x8d\xc9\x8c\x62\xb6\x12\x37\xb6\xc7\xb7\xf2\x09\xba\xf5\x81\x35\xb5\xab\x19\x4f\x7d\x25\x0c\x15\xbe\x39\x22\x65\x11\xfd\x36\xed\xb0\x41\x72\x90\x27\x2c\xe5\xc6\xa6\xcb\x1a\x14\x23\x47\xa0\x26\xfc\x3c\xda\x67\x1f\xfd\x18\xce\x12\xee\xe1\x08\x98\xaa\xa6\xb3\x3d\x32\xba\x3f\x3f\x2a\xea\x75\xe5\x37\xeb\x1d\x69\x4d\xb1\x3a\xde\x44\x09\x2b\x7c\x34\x9b\xfc\x0e\xf4\xb3\xdd\x47\x26\xcc\x05\xf1\x6e\x8b\xe7\x16\x15\xf0\xda\xd0\x66\x6a\xb9\x52\x8b\xed\xea\xae\x4f\xd2\xba\x55\x25\xc3\x89\x3c\x32\xcf\x2d\x7b\xff\x3b\xd0\xcf\x51\x11\x6e\x5f\xf2\x55\xc9\x62\x42\xee\x19\x5c\x16\x37\x56\xf6\xd7\x96\x73\x12\x32\x3c\x59\xc2\xb9\x3b\x5a\x74\xa6\x5c\xb0\x99\xb3\x12\x7e\x4b\x6a\xfe\xf3\xa0\xe1\xf3\x77\x8c\x4f\xac\xc3\x78\x89\x3f\xb3\x54\x41\x09\xe5\x45\x35\xdf\x8a\x5d\x37\x3c\x70\x09\xaf\x85\x78\x2f\xc9\x76\x42\xae\xce\xf5\x6c\x1b\xfa\xc7\xd8\xf0\x6b\xea\xe3\x1c\x8c\xea\xe2\xee\x6f\xa3\xaa\x40\xa8\xb2\xd8\x40\x94\xe7\xf4\xbc\x88\xcf\xa1\xdd\x86\x02\xca\x41\x63\xcf\x7d\x78\x9a\x4f\x0f\xfc\x6f\x61\xfe\x59\x71\xab\xda\xa8\x2b\xfb\x7f\xff\x7a\xca\x7f\xd0\x3f\xf8\x5a\xbd\x25\xdd\x79\x39\x8d\xb2\xf5\x3e\x72\x66\x61\xa4\xcc\x08\x83\x52\x7b\x32\xe1\xce\x6b\xf8\x90\x57\x2b\xee\xac\x16\xf3\x7d\xd1\x70\xa8\x6c\xff\x09\xc9\xb7\xac\xb8\xc9\xdc\x83\xc7\x15\x13\x65\x9b\x2d\x62\xb0\xca\x5f\xfb\xb5\xa9\xae\xe6\x67\xa9\xdb\xa5\xda\x12\x2c\xea\x83\xb6\x49\x77\xdc\x51\xda\xfe\xa3\x21\xbc\x6e\xcc\xd7\x35\x9e\x9f\xc5\x05\xbf\x9c\x4e\x6b\x43\x3f\x74\x13\x8f\x2c\x1e\xe8\x76\x6d\x10\x32\x0f\x3c\xb9\xa8\xbc\x70\xdb\x5f\x2e\xc7\x73\xf2\xaa\xe8\xa5\x55\x66\x6d\xec\xf4\x3f\x79\x91\x15\x0e\x57\xa3\x3c\xc1\x88\x8e\x31\x84\xd8\xe4\x09\x80\x26\xff\xfc\x1e\x97\x29\x16\xe9\xe9\xb1\x8f\x6e\x9d\xba\x20\x15\x9f\x2e\xda\xe0\x46\x63\xbc\xd7\xc5\xbe\xc8\x33\xe1\x3a\x62\x6e\xea\x4d\x38\x85\xb7\x77\x2f\x19\x95\x99\x97\x3b\x79\xee\x30\xae\x51\xd5\x5f\xc3\x51\x7f\xd3\xd2\x21\xf5\x1c\x08\x18\x21\x30\x66\x39\x44\x0c\xca\x09\x3c\xfb\x52\xbd\x71\x39\xdf\xd9\x43\x76\x46\x5c\x0e\x4d\x60\x15\x1b\x39\x8e\xbd\x03\x95\x15\x75\x59\x1a\xce\x4e\xdc\x42\xda\xd2\x87\xf8\x50\xce\x57\xef\x23\xda\xf7\x51\xb0\xf0\x14\xb2\x97\xcb\xed\x71\xd1\x7a\x46\x3d\x68\x34\xf4\x25\x91\x41\x05\x2b\x79\xeb\xc7\x28\x2d\x27\xec\xfa\x2a\x4a\xd7\xf3\x66\x76\xdf\xff\x18\x7a\xc8\x32\x1b\x72\x57\xbe\x3d\x2d\xfc\xea\x81\xa8\xbc\x29\x55\x09\xb7\x42\xdb\xb8\x9c\x5f\xb7\x79\xb2\x3d\xcf\x2a\xa6\xb8\xf2\xd0\x77\xb2\xb9\x66\xc5\x27\xda\x23\x1c\xeb\xd2\xf2\xfd\x7c\xc5\xb1\x69\x94\x7f\xfd\xfd\x16\x78\xfe\x66\x08\x89\xba\x91\xce\xeb\x53\x03\x2a\x03\xef\x6f\x8c\x3b\x8d\x3d\xe7\x6e\x2f\xf6\x8b\xf5\xd9\x98\x61\x89\xc8\x5d\x76\x3a\xec\x33\x5f\x72\xb3\xdf\x03\x7d\x12\xd8\x7a\x88\x3c\x6b\xf2\x3a\xe9\x6c\x5d\xe6\xce\x48\x64\xbd\x2c\x09\x50\xd8\xd4\xeb\x84\x2f\x56\x60\x5b\x97\x73\x7a\x56\xef\xf9\xbb\xf7\xe8\xcb\xa6\xaa\xca\xac\xaa\x18\x33\x36\xac\xc8\x2d\x03\xdb\xe8\xff\x6e\x8e\xf1\xe6\xcf\xc4\xbb\xe2\x34\xa9\x9d\x91\xdc\xc8\x1b\xd7\x5f\x32\x60\x2d\x0a\xfc\xec\x70\xd6\x1e\xbc\xe1\xf6\x42\xad\x32\x40\x5b\xf1\x89\x90\x8d\xf6\xff\x00\xf9\x49\xf4\x91\x96\x74\x5e\x2f\xac\x1e\xcb\x93\x73\x10\x18\xe4\x5d\xe4\xae\x8f\x8b\x4b\xd0\xef\xa5\xab\xd6\x89\xa7\x07\xa5\x61\xe2\x6c\xcf\xc7\x57\xfb\xb0\xec\x8d\xb4\x31\xdf\xa2\x27\x71\x93\xbc\x7b\xf2\x84\x7d\xf3\x64\x58\x8c\x90\x30\x16\x4c\xec\xd8\x36\x8b\x00\xe2\x9f\x95\xb3\x4a\x60\x6d\xb2\x4d\xe1\x3a\x15\xd3\x8c\x6c\x92\x65\xf3\xac\x29\xe6\x76\x3f\xc8\x20\xec\x17\xed\x0a\x1f\xf6\xbd\xb7\x02\xc7\x57\x5f\xbc\x32\x92\xc6\x89\x87\xa5\xf8\xc8\xb2\xc8\x71\xf2\x97\x5b\x9a\xe1\xbd\x6d\x8c\x00\x1a\x3a\xb7\x22\xcb\x02\xd8\x76\x06\xd3\xe0\x79\x31\x7a\xc1\x08\x5c\x14\x03\xc0\x85\xb6\x46\x8e\xb6\x3f\x85\xfb\x68\xb5\x28\x56\x73\x7c\x98\x0b\xf7\x9b\xc6\xc8\xe9\xae\x89\x63\xeb\x84\x5e\xaf\x1b\x2b\xc7\x48\xc7\x4c\xe5\x38\xcf\xab\x90\x1b\x5e\x96\x1c\x5e\x3e\x40\x68\xec\x20\x08\xb0\x83\x2c\x4c\x5f\x82\x0e\x52\x57\x2a\x8f\xe3\xa8\x33\x66\x5c\xef\xb1\x34\xc4\x94\x7f\x30\x86\x33\xb7\xbb\x64\x03\x76\xc2\xfe\xe2\xcf\x1e\x36\x02\xf7\x4a\x7a\xd5\x8d\xca\x6f\x4a\x2b\xcb\xd6\x4c\x65\xa4\x41\x1d\x3c\x1c\xfb\x7f\x8d\xd4\xf2\xb3\xf2\x7f\x87\xae\x37\xdf\x42\x0f\xc3\x80\x76\x76\x92\x89\x5b\xb6\xbd\x9b\xd5\x88\xeb\xcb\x65\xaf\x6e\xb5\x9a\x30\xd3\xe4\x4a\x95\x6b\xbf\x6c\x0f\x4c\xb4\x0d\xce\x1d\x9f\xc8\xd9\x3f\x27\x78\x1a\x08\xdc\x3c\xfc\xfe\x68\xe9\xa7\x05\x39\x34\xb3\x3a\x0c\xb3\x9b\x73\xd2\x2e\x9b\xa4\x5f\x33\x70\xdb\xee\x74\x30\x52\x96\xce\x71\x7a\x77\xdf\x0d\x24\x28\x9b\xbc\x72\xd2\x21\xa3\xbe\xfc\x51\x1b\x8b\x75\xe7\x6c\xf3\x43\x2b\x77\x93\xe9\x32\xd1\x53\x46\x5d\x40\xe5\xae\x79\xe2\xc4\xa8\x36\xb7\xe3\x7a\x1b\x2d\x77\x9d\x69\x36\xf4\x74\xce\x3e\x02\x7e\x35\xf2\xd3\x00\x1c\xcc\x98\x37\x3d\x51\x78\xf0\x5a\xa6\x0b\x0b\x36\x95\xce\x45\x51\x31\x6e\x41\x1c\x69\xd7\x57\xea\x54\xf2\x22\x46\xc0\xfb\x8f\x81\x9f\xad\xb1\x7a\xad\x36\xb1\x62\xd7\xb5\x2e\xa8\xd6\x46\xaf\xf2\x1c\x74\xde\xe5\xb4\x37\x7c\x1e\xcd\x7b\xe7\xc2\xaa\xad\xad\x34\xd7\x19\x2a\x67\x1f\x01\xb3\x5f\x77\x27\x7d\x6a\x6d\x66\x71\x55\xb6\x46\xda\x34\x6a\x95\x2e\x0e\xf1\x35\xa9\x13\xcf\x34\x11\x2e\x24\x8d\xda\x8b\x11\x7b\x61\xb7\x19\x2f\x28\xbf\x81\xf8\x24\x2a\xd7\x6c\xd2\xc3\xcc\x86\x92\xd7\x49\x6b\x91\x23\xae\x62\xf8\x77\xf5\x96\x24\xd7\x4a\x5e\xdc\x16\xb3\xed\xbc\x74\xab\x0b\xd3\x4c\xf8\x57\xbb\xa3\x1a\x9a\x7f\xf5\x55\x5d\x3a\x4e\x5d\x31\x46\x55\x39\xe5\xdf\xef\x46\x7d\x53\xfe\xe1\x1d\x4a\x0e\xf2\x80\x02\x6e\x6c\x22\xdb\x01\x96\x49\x5c\xc4\x3e\x1b\xe2\xdb\xf4\x10\x05\xe7\x99\x10\xfb\x6c\x3b\x05\xd7\x29\xc7\x55\xfb\x2c\xc1\x69\xeb\xec\x6d\x6f\x12\x16\x62\x51\x65\x6e\xa9\x6d\x8e\x9e\xf5\x0b\x76\x2f\xb3\xb2\xf4\xee\xbc\x35\xf6\x60\x5f\x6e\x96\xaa\xfe\x1e\x00\xc6\xe0\x83\xf9\x55\x26\x25\xa3\x05\x5d\x7b\xef\x9d\xc9\x32\xb4\xd7\x07\x61\x7f\xe2\xce\x7b\xa6\x0e\xe7\xc7\x38\x5e\xbf\x5a\x81\x55\xe6\x3b\x41\xec\xf7\x8c\x95\x34\xdd\xb0\x9e\xe1\x0b\xac\xa2\xe2\x75\xef\x9c\x4e\xca\xb6\xe9\x54\x99\xab\xe6\x93\x68\xa6\x09\x23\x73\x49\xec\x23\xa7\x56\xec\x8c\x74\x1e\x89\x16\x4a\xff\xee\xc2\xaa\xca\xd2\xc1\x4a\x30\xcb\xe1\x6d\xe7\xf2\x7b\xaf\xf3\xb6\xca\x6b\x91\x9b\xcb\x9b\xbb\x3f\x7d\x70\xf6\x06\xad\x17\xe1\xee\x34\x7d\xa0\xea\xb6\x4c\x2c\xc0\xec\xa2\x5e\x5a\x6e\xc3\xa4\x7b\xd7\x6c\xa8\xca\x5d\x88\x19\x2b\x33\x4b\xe3\xfb\x74\x6f\x18\x03\xad\xa7\x0f\x6f\x75\x5f\x1a\x55\x16\xe0\xcb\x11\x1e\xfb\xbc\x3a\x38\xdc\xae\x90\xc1\x21\x14\x6c\xff\xea\x72\x77\x7c\x29\xde\x69\xf9\x1b\x68\xee\xc6\xc6\xb7\x40\xe6\xb3\xa4\xd2\xf5\xca\xa9\xd3\x08\x9a\xf6\xa3\xa9\x44\x7c\xb8\xfa\xe1\xd6\xcc\x47\xed\x3c\x2f\x89\xf7\xb0\xee\x45\xb1\x09\xd7\xa9\x92\xce\xde\xf5\x6a\x1f\x3e\x99\x61\xb7\x46\xcf\x18\x49\x91\x3f\x29\xcb\xf0\xc8\x07\x01\x1c\xc5\x08\x8c\x39\x6a\xf3\x02\x6f\x10\x64\x08\xcf\xb3\x3e\xb9\xe5\xb3\xed\x46\xee\xb0\xb4\x4a\xf9\x2d\x23\xcd\x2b\x6d\x72\xa5\x3c\x73\x39\xed\x03\x65\xa7\x1e\xd5\x29\x3c\xd8\xf6\x63\x7d\x39\xb5\xbf\xcb\xc9\x23\x0e\x70\x08\x12\x38\x76\x6c\xd7\x14\x00\xe4\x6d\xc4\xc1\x3f\xca\xe9\x1b\xa9\xdd\xbf\x90\x42\xc0\x0f\x3d\x56\x11\x3b\xb6\xa9\x61\x99\x18\x22\x6a\xa1\xe7\x23\xa8\xd8\x1c\xf7\x74\x47\x97\xa9\x34\x8d\xd9\x07\xb6\xd4\x89\xc7\x1c\xfa\x76\x23\x89\x74\x32\x6d\xe8\x24\x97\x94\x96\x8d\x75\x53\x4b\xde\x2d\xf6\xba\x74\x6a\xcb\x2f\x99\xcc\x0c\xbf\x69\xed\xf3\x52\x97\xe7\xd1\x55\x80\xac\x32\x1f\x95\xad\x2d\xb9\xea\x24\x3a\x71\xdb\xba\x6d\x8a\xe9\xe9\x51\xce\xf8\x85\xc6\x2e\xe6\xdd\xa8\x9d\x75\xea\xec\x23\x58\xf8\x27\x61\xdf\x92\x95\xbf\xbe\xdc\xf3\x7a\xf1\x6c\xf0\xdb\x54\xb3\x02\x93\xa4\xbe\x0f\xb6\x86\x36\x39\x8d\xae\x75\xc5\x73\xd1\xca\x2e\xc0\x6e\xc7\xe5\x17\xb7\x67\x23\x29\xd2\x4f\xd5\x87\xc8\xef\x2c\x48\x7a\x56\x7a\x41\xf2\x11\x54\x66\x8a\x9b\x2e\xc4\xf6\xb2\xb0\xa8\x79\x73\x57\x9b\x65\xed\xca\x09\xd4\x4f\xba\x77\x4b\x47\xc2\x4c\xf9\x10\xf9\x35\xff\x39\xe4\x9c\x85\x93\x35\x72\xb1\xea\x2e\x27\x9a\xeb\xcd\x6a\x46\x1e\x0f\xce\x9e\x87\xfc\xd9\x6d\x6e\x23\x1e\xee\x0e\xeb\xab\x63\xd1\x84\x7c\x88\xfc\xad\x76\xdc\x97\x76\x5b\x39\xc9\xb7\xf9\x8e\x80\xf8\xa0\xaf\xad\xa2\xe5\x94\x52\x33\x99\x86\x6b\x59\x2d\x97\x44\x01\x0b\xc2\xa5\xcf\x36\x36\xf8\x1d\xc8\x27\x61\xd9\x79\xc1\x67\x9a\xa5\x51\x5c\x96\xbd\x00\xc0\x43\x2d\x96\xa1\xa6\x05\x5d\xd9\x5e\xf0\xea\x22\xb0\x88\xf1\x99\x73\x1b\xd6\xef\x3a\xb6\xd5\x89\xf5\xb5\xa3\xd2\xdf\xcd\xb8\x86\xf7\xd0\xbb\x67\x35\xe7\x00\x0f\x29\xe4\xe1\xd0\x09\x8b\x17\x4c\xde\x26\xc0\xe5\x2c\xe3\xd9\x45\x4f\xad\x5c\xb8\xd3\xc6\x48\x1e\xb9\xb8\xe5\x36\xae\xb8\x3b\x5e\xc2\xc2\x10\x47\xe6\xcd\x5b\x3a\x1a\xa8\xd4\x63\xc2\xda\xaa\xbc\xe3\x5e\x9d\xba\xa6\x74\x8d\xbb\xf3\x57\x6e\x3e\x7a\xb5\x42\x38\xf0\x88\xe6\x49\x63\x7b\x62\x33\x63\x52\x6f\xf6\x98\xde\x21\xb7\xbc\xe7\x0c\x11\x38\x4f\x39\x14\x6e\x44\xbd\x73\x10\x9a\x33\x6d\xf2\x2b\xd0\xd7\x4b\x95\x45\x56\x91\x38\x89\x26\xda\xc9\x5e\xb2\xb3\xe8\x0c\xce\xc6\xe6\xce\xd4\xb7\xc9\x44\x75\x84\x13\x77\x39\xf7\x53\x16\x6c\xea\x8b\xd6\x80\x5f\x83\xbe\xf5\x0c\x69\xb8\xfa\x90\x6f\x76\xbb\xaa\x9d\xcc\xfb\x24\xe1\x15\xc7\x5f\xfa\x3d\x69\x96\x6a\xac\x37\x3a\xb3\x48\x8a\x43\x74\x5f\x42\x25\xbd\x4c\x3e\x40\xfb\x03\x22\x76\x81\x91\x7a\x02\x18\x9c\x7d\xf3\x35\xa5\x6d\x08\x68\xb1\x80\x85\x08\x70\x63\x82\x0d\x24\x40\xc3\x66\xb1\xf1\xbc\x40\xf5\xd9\x51\x09\x58\xae\xa0\x58\xd5\xd0\xe4\x6c\x5d\xdc\xa0\x29\x22\x29\xd2\xea\x74\x21\xad\x8b\x68\x7a\xcc\x45\xb9\x59\xca\x5d\xfd\xfa\x05\xfb\xe6\xed\x35\xc4\xd8\x4e\x8c\xf2\x5b\x4d\x2e\x7a\x0e\x39\x61\xff\xc2\x7b\x8f\x6b\xd3\x9f\x26\xb7\xaa\x8b\x81\xaa\x79\x8b\xe3\x72\x96\xec\xa7\x73\x6b\x73\xcc\xfd\xab\x2f\xd8\x8e\x75\x3d\x98\x1c\xf9\x10\x97\xfb\xd7\xb8\xd9\x17\xa7\xb6\xec\x2f\x41\xc6\x98\x66\x16\xd7\xdf\x77\x0f\x57\xcc\x53\xaf\x29\xf0\x20\xa6\x6b\xab\x59\xa6\x27\xd4\xf0\x27\x47\x07\xe2\xec\xaa\x72\x0e\xcb\xb3\xd3\x64\x62\xc2\xcb\x6a\x3d\x6a\xf4\x17\xb8\xe1\xef\xf3\x44\x0f\x09\xeb\x90\x83\x90\xb0\x63\x6c\xb9\xc8\x65\x05\x93\x72\xf8\x79\xa9\xd8\xe9\x5a\xf1\xb4\xcd\xa5\x15\x97\x06\x75\x46\x16\x9b\x28\x37\xb1\xb4\x2b\x66\xa1\x2c\x48\x6a\x9d\x04\xd9\x5f\xd1\xdd\x85\x0b\x77\xc3\x42\xcd\xbe\x64\xf9\x50\xf0\x9a\x56\x4d\xf5\x25\xf8\x9a\x2f\xf4\x7a\x7c\xb3\xe7\xd5\x4a\x30\xfc\xea\x7c\xc0\xda\x61\x3b\xda\x5d\x85\x9b\x7c\xd3\xdd\x3b\x0a\xca\xfb\x95\xf5\x36\x88\x13\x16\xdb\xdd\x2d\x4c\x92\xe8\xa7\x78\xe8\x8f\xe2\xbd\x1e\x55\xc9\x41\x49\x22\xe8\x94\x13\xe5\xe6\x97\xc9\x6d\x33\x9a\xdc\x65\x1f\x92\x47\xbd\x77\x43\x73\xd1\xd6\xe4\x58\x37\x99\x5b\xac\xed\x46\xff\x09\xde\xab\x0b\x31\x8a\x16\xa9\x33\x99\xa1\x2e\x43\x8a\x1b\x58\xdb\x4b\x6a\x4c\x7b\xf5\x7c\x5c\xa8\xc2\x91\xc3\xf6\xa2\xed\x9c\xb3\xa5\x17\x4a\xae\xf0\x3f\xc5\x7b\x1e\x6f\xdf\x39\xc1\x76\xcd\xf2\x9a\x09\x2e\x36\xb4\xed\x15\xbd\xef\x6e\x4c\x76\x4a\xc9\xfd\x4a\xae\xa9\x13\x73\x91\x30\x7f\x14\xf5\x56\x69\x7f\x32\x5e\xf4\x05\xff\x61\xbc\xb7\xbd\x65\x7d\xed\xa1\xdc\x63\x75\x69\x97\xa2\xad\xb6\xed\xa3\x9b\x30\xb6\x96\x45\xc4\x09\xe5\x91\xd6\x6e\x16\x66\x93\x49\x82\x2b\x86\x75\xf6\x73\xa0\xff\x42\xb0\xc1\xe5\xff\x92\x95\x1e\x63\xd4\x59\x12\xbc\x65\x62\xbe\xa6\x51\x7b\x12\x92\x9d\xcc\xba\xe0\x51\xbf\x18\xf5\xde\xfa\x66\x95\x8f\xfd\xd2\x28\x92\x73\x28\x4c\x94\x84\x4b\x01\xdf\xc6\x4c\x71\x66\x76\xb3\x9f\x01\xbe\x06\x1e\xab\xc9\x74\x33\xdd\x1e\xae\x76\x79\x3f\xce\x59\xff\xe8\xcd\x14\xd3\xe2\xae\x46\xb5\x3a\x2f\x7c\x6f\xb6\xbf\xf5\x6e\xd7\x1f\xef\xd9\x45\xde\xff\x0c\xf0\x9b\x8f\x35\x7b\x84\x78\xb9\x48\xaa\x6d\xba\x80\x6a\xa6\x9d\xf7\xd1\x6e\x5a\xad\xd6\x8e\x20\xb5\x67\x49\xd8\x3b\x97\xc7\x51\x7b\x3c\x62\x7b\x73\x8a\x7e\x81\xf4\x27\x44\xfb\xab\x1d\xdc\xf7\x79\x91\xad\x46\xa3\xcc\x4e\x26\xf3\x3d\xd0\x57\xfc\x3e\x36\xdb\x30\x3e\x6c\x7a\x70\x63\x79\x6f\xe9\x4b\x4b\x7d\x41\xab\x50\x13\x48\xc1\x80\x9f\x43\xbe\xda\x42\x27\xef\x54\x72\xce\x3d\x51\x4a\xb6\xd9\x85\xeb\x69\xe7\x21\xbe\x4d\x56\x9b\x4b\x5f\xcc\x04\x23\x52\x96\xf4\x92\xba\x79\xb0\x8a\x47\xe4\xe7\x90\xdf\xda\x5f\xaf\x44\x83\x5c\x5c\x95\x17\xcf\x73\x31\xef\x2e\xda\x22\x8b\x46\x7b\x70\x38\x30\xe0\x32\xf5\x55\xc9\x94\xe5\xc5\x64\xba\x5f\xdf\x25\x34\xf9\x25\xd6\x93\x78\x73\x27\x6b\xee\x6a\x66\x15\x72\xae\x19\xc6\xa2\x59\x08\xb3\x30\x5b\x59\x87\x7a\x2d\x12\x7c\x6b\x18\x37\x29\x6c\x5d\xdd\x59\xab\xab\xfe\x2a\x5e\x9d\x65\xf1\xb3\x3b\x2a\x00\x16\xf2\x08\x51\x0e\x08\x63\x64\xb9\x36\x46\xd0\x76\xb0\xe3\x0c\x73\x00\x12\xcf\x75\xf5\x98\x4b\x5d\x7b\x34\xcf\xef\x17\xf5\xf4\x20\x8a\x34\x33\xf4\x43\x72\x84\x7d\xd5\x7b\x7b\x91\x2b\xae\x19\x57\x2d\xc8\xbf\x23\x79\x1a\xd0\x7d\x39\xd3\x2e\x8e\x6d\x9a\x58\x3d\xa9\xd4\x1d\x86\x03\x44\x96\x67\x64\x7f\xce\x37\x86\xb2\x16\x6d\x2f\x4c\xaf\x23\x2f\xdb\xbe\x9b\xa3\xc7\xd7\x76\x49\xef\xae\xd0\xee\x6d\xc0\xdc\x0c\x71\x62\xe8\xd6\x34\xea\x2d\xb9\x8a\x3b\x49\x98\xa2\x47\x40\xf4\xe3\xc5\x9f\xb3\xa0\x62\x0f\xe7\xbc\x91\xe6\x60\xff\x63\xb8\x57\x6d\x78\xb4\xe5\x7c\xed\xa9\x96\xad\x37\xca\xf4\x1e\x5c\x66\xd7\x99\x0c\xaf\xab\x8d\xb1\x9d\xa6\xab\x4b\xd5\x21\x65\x67\x1b\xd4\x9c\xb6\xb2\xfa\x63\xb8\x6f\x9a\x70\xbb\x89\x13\x84\x96\xda\x1c\x79\xfb\x19\x9d\x67\x9b\xc4\x4f\x4a\x97\x0b\x9d\xe5\x12\x35\x7e\x21\xe9\x1b\xed\xba\x4a\x78\xfb\x9e\xfc\x1c\xe7\x49\x2c\xd5\x44\xf7\x04\x45\xe5\x7c\xb6\xa0\xd2\x09\x9c\x85\xc2\x92\xa7\xd7\x79\x9d\x33\xe7\xa8\x81\xd6\xfd\x6a\x14\xbb\xea\xc0\x9c\xaf\x6f\xc1\xa5\xb7\xbc\x92\x37\xb4\x8e\xb1\xca\x3e\xaf\xb3\xa7\x4f\xc4\x03\xe1\xed\x35\x52\x1e\xd3\x31\xe0\x80\x60\x62\x40\x1c\x4e\x78\xde\x8e\xd9\xc3\x42\xa0\xfa\x04\x49\x7b\xd7\x91\x52\x57\xdf\xa9\xf6\xdd\xd8\x9f\xc1\x9e\xeb\x77\xe5\x4e\x0a\x84\x34\x5e\x64\xa8\x7e\x20\x93\x7c\xc8\x06\x01\x12\x00\x82\x08\xe0\x31\xa1\x06\x75\x39\x8e\x15\x78\xfb\x93\xd8\x04\x80\x01\x8f\x10\xe4\x20\x3f\xb6\x10\x4f\xb0\x03\xa0\x2d\xbc\xd8\x6c\x76\xb8\xf1\xac\x59\x09\x19\x11\xd0\x89\x18\x79\x30\x58\xbb\xc1\x52\xa7\xb3\x9c\x85\x7b\x3d\x5a\x9c\xf9\x22\xb7\xab\x4a\x01\xa3\xf6\x63\x36\x0a\x01\x04\x04\x42\x3a\xb6\x4c\xcb\xe4\x28\x40\xc2\x6b\x74\xb3\x0f\xbc\xb9\x2e\xdc\x0b\xf9\x71\x0d\x66\xbc\x9e\xdc\x57\x8e\x65\x44\xb3\x65\x68\xa7\xe7\x10\x87\x21\x11\x84\x38\x9d\x5b\x91\x2b\x2a\x1f\xb3\xb1\x80\x42\x84\x01\xc6\x63\x57\x10\x2c\xde\x76\x81\xe0\x98\xf4\xb3\xd8\x86\x42\x42\x81\x08\xc3\xeb\xee\xb6\xe3\x22\x96\x77\x6d\x68\x90\x4f\x62\x83\x00\x42\x28\x40\x8a\xe9\x98\xe7\x6c\x8b\x17\x5c\x00\x29\x05\x9f\xc2\x86\x00\x60\xd1\x90\x09\xc7\x22\x3c\xe6\xa8\x89\x78\x1e\x40\x6a\x58\xc2\x40\x93\x57\x67\xc8\x19\x65\x61\x74\x3a\xe1\x6f\x34\xba\x01\x71\x9f\x46\xb7\xf3\x7e\x59\x15\x96\xae\xa9\x6c\xbd\x0a\xa6\x93\x5c\x0b\x2f\xff\x9a\xe6\x69\x50\xeb\x87\xb2\x53\xf7\x6e\xaf\x6e\x7c\x7f\xb1\xf3\xf5\xe8\xba\xad\xe8\x2a\x5f\x13\x9d\x2f\xd7\x2b\x27\xb9\xc0\x7e\xb1\xf6\x25\x4f\xaf\xb3\x77\x83\x72\xba\xd7\x48\xce\xf0\x3e\x19\x87\x04\x48\xc7\x14\x08\xae\x63\x3a\x2e\xcf\xbf\x7c\x2d\x71\x09\x8c\xf3\x41\x05\x94\x43\x00\xaf\x5d\x56\x59\x8f\x5c\xe1\x08\x83\xe1\x39\xcf\x5e\xea\xdc\xdb\x6a\xb2\x3f\x8a\x1a\x8e\xf6\x93\x0f\xa8\x30\x60\x21\x45\x1c\xe6\xc6\x06\xb4\x39\x96\x22\x96\x23\x0e\xff\x29\x54\xc3\xfa\xc2\x48\x80\xfc\xd8\x71\x6d\xd6\x44\xc8\x44\x14\x3d\xaf\xe6\x5b\xd8\x2b\xeb\xb3\xe7\x54\xee\x26\x17\xd9\x4a\xed\x8e\xcb\xed\x32\x11\x56\x39\x4f\x1e\x37\x9f\x6e\xf6\xe7\x7b\x21\x2d\x16\x78\x5f\xf2\x1f\x50\xf1\xc3\xe4\x61\x30\xbc\x0a\x0d\xb1\x65\x3a\x36\x62\x05\xee\x79\x29\xf3\xec\x88\x9e\x4e\x06\x77\xca\xb3\x25\x89\xd6\x6e\x02\x78\xf0\x10\xc3\x45\xac\x6a\xf3\x87\xaf\xfb\xd5\x6d\x35\xbb\x6d\x39\x75\xe1\x44\xbf\xa6\x82\x00\x03\x00\xb1\x40\xf9\xb1\x01\x0d\x93\xa7\xb6\xe9\x40\xfc\x9c\x17\xb0\xbc\x3c\x64\x3e\xdd\x57\xbb\xb8\x6a\x3d\x47\x9b\xec\x66\x58\x6d\xb6\xc2\xe2\x5a\x4d\xac\x74\x79\x5f\x92\x7b\xaa\xb6\x1b\x86\x16\xd1\xf5\x03\xaa\xa1\x24\x95\x45\xc3\xa3\x3f\xc8\xa5\x43\x63\x12\x81\x52\xfe\xd9\x25\x44\x9a\x52\x5d\xe3\xcb\x8e\xc5\x2b\x3e\xeb\x0c\xd8\x00\x81\x3d\xaa\x81\xa5\xd4\x8a\xc4\x3f\x7a\xee\xca\x56\x9b\x48\x27\xc2\x5c\x27\x1f\x50\x21\xc4\x41\x81\x62\x0a\xc6\xb6\x41\x79\xc0\x11\x93\x20\x41\xf8\xf3\x54\xc3\x13\x75\x70\xc8\x86\x13\x20\x1c\x03\x02\xa8\x6d\x71\x3c\x76\x81\xf1\x29\x54\x08\x70\x50\x40\x90\xd2\xb1\x0b\x39\x84\x04\x87\x15\x58\xf3\x39\x84\xbf\x64\xce\xab\xb2\x40\x87\xba\x4c\x13\xf5\xca\x8e\x66\xb2\xb2\x9a\x0b\xb6\xa7\x9e\xd3\x44\xdc\x1c\x7d\xcf\xea\xa7\x47\x38\xbf\x0a\x89\xfa\x11\x15\x22\x90\x45\x2c\x86\x63\xd6\xb2\x86\xdd\xc2\x06\x9c\xfd\xac\x16\x38\xbc\xa9\xfd\xd6\x57\x2a\x39\xdf\x61\xae\x11\xd5\x48\xcf\xb2\xb0\xe1\x8c\x49\x40\xad\x03\xdc\x23\x5c\x6f\x1f\x2b\x6b\x04\x54\xfd\x9d\x5a\xbc\xd5\x9d\xfd\x87\x0c\x0a\x00\x0d\xc9\xc0\xc3\x9e\x31\x06\xac\x40\x2c\x64\x13\x1b\xb0\xcf\x8a\x11\xdd\x38\xfd\x74\xcb\x93\x0c\xdb\x8f\xfd\x54\xed\xe2\x7c\xd4\xe7\xa2\x29\x39\x87\xe9\xa8\x9a\x9a\x47\xef\xb0\x6c\x7c\x6a\xa7\x34\xac\x3e\x24\xe3\xdf\x86\xc7\x13\x30\xb6\x5c\x17\x11\x6a\xb0\x14\x08\xe6\x13\xd9\xdc\x59\xb7\x96\xe7\x85\x21\x4e\xd4\xbb\x3e\xca\xb6\x47\xf3\xd0\x1a\xed\x61\xa9\xa9\xb0\xf1\x09\x3f\x0b\xeb\x29\xd1\x97\xb7\x78\x07\xde\x91\xc5\xc1\x6b\xdd\xfd\xf0\xe6\x3e\x14\x30\x00\x74\x6c\xb1\x1c\x00\x68\x08\x30\x5b\xcf\xe6\x86\x7e\xb2\x53\x09\x2e\x12\xb6\x93\xb5\x8d\x78\x3d\x46\x9a\x8e\x98\x70\xd6\xc4\xae\x8c\xf9\x95\xa5\x9c\xfb\x9c\x59\x4c\x54\xef\x9e\xcd\x7e\xcd\xf5\x36\x89\x90\x23\x78\x78\xdf\xdf\xc4\x0e\x6b\x50\x6a\x09\xec\x73\x9c\xa1\x75\xfc\xac\xd9\x18\x38\xde\xb4\xc6\xf9\x62\xdd\xbb\x4a\xa5\xd7\x6e\x4f\xfb\x7d\x7c\x12\x89\x01\x56\x13\x55\x6c\xaa\x4b\xbe\xcb\xf4\x8f\xb8\x30\x80\x08\x43\x9e\xe0\x31\x65\x21\x71\x6c\x6a\x1a\x0e\xeb\x7e\xce\xb8\x86\x4b\x5b\x8a\x87\x3e\xa8\x36\x80\x00\x98\xac\x69\xf3\xe6\xab\xc9\x76\x06\xb2\x18\x32\x1e\x84\x73\xef\x5a\xcb\xb1\xa7\x5f\xf5\x56\x8c\x14\xd7\xd9\xce\x79\x21\xb3\x14\xed\xb1\xd8\x32\xfc\x3d\xb2\x3e\xe2\x22\x40\x18\xee\xbe\x11\x1e\x0b\x54\x30\x09\x81\x86\x45\x10\xfa\x1c\xae\xb7\xeb\x6f\x20\x50\x38\x26\x90\xd8\x3c\x0b\x4d\x93\x18\xd6\x27\x71\x61\x30\x64\xcd\x02\x3c\x86\xec\x60\x90\xb2\xd4\xa4\xb6\xf3\x29\x5c\xc3\x2b\xed\xc3\xf3\x5c\x00\x8f\x5d\xdb\x86\x96\x6d\x10\x17\xd0\x67\xdd\xa0\xc5\xfa\x1a\x59\x17\xb2\x0d\x75\xfd\xc4\x9b\x5a\x23\x05\x9d\x74\xc4\x75\xde\xf7\xe6\x68\xad\xe7\x7a\xac\x9d\xd7\x9d\xeb\x9f\xcb\xea\x97\x5c\xc3\xde\x8b\x01\xe4\x29\xa5\xc2\x58\x80\xc0\x74\x38\x43\x20\xf8\x65\xe3\xc0\x5d\x0d\xe7\xa1\x3d\xe7\x7d\xdd\x65\xef\xfb\x9d\x22\xfa\x67\x65\xea\xcf\x1f\x27\xde\x0b\xc3\x6a\x37\x63\x5c\x0d\x2b\xfd\xde\xde\x5c\x3f\xe2\xc2\x00\x21\x40\x79\x0a\xc7\x1c\xe6\x59\x6e\x78\x8d\x8f\x87\xe6\x30\xa0\xb3\x3f\x72\xf9\xbd\xbc\xbc\x68\x04\xc2\x6a\xa9\xf1\xcc\x5d\x9b\x1e\x6f\xdc\xd5\xc8\x6f\x86\x76\xd7\xad\xf5\x7c\xbe\xf4\x9b\x4a\x06\xd1\xbf\x23\xf9\x23\x03\x4a\x32\x33\x78\x69\x31\x2c\x00\x3c\xe4\x52\x43\x16\x08\x63\x1b\x73\x58\x70\x79\x96\xba\xc6\xf3\x26\xff\x18\x65\x42\x40\x16\xb9\x64\xda\x01\x2e\x75\x48\x13\x63\xcf\x6c\x3b\x50\x77\xf7\xb5\x60\x9f\x17\xd7\x99\xfc\x10\x58\x2a\x4f\xf7\xb3\x8f\xd9\x38\x28\x00\x40\x10\xe5\xc6\x36\x32\x6d\x64\x20\xc1\x06\xe8\xd9\x54\x9b\x31\x81\x9c\x9a\x4d\x71\x0f\x52\xf5\x28\x5a\x5a\x51\xf9\x05\x2f\x46\x79\xbf\x97\x32\xe7\x94\x5a\x92\x74\x95\xd3\xb5\xef\x18\xa3\xf7\x06\xe8\x30\x39\xcf\x54\x14\x62\xc8\x63\x0e\xe3\x31\x31\x5d\xd6\xc6\x90\x73\x38\xf0\xac\xef\xc9\x25\x20\x8b\xe9\xd4\x4c\x95\xc4\x64\xed\xa4\x5a\x2d\xf4\x25\x5a\x1b\x75\xe9\xcb\x4c\x5a\xf8\x9d\x35\x6f\x52\x4f\xbe\xf7\x46\x6a\xfd\x98\xea\x35\x12\x04\xf6\x72\x5f\x5e\xf5\xf5\x06\x30\xb8\x88\xc3\x86\x1e\x8b\x05\x6f\x2a\x2b\xcc\xef\x55\xbe\x7f\xa8\x19\x75\x22\x35\xc4\x52\x6c\x5d\x7f\x06\xf7\xd7\xa3\x25\x10\x02\x3a\x24\xa7\x20\x3a\xb6\x04\xe0\xb8\x8e\xc3\x01\xfa\x12\xb3\xd8\x17\xbb\xe3\xe4\xde\x17\xd5\xcc\xb4\x9c\xc5\x63\xfa\xd8\x78\xde\xfc\x92\x96\xc5\x1c\x8a\x86\x6e\xdd\xbd\xc8\xd6\xe8\xb6\xe6\x56\x37\xef\x37\xc8\x86\xa4\x00\x0e\x0a\x63\x80\x09\x44\xac\x43\x01\x64\xcd\x3f\x49\xf6\x2d\xa3\x6b\xa5\x73\x99\x1c\xa6\x77\x7c\xd9\x9c\x5d\x83\x8a\xdb\xe8\xa1\x07\x6e\x77\x4b\xba\x1c\x1e\x7b\x85\x53\x12\xf5\x52\xcc\xbb\x75\x7b\xdf\xff\x14\xe7\x49\xac\x0a\xec\xab\x10\x4e\xc4\x5a\x70\x76\x39\xe3\xab\x7d\xae\x62\x37\x26\xee\xad\xd0\x66\xe7\x65\x6b\x5f\xa5\x59\xe1\x6b\xc1\xed\xa6\xbf\xb7\xea\xff\x6e\xa6\xff\x2d\xfe\x80\x20\x0f\x39\x4a\x08\x1e\x5b\xa6\x03\x5c\x01\x03\xce\x06\xcf\xc7\x66\xb2\x86\x1b\xe6\x88\x6b\x63\x2f\x47\xd2\xa5\xe2\x70\x79\x5b\xb6\xb5\xee\xcf\x71\x8b\xdd\x3a\x69\xdb\xaa\x00\x53\x3d\x99\x68\x15\xf9\x80\x8a\x43\x04\x61\xc2\x03\x3c\xc6\x2c\x87\x1d\x02\x4c\x03\x21\xfa\x29\x54\x3c\x62\x87\x23\x9a\xc2\x31\x6f\x10\x08\x1c\xce\x64\x31\x6f\x7f\x0a\xd5\x60\xd3\x63\x0c\x01\x1c\x43\x16\x5a\x36\xe1\x1c\x01\xba\x9f\x42\xf5\x76\x35\xc3\x22\x96\x08\x63\xc1\x24\xae\xe0\x52\xc3\xa6\x50\xf8\x14\x2a\x08\x87\xb2\x16\x0c\xe0\xd8\xb0\x0d\x87\x35\xb0\x0d\xa1\x60\x7c\x0a\x15\x1a\xcc\x1a\x04\x28\x1d\x63\x0a\x6d\x48\x88\x6b\x40\xd7\xfa\x1c\xaa\xe1\x90\xc1\x83\x8d\x28\x20\xce\x15\x38\x8e\x25\x96\x85\x3f\x81\xea\x2d\xb1\x02\x21\xca\x52\x34\x86\x0e\x18\x32\xf0\x6d\xd3\xe4\x9c\xcf\xa1\x42\x14\x08\x83\x73\x3e\xb6\x11\xeb\x0a\xae\x60\x50\xce\xfd\x9c\x51\x21\x38\x04\x1c\x20\x01\x63\x6c\x20\xc4\x52\x60\xb1\xaf\x1e\xd8\x9f\xa3\xc2\x10\x42\x1e\x63\x8a\xc7\x36\xcf\xf3\x1c\x07\x39\x16\xd2\x67\x0d\xac\x05\x79\x51\xbb\xbc\xbc\xdd\x74\xd4\x39\xa2\xb2\x4e\xb9\x82\x75\x8e\x85\x22\x5e\x0b\xfd\x94\xae\x2a\x5c\xfb\xcb\x0c\xef\xf3\xd8\xfb\x80\x8a\x00\x82\x86\xf7\x78\xe9\xd8\x31\xa9\x69\x99\x14\xba\xc8\xc0\x9f\x42\x35\xa4\x42\x03\x42\x20\x1d\x0b\x96\xc3\x19\xac\x80\x80\x0b\x84\x4f\xa2\xc2\x50\x40\x02\x61\xc7\x2e\x71\x38\xce\xc6\xac\xcd\x22\xeb\x53\xa8\x86\x56\xc3\x02\x04\x44\x18\xb3\x80\x02\xd6\x25\xc3\x83\x0d\xcf\x3b\xbb\x7c\x78\x8c\x9a\xc3\xec\x38\xba\xcc\x53\x4d\xe3\xd7\x9d\x46\xf3\x07\xde\xba\xb8\xbc\x62\xd1\xbd\x4e\x4f\x15\xe9\xae\x84\x18\xeb\xa8\xfa\x88\x0a\x0e\xef\x26\xc3\x41\x2d\x10\xef\x02\xd3\x76\xa8\xc0\x3f\xdb\x22\x0f\x2a\x6a\xa7\xe3\xf1\x98\x4c\x96\x2c\xc7\x1d\x1f\xeb\xf5\x42\x1f\x01\x33\xdc\x6d\xc1\x3a\x6a\xb6\xeb\x80\x30\x74\x51\x2f\xcf\x15\xf3\x21\xd5\x5f\x41\x87\x31\x36\x01\x61\xa1\xe3\x58\x3c\x15\x3e\x85\x8a\x43\x64\x30\xe6\x09\x1d\x5b\x06\x44\x00\xda\xc0\x76\x3e\x69\x54\x3c\xc4\x90\x40\x0c\xf0\x98\x23\xb6\x45\x6c\x0e\x21\xe0\x70\x9f\x41\xf5\xb6\xad\x63\x4a\x08\x18\x23\xc7\x82\xbc\x80\x21\x76\xdc\x4f\x19\xd5\xdb\x4b\x1a\x2c\xe0\x29\x18\x5b\xc0\x36\x2d\xc8\x19\x98\x52\xfc\xe7\xa9\x06\xa7\x0b\x12\x48\x29\x81\x78\xcc\x1a\xae\x49\xa1\x40\x1d\x6a\x18\x9f\x42\x35\x28\xa0\x40\x58\xc4\x0e\xb6\x05\x07\xd1\xb0\xae\x4c\xf4\x39\x54\x43\xc7\x20\x4a\x29\x3f\xa6\x06\x15\x78\x03\x11\x0e\x18\x9f\xf0\xad\x86\x15\x85\x58\x88\x20\x40\xfc\x18\xd8\x0e\xb0\x86\xa6\x1e\xac\x60\x7e\x0a\x15\x46\x04\x12\xcc\x01\x6e\x6c\x63\xc7\xb6\x04\x81\xc3\xaf\xa7\x70\x91\x37\x46\x53\x8a\x32\x87\x9c\xb5\xe8\xe5\x93\x84\xd9\xb0\x7d\x7f\x52\x4e\xaa\x60\xe5\x86\xa4\xec\xf0\x84\x9f\x2e\x96\x33\x91\xfe\xd2\x90\x1e\xba\x6d\x0d\x86\x27\xc2\x90\x8c\x81\x39\x34\x5d\x62\x09\x32\x2c\xf4\x29\x54\x2c\xa2\x00\x40\x96\xd2\x31\xb1\x28\xa2\x84\x05\x18\x93\xb7\x94\x8b\xfb\xe2\xa2\x6c\xd5\xce\xdc\xb8\xd2\x31\x62\x8c\x8b\x98\xb8\xf6\xda\x8b\xca\xc3\x69\x74\x43\xb5\x65\x3e\x9c\x51\x3e\xb3\xf0\x9d\x9e\xc9\xbf\xe2\x78\x1a\x0e\xb3\xe5\x4e\xa0\x35\x26\x7c\xc9\x59\xde\xc2\xe7\xa1\x96\xe1\x0c\xfa\xdb\xae\xc6\xe8\xa0\x9e\x76\x81\x75\x2b\xb3\xd5\x1e\x55\xb7\xf7\xc3\xc9\x8c\xa6\xf6\x5f\x1b\x65\xf2\x08\x22\x88\x30\x1e\x76\x75\x67\xa8\xbf\x20\x98\x37\x9f\xbf\xd3\x86\x01\x0e\x7b\x89\x93\xc6\x29\x56\xe1\x64\xd1\x65\xdb\xc6\x3d\x55\xfc\x5e\x5e\x68\x65\xa3\xef\x53\xb6\x3f\x5f\xb1\x31\x1a\x1d\x81\xfe\x11\xdb\x10\x2a\x64\x11\xa0\x04\x72\x63\x87\x25\x8e\x6b\x71\xe8\x5d\x23\x52\x6f\x9b\xdf\xe5\x64\x7e\xbc\x1a\x44\x89\xeb\xd2\x5e\xcc\xb8\x78\xce\xa6\x8a\x7c\x6f\x5d\x7d\xb3\xdb\xe6\x9c\xc5\x3f\xb2\x4b\x5b\xaf\xdb\x8f\xd9\xd8\x21\x2e\x8e\x09\x11\xc6\xc0\x45\x02\x66\x05\xcb\x75\x08\xfd\x24\x36\x88\x00\x42\x88\x0a\x54\x18\xf3\x94\xb7\x90\x61\x13\x8b\x37\xd9\x4f\x61\x1b\xf6\xc2\x21\x22\x0f\x04\x0c\xc7\xa6\x4b\x78\xd3\x85\xac\x61\xf2\x6f\x53\x78\x7c\x5c\x0e\x97\x69\x46\x50\x22\x78\x7b\x27\xcd\x66\xd8\x14\xa6\x8b\x60\x96\x7b\x54\x59\xa0\x65\xa4\xd3\x79\xb4\x53\x8c\xd6\xab\xff\x35\xcd\x1f\x19\x54\xd5\xa7\xd6\x7f\xb8\x86\x5e\xb1\x90\x40\x1e\x0c\xf9\x6f\xd0\x66\x81\x43\x58\x00\xad\x97\xd8\xae\xd6\xa9\x9e\xd3\xd2\xd3\xb2\x7b\x34\x0e\x65\xc2\xe5\x71\x46\x1b\x27\xcc\xc3\x53\xbc\x75\x98\xe0\x51\x4e\x39\xb0\xcc\x5c\x0e\x19\xea\x07\x5c\x10\x02\x7e\xb8\xb3\xc6\x70\x4c\x90\x89\x21\xc7\x73\xd4\x06\x9f\xc4\x85\x10\x84\x02\x46\x90\x1d\x63\xce\xe1\x5c\xc0\x43\x8b\xd8\xe4\x33\xb8\x86\x05\xc6\x41\x4a\x05\x82\xc7\x0e\x42\xd4\x36\x38\xce\xe0\x1c\xf6\x73\xb8\x08\xc2\x00\x11\x1e\x82\x31\x84\x08\x61\x00\x05\x64\x51\xfe\x73\xb8\x84\xc1\x27\xa1\x10\x80\xb1\x65\x53\x5b\xa0\x06\xc1\x06\xeb\x7c\x02\xd7\x70\x74\x41\x0e\x40\x0a\x28\x19\x12\x5e\x29\x01\xc8\x72\x38\x6a\x7c\x0e\x17\x8b\x28\x02\x98\x07\x68\xcc\x3a\xbc\xc3\x61\xde\xb0\x11\xe0\x87\xc9\x2b\x5a\x4d\x9e\xda\x60\x53\xa8\xe6\xb2\x73\xcd\xac\x4e\x25\x3f\x41\xd3\x5e\x55\xe0\xc1\x2f\xc8\x95\xcd\xb4\x65\x86\xe0\x61\xb1\x9c\xfc\x3b\x92\x3f\x34\xa0\xea\x3f\x54\x6f\xe1\x1f\x0c\x09\x01\x90\x1b\x43\x48\x29\xb4\x01\x6b\x99\xd6\xb3\xeb\x73\x38\xee\x78\xe9\x2e\xf5\x16\x63\x2b\xd4\xe4\x8f\xd4\xaf\xfc\x3a\x0a\x0f\xa3\x19\x41\xc7\xb4\xaf\x36\xa2\xbe\xb3\x43\x2b\x58\xf8\xd7\x8f\xa8\x30\x80\x74\xb8\xac\x1e\x13\x01\xf3\xd4\x61\x1d\xf8\x7a\x2b\xfe\xe7\xa8\x06\x97\x8e\x07\x84\x92\xb1\x63\x3a\xd0\x74\xb1\x63\x9b\x18\x7f\x12\x95\x30\xf4\x8f\x24\xec\xd8\x06\xa6\x33\x94\xa5\x58\x98\x73\x3e\x83\x6a\xb8\x23\x44\x00\xb3\x18\x8c\x87\x0e\x05\xae\x6b\xda\x90\x1a\xf4\x53\xa8\x86\x68\x2f\x4b\x05\x44\xc6\x2c\x6b\x72\xe6\xd0\x7e\xc9\xb0\xf8\xcf\xa1\x82\x43\x5e\x06\x82\xdc\x98\x1a\x16\x6f\x10\x42\x4c\xdb\xfa\xa4\x51\x21\x04\x09\x45\x80\x1d\x0f\x8d\xe2\x5d\xe0\x20\xd7\x10\xcc\x4f\xa0\x1a\xc2\x3f\x14\x12\x24\x10\x61\x50\x0b\x08\x4d\xdb\x18\x06\xf7\x29\x54\x18\xa2\x21\xdf\x1f\x73\x63\xd7\x31\x6c\x4e\x18\x2e\xbb\x5e\x9e\x3b\xf1\xe1\x26\x3c\x5b\x8e\x70\xd1\xd6\x8b\xfd\xac\x3a\x73\x6d\xbe\xda\x88\xe1\x42\x90\xea\x4d\x2c\xc6\xa7\x66\xbe\x9e\xdd\x6c\x69\x63\xce\xaa\x0f\xa8\x08\x7c\x6b\xc5\x05\xf0\x58\xe0\x38\x8c\x38\x8c\x81\x20\xd8\x9f\x43\x85\x10\x64\xe9\x90\xc1\x20\x50\x6c\xd9\x86\x6d\x19\xbc\x40\x3e\x85\x8a\x82\x41\x2d\x38\x44\xc6\xd8\x71\x31\xc2\x2e\x71\xa1\xfb\x39\xa3\xa2\x80\x83\x2c\xe0\x86\xca\x35\xcb\x26\x84\x38\x3c\x4b\xb1\xf9\x29\x54\x43\x11\x08\x4b\x21\xe6\xc7\xd4\x36\x10\x4f\x79\x0e\x3a\x82\xf5\x39\x54\x83\xef\x88\x00\xc2\x63\x8b\x52\x96\x33\x89\x60\x51\x1b\x7c\x0a\x15\x87\x58\x20\x0c\x55\x17\x63\xd7\x12\x04\xdb\x35\x5d\xd7\x24\xce\xa7\x50\xf1\x43\x4a\x06\x00\x43\x5f\x7c\xce\xe4\x4d\x96\x37\x21\xa1\xec\xa7\x50\x09\x70\xf0\xe4\x30\xe1\xc7\x26\xb1\x6d\xc3\xb0\xb1\xcb\x1b\xf8\x33\xa8\xe0\x10\x95\xa1\x90\xa3\x60\x6c\x9a\xd8\xe5\x6d\x13\x0b\x2e\x22\x9f\x43\x05\x01\x14\x08\x46\x68\x6c\x02\x81\x00\xd6\xb0\x2c\x93\x70\x9f\x42\x05\x87\x7a\x7d\x0a\x30\x3b\x26\x16\x67\x08\x36\x70\x1d\xf0\x39\xdf\x0a\x22\x40\x00\x87\x30\x22\x63\xcb\x21\x08\x71\x06\xa1\x0e\xfa\x14\x0d\x84\x08\x81\xe1\x10\x41\x64\x6c\x13\xc2\x43\xc3\xb2\x21\x2f\xb8\x9f\x44\xc5\x23\x88\x87\x94\x45\x40\x86\xa4\x60\xd3\x75\x1c\x9b\xff\xf3\x54\x6f\x2e\x2a\x3b\xa8\x3b\xa4\x63\x13\x40\xd6\x31\xb1\x6d\x09\xfc\x27\x4c\xe0\x40\x35\xa8\xc5\xdb\x04\xf2\xac\x29\x40\x40\x09\x6f\x5a\xf0\x73\xa8\x86\x93\x91\x60\xc4\x8e\x1d\x40\x38\xca\xb2\xae\xed\xf2\xe8\x53\xa8\x10\x40\x90\x25\x1c\x42\x63\x1b\x02\x68\xda\x43\x02\x9e\x4d\x3f\x87\xea\x6b\xb8\x98\xf0\x63\x88\x0c\xd6\x42\xb6\x65\x41\xe7\x13\x76\x8b\xb7\x58\x27\x1e\xda\xb1\x43\x30\xb6\xe9\x70\x8d\xea\x20\x0b\x18\x9f\xa3\x16\x18\xe1\xe1\x9d\x05\x48\xc6\x3c\xb5\x0c\xce\xa2\xa6\x40\xad\x4f\x38\xf0\x07\xdf\x14\xc3\xc1\x3d\xc0\x78\x6c\x61\x9b\x07\x88\x02\xc8\x81\xcf\xa1\x22\x43\x8b\x16\x4c\x21\x1c\x43\x81\x72\xa6\x49\x1d\xd6\x86\x9f\xb0\x5b\xbc\x45\x70\xdf\x26\x90\x92\x31\xa0\x02\xcf\x52\x8e\x1f\x9a\x2d\x7e\x0a\xd5\xe0\x1c\x80\xe1\x93\x8d\x5d\x68\x5a\x1c\x66\x11\xa1\xe6\x27\x51\x21\x8a\x86\x3c\x27\x32\xb6\x6d\x53\xe0\x01\x6b\x63\x6c\xbc\x15\x1a\x29\xbe\x70\xcd\x88\x7f\xd8\x29\xc9\x6d\x9b\xbb\x8b\x66\x42\x4c\x8d\xd9\x6a\xab\xe3\xc4\xf4\x6f\x19\x9a\x98\xe7\x8d\x26\xa6\xce\xdd\xce\xfe\x15\xc7\x1f\x19\xce\x5f\x4f\x66\x7e\xdb\xd3\x39\x20\x40\x0a\x30\xe2\x31\x1a\x43\x62\x01\x9b\xf0\x8e\x61\x83\x67\x0b\x70\x53\xa8\xfc\x4c\xdf\xea\x90\xa4\x61\xb4\xc4\x6e\xa1\x9e\x47\xb9\xc5\xae\xed\xb4\xf5\x03\x46\x09\xf3\x56\xae\xb9\xbe\x6f\xda\xed\xfe\x27\x5c\xaf\x55\x48\x7f\x02\xef\x5b\xc2\xd1\x90\x17\xc8\x41\x4c\x85\x61\xeb\xe1\x5c\x17\xd9\x94\xe3\x58\x1b\xfd\x61\xb6\x67\x3c\x73\x56\x0a\x95\x7b\xc1\x7b\xfe\xe6\x26\xd4\x5d\x0b\xdd\x08\x07\x75\xe6\x69\xde\x0a\x8f\xf2\x7a\x7d\x5e\x15\xde\xdd\x18\x51\x7b\x12\xfd\x14\xef\xad\x29\x9c\x95\x45\x5b\x7b\x32\x5a\x26\x01\xdd\x2d\x17\x97\x75\xbc\x8e\xe3\xfd\xc1\xbb\x06\xde\x64\xe6\xca\x97\xe5\x64\xa6\xc9\x27\xd5\x10\x2d\xf4\x0b\xa0\x27\xc1\xe8\x2d\xb3\x98\xbd\x56\xaf\x4e\xe7\xc7\xde\xdf\xd6\x53\xf5\x5e\xc8\x0f\x29\x9f\xb3\x41\xb9\x15\x1e\x02\xdf\x49\x96\x93\x55\xcd\x22\xf8\x6e\xa0\x41\xf2\x9c\x59\xc7\x03\x32\x34\x70\xa2\x02\xe1\xc6\xae\x69\x02\x64\x22\x24\xc0\x97\x49\xad\xb5\xe5\x66\x17\xf4\xe2\x1e\x04\xa9\x76\xbf\x76\xe6\x46\x08\x23\xaa\x8c\xee\xf7\x33\xb3\xbd\x1c\xf6\xfe\x51\x3a\x64\x33\x82\xa1\xf2\x11\xd7\x5b\xc8\x96\x12\x42\xf8\x31\x4f\x0d\xcb\xe5\x6d\x24\x50\x68\x7d\x06\xd7\xd7\xfa\xa9\x21\x1d\x97\x1b\x0b\x36\x22\x0e\x8f\xd0\x70\x53\xf1\x29\x5c\x10\x20\x02\x86\x3b\x11\x32\xa6\x94\xda\x88\x37\x11\x2b\xb8\x6f\xa9\x6b\x0c\xed\x2e\x31\x7f\xa5\x67\x81\x95\x46\xc7\x5a\x3e\xc4\x59\xda\xcc\x8b\x85\xac\x9c\x4f\x4d\x2f\x46\x8b\xe5\xba\x5d\xf8\xc2\x72\x39\xaf\xfe\x1d\xc9\x9f\x19\xd0\xbb\xe2\xc7\xe1\xb9\x39\x38\x9c\xf1\x84\x8e\x01\xe7\xda\x3c\xe1\x80\xcd\xbe\x34\x11\x4f\xb9\x8d\xd8\xd8\xd6\x94\x49\x67\x8f\xee\xb4\x48\xd6\xa6\x74\xbe\xd2\xdc\x3d\x1f\xd7\xc5\xd4\x12\xd1\xea\xc6\x86\xfd\xf5\xae\x92\xe4\x63\x32\x1e\x0d\x05\x45\x3c\x41\x63\xd6\xb2\xa1\x6b\x39\xf6\x70\x09\xf8\x49\x64\xc2\x50\xfd\x30\x18\xed\x63\x01\xb8\x06\xcb\x23\x0b\xbd\x86\xa1\xff\x20\x19\x7c\xcb\xc9\x85\x1c\x62\xc7\xac\xc5\x39\x18\x12\x93\xa5\x2c\xfe\x14\xb2\xa1\xcb\x03\x19\x9e\x69\x23\x74\x6c\xba\x6f\xa5\x91\x9c\x6d\xf0\xe6\x27\x91\x7d\xbd\x06\x84\x14\x8d\x5d\x0e\x71\xa6\xe3\x3a\x1c\xe5\x9f\x97\xb2\x70\x8d\xb9\x2e\x34\xc0\x2d\x2d\x70\x30\xf7\xb1\x9c\x29\x65\x66\x5f\x84\x6c\xa3\x5e\xee\xb6\xe5\x6c\x1e\xb1\xae\xf1\x8f\x50\xbd\x5f\x3f\x24\x1b\x2c\x26\xf8\x96\x8d\x03\xa1\x40\x29\xe4\xb0\x69\xbf\x3c\x41\xb8\x16\x1f\xa7\xc5\xd6\xd0\xd8\xee\xb2\x0d\xa3\x3d\xce\xd2\x06\xce\x97\xb3\x79\x09\x0e\x67\xe8\x89\x9c\xb5\x8a\x60\x33\xe7\xa3\x85\x56\xfd\x06\xd9\x10\xa9\xa3\x83\xea\x9b\x26\xb0\x80\xc1\x3a\x3c\x61\x3f\x91\x8c\x1b\x6e\x6c\xc7\x0e\x4b\x01\x16\x9c\xa1\x8d\x1d\xff\x59\x64\x88\x1f\x0a\x65\x00\x18\x1b\x26\x82\x90\x0c\x6f\x89\xf1\xe4\x93\xc8\x08\xa2\x90\x0e\xc5\x32\x63\x3c\xe8\xbc\x83\x88\x81\xd8\xe7\x45\xad\x79\xa1\x2e\x24\xde\xf4\x72\x0b\x8a\xab\x3c\x4d\xbb\x8b\xd1\xdc\xe0\xe2\x0e\x67\xb2\x3c\x79\x08\xab\xf0\x14\xec\x39\xbe\x9b\x5e\xc0\xc7\xda\x48\xdf\xde\xc6\x1c\xf2\x72\x90\x0d\x59\x93\xc7\xae\x23\xf0\xd6\x67\x91\x0d\x7e\xc2\x90\xff\x3e\x7e\xf3\x47\x38\x30\xe4\x55\xd1\x4f\x22\x1b\x62\x76\x88\x40\x38\x5c\x26\x18\xd8\x06\x10\xf1\x2c\x79\x5e\xd4\x4c\x39\x2f\x5a\x4d\xb7\xc9\x9c\xbb\xc9\x1b\xaf\x3d\x1c\x8e\x73\xab\x1e\x69\xcc\xca\x25\xdb\xf9\x19\x56\xfa\x43\x3f\xee\xf7\x47\xcf\xb5\x3e\x26\x1b\xae\x39\x29\x0f\xd9\x31\xeb\x00\x32\x3c\xb3\x63\x9a\x86\xf5\x69\x64\x3c\xa4\x18\x62\x3c\xec\xfa\xa6\x0b\x38\xd3\x11\x6c\xf0\x49\x64\xfc\x10\x5f\x00\x43\x86\x0e\xcb\xf3\x82\x6d\x08\x36\x25\x2f\x45\x91\xe6\x08\xcd\xa8\x3d\xb9\xfa\x97\xf6\x72\x6b\xeb\xf4\xc6\xea\x93\x62\xaa\x20\x7e\xe4\x20\x2b\x11\xb2\x3a\x02\xf6\xd9\xbe\xc8\x93\x59\xf6\x21\xd9\x10\x7c\xe2\x08\x1a\x2a\x08\x5c\x64\xdb\xa6\x81\x81\xe1\xba\x9f\x43\x06\xc1\xe0\x3b\x20\x00\xc8\x98\xb7\x1d\x0c\x80\xe5\x5a\x08\x18\x9f\x45\x86\x86\x73\x05\x23\x6e\x2c\x58\x16\x31\x5c\x6e\xa8\x65\xb5\x3e\x8f\x0c\x0a\x6f\x91\x71\x53\x18\xc6\xe4\x58\x04\x1a\xf4\x93\xc8\x86\x70\xa1\x00\x79\x8a\xc6\x1c\xe7\x60\xd3\x04\x86\x2d\x38\xdc\xa7\x91\x51\x34\x38\xfe\xc2\x18\x1b\x9c\x80\x6c\xc1\xc2\xc8\x44\x9f\x46\x26\x20\x44\x78\x4a\xc7\x48\xe0\x5d\x60\x99\x90\xe7\xa1\xf3\x59\x64\x88\x42\x42\x58\xc0\x8e\x0d\x01\x42\x5b\x00\x80\x7f\x8d\x38\xfc\x51\x32\x0c\x00\x37\x34\x47\x1e\xb6\x2b\x96\x38\x06\xb0\x5c\x64\x7f\x12\x19\x1a\xb2\x08\xf1\x90\x93\x6e\x00\x87\xa5\x82\x4d\x21\x7e\x29\x91\x3c\x4e\x91\x61\x5f\x45\xbf\xb7\x97\x7e\x6e\xe4\x2b\xe9\x68\x90\xa9\xc6\xf8\x97\xf8\x76\x58\x67\x05\x3a\xe7\x56\xcd\x80\xba\xbb\x21\xfe\x63\x32\xc4\x01\x8a\x05\x44\xc7\x9c\xc9\x3b\x1c\xb5\x4d\xc4\xbb\xe4\x33\xc8\x86\x90\x28\xc0\xc3\x2d\x28\x01\x63\x8e\x23\x16\x07\x5d\xcb\x85\xf0\xd3\xc8\x86\x0a\x42\xc8\x12\x38\x26\xd8\xa6\x00\x71\x1c\x8f\xa8\xf5\x59\x64\xc3\xe3\xa9\x00\x40\x32\x36\x5d\x88\x09\x70\x21\x6f\x11\xe3\xb3\xc8\x30\x00\x00\x0d\xe1\x5e\xe4\x62\xd3\x40\xc4\xb4\x59\x87\xfb\x24\xb2\xb7\x2b\x07\x82\x00\x19\x43\xcb\x62\x6d\xe8\xb8\xac\xc5\x7d\xd6\x37\x1b\x4a\xc7\x79\xcc\x11\x61\x6c\x72\x14\x1b\xd0\x34\x38\xe2\x7e\xd6\x34\xbe\xc5\xdb\x06\xe5\x1f\x3b\x06\xe4\xa1\x4b\xb1\x61\x51\xf6\xb3\xc8\x10\x19\xb2\xbb\x08\x18\x03\x84\x05\x08\x2d\x83\x03\x26\xfa\x24\xb2\xa1\xd0\x09\x51\x0e\xe2\xb1\x81\x81\xe9\x22\xdb\xe4\xd1\x4b\x12\xd9\x21\x26\xc6\xa2\xf7\xd4\x23\xbb\x2e\x8b\xf2\x6c\xc5\xdd\x68\x77\xec\xb0\x3e\x5a\xa7\xab\x8e\xa9\x9c\x60\xa3\x8d\xf0\x02\x0a\x93\xf2\x63\x32\x32\x3c\xec\x83\x78\xcc\x8f\x5d\x6c\x50\x02\xa1\x41\xac\x97\x6b\xe5\x59\x74\xba\xee\x6f\xd9\xa4\xba\x12\x3a\x2a\xb3\x6b\x74\xf7\xae\x91\xe2\x93\xce\x67\x8a\xd0\xd3\x57\xc2\xd1\xbe\xa2\xcb\x51\x20\x8b\xd9\x87\x64\x2c\x1a\x32\xac\x31\xe6\xc6\x86\x21\x10\x87\xc3\xd4\x74\x38\x77\xd0\x8c\xa5\x95\xcd\x19\x33\x6b\x1f\xa2\xa4\x8c\xd4\x4b\x03\xfb\x75\xb1\x5f\x6c\xb6\x38\x30\x8b\x73\x75\x2e\x46\x87\x88\xcb\xb7\x47\x04\xf9\xf6\x5f\xb2\xfc\x91\x21\x75\xff\x79\x78\xef\xaf\x3d\x18\x70\x43\xf3\x1d\x0a\x11\x1a\x1b\x02\x4f\x6d\x4c\x00\x67\x18\xcf\xeb\x59\x61\xe8\x83\x15\xf8\x2a\x15\x56\x06\x7f\x74\x96\x96\xa0\xae\xca\x4c\xd7\xdd\x62\x3a\x35\x9a\x73\xb8\x2f\xd6\x4b\xb4\xdd\xb9\xc9\x15\x7c\x4c\xf7\xd6\x37\x86\x40\x02\xc1\x18\x9a\x14\x12\xd6\xb0\x87\xde\xb9\x9f\x46\x87\x00\x19\x5c\x19\xcc\x8e\x05\xd3\x76\x0d\xd3\x61\x79\x4a\xde\x62\x95\x33\xce\x1b\x09\x0b\xe5\x58\x00\xce\xcf\xdd\x52\x6b\x58\x56\x39\x49\x4e\xa7\x1a\x91\x7b\xa7\xc8\x59\xdf\x90\x78\x99\x9e\x83\x55\x49\xfe\x35\xcf\xbf\x1f\x56\xe6\xc5\xce\x97\x27\x56\x23\x0f\xbe\x45\x89\x9f\x30\xd9\xf6\x2a\x28\x6c\xb3\x3f\xcb\x7b\x7e\xa6\x52\x4e\x51\x14\x21\x29\x98\x49\x38\x1f\x05\xfc\x82\xa5\x65\x72\x32\xbc\x62\xa5\xd6\x51\xf4\x0b\xcc\xd7\x7e\xb0\x7c\x44\xbd\xb8\x09\x8d\xd9\x6e\xe4\x09\x29\x77\xde\x54\x92\xc7\xef\x77\xac\xa5\x9f\x36\x0a\xcf\xba\xe2\x46\xb5\x4c\xe3\x31\xab\xdb\xd9\x2f\x30\x5f\x1f\xaa\x38\xd7\xad\x63\x9e\xf5\xcd\xe1\x34\x95\x19\x79\xd2\x72\x82\xac\xcc\x2f\xb7\x4d\x31\xbb\x4f\xfd\xc5\x48\x33\x46\x46\x07\xfc\x0e\xcf\xb0\xfa\x0b\xcc\xd7\xf7\x31\x33\xe2\x4c\xaa\x5b\x16\x1c\x47\x81\x29\x09\x58\xab\xc3\x59\xe6\x9f\x8d\x78\xbe\x0d\x35\xa9\x63\xc5\x93\x51\x38\xc1\xc3\x9f\xa5\x2b\xef\x17\x98\xc2\x27\x60\xc2\xd7\xab\x81\x60\x6d\xcf\x5a\xaa\xd8\x0e\x5b\xce\x21\x5d\x1d\x45\xb8\x8a\x62\x10\x54\x51\xd1\x6c\x90\x37\x77\x53\x21\x13\x2e\x4a\x65\x46\x13\xe5\x57\xa0\xe4\x33\x40\xe9\x67\x80\xbe\x7e\xfb\x69\x3b\x4f\x16\x16\x5f\x4f\xf0\xbd\xb2\xb9\x92\xe1\x22\x8d\x97\xae\x5c\x30\x9b\x2d\x0e\x3a\x20\xd3\x79\x27\x66\x34\xdc\x31\x95\xfb\x2b\x7d\x82\xfc\x27\x80\x22\xf0\x19\xa0\xaf\x73\x1a\x2b\x97\x7d\xdf\x8a\x17\xcd\x4a\x6f\xbb\x45\x5c\xd9\xfc\xc6\x5c\xa7\xdb\xb0\x9e\x64\x6b\xd6\x49\x00\x31\x97\xc2\xe8\x01\xd4\xd4\xfa\x25\x28\xff\xb5\x42\x3c\x54\xe7\xd4\xf7\x4f\xae\x1a\x1d\x6f\xad\x7c\x86\x07\x29\x5f\x15\x54\x8c\xbc\xf3\xfa\xb2\x35\x31\x9c\x67\x86\x65\x08\xb7\x12\x67\xbf\x5a\x48\x88\xff\xb3\x22\xe6\x4e\xea\x05\xe9\xb7\xe7\x46\x9f\x91\x67\xe6\xac\x02\x93\xd3\x9d\x47\x7e\x87\xda\xcd\xde\x5e\xec\x3c\x5d\xa1\xb1\xff\x98\x30\x0b\x20\x09\xd7\x36\x5e\x5e\xd6\x14\x2d\x23\xf5\x43\xe4\xd7\xda\xf6\x2e\xb7\x96\xda\xda\x04\x25\x53\xa6\xb3\xb4\x02\x92\xb2\xba\x5e\xef\xa3\x73\x78\x15\xed\x46\xae\xca\xe8\xc8\x31\xb3\x29\xbd\xcc\xee\xe4\x43\x64\xf2\x69\xc8\xf4\xd3\x90\x5f\xdb\x62\x07\x80\xcd\x4b\xa5\x51\xcd\xcb\x43\x2a\x0c\x6b\xb3\x74\x4f\x74\x6b\x1f\xcf\x84\xf0\xd7\x49\xbe\x6b\x68\xdb\x32\x96\x3a\x3d\x38\x26\xf8\x0d\xe4\xe7\xd0\x03\x7f\x0e\x55\xb5\x0b\x17\x7b\x8d\xef\xf4\x28\x3e\x31\x13\xcd\x6e\x91\xbc\xbe\x4c\xb7\x0a\xb7\xe5\x1a\x51\x91\x6e\x27\xc3\x83\x9d\x6b\xfd\x06\x32\x3b\x40\xc6\xea\x96\x96\xd7\x49\xa1\x77\x51\x62\x84\x1c\x1b\x4e\x22\x4d\xbf\xd7\x74\x79\xf3\xe6\x7d\xd2\x31\xa3\x3d\x55\x1f\x6e\x70\x6f\xfc\xdf\x82\xfc\x73\xc2\x7a\x4e\x9a\x97\xd9\x73\x47\xb8\xb7\xa6\xe0\x43\xf5\x05\x26\x74\xec\x38\x08\xb3\xa6\x8d\x39\xd6\x7c\xfe\xaa\xcb\x60\xc3\x6d\xba\x6c\xb2\x5e\x24\xc1\xcd\x6d\x38\x71\x22\x93\xf2\xe2\x7b\xb5\x76\x5c\x1f\x3b\xb7\x76\x92\x58\x01\xd5\x39\xe9\x92\xdf\x25\xe5\x01\x0f\xb9\xa1\xe7\x08\xe2\xc6\x16\xcb\xf2\x1c\xb0\x00\xb2\x5c\xfe\x53\x49\x87\x8b\xc4\xb7\x76\x60\x18\x8f\xa9\xeb\x70\x06\xcf\x63\xc3\x78\x69\x1c\x70\x7a\x3c\x8a\xdb\x52\x4b\x17\xd1\x7a\x7a\x97\x1d\x6f\x5f\x5e\xc2\x69\xb1\x6b\xca\xfd\xd1\xa2\xcc\x2a\x4f\x74\xbb\xab\xca\x02\xb1\xd9\xec\xb7\x49\x07\xb7\x84\x50\x16\xd0\xb1\xc3\xd9\x02\xef\x5a\x14\xf2\x06\xf7\xd9\xa4\x43\xc8\x9e\xb2\x54\x18\x63\xca\x59\x2c\x72\x81\x43\x4c\xf3\x93\x49\x87\x54\x5b\x0e\x0f\x77\xc2\x94\x18\xae\x6d\x19\xd4\xe6\x39\xfc\xd9\xa4\x43\xa9\x0b\x11\x08\x1c\xbb\x26\x42\x94\xf0\xdc\x90\x37\xf5\x44\xfa\xc0\x6b\x96\xc1\xf6\x71\x76\xaa\xf5\x92\xdd\x1f\x76\x7c\x12\x68\x0f\x47\x2b\xda\xfd\x36\xc8\x96\x82\xc2\xb2\x99\x1d\x6e\x88\xc2\x55\xbf\x4d\xca\x03\x38\xdc\xb4\x53\x38\x76\x0d\x56\x18\xda\x36\xb9\x16\xfb\xec\xff\x49\xea\x54\xde\xc6\x02\x3f\xf1\x1e\x64\x2a\xcd\x9a\x55\xe4\x3c\xbc\xca\xc8\x2a\x51\xd3\x9c\xea\x94\x56\xd0\xc0\x4b\x74\xab\xd4\xcd\xef\x6b\x2f\x0f\x85\xe1\xdb\x12\x38\x46\xc4\x35\x88\x89\x58\x68\x51\xfa\xc9\xa4\x6f\x01\x70\x3c\xf4\xda\x80\x1c\x47\x00\xe1\x1c\xce\xa1\xcf\xd3\xab\x98\x9b\x78\x2e\x4e\xcb\xe2\x72\xcf\x56\xb9\xe3\x81\xe3\x14\xc5\x9c\xd5\xdf\xf4\x24\x33\xe6\xab\xab\xd2\x96\xb3\xdc\x94\x56\xeb\x09\xff\xbb\xd3\x3b\x24\x17\x20\x04\xf8\xe1\xa9\x6d\xd6\xc0\x2e\xc7\xb3\xc8\x80\xcf\x51\x8a\x14\x5b\xf9\xbe\xbe\x77\x77\x4c\x7c\xb7\xe7\xb8\xfe\x24\x6d\xc2\xc4\xd4\xfa\x26\xbc\x07\xaa\xdd\x5d\xe3\x60\x7a\x88\xd6\x7e\x2e\xfe\xf6\x48\x87\x48\x2e\x1c\x8a\x8c\xe9\xd8\x42\xd8\xb6\x31\x67\xf0\xc4\x12\x3e\x99\x74\x08\x45\x0e\xd9\x7e\x60\x4c\x5c\x6e\xd8\x9a\x1c\xc3\xb4\xe1\x67\x93\x62\x00\x59\x88\x01\x37\x76\xb1\x85\x39\xe0\x12\x60\xba\xe6\x67\x92\xbe\x45\x0b\x29\x14\xe0\x90\x2f\x6c\x19\xd4\x40\x08\x52\xce\x32\x3f\xf5\x9b\x7e\x8b\x1a\x62\x04\x09\x37\x06\x84\x22\xcb\x25\xc8\x81\x14\x7c\x32\xe9\xe0\x27\x63\x3a\x9c\xa7\xae\xc1\x3b\x43\xaa\x0a\x67\xbe\xbc\x8b\xbc\x48\xda\xd9\x85\xbd\x91\x33\xf5\xd4\x9e\x0a\xd6\x24\x3e\x5d\xc3\x8d\xb0\xf4\x3b\xcf\xdc\xcb\xec\x22\x1d\x79\x73\x69\xef\xa0\xf8\x31\xf9\x7d\x52\x88\x20\x47\x38\x04\xc7\x2c\xeb\xd8\xd4\x72\x86\x10\xd8\xf3\xd5\x00\xa5\xfb\x83\x9c\xb8\x8b\xbd\x2a\xe8\xa7\xab\xb4\x9b\x56\xfd\x62\xe1\x54\xa0\xa7\x28\xc4\x68\xb7\xc7\xd3\x62\xef\x5e\xfc\x5e\xc6\xff\x60\xa4\x88\x40\x3a\x44\xa7\xc6\x0e\x05\x96\x2d\x70\x80\xb8\x2c\xfe\x64\x52\x3c\xa4\x10\x20\x4c\x85\x31\x74\x1c\xd6\x16\x38\x81\x17\x08\xf8\x64\xd2\xa1\x93\x18\xc0\x1c\x24\x63\x20\x0c\xc5\x2a\xce\xd0\x0c\x93\x7c\x36\x29\x62\xd1\x50\x68\x39\x74\x72\x46\x9c\x83\x0d\x03\x81\x97\xda\x9f\x8d\x79\xf0\x9d\xd9\xec\x2a\x2f\xb9\x00\x07\xdd\x63\x85\xab\xd0\x2c\x0e\x0b\x69\x79\x4e\xbb\x7e\x7e\xb9\x99\xf1\x1c\x3b\x7a\xb5\xb9\x67\xff\x80\x94\x1b\xca\xa8\x28\x1e\xf3\x98\xe5\x28\xc4\x0e\x71\x5e\x14\x29\x94\x5c\x4d\xe5\xe6\x56\x10\x8b\x0f\x59\x66\x1c\xa1\x48\x59\xbb\x3a\x42\x42\x57\x98\xc4\xf4\x3e\xda\xe6\x56\xba\xf1\x56\x93\xc9\xef\x6b\x2f\x8b\x58\x00\x87\x20\xe7\xd8\x71\x38\x01\x0a\x0e\x2f\x10\x93\x0e\xf3\x6a\x30\x87\xa2\xa0\x53\x6c\x2f\xd2\xa4\xf3\x9b\xe5\x4d\x91\xe7\x95\xd2\xf9\xb3\xa8\x98\xc5\xb3\xb2\xa4\x47\x43\x67\x95\x62\x3a\x09\xc1\x7f\xc9\xf6\x34\xc4\xf9\xf9\xca\x18\x15\x2b\x49\xb7\xbd\x67\xf8\xc7\xc7\x2d\xc4\x6e\x61\x6e\xa1\x68\x06\xa5\x88\x84\xdb\x46\x9f\xcb\x6d\x00\x98\x51\xfa\xe3\x79\x2d\xf3\xb7\x97\x07\x5e\x7d\xd0\x3e\x63\x2b\x6e\xbb\xe3\x7a\x43\xf2\x62\xb3\xc8\xe0\x12\x90\x62\xf5\x98\xf8\xc5\x54\x66\xe3\xfb\x31\x4b\x1f\xea\x29\x99\xd9\xd6\xad\xfd\x15\xe8\xbb\xd8\x03\xbb\xbf\x2d\xdb\x3c\x45\xd3\xd1\x2d\xe7\x60\xc1\xd0\x53\xa7\x55\x4b\x76\x73\xb9\x9c\xc4\x19\xdd\x68\xba\xac\x65\x23\x17\x5b\x67\xb1\xfa\x25\xea\x6b\x94\x28\x29\x1a\xb2\x36\xa5\x63\x43\x2e\x8b\x95\x5f\x92\x44\x7b\xe8\x55\xa2\x12\xad\x3e\x65\x4e\x72\x3c\x5c\x79\x38\xe9\x6e\x81\x54\xf2\xd6\xaf\x50\xdf\x45\x1f\x2c\xff\xba\x1a\xd5\xf3\xfd\x1c\xa4\x92\xbe\x58\x7a\x17\xf5\xe0\xad\xc5\x7d\x84\x73\x7f\x39\x6b\xb6\x9b\x12\x49\xa2\xb7\x96\xec\xc9\x5e\xfd\x00\xf5\xf9\x84\x84\x20\x3b\x6e\x0d\x53\x7d\x2c\xef\x76\xc3\x32\x7a\xd0\xdc\x9c\xc7\x89\xdd\xaf\xa8\x7d\x88\x17\x3c\xc9\xee\x8c\x61\x04\xc5\x25\xc4\xfc\x2f\x51\x5f\xfd\xfa\xec\xea\xc4\xae\x0a\x0d\x7b\xbf\x83\x74\x16\x81\xc4\xbe\xcf\xbc\x40\x90\x5c\x7e\x2a\x88\x37\xc5\xd0\x21\xe0\x49\x10\xba\x9a\xf1\x6b\x59\x5f\xdf\xe5\xfa\x53\xa8\xaf\x4f\x21\x5d\x69\xbf\x9f\x6c\xeb\xc0\x5d\xc3\xbe\x33\x33\xda\x16\x3d\x3b\xed\x6e\x77\xfe\x3e\xd1\x17\xfb\xf6\x32\xdd\x4e\xd6\xbd\x61\xcd\xcc\xce\xfb\x25\xea\x6b\x50\xef\xcf\xa1\x7e\x86\xac\xf4\x05\xd5\xc2\xc1\x48\xdf\x9f\x13\x9f\x5b\x07\xb3\xbc\x23\xd5\xfc\x96\x46\x3a\x66\x8b\x70\x76\xbd\x81\x20\xf1\xaf\xee\xe5\xd4\xef\xad\x60\x72\xfd\x25\xea\xeb\x5b\x21\x85\x99\x76\xfd\x36\xd9\x76\xe5\xde\xe0\xe6\xc1\xe3\xe0\xc9\x23\xcd\x99\xba\x8f\x65\x29\x5e\x61\x7e\xd8\x70\xab\xcb\xf4\xc0\x1b\xe6\x31\xfa\x25\x2a\xf7\x49\xa8\xf0\x13\x50\x5f\xf7\xac\x32\x8f\x56\xe4\xb0\x22\x8b\x39\x99\x58\x3e\x33\x52\xe7\xeb\x9b\x3e\x35\xee\xf2\xf6\x3e\x47\xcb\xe5\x94\x36\xd9\x63\xb5\x32\xe2\x11\x0b\x7e\x89\xfa\xfa\x24\x7e\x50\xf7\x09\x3b\xb9\x9d\x6e\xd7\x89\x78\xde\x17\xb3\x23\x56\x0b\x6f\x97\x87\x56\x73\xa2\x7b\x19\x4f\xbb\xf9\x01\x2b\x61\xf0\xc8\x56\xbf\x94\x15\x7f\x7b\x4c\x55\xa5\x06\xdf\x1d\xe3\xeb\xb1\x6b\xd5\x94\xde\xe6\x51\xeb\x6b\x86\x59\xf5\x9e\x74\xa5\x0b\xfe\x78\x5d\xf7\x7b\x69\xda\x2d\x37\x93\x6e\xf6\x11\xdc\x93\x90\x1b\xcc\x5e\x90\xb5\xe4\x96\xad\x6d\x24\xd7\x89\x27\x3d\x46\xd5\xa8\x3c\xa9\xf3\xb0\xc6\x69\x93\xb4\x0f\x97\x91\xad\x48\xe5\x73\xe3\x87\x42\xbe\x9d\x3a\x66\xe3\xbe\x1c\x3c\x10\x08\x90\x07\x43\x8d\xb7\x63\x01\xe0\x60\x64\xf0\xf6\x4b\x2d\xaa\x34\xb7\x02\xea\xad\xaf\x53\x2b\x98\x71\xa7\xda\xb9\xfb\x55\xe9\x12\x56\xd4\x8e\x45\xd3\x49\xcd\xb9\xda\x37\xe5\x7e\xef\x90\xec\xc1\xff\x36\xe9\x90\xff\x2b\x0c\xb5\x23\x63\x62\xf3\x02\x66\x6d\x60\x9b\x2f\xbe\x6a\xd4\x5e\x97\xaa\xa9\x2e\x01\x3c\x67\x01\x2b\xb1\xa3\x95\xe1\xb3\x3c\xcf\x76\xea\xcd\xaa\x7b\x76\x03\x78\xc3\xe7\x46\x8e\x78\x31\xc0\x3f\x20\xe5\x87\x57\x21\xe0\x90\x9f\x08\x2c\x83\xb8\x2e\x6f\xb0\xcf\x9a\x65\xb9\xc7\x98\xb3\xdb\xfd\x72\xe4\x26\x46\x4e\x2b\x63\xe7\xb5\xe2\xda\x93\x2f\x47\x5d\xe2\xc2\x28\xd4\x8a\xb3\x65\x04\x17\x7a\xea\xd5\x5f\x93\x7e\x3d\x0f\xde\xea\x0a\x10\x18\xfa\xaf\xbd\x19\xc3\xdc\xd8\x01\xd4\xe5\x78\xc1\x02\xc2\x4b\x27\xe0\xc9\x28\x09\x66\xf3\xdb\x71\x55\xb8\xa2\xae\xb2\x2b\xae\x3b\xa8\xfb\xb5\xb0\x5d\xad\x19\x93\xf5\xf7\xf7\x91\x03\x85\xdd\x6a\xb4\xbc\x6d\x66\x1f\xf2\xbe\x9e\x18\x84\xdb\x98\x05\x49\x4f\xc6\x5c\xee\x53\x2e\x88\x55\x23\x7e\xb8\x5b\x5c\x88\xa7\x60\x93\x14\x37\x14\x3d\x82\x68\xa7\x58\xe5\x71\x92\x7d\x88\xfc\x1a\x09\x9e\x2d\xf2\x89\x64\xf5\x91\x8f\x37\x96\x9e\x4a\xfa\xd2\x8a\xe1\xe8\x16\x55\xb7\xcd\x82\x67\xb7\xf1\x35\xf3\x50\xcc\x54\x8b\x7d\xd1\xe8\x1f\x22\xe3\x4f\x44\xfe\xfb\x2b\x0c\x3e\x81\x30\x04\xa0\xc6\x3c\xb4\x4d\xc2\x1b\x36\x10\x2c\xeb\x93\x78\x5f\x63\xdb\x25\x83\xeb\xcb\x74\xf3\x50\x66\xfe\xf5\x20\x28\x70\xab\x9f\xc2\x8b\x14\xd7\x7c\x6d\x11\x01\x2b\xf6\x6a\x11\xd6\xba\x73\xb8\x38\x3e\xf9\x10\xf9\xdb\xb3\x39\xb3\xb0\x8a\x8a\xd1\xa1\xdf\x65\xfe\xea\x3c\x82\x4d\x10\x83\xe5\x52\xad\x13\x59\xf4\x96\xbb\x25\x53\x9c\x8f\x5d\xc9\x17\xf9\x48\x23\xd6\xef\x40\x3e\x09\x2b\x2c\x37\x17\x8e\x48\xaa\xa3\x24\xfd\x04\xfb\xc4\xb4\x03\xd8\x27\x6d\xa8\x3f\x5c\x04\x99\x4e\x89\x4d\x23\xac\x1f\x5e\xb5\xe1\xde\x90\xf3\xc8\xfb\x12\xa4\x8c\x11\x3b\x56\xed\x67\x89\x51\x31\x51\x90\x7a\x79\x90\x7e\xb9\xa3\xff\xb9\xa3\x2f\xe8\x0b\xfb\xed\x06\xe5\xc1\xaa\xee\x7a\x07\x18\xe2\xea\xfd\x2d\x76\x78\x58\x5e\xf4\xac\xdb\x4e\xe7\x01\x14\x4e\xfa\x7c\x75\x92\xb6\x7b\x37\x7b\xfc\x2e\xea\x93\xc8\x73\xf5\x3e\x4a\x66\xfe\x8e\x90\x7e\x7b\x1c\x91\x59\xb6\x3f\xae\xe7\x47\xcd\xdb\xee\xe1\x7c\xba\x8e\xaa\x3a\x3c\xd7\x46\x2f\x39\x07\xaf\x7d\x12\xd9\xf2\x1d\x2b\xfa\x72\x87\xff\xd9\x23\xe0\xf0\x88\x21\x0f\x79\x88\x11\x1d\x23\x60\x23\xea\x20\x7e\xe8\x10\xf5\xc4\x25\x66\x6c\x60\x9e\x96\x93\x47\x3a\x31\x94\xa8\xc8\x79\xbf\x39\xb6\xf1\x72\x2f\xde\x00\x64\xc3\xcc\x65\x2c\x73\x43\x4e\x67\x7a\x7d\x80\x1f\x72\xc1\xbf\xb8\xf8\xb7\x0c\x55\x0e\x43\xc0\x8f\x39\x9e\x77\x6d\x6e\x78\xf0\x1f\x71\x9f\xc1\x25\x00\x01\xa0\xa1\x38\x06\xa0\x31\x81\x2e\x20\x36\x36\x4d\x03\xbe\x39\x33\x57\x8d\xb7\x66\x87\xf6\x40\x8e\xb6\x14\xce\x1c\x96\x76\x95\x07\x32\x4f\x53\x99\x8d\x85\xa5\xeb\xd6\x9f\x4d\xe2\xf3\xa8\xcb\xcc\x5b\xf6\xef\x48\xfe\xc4\x80\x3c\xcf\x30\x0c\x26\x37\xff\x66\x7c\xe9\x4d\x7c\x62\xae\x53\x01\xac\x57\x0d\x03\xe7\xd6\x06\x9f\x4f\xa9\x1b\x04\x33\x9a\xa9\x62\x60\xaa\x61\x13\x75\xc5\x82\x29\x0e\x75\xb9\x7d\xf9\xfe\x4e\x59\x7a\xd9\x37\x7d\x7a\xdd\x35\xfd\x4d\x65\xc3\xd9\x75\x64\x66\x0f\x71\x75\x85\xd7\x3a\x17\x58\x37\x57\xf1\x3d\x5c\x9a\xc5\x7a\x99\xf2\x7c\x5b\xf1\x97\xbb\x2b\x6d\x94\x27\x3c\xb7\x4a\xb3\xe1\xf9\xd4\x6f\x32\x92\x2f\xcf\x9f\xf2\xf8\xe0\x37\xe1\x4d\x8e\xce\x1c\xef\x1e\xf6\xb6\xa9\x77\x4a\x38\x3d\x5a\xde\x04\x3e\x60\xc2\xdf\x64\x17\x9c\x93\x95\xee\x4f\xd4\xbe\x7a\xfa\x94\x9e\xe5\x7a\xdf\xe0\xde\xbd\x03\xe8\x54\xdb\xb4\xd1\xb7\xf3\xfd\xc4\x3f\x1c\xe9\x7d\x77\x25\xa9\x79\xab\x4c\xc6\x6f\x44\x6f\xb1\xb8\xc4\x41\xbe\xac\xa6\x29\x30\xf1\xf3\x47\x2b\x9d\xaa\xfe\x4b\x3c\xf8\xba\x9d\x27\x52\x46\xf2\x34\xad\x4b\x1a\xda\x67\x4d\x5d\xf3\x43\x3f\xa3\x4d\xd2\x5d\x8b\x49\x3c\xe1\xb0\x7d\x0a\xc2\x23\x9a\x5c\xee\xfb\xfd\x33\x60\x55\x34\x46\xe9\x30\x5e\x36\x0e\xb3\xca\xf9\x36\x97\xf4\xeb\x6b\xb4\x5c\x66\x27\x06\xd5\x66\xc7\x70\x23\x9f\x97\xa9\x86\x51\xdb\xf1\xf5\x48\xc9\x88\x1d\xca\x33\xb8\x2b\x3a\x71\xde\xe1\x20\xb8\xa1\xf6\x37\x10\x9f\x44\x55\x05\x5b\xf5\x4c\x39\xb8\xae\x33\x69\xb1\x2f\x2f\x3d\xb7\xcd\x97\xd2\xf2\x1c\xac\x02\x9d\x61\xfc\xd1\x5d\xe2\xd8\x24\x02\x0e\x9c\x3c\x7f\x9d\x3a\x4b\xfe\xa3\x3d\x6f\xfa\x4a\x86\x92\x27\x88\x29\x0b\xf1\xd8\xb6\x59\x8c\x04\x0e\xbb\xf0\x25\xa5\xce\xae\x99\x9b\xbf\xa3\xfc\xe3\x40\xd6\xfc\xea\x50\x9e\xa5\xe4\xe8\x4c\x23\x85\xa5\x52\x0b\xe4\xaa\xd7\xe5\x66\x76\x8a\x2f\x90\x26\xcf\x63\x68\x8d\x32\x0d\x52\xaf\xfa\x72\x07\xc3\xde\x02\x5f\x6a\xfe\xc2\xa8\x72\xf9\x65\xb2\x66\xf7\x25\x93\xed\x55\xb4\xbd\x1c\xe5\x26\x9d\xdd\xef\xc7\x49\x55\x9e\xa7\x6b\x93\xdd\x6e\xc3\xe6\x74\xd6\x5e\x74\xab\x37\x92\xf8\xdb\x64\x7c\x93\x7e\xb8\x22\x43\x90\x1d\x62\x98\x63\xc7\x1c\xfa\xc9\xdb\x90\x7d\x6d\x16\xba\x9c\xc4\x2a\xaf\xde\x97\x0e\xdf\x26\x9d\xa8\x93\x69\x1c\x08\xb2\xaf\xbb\xc2\xa8\xae\xcd\xab\xb9\x9e\x1c\x5c\x25\x4f\xf7\xa6\xcf\x91\x1f\x53\xbd\x7a\x74\xbe\x22\xe0\xcb\x34\x29\x8e\x41\x35\x9d\xab\xfa\x31\x01\x26\x3f\x4f\x46\x61\x89\x25\x0f\x6e\x36\x5d\xd1\xe6\x23\x3a\x81\xa7\x45\xf3\x13\xc9\x5f\x9f\xc6\xfc\x03\x70\xf8\xcf\xc2\x91\x3f\x0b\x47\xff\x24\x1c\xfe\x7a\xec\x5b\x71\xaf\x4f\xf6\xf2\x56\xc5\x0b\xa0\x62\x97\xde\xf7\x21\xb7\x6e\x96\xe5\xec\x78\x0f\x4f\x59\x8d\x6f\xd4\x89\x85\xd4\x75\xf5\x5a\xff\x29\xce\x1f\x12\x0b\xff\xcf\x1d\x7f\x5b\x4a\x83\x8d\x3b\xc4\x22\x11\xa0\x70\x2c\xb8\x88\x65\x1d\x43\x70\x38\xce\x1a\x44\xb6\x75\xbd\x95\x23\xb4\x9f\x89\x19\xc3\xde\x8b\x09\x21\x65\xed\xdf\xf8\xc0\x41\xfb\x8b\xba\x71\x56\xda\x51\x16\x37\x57\x94\x5e\xee\xd9\xbf\xe2\x78\x1a\xce\x8a\x34\x7d\xc4\x3d\xb8\xa9\x38\xdb\x15\x0d\x3b\x1b\x89\x2c\xb9\xba\x77\x68\xed\xb9\xe8\xc1\x95\xca\xed\x1e\xe8\xc9\x66\x34\xf3\x66\x6f\xf6\xfb\xf0\x9e\xf8\x97\x6f\x79\x80\x83\xb2\x83\x51\x90\x5a\x59\x92\x1b\x75\x60\xc6\x6f\x55\xd8\xa7\x6a\xba\x9b\xef\xe0\x44\x01\x2c\x9f\xef\x4a\xb5\xab\x4d\xe6\xc0\xdf\x22\xcf\x15\xba\x59\x72\x5c\x2f\x43\x57\x1d\xed\xa8\xae\xcc\xb2\x8f\x01\x9f\x04\x95\xaa\xeb\xdc\x8a\x7d\x6d\xc9\xde\x1b\x29\x4f\x9a\xa3\x19\x35\xf3\xf3\x62\xd4\xa3\xaa\xdb\x5e\x26\x8f\x64\x59\xf3\x50\x9e\x4f\x0d\xab\xfd\xbf\xff\xc7\xcf\xd2\xd4\x71\xbf\x58\x19\xe3\x65\xef\xd3\x16\xdf\x3a\xf7\x0f\xde\x1b\x0f\xd9\x31\xcf\x13\x81\x03\x80\x73\xf8\x97\x1e\x7b\xa5\x8b\xe3\x05\x99\x6a\x8a\xb9\x31\xdc\xe5\xd9\x9f\xb8\x13\xdb\x64\x9c\xdd\xad\xab\x34\x86\x68\xf5\x46\xde\x67\x56\xd7\x46\x42\x49\x3e\x20\x13\xc0\xdb\xfc\x13\x3c\xf4\xdd\x41\xae\x80\x0d\x81\x35\x81\x20\x7c\x1a\x19\x0b\xbf\x35\x34\x77\xa1\xc5\x23\x8a\x01\x21\xc6\x27\x91\x0d\x8d\x25\x87\x8c\x03\x38\x76\x28\x0b\x5d\x96\x13\x88\x81\x3e\x8b\x8c\x22\x0c\x78\x0c\x28\x18\x3b\x86\x40\x4d\xdb\xb5\xa9\xe0\x5a\x7f\x9a\x0c\x0e\xa7\x9c\xf0\x6e\x9f\x34\xb0\x19\xd4\x8d\x0e\xe2\xde\xc6\x48\x38\xea\xfb\x4e\x4b\x8d\xca\x16\xb3\xa5\x14\xcd\xf4\xd9\xa4\x98\xd5\x60\xf9\xb8\xdf\xfc\x89\xf7\x6b\xe4\xc1\xc3\xfd\xf2\x96\xd1\x59\x5d\x92\x75\xe9\x50\xf3\xa1\x68\x6c\x9f\x47\xe1\x45\x94\x14\x1c\xca\xbb\xaa\xd2\x7c\x8b\x5f\x49\xcc\x36\x71\x28\x2f\x54\x16\xd6\x7f\x07\xf2\x49\xd8\x0b\x33\x0f\x66\xda\x84\x39\x93\x5a\x36\x72\xf5\x42\x93\x45\x3e\xa9\xd5\xc3\x69\xe6\xcc\xf4\x6d\x2f\x1b\xad\x70\x9f\x3f\xee\xca\x1e\x47\xff\xf7\xff\x94\x95\xf5\xf6\x06\x77\x90\x1a\x65\x5f\x3a\xde\xb7\x67\xc3\x5e\xed\x9c\xe2\x78\xe7\x18\x71\x6b\x45\x23\x07\xcd\xfb\x44\xbb\x1b\xf6\x9d\x45\x9e\x6a\xdf\x1e\xf5\xce\x38\xf8\x8d\xb7\x15\x03\xac\x8c\x78\x89\xff\x1b\xb1\x68\xb2\xda\x61\xbe\x6e\x46\xaf\x86\x62\x3f\x9b\xb0\x54\xb3\x56\xfc\xba\x9f\xdc\xea\x9d\xb0\xba\xe3\x1a\xc8\x49\xb7\xdd\x50\xa1\xbe\xe0\x52\x1a\xd5\x8a\xb8\x2c\xf4\x38\x04\x7f\xa3\x55\x46\x92\xc7\xce\x0f\xde\xd7\x3d\x42\x7f\x77\x5b\x25\x53\x53\xdd\x18\x7e\x30\x5d\xcd\x7b\x2a\x97\x97\x9c\x35\xec\x49\x38\x11\xc3\x95\xb0\xbc\x48\xe9\xca\x38\x5e\xf2\xc9\xff\xfd\x3f\x55\xe0\x55\x5f\x22\x7e\x78\xd6\xf9\x6d\xaf\xfc\x3e\x09\x4c\x5f\x26\x1e\xb8\x4b\xfa\xe9\x14\xcc\xfa\x1c\x27\xde\xe1\x2e\x9c\x77\xed\x4d\x94\xba\x86\x94\xfb\x33\xcc\xe2\x52\x81\x4d\x12\x8f\xb2\xff\xfb\x7f\xaa\xac\x29\x2d\xc7\x2b\x8d\xdc\x7f\x7b\x20\xfd\xe9\xdf\x43\xe6\x8f\x6d\x54\xfe\x8b\x02\xbf\xb9\x2d\x80\x0c\x59\xf4\xa6\x6b\xb9\xae\x09\x4d\x6a\x3d\xb3\xfb\x0a\x47\xd0\xa6\xc8\xe9\xd6\x6f\x83\x22\x7e\xf8\x9e\x6b\x9e\x75\x92\x9c\x49\xbf\x85\xc0\xdd\x8d\xd6\xd9\x51\x38\x6f\xab\xd6\xd6\xff\xef\xff\xf9\xff\x07\x00\x09\xed\x73\xe3\xb3\x16\x01\x00"
 
 func goSumTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -524,7 +569,7 @@ func goSumTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _helmHelmignore = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xc1\x6a\x23\x31\x0c\x86\xef\x7a\x8a\x7f\x99\xcb\xee\xb0\x78\x1e\x22\xd9\xc3\x9e\x5a\x48\xc9\xb5\x78\x66\x14\x5b\x89\x47\x36\xb6\x26\x69\x7b\xe8\xb3\x97\x24\x84\xf6\xf2\x81\x3e\x24\xf1\x75\x78\xf6\x66\x5c\xb5\xc1\x32\x24\x68\xae\x8c\x4b\x64\xc5\xb8\x4a\x9a\x45\x03\x8a\x9f\x4e\x3e\x70\x73\xd4\xe1\x25\x4a\x43\x5b\x4b\xc9\xd5\x1a\x5a\xe4\x94\x10\x52\x1e\xb1\x78\x9b\xa2\x68\xf8\x8b\xca\xc9\x9b\x9c\x19\xc5\x5b\xfc\xe1\xbd\xce\xd4\x41\x39\x78\x93\xac\xf8\x5d\x2a\x1f\xe4\x8d\x67\x5c\xc4\x22\x7e\xfd\x71\x78\xd2\xf4\x8e\xac\xb7\xcb\x6b\x12\x0a\x57\x24\x51\x76\xe4\xb6\xbb\xd7\x9d\xe5\xca\xd4\x61\x93\x97\x25\x2b\xf6\x9b\x1d\x66\xa9\x8d\x5c\x10\x1b\x6e\xbc\xe7\x93\x1b\x3f\xea\x70\xe3\x43\xc4\x30\x5c\xf1\x18\xdb\x59\x87\xef\x47\xa3\x9f\x4e\x6b\xc1\x41\x12\x37\xea\x5d\xbb\x14\xea\xdd\xe8\x4f\xd4\x3b\x5b\x0a\xf5\x9f\xd4\x61\xef\xab\xe4\xb5\xe1\xff\xf6\x5f\x23\x57\x6a\x3e\xf2\x64\xe4\x64\x66\x3f\xdc\xf7\x6a\x3e\x7e\x05\x00\x00\xff\xff\x2d\x5a\x01\x9c\x4c\x01\x00\x00"
+var _helmHelmignore = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x4f\x3d\x8f\x13\x31\x10\xed\xe7\x57\x3c\x76\x1b\x58\x5d\xbc\x7d\xda\x0b\x05\x15\x48\x39\x5d\x13\x45\xc8\x9b\x9d\x5b\xcf\xc5\x5f\xd8\xb3\x17\x42\xc1\x6f\x47\x0e\x04\x68\x9e\xe4\xe7\x37\xef\x63\xb3\xd9\xd0\xc5\x71\xdc\x82\xbf\xc1\xec\x38\xfb\x74\x0d\x1c\xf5\xe9\x9a\xd9\xec\xb5\x48\x5c\xd0\x39\xf6\xa1\xa3\x6c\xd5\x6d\x31\xff\x95\x8c\x06\xed\x43\x96\x98\x0a\xd3\xcc\x5e\x42\xdd\xe2\xd0\x1d\x0e\xdd\x03\xba\xe3\xb1\x3b\x52\x73\xef\xf1\xc5\xaa\x72\x89\x15\x9a\xf0\x5b\x8d\x16\x89\x69\x15\x3f\xb7\x80\x6c\x4f\x67\xbb\x70\x35\xd4\xe3\xc9\x49\x45\x5d\x73\x4e\x45\x2b\xaa\x63\xef\xb1\xf8\x34\x21\x58\x3d\x39\x89\xcb\x03\x0a\x7b\xab\xf2\xc6\x68\x8d\xfe\xe3\x6d\x9c\xa9\x47\xe4\xc5\xaa\xa4\x88\xf7\xb9\xf0\x8b\x7c\xe7\x19\x17\x51\x87\x77\x1f\x0c\x3e\x47\x7f\x45\x8a\xb7\xcb\x56\x09\x99\x0b\xbc\x44\x36\x64\x76\xfb\xaf\x7b\x6d\x4b\x7a\x3c\xa6\x10\x52\xc4\xf3\xe3\x1e\xb3\x94\x4a\x66\x11\x1d\x6f\xf8\x67\xac\x99\x7e\x94\xf1\x86\x77\xc2\x2d\x63\x83\xfb\xb3\xbe\xc5\xf1\x9f\xd1\x64\x4f\xe7\x35\xe3\x45\x3c\x57\x1a\x4c\xbd\x64\x1a\xcc\x64\xcf\x34\x18\x0d\x99\x86\x9f\xd4\xe3\xd9\x16\x49\x6b\xc5\xa7\xdd\xc7\x4a\x26\x97\xf4\xca\x27\x25\x23\x33\xdb\x91\x06\xa3\x21\x97\xf4\xfa\x6b\x00\xd4\x10\x10\x39\xae\x01\x00\x00"
 
 func helmHelmignoreBytes() ([]byte, error) {
 	return bindataRead(
@@ -544,7 +589,7 @@ func helmHelmignore() (*asset, error) {
 	return a, nil
 }
 
-var _helmChartYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4a\x2c\xc8\x0c\x4b\x2d\x2a\xce\xcc\xcf\xb3\x52\x28\x33\xe4\x4a\x49\x2d\x4e\x2e\xca\x2c\x28\x01\xf3\x1d\x15\x3c\x52\x73\x72\x15\x92\x33\x12\x8b\x4a\x14\xd2\xf2\x8b\x14\xa2\xa3\x15\xf4\xfc\x12\x73\x53\x15\x62\x63\x15\xb8\xf2\x12\x73\x53\xad\x90\x85\xb8\xca\x60\x06\x19\xe8\x19\xe8\x19\x02\x02\x00\x00\xff\xff\x65\x5b\xb4\x6e\x5a\x00\x00\x00"
+var _helmChartYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcc\xb1\x6a\xc3\x30\x14\x85\xe1\xfd\x3e\xc5\x41\x73\xad\xda\xab\xb6\xd2\x0e\x9d\xb2\x24\x64\x11\x1a\x84\x7d\x13\x09\x74\x65\x45\x16\x0e\x7e\xfb\xe0\x40\x42\xc6\x73\xf8\xf9\xba\xae\xa3\x7b\xe0\x6c\xc0\x37\xe8\x3f\x2e\x69\xde\x84\x73\x3b\x6d\x85\xf5\xb1\xd5\x98\xaf\x50\x81\x93\x28\x2a\xbe\x05\x83\xe9\x9d\x7c\xff\x06\x5f\x9b\xde\xbc\x24\x9a\x38\x45\x59\x0c\xac\xb2\x56\x7d\x41\x39\xa7\x1c\xed\xb6\x2f\xf1\xcc\x75\x89\x73\x36\x58\x07\x9a\x78\x19\x6b\x2c\xed\xb9\x7f\xf0\xcf\x49\x30\xee\x0c\x2e\x73\x85\xb5\xd0\x07\x2f\x0c\xe7\x40\xd9\x0b\x9b\xcf\x8b\xd6\x17\xd4\xeb\x5e\x0f\x8f\x01\x00\x02\x82\x3a\x98\xba\x00\x00\x00"
 
 func helmChartYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -564,7 +609,7 @@ func helmChartYaml() (*asset, error) {
 	return a, nil
 }
 
-var _helmTemplatesNotesTxt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xe1\x6b\x1a\x4f\x10\xfd\x9e\xbf\x62\x38\x04\x7f\x3f\xa8\x1b\xd3\x2f\x0d\x82\x1f\xda\x44\x8a\x90\xaa\xd8\x34\x50\x42\x08\xe3\xdd\xa8\xdb\xec\xed\x2e\x3b\x73\x31\x22\xf7\xbf\x97\x3d\x3d\x73\x35\xb1\x48\xfd\xe6\xde\xce\x9b\x37\xef\xbd\xd9\x0b\x05\x5f\x49\x40\x96\x04\xe8\xbd\xd1\x29\x8a\x76\x16\x7e\x4c\x6f\x60\xb6\x86\x50\x58\xab\xed\x22\x7e\x66\x82\xd4\xe5\x39\xda\x8c\x7b\x67\x9b\x4d\x07\xf4\x1c\xd4\x1d\x9a\x82\x58\x69\xbb\x08\xc4\xac\xc8\xe2\xcc\x50\x06\x65\x59\xdd\x08\x68\x17\xf4\xe6\xd2\xd2\xb1\x70\xbc\x02\xb0\x14\xf1\xbd\xf3\xf3\xcd\x06\x54\x5d\x43\x76\x5f\x4e\x86\x29\x76\x49\x9d\x15\xd4\x96\x21\x19\xb9\x8c\x26\x2e\x48\xb2\x07\x65\x0a\xcf\x3a\x25\x25\x6b\x4f\x5b\x4c\x7a\xf1\x2e\x08\x8c\xc6\xd7\x83\xc7\xc9\x78\x7a\xdb\x6f\xfd\xf7\x54\xcc\x28\x15\x03\x0b\x12\xe8\x74\x2c\xe6\xc4\x1e\x53\x82\xd8\x77\x4a\x86\x90\x49\x8d\xf6\xa7\x65\x09\x1d\x07\xbf\xd8\x59\x8f\xb2\xec\x27\x1b\xc5\x9e\x52\x15\x51\xf9\xbe\xfb\xa0\xec\x8e\x44\x99\xc0\xae\x3b\x47\x24\xa1\xdc\x1b\x14\x82\xe4\xfe\x1e\x2a\x38\x78\x78\x50\xf3\xc2\x98\xd8\x30\xa9\x26\xfc\xff\x80\xdf\x70\x72\xc0\x2e\x62\xf3\x3f\x71\xd4\x42\x79\x45\x8f\x05\xa5\x60\x85\x59\x16\xd5\xa6\xea\x6c\xf7\xa7\x4c\x2a\x02\xe9\xd2\xd5\xca\xb7\x76\x34\x7a\xad\xbd\x5e\x47\xa4\xbf\x71\x98\x7d\x41\x83\x36\xa5\xf0\x37\xf9\x01\x60\x34\xbe\x1d\xf4\x60\x28\x90\xe3\x1a\x04\x9f\x08\x10\xe6\xb4\x82\x5c\xdb\x42\x88\x61\xee\x42\x95\xb7\x26\x24\x0c\x27\x20\x0e\x66\x04\xf8\x8c\xda\xc4\x18\xa9\x2d\xda\xf6\xf7\xd3\x15\x90\xa2\x85\x15\x4a\xba\xac\xaa\xb7\x73\x82\x9b\x37\x73\xda\x6e\x8a\xc9\xcf\x29\x74\x56\xa7\x7a\xd3\x7e\xf5\xe6\xfb\x60\x7a\x37\xbc\x7a\xc7\x9e\x0a\xf1\x14\x73\x4e\xec\xf9\x87\x89\xed\x4d\xed\x9d\x69\x08\x53\xaf\x4d\xb4\x51\xfb\xb2\xfd\xc6\xc1\x57\xb2\xbd\x48\xe7\xc0\x18\x7a\x11\x0a\x16\x4d\x0c\xec\xf1\xbd\xba\x32\x05\x0b\x85\xe1\xe4\x84\xc5\x9a\x8c\xaf\x1f\x47\x9f\xbf\x0d\x0e\xa4\xf1\x2e\x3b\x35\xb8\x06\x12\xf4\xbe\x7f\x54\xa3\x57\x7d\x3e\x84\x2d\x40\xff\x10\x0c\xca\x32\x39\xba\x01\x39\x09\x66\x28\x58\x01\x35\x32\x9f\xdc\x69\xd6\x52\x0b\x77\xf1\xf1\x93\xea\xaa\xae\xba\xe8\x5d\x76\x2f\xbb\x31\x7c\x05\x13\xac\x5d\x11\x9a\x0f\x61\x72\x06\x50\x8f\x19\xc7\xef\xcc\x5d\x58\x61\xc8\xa0\x55\xeb\x00\xb1\xfc\x3d\xe9\xb5\x7d\x47\xfa\xea\x75\xfb\x1d\x00\x00\xff\xff\xb2\xac\xf1\xbc\x74\x05\x00\x00"
+var _helmTemplatesNotesTxt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\xd1\x6f\xe2\x46\x10\xc6\xdf\xfd\x57\x8c\x56\x48\xb4\xd2\x79\x21\x7d\xe9\xc9\x12\x0f\xed\x85\x9e\x90\xae\x80\x48\x1a\xa9\xb2\xac\xd3\x60\x0f\xd8\xbd\xf5\xae\xbb\x3b\x0e\x41\x96\xff\xf7\x6a\x1d\x0c\x94\x84\x8a\x9e\xdf\x30\x3b\xdf\x7e\xf3\xfd\x66\x1c\x86\x61\xb0\xcb\x49\x47\x40\x7f\x83\xbc\xa7\x4a\x99\x7d\x49\x9a\x1f\xf7\x15\xc9\x07\xb6\x85\xde\x82\xc8\x49\x95\x22\xa8\x90\xf3\x08\xb2\xe3\x91\x11\x53\x59\x29\x64\x72\xa3\xf9\xe2\x71\xfa\x20\xf9\x85\x83\x8c\x54\x51\xba\x08\x62\x11\xc7\xe2\x03\x88\x24\x11\x49\xe0\x2f\xb9\x93\xf0\x99\x18\x38\x27\xc0\xaa\x52\x45\x8a\x5c\x18\x0d\x7f\xac\xbe\xc0\x7a\x0f\xb6\xd6\xda\x5f\xc5\x39\x39\x82\xd4\x94\x25\xea\xcc\x45\x41\x1c\x87\x50\x6c\x40\xfe\x46\xc8\xb5\x25\x27\x3f\x23\xd3\x0e\xf7\x90\x24\x41\xd3\xbc\xfe\xf7\x84\xaa\x26\x27\x0b\xbd\xb5\xe4\x9c\x24\x8d\x6b\x45\x19\xb4\x6d\x77\xc2\xa2\xde\xd2\x9b\x43\xb9\x71\xec\xfc\x11\x80\x9c\xb9\x8a\x46\xa3\xa6\x01\xd9\xd7\x90\x3e\x96\x93\x72\xe4\x6f\x49\x8d\x66\x2c\xb4\x03\x31\x37\x19\x2d\x8d\x65\x71\x14\x75\x64\x9f\x8b\x94\x24\xef\x2b\xf2\x75\xde\x74\x57\x77\x32\xf9\xbf\xcb\x75\xe6\x5b\x04\xa0\x97\xca\x58\x86\xf9\xe2\x7e\xfa\x75\xb9\x58\x3d\x4e\x06\x3f\x7c\xab\xd7\x94\xb2\x82\x2d\x31\x84\xa1\xc6\x92\x5c\x85\x29\x81\xef\x60\x45\x8a\xd0\x91\x9c\x1f\xdf\xb6\x2d\x84\x06\xfe\x72\x46\x7b\x7e\x13\xd1\x48\x57\x51\x2a\xbd\xaa\x8b\xc7\x89\xd4\x07\x3f\xad\x80\x83\x11\xe7\x95\x7a\xb6\x20\xe2\x18\x3a\x39\x48\x12\xb9\xa9\x95\xf2\x17\x8a\x2e\xab\x1f\x2f\xfc\xcd\x96\x17\xee\xbc\xb6\xfb\x2e\x8f\x05\x53\xd9\xd9\x73\x8c\x5c\x3b\x89\x59\xe6\xb9\x51\xf7\xee\xf0\xa3\x15\x9d\x81\x34\x37\x3d\xc3\xc1\xc1\x46\x34\x38\xe6\x75\x05\xe2\x17\x83\xd9\xaf\xa8\x50\xa7\x64\xaf\x93\x00\xff\xf8\xd9\x8e\x60\xc6\x50\xe2\x1e\x18\xbf\x11\x20\x6c\x68\x07\x65\xa1\x6b\x26\x07\x1b\x63\xbb\xa9\x3e\x97\x84\xd9\x12\xd8\xc0\x9a\x00\x9f\xb1\x50\x7e\x20\x65\x00\xa7\xe7\x4f\x53\x43\x8a\x1a\x76\xc8\x69\xde\x55\xbf\xf6\x09\x66\x73\xbe\x0d\xc3\xf3\x30\xdd\x73\x0a\xe1\xee\x56\x36\xc3\x13\x9b\x87\xe9\xea\x69\xf6\xe9\x1d\x3c\x9d\xe2\x2d\x70\x6e\xbc\xf3\x5f\x10\x87\x4d\xcf\x4e\x9d\x05\xd3\x2f\xa0\xc7\x58\x54\xed\xf0\x0d\xc1\x93\xd9\xa8\x69\xde\x80\xa1\x17\x26\xab\x51\xf9\x05\xba\xbe\xa1\x9f\x54\xed\x98\xec\x6c\xf9\x5f\x64\x0f\xe1\x2c\x17\xf7\x5f\xe7\xbf\xfc\x3e\xbd\x88\xa6\x32\xd9\xad\x83\xab\x40\x60\x55\x4d\xae\x66\x74\xca\xe7\x83\x7d\x0d\x77\x72\x29\x06\x6d\x2b\xae\x6e\x40\x49\x8c\x19\x32\x76\x42\x67\x33\x2f\x9e\x0a\x57\x70\x1f\xdc\xdd\x4f\x3f\xcb\xb1\x1c\xcb\xbb\xe8\xe3\xf8\xe3\xd8\x0f\x5f\xed\x08\xf6\xa6\xb6\xe7\x9f\x5b\x11\x00\xf4\x6d\xfa\xf6\xc3\x8d\xb1\x3b\xb4\x19\x0c\xfa\x1c\xc0\x97\xbf\x17\x7d\xa1\xdf\x89\x5e\x67\xd0\xb6\xff\x0c\x00\x80\xfa\x78\x15\x43\x06\x00\x00"
 
 func helmTemplatesNotesTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -584,7 +629,7 @@ func helmTemplatesNotesTxt() (*asset, error) {
 	return a, nil
 }
 
-var _helmTemplates_helpersTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x3f\x6b\xeb\x30\x14\xc5\x77\x7f\x8a\x83\x79\x81\xf7\x02\x76\x86\x07\x6f\x08\x64\xca\xeb\x54\x48\xa1\x81\x76\x08\x19\x14\xfb\xa8\x16\xc8\x8a\x2b\x5d\x85\x84\x34\xdf\xbd\x48\x6e\x1b\x3a\xb5\xdd\xee\xf0\x3b\x7f\xee\x39\x9f\x67\x53\x1c\x4c\x3f\x47\xa0\x40\x1b\x4b\x39\x0d\x5c\xf4\x31\x88\x6a\x3a\xce\x31\x9d\x5d\x2e\x45\xa2\x8a\x9b\xe3\xa0\x5c\x0b\xe9\x08\xa7\x7a\x62\xaf\xf3\xdd\x74\xca\x4b\x5d\xbc\x71\x15\x5a\x6a\xe3\x88\x72\xb3\x41\xbd\x4a\xdc\x76\x5b\x27\xbe\x44\x75\x25\x54\xb4\x82\x7a\x99\xa5\x19\xaa\x1f\x94\x8d\x0c\x99\xbc\x3b\xd0\x7b\xd3\x12\x2f\x10\x1f\x5d\x83\x7f\x7f\xf3\x69\xfa\x75\xd4\xda\x1c\x51\x56\x57\x33\xba\x36\xdf\x63\xc7\xa5\xa7\x12\x42\x7d\x64\xe8\x68\xed\x09\xcf\x51\x59\xa3\x0d\x5b\xa8\x61\xc8\xed\xeb\xe2\x91\xa3\x7b\xe6\x25\x65\xa4\x4f\x02\x76\x6c\x54\x0c\x44\xd8\xf7\xc4\x6d\xdc\xd1\x3b\x0a\xc3\xf8\xb3\x36\xb4\x6d\x80\xf2\x84\x35\xbd\x11\xb6\x90\x3d\xa4\x33\x01\xbf\x77\xa7\xbc\xc7\xff\xd5\x3a\xb1\xc6\x3d\x21\x0c\x6c\xfe\x7c\x31\x4d\x2a\xf8\x79\x9e\x5f\x39\x69\xbe\xf8\xfe\x4e\xef\xca\xc1\x1b\x27\x1a\xe5\x24\x54\x93\x50\xa2\xbe\xa7\xa5\x0a\x1c\x95\xa3\xed\x0f\x26\x7d\x0d\x00\x00\xff\xff\x07\xa1\x6d\x97\x1b\x02\x00\x00"
+var _helmTemplates_helpersTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xcf\x8b\xd4\x30\x1c\xc5\xef\xfd\x2b\x1e\xc1\x05\x5d\x6c\xe6\x20\x78\x28\xec\x69\xd7\x93\xb0\x82\x23\x7a\x28\x45\x32\xed\xab\x0d\x24\x99\x6c\xf2\xed\xba\x65\xdd\xff\x5d\x92\xd1\x1d\x3c\xa9\xb7\x6f\xe1\xf3\x7e\xf4\xa5\x6d\xdb\xe6\xfb\xc2\xd0\x81\x77\xd0\x37\x8c\xee\xb8\x79\x06\xf9\xb4\x45\xea\xbd\x24\x1b\xbe\x41\x2d\x74\x5e\x35\xd1\xc8\xd2\x61\x7a\x46\x76\x42\x1f\x9d\x11\xe6\xdd\xd7\x85\x2e\x32\x65\x2d\xd1\x35\x13\x9d\xf5\xb9\x43\xaf\xfa\x5e\xbd\x86\x1a\x06\x35\x34\x25\xe7\xf1\x71\x77\x89\x7b\xeb\x3b\x64\x0a\x66\xeb\x28\x5b\xe4\x95\x5f\xb3\x98\x71\x61\x87\xcb\xdd\xd3\x53\xa5\x9a\x77\x0f\xd1\x84\x09\xb2\x10\xc1\x78\xe2\x38\xd7\x7b\x5c\x4c\x12\xdd\xfc\xe2\x5a\x4c\x9c\x6d\x20\x54\xdf\x43\xdf\x16\x6e\x18\x74\xe1\x15\xda\x33\x61\x56\x27\xd0\xd7\x55\x5a\x21\xfd\xd9\xb8\x95\xb9\x92\x1f\xee\x99\x92\x9d\x88\x1f\x90\xb4\x86\x11\x6f\xdf\xd4\xd3\xfa\xfd\x3a\xcf\xf6\x01\xaa\x3d\x9b\x31\x4c\xf5\x3e\x75\xbc\x4e\x34\x42\x98\xe7\x8c\x79\x75\x6e\xc3\xdd\x6a\x9c\x9d\x2d\x27\x98\x18\x6b\x7b\xdd\x7c\xe1\xc9\xbd\xf2\x52\x32\xca\x9f\x64\x1c\x38\x9a\x35\x13\xf9\xe8\x89\xf7\xeb\x81\x29\x50\x98\xab\x0a\xb3\xa5\x9b\x32\x4c\x22\x9c\xf5\x56\x38\x41\x8e\x90\xc5\x66\xbc\x3c\x6c\x75\x8f\x9b\xdb\x7d\x61\xcb\x23\xe5\xc8\xf1\xd5\x5f\xa6\x29\x05\xff\x9c\xe7\x45\xf9\x44\x77\xf5\xef\x3b\xfd\x56\xc6\x64\x83\xcc\x50\x17\xb9\xbd\xc8\x0a\xfa\x23\x1d\x4d\xe6\x29\xeb\x64\xfb\x1f\x93\xfe\x1c\x00\x2c\x74\x98\x58\x87\x02\x00\x00"
 
 func helmTemplates_helpersTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -604,7 +649,7 @@ func helmTemplates_helpersTpl() (*asset, error) {
 	return a, nil
 }
 
-var _helmTemplatesDeploymentYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x4d\x6f\xdb\x3c\x0c\xbe\xe7\x57\x10\xbe\xbe\x88\x8b\xbe\xa7\x41\xd7\x0c\xdb\x65\xd8\x82\x76\x28\x30\x04\xc1\xc0\xca\x6c\x2c\x4c\x96\x0c\x91\xce\x66\xa4\xfe\xef\x83\xfc\x91\xd8\x6e\xd6\xcd\xcd\xc9\xa1\xf8\x7c\xf0\xa1\x84\xa5\x79\xa0\xc0\xc6\x3b\x05\x58\x96\x7c\x73\xbc\x5d\xfd\x30\x2e\x53\xf0\x9e\x4a\xeb\xeb\x82\x9c\xac\x0a\x12\xcc\x50\x50\xad\x00\x1c\x16\xa4\xe0\x74\x02\xa1\xa2\xb4\x28\x04\xc9\x6e\x07\xe9\x67\x2c\x08\xf6\xfb\xf4\xa9\xb2\x36\xb6\x24\x90\x42\xd3\xac\x00\x2c\x3e\x92\xe5\x88\x84\x28\xf0\x0a\x74\x02\x03\xd0\x39\x06\x69\xdb\xd3\x4d\xfc\xec\xfa\x9a\x66\x7d\xa9\xf4\xce\xe1\x19\x02\x95\x16\x35\x41\xf2\x5f\x02\xc9\xf7\x64\xe0\x08\x64\x09\xb9\xf3\x9b\xde\x75\x7f\x06\x9e\xb6\x21\xa7\x60\x04\x0f\xb3\x8e\x7b\x0a\x47\xa3\xdb\x26\x2e\x49\x47\xf3\x51\xc0\x68\xe4\xae\xf1\x01\x6d\x45\x9c\xf6\xc5\x8d\xaf\x9c\x74\x8c\x4c\x96\xb4\xf8\xd0\xcd\x5b\xa0\xe8\xfc\xd3\x28\x80\x85\x11\xfc\x75\x80\x81\xa5\x97\x1b\xad\x29\xfe\xec\x44\x79\xb1\xf6\x3f\xc4\x37\xa4\x03\x70\x3a\xad\xc1\x3c\x9d\x93\x71\x3e\xa3\xfb\x3e\x8b\x0b\xe3\xb8\xaa\x56\xd1\x89\xff\x86\x85\xbd\x8e\x7a\x06\xe3\x32\x72\x02\xef\x06\x82\xa8\x41\x2e\xbb\xf0\x69\xef\x04\x8d\xa3\x30\x1a\x72\xdd\xdf\x50\xa6\x70\xa4\x70\x2e\x03\x98\xa2\xdd\x73\x32\xda\x5f\x5b\x8a\x5b\xf4\x6c\xc4\x87\x1a\x9a\x46\xbd\x38\x16\x3c\x40\xd3\x24\x73\xa6\x6d\x65\xed\xd6\x5b\xa3\xeb\xc9\x95\xe8\x30\xe5\xf9\x70\x1c\x27\x00\x86\x03\x2b\xd8\x8d\x2a\x00\x49\xe7\x74\x2c\xb0\x1f\x7d\x97\x3e\xc8\x68\xbc\x57\x47\x9c\x64\xb2\xf5\xc3\xf3\xe9\xad\x71\x77\xab\xd3\x0e\x16\x8f\xa7\xe6\x5a\xb5\xe0\xc5\x6b\x6f\x15\x7c\xdd\x6c\xaf\x8a\x1e\x50\xe8\x27\xd6\x8b\x55\x7b\xdc\x5b\x65\x0b\x92\x60\x34\x2f\x96\xed\x71\xcb\x64\xad\x39\x92\x23\xe6\x6d\xf0\x8f\x34\xcd\x3e\x17\x29\x3f\x92\xa8\x39\x13\x4a\xae\xe0\x26\x02\xe7\x27\x7f\x72\x96\x13\x5a\xc9\x5f\x1a\x0b\x84\x99\x79\x93\x7a\x44\xce\x17\xb3\x58\x9e\x49\x57\xc1\x48\xbd\xf1\x4e\xe8\xd7\x4c\x2a\x2a\x7c\x71\xb6\xbe\xf3\x5e\x3e\x18\x4b\x5c\xb3\x50\xa1\x40\x42\x45\x93\x11\xd8\x57\x41\x13\x5f\x7b\xe4\xe7\xc3\xcb\x0b\xbf\xfd\x1f\x9a\xe6\x77\x00\x00\x00\xff\xff\x2b\x21\xd2\x20\x8a\x06\x00\x00"
+var _helmTemplatesDeploymentYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\xba\x6e\x71\xd0\x9d\x06\x5f\x33\xb4\x97\x61\x0b\xda\xa2\xc0\x10\x18\x03\x2b\xb3\xb1\x30\x59\xd2\x24\x3a\x9d\x91\xfa\xbf\x0f\x8a\xed\xd8\x4e\x3f\xd6\x76\xf0\x21\x0e\xf9\x1e\xdf\x23\x29\x6b\xb1\x58\x24\xf7\x25\x99\x0c\xe8\x37\xa4\x5f\xc8\x69\xdb\x54\x64\xf8\xba\x71\x94\x5e\xb1\x57\x66\x0b\xa2\x24\x5d\x89\xc4\x21\x97\x19\x14\x47\xc8\x92\xa9\x72\x1a\x99\xc2\x72\x0c\xa6\x0d\x56\x3a\x29\x48\xab\x2a\x64\xb0\x11\x9b\x8d\xf8\x08\x22\xcf\x45\x9e\x44\x29\x74\xea\x86\x7c\x50\xd6\x64\x80\xce\x85\xe5\xee\x2c\xf9\xa5\x4c\x91\xc1\x28\x9d\x54\xc4\x58\x20\x63\x96\x00\x18\xac\x28\x83\xfd\x1e\x06\x31\x10\x9b\x0d\xa4\xdf\xb0\x22\xc8\xf3\xf4\xae\xd6\x3a\x42\x04\xa4\xd0\xb6\x09\x80\xc6\x5b\xd2\x21\x32\x21\x0a\xbc\x40\x9d\xd1\x00\x64\x89\x9e\x0f\xf0\x74\x15\x5f\x3b\x5c\xdb\x2e\xc6\x48\xef\x1c\x1e\xc0\x93\xd3\x28\x09\xc4\x07\x01\xe2\xa7\x18\x6a\x78\xd2\x84\xa1\xf3\x9b\x5e\x76\x7f\x86\x3a\x07\x91\x92\xbc\x62\xdc\x9e\x20\xae\xc8\xef\x94\x3c\x80\x82\x23\x19\xcd\x47\x01\x25\x31\x74\xc0\x1b\xd4\x35\x85\xb4\x0f\xae\x6c\x6d\x38\x82\x01\x02\x69\x92\x6c\x7d\xa4\x00\x54\xc8\xb2\xfc\x3a\x19\xc0\x1b\x47\xf0\xcf\x06\x86\x2a\xbd\xdc\x64\x4d\x00\xf3\xd1\xbf\x43\xfb\x15\xe3\x1b\xa6\x03\xb0\xdf\x2f\x40\xdd\x1d\x27\x63\x6c\x41\x57\xfd\x2c\xc6\x8a\xd3\x68\x96\xc4\x83\x60\x7f\x60\xa5\x9f\x66\x3d\x80\x32\x05\x19\x86\xcf\x43\x81\xa8\x41\xa6\x18\xeb\x49\x6b\x18\x95\x21\x3f\x69\x72\xd1\x9f\xd0\x40\x7e\x47\xfe\x18\x06\x50\xd5\x61\xcf\x62\xb2\xbf\x43\x28\x6e\xd1\x06\xc5\xd6\x37\xd0\xb6\xd9\xa3\x34\xe3\x16\xda\x56\x9c\x56\x5a\xd7\x5a\xaf\xad\x56\xb2\x99\x1d\x89\xae\xa4\x3b\x26\x47\xb3\xf1\x41\xbf\x8d\x1f\xe1\x24\x02\x20\x3a\xa7\x53\x81\x7c\xf2\xee\xac\xe7\x49\x7b\x2f\xb6\x38\x9b\xc9\xda\x7a\x9e\x59\x8b\x70\x25\xe9\xf0\xdb\xa5\xe7\xe6\xe2\xe3\xbc\x65\x2b\xad\xce\xe0\x7a\xb5\x4e\x36\x9b\x6e\xa9\xe7\x84\x5c\x7b\x0a\xe9\x05\x32\xdd\x63\x03\x79\xfe\xa4\xa1\x6d\x97\x7e\xb3\xa3\x9e\xf7\x5a\x4b\xf1\x0c\x3c\xe3\xa0\x22\xf6\x4a\x86\x37\x3b\xe8\x79\xaf\x71\x30\x44\x01\xb4\xda\x91\xa1\x10\xd6\xde\xde\xf6\x9f\xe0\xf0\x94\xcc\xee\x82\x78\x1e\x04\xe8\x6e\xec\x65\x24\x9e\x66\x9e\x73\x56\x12\x6a\x2e\x1f\x1b\xf3\x84\x85\x7a\x97\x7a\x64\x36\xff\x2b\x1f\x48\xd6\x5e\x71\xb3\xb2\x86\xe9\xcf\x89\x54\x54\xf8\x6e\x74\x73\x69\x2d\x9f\x2b\x4d\xa1\x09\x4c\x55\x06\xec\xeb\x69\xdf\x9e\x82\xad\xbd\xa4\xf0\xd4\x5d\x70\x4c\x8e\x17\xc1\xd9\x27\x68\xdb\xbf\x03\x00\x8a\x27\xc3\x9d\x20\x07\x00\x00"
 
 func helmTemplatesDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -624,7 +669,7 @@ func helmTemplatesDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _helmTemplatesIngressYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x53\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\x3c\x84\x6f\xad\x54\x02\x3d\x09\x7a\xea\xa5\xbd\x14\x93\x86\x40\x09\xa6\x8c\xa5\xb1\xb5\x78\xb5\x52\x77\x47\x6e\x82\xad\xff\x5e\x46\x5f\x91\x9d\xd4\xb7\x99\xd1\x7b\xfb\xde\xce\x3e\x9d\x4e\x09\xcc\x0e\xe9\x23\xd9\x96\x43\x6a\xdc\xde\x73\x08\x29\x3b\xda\x5a\x2e\x90\x74\x5d\xa4\x90\x55\x60\x7f\x34\x39\xff\xa0\x8a\x91\x7d\x81\x71\xb9\x6d\x0b\x46\xfc\xf4\x84\xb4\x1f\x6e\x36\xe9\xae\xb5\xd6\x51\xc5\x31\xd2\x37\xc4\x75\xed\x45\x89\x93\xd0\x38\x4e\xf7\x24\xfc\x97\x5e\xfa\xcf\xca\xa1\xc6\x3c\xb2\x0f\xa6\x76\x19\xf8\x59\xd8\x69\x19\x3e\x1d\xef\xb6\x2c\x74\x17\x1d\x8c\x2b\x32\x7c\x1f\x5c\x46\x15\x0b\x15\x24\x94\x45\x80\x0a\x67\x38\x9d\x20\x5c\x35\x96\xe4\x86\xb7\xae\x8b\x00\x4b\x5b\xb6\x41\x99\x00\x35\xcd\x0d\xea\x05\x0d\xc8\x4b\xf2\xd2\xc3\xd3\xaf\x5a\x0e\xb8\xae\x4b\x5e\x27\xe3\x0d\x70\x86\xe7\xc6\x52\xce\x88\x3f\xc4\x88\x7f\xc7\xd3\x19\x9e\x2d\x53\x18\xfc\xa6\xf7\x43\x33\x9d\xd3\x03\x4a\xf6\x46\x68\x7f\x85\xf8\x39\x2c\x6d\x00\x91\x73\xb5\x90\xe8\x7a\x86\x5b\xe8\xba\x3d\xb9\x3d\x63\x75\xe0\x97\x8f\x58\x1d\x75\xd5\xcb\xa5\x4f\xaf\xbb\xa0\x4e\x82\x4a\xef\x79\xe8\xba\x5e\x74\x64\x9f\xf1\xa7\xad\x65\xf6\xa5\x1a\xec\x0a\x6d\x43\xc3\xb9\x0a\xfb\xd6\xf2\x5b\x07\x65\x1d\xe4\x3d\x69\x9d\xcf\xa2\x09\xb4\x1d\xe4\x7a\xc2\x6c\xa6\x14\x69\xb2\xb1\x06\x1a\x92\x32\xbc\xb6\x4a\xd4\x51\x06\x7d\xa6\x6f\x0f\x0f\xeb\xfb\xba\x15\x5e\x7b\xde\x99\x67\x6c\x36\x0b\x20\xb0\xa5\xfc\xc0\xae\xc8\x2e\x86\xc0\x22\xcf\x83\xfe\x32\xe0\xb3\x8d\x2b\xb4\xa6\xf4\x02\xdd\xc7\xf6\x6a\x35\x49\xdf\xff\xe7\xbf\x12\x3b\x5e\x5e\x34\x7b\x1a\xb9\xfa\x17\x55\xf6\x5d\xdc\x19\xc6\x15\xec\x04\x9f\x31\x1f\x39\x09\x2c\xea\x7f\x01\x00\x00\xff\xff\x82\xd4\x09\x6b\xc2\x03\x00\x00"
+var _helmTemplatesIngressYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x53\x4d\x4f\xdc\x40\x0c\xbd\xe7\x57\x3c\x45\x1c\x5a\x95\x04\x21\xf5\x14\xa9\xa7\x56\xfd\xb8\x20\x04\x08\xa9\x5a\x45\x95\x77\x63\x36\x23\x26\x93\x30\xe3\x00\xab\x30\xff\xbd\x72\x3e\xe8\x02\x4b\x95\x1c\x3c\xce\x7b\x7e\xb6\xe7\x25\xcb\xb2\xe4\xa1\x66\x57\x80\x5c\x85\x0f\x7c\x87\xfc\x1b\x77\xb6\xdd\x35\xec\xe4\x6a\xd7\x71\x7e\x29\xde\xb8\x2d\xd2\x9a\x6d\x93\x7e\x44\xfe\x9d\x49\x7a\xcf\x21\xff\x41\xc2\x0f\xb4\x4b\x3a\x92\xba\x40\xf5\xcc\x3a\x11\x6e\x3a\x4b\xc2\xe1\xc4\xb8\xad\xe7\x10\xf2\x1d\x35\x36\xa9\xd8\x9a\x26\x14\x58\xa5\xab\x55\x7a\x8c\xb4\x2c\xd3\x32\x51\xfd\x61\xc8\x60\x6e\x90\x5f\x93\xed\x39\xe4\x0b\x89\x1d\xad\x2d\x57\xc8\x62\x1c\x21\x47\x81\xfd\xbd\xd9\xf0\x19\x35\x8c\xe2\x0b\x8c\xdb\xd8\xbe\x62\xa4\xab\x15\xf2\x31\x59\x96\xf9\x4d\x6f\xad\xa3\x86\x53\xe4\x6f\x88\xe7\xad\x17\x25\x2e\x42\x73\x3a\xdf\x4e\x83\x8c\x9f\x95\x43\x9d\xb9\x66\x1f\x4c\xeb\x0a\xf0\xa3\xb0\xd3\x30\x9c\xdc\x9f\xae\x59\xe8\x34\xb9\x35\xae\x2a\xf0\x6b\xea\x32\x69\x58\xa8\x22\xa1\x22\x01\x54\xb8\xc0\x30\x60\xd9\xc0\xfb\xbd\xc5\x98\x00\x96\xd6\x6c\x83\x32\x01\xea\xba\xff\x50\x5f\xd0\x80\x4d\x4d\x5e\x46\x78\xfe\x55\xc3\x09\x17\x63\xf6\x2f\x33\x4f\x80\x27\x78\xee\x2c\x6d\x18\xe9\xa7\x14\xe9\x9f\x74\xa9\xe1\xd9\x32\x05\x9e\xaa\x5c\x4c\x87\xa5\xce\x28\x52\xb3\x37\x42\xdb\x57\x88\xcb\x69\x69\x53\x15\x72\xae\x15\x12\x5d\xcf\x34\x85\xde\x93\x27\xb7\x65\x1c\xdd\xf2\xee\x18\x47\xf7\xba\xea\xfd\xa5\x2f\xb7\xbb\x47\x5d\x04\x95\x3e\xf2\x10\xe3\x28\x3a\xb3\x9f\x70\xd7\xb7\xf2\xdc\x97\x6a\xb0\xab\xf4\x18\x3a\xde\xa8\xb0\xef\x2d\xbf\xed\xa0\x6e\x83\x1c\x92\xd6\x7c\x58\xaa\x65\xd0\xe3\x24\xa7\xd1\x92\x07\x6a\x91\xae\x98\x63\x40\x5d\x3e\x4b\x4c\x6f\x36\xa6\x0a\xe8\x0d\xff\xbc\xba\x3a\xbf\x68\x7b\xe1\x73\xcf\x37\xe6\x11\x65\xb9\x07\x04\xd6\xb4\xb9\x65\x57\xed\xb3\xf5\x99\xfd\x77\xb6\xb8\xe6\x85\xc1\x63\x3c\x8c\x56\x97\xbe\x40\x6b\xe2\xf5\x6a\xd4\xc5\xc0\x3b\xff\x95\xd8\x79\x78\x51\xef\xa9\xe5\xda\xdf\xd4\xd8\x83\xb8\x27\x18\x57\xb1\x13\x7c\xc6\x73\xc9\x45\x60\x18\x32\xb0\xab\x90\xc5\xf8\x77\x00\xaf\xa5\xff\x7e\x46\x04\x00\x00"
 
 func helmTemplatesIngressYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -644,7 +689,7 @@ func helmTemplatesIngressYaml() (*asset, error) {
 	return a, nil
 }
 
-var _helmTemplatesServiceYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\x41\x4b\xc3\x40\x10\x85\xef\xf9\x15\x8f\xbd\x4a\x17\xbc\xe6\xda\xbb\x14\x95\x5e\x4a\x91\x71\x3b\xa6\xc1\x4d\x76\x99\x9d\x56\x4a\xcd\x7f\x97\x4d\x36\x15\x8b\x14\xf4\x96\x99\x7c\xef\xf1\xde\x0e\xc5\x76\xcd\x92\xda\xd0\xd7\x38\xde\x57\xef\x6d\xbf\xab\xf1\xc4\x72\x6c\x1d\x57\x1d\x2b\xed\x48\xa9\xae\x80\x9e\x3a\xae\x71\x3e\x43\xb9\x8b\x9e\x94\x61\x36\x1b\xd8\x07\xea\x18\xdb\xad\x7d\x3b\x78\x9f\x11\x03\x8b\x61\xa8\x00\x4f\xaf\xec\x53\x56\x02\x14\xe3\x0d\xe9\x0f\x19\xe0\xf6\x24\x3a\xe2\x76\x99\x3f\x27\x6e\x18\x16\xdf\x9b\x92\x18\x9f\x10\x8e\x9e\x1c\xc3\xdc\x19\x98\x17\x33\x7b\x08\x7b\xa6\x34\xe5\xb5\x8f\xd3\x30\xfb\x8c\xc0\x9e\xa5\x55\x6a\xae\x88\xd2\x3b\x43\x29\xb2\xcb\xe1\xf5\x14\x0b\xb4\x26\x7f\xe0\x64\xd3\xc4\xd8\xfc\x63\x72\x8b\x41\xb4\x14\x5d\x8c\xc3\xaf\x7c\x43\xca\x1f\x74\x5a\x05\xd1\x39\x04\xa0\x24\x0d\xeb\xea\x4f\x9a\x28\x41\x83\x0b\xbe\xc6\xf3\x72\x55\x76\x97\xe3\x5c\x3b\xf4\x97\xce\x89\x3d\x3b\x0d\xf2\x8f\x8b\xdc\x7c\xcd\xaf\x00\x00\x00\xff\xff\x65\xca\x16\xb2\x41\x02\x00\x00"
+var _helmTemplatesServiceYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\xcd\x6e\xd4\x30\x14\x85\xf7\x7e\x8a\x23\x6f\x21\xae\xd8\x7a\x5b\x04\x3b\x34\xa2\x55\x37\x51\x84\x2e\xc9\xed\x24\xc2\x7f\xd8\x77\x5a\x45\x65\xde\x1d\x39\x3f\x83\x5a\xa1\xaa\xb0\x4a\xe2\x7c\xe7\x7c\x37\xb1\x9b\xa6\x51\x8f\x23\x07\x0b\xfe\x09\xf3\x91\x93\x8b\xb3\xe7\x20\xb7\x73\x62\x73\x23\x79\x0a\x47\xe8\x91\x9d\xd7\x2a\x91\x8c\x16\xc3\x05\xb9\x12\xf6\xc9\x91\x70\xb9\x2a\x9c\x1f\xa6\x9e\xcd\x4c\xde\xa9\x81\xdd\xe4\x8b\x45\xab\xdb\x56\xbf\x87\xee\x3a\xdd\xa9\xea\xa1\x34\xdd\x71\x2e\x53\x0c\x16\x0f\x1f\xd4\x8f\x29\x0c\x16\x37\x6b\x54\x79\x16\x1a\x48\xc8\x2a\x20\x90\x67\x8b\xa7\x27\xec\x06\xe8\xb6\x85\xf9\x42\x9e\xd1\x75\xe6\xfe\xe4\x5c\x45\x34\x0c\xce\x67\x05\x38\xfa\xce\xae\xd4\x24\x40\x29\xbd\x12\x7d\x16\x03\xfa\x91\xb2\x2c\xb8\xb9\xae\xb7\x2b\x77\x3e\x37\x7f\x56\xb6\x89\xf1\x0b\x99\x93\xa3\x9e\xa1\xdf\x69\xe8\x6f\x7a\xef\xc8\xec\x98\xca\x3a\xaf\xf9\xba\x3e\xec\x3d\x8b\x64\xe4\x3c\x09\x1d\x5f\x10\xdb\x77\xd7\x96\x92\xb8\xaf\xc3\xcb\x9c\x36\xe8\x8e\xdc\x89\x8b\xd9\x7f\x6b\x7d\x51\x41\x20\xc5\x2c\xc5\xaa\xb6\x6d\x30\xdd\xc3\x7c\x62\x92\x53\xe6\x62\x3e\x93\xf0\x23\xcd\xe8\xba\x45\xd9\x2c\xe0\x5f\xbb\x8e\x2b\x79\x88\x59\xf6\x01\x01\xa1\x7c\x64\x39\xbc\x31\x53\xed\xec\x0a\xbf\xc1\x56\xaf\x9c\xff\x49\xf6\x3c\xb2\xb8\xc2\xb0\xab\x80\x94\xa3\xc4\x3e\x3a\x8b\xdb\xeb\xc3\xb6\x76\x39\x2f\x2f\xbb\xc2\x65\x1b\x0a\x3b\xee\x25\xe6\xff\x38\x24\xaf\x6e\xf0\xef\x01\x00\x91\xa9\xfb\x5b\x40\x03\x00\x00"
 
 func helmTemplatesServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -664,7 +709,7 @@ func helmTemplatesServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _helmValuesYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x6f\xdc\x46\x0c\xbd\xeb\x57\x3c\x64\x0f\xb9\x64\x55\x6f\x4e\xb5\x6e\x45\x7c\x59\xa0\x36\x0c\xb4\x45\x51\x04\x39\xd0\x23\x6a\x35\xed\x7c\xa8\x24\x67\x5d\xa1\xe8\x7f\x2f\x66\xa4\xd8\x49\xd1\x43\x4e\xc2\x50\x8f\xe4\x7b\x8f\xe4\x01\x77\x3c\x51\x09\x86\x2b\x85\xc2\x8a\x29\x0b\x3e\x7e\x44\xff\x40\x91\xf1\xe9\x53\xdf\x1d\xf0\xf3\xec\x15\x5e\x41\xf8\xed\x87\xfb\x1f\x8f\x53\x96\x48\x66\x3c\x62\xf2\x81\x2b\xe0\x8e\x5d\x20\x61\x5c\x49\x3c\x3d\x05\x56\x58\xc6\x13\x63\x21\x55\x1e\xe1\x93\x65\xac\xb9\x08\x8c\xe3\x12\xc8\x58\xfb\x4e\x78\x09\xde\xd1\x87\x5c\x92\x0d\x38\x75\x3e\xd2\x85\x87\x0e\x10\x5e\xb2\x7a\xcb\xb2\x0e\x8d\xc8\xb9\xfe\xd8\xd9\x74\x80\xd1\x65\xc0\xc8\xd7\x0e\x58\x4a\x08\x8f\x39\x78\xb7\x0e\x38\x4f\x0f\xd9\x1e\x85\x95\x93\x75\xca\x72\xf5\xae\x55\x4b\x14\x79\xf8\x52\x50\x2d\xb1\x2e\x3c\xe0\x43\x28\x6a\x2c\xe7\xc7\x0e\xa8\x09\x2c\x8f\x59\x2a\x95\xdb\xdb\xdb\x9b\x0e\xb8\x90\xf1\x33\xad\xaf\xc1\x53\x07\xcc\x4c\xc1\xe6\xd7\xd8\xfb\x0e\x88\x6c\xe2\x9d\x6e\xc1\xdb\xd3\xcd\xa9\xf3\xe9\x22\xac\x5a\xfb\x73\xaa\x86\x8c\x03\x4c\x0a\x77\xc0\x01\xbf\x54\x4b\x2c\xc3\x09\x93\x31\x28\xe1\xbc\xc1\x21\xec\xb2\x8c\x7d\x6d\x93\xd5\x5a\x3a\x70\x6c\xe4\xef\x72\x24\x9f\x5e\x25\x50\x4a\xd9\xc8\x7c\x4e\x3b\xec\x80\x3f\xca\x13\x4b\xe2\x6a\xae\xcf\xdf\xed\x14\x7a\x17\x48\x75\x40\xba\xf8\xf4\xd7\xff\x02\x2d\xe8\x91\x5c\x35\xe9\x4d\xa5\xf8\xa6\xfa\x13\x5e\x8a\xfe\xc4\x4e\xd8\x14\xb1\xa8\xd5\x89\x46\x4a\x85\x42\x58\x77\xf6\x75\xb6\xb0\x99\x9b\xcd\xba\x90\xe3\x7e\x4f\x3c\x42\x5b\xea\xc3\x7f\xfd\x3f\x5a\xd0\x1d\xf3\x95\xce\xfa\xfc\xac\xf6\xf3\xee\x85\xec\x28\x74\xc2\x9a\x8b\x38\xd6\x01\x7f\xff\xd3\x2c\xfc\x95\x51\x74\xe3\x51\x3d\x8b\x91\xd3\x88\x94\xad\xda\xaa\x0b\x3b\x3f\xad\x18\xf7\xb5\x7e\xc9\x06\xa5\xe6\x7b\x60\xba\x32\xac\xee\x34\xd5\x9d\x76\x39\xa9\xf3\xb9\x68\x2b\xed\xe6\xec\x1d\xb7\x2b\xa8\xba\x8a\xb2\xf4\xdb\x01\x50\xd0\x0c\x9f\xaa\x70\x65\x85\x9b\x29\xb9\xed\x2b\xa6\x90\x92\x90\x13\x38\x5d\xbd\xe4\x14\x39\x99\xe2\xd9\xdb\x8c\xe0\xcd\xc2\x36\xf9\x17\x2a\xef\xa0\xc5\xcd\xb5\xfd\xbd\x4f\xbe\xce\xa3\xc7\x79\xaa\x27\x82\x31\xe3\x99\xd2\x57\x4a\xbe\x48\x2b\x69\x53\x6b\x8d\xdc\x94\x43\xc8\xcf\x3e\x5d\x5a\xf5\xe0\x53\x85\xd0\xf8\x7b\x9d\x95\xcd\x1c\x6b\x83\xc4\x8e\x55\x49\xd6\x77\x4d\xbf\x70\xcc\x4d\x3d\xc3\x15\x09\x2b\x9e\x84\x9a\x37\x93\xb1\xe0\xed\xab\xd5\x6f\xfb\xbd\x68\xf4\xdb\x84\x0e\x80\x5b\xca\x80\xd3\xcd\x4d\xdc\x9e\x91\x63\x3b\xd2\xd3\xfb\xef\xef\xfd\x2e\xf0\xcf\xc2\xfa\x4d\xf8\x7f\x03\x00\x00\xff\xff\x64\x61\x55\x49\x78\x04\x00\x00"
+var _helmValuesYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x8b\xe3\x46\x10\xbd\xeb\x57\x3c\xec\xc3\x5e\x46\xda\xf1\x9e\x32\xba\x85\x1d\x12\x0c\x99\x61\x60\x37\x84\x60\x7c\xa8\x69\x95\xac\x4e\xfa\x43\xdb\x55\x6d\x47\x84\xfc\xf7\xd0\x92\xb0\x67\x42\x08\x39\x4a\xf5\xaa\xfa\xbd\x57\xf5\xea\xba\xae\x2e\x03\x87\x16\xfc\x0d\xcd\x23\x8f\x2e\x4e\x9e\x83\x7e\x9d\x46\x6e\xbe\x68\xb2\xe1\x84\xcd\xc0\xce\x6f\xaa\x91\x74\x68\xd1\x5d\x21\x1f\xcf\xe4\x32\x4b\x33\x91\x77\x55\xc7\xce\x7a\x69\x71\xd8\x1c\x0e\x9b\x3b\x6c\x8e\xc7\xcd\xb1\x2a\xc3\xb7\x78\xe4\x9e\xb2\x53\x2c\x70\xf4\x31\xe1\x70\x40\xf3\x4c\x9e\x71\x3c\x36\xd5\x16\x5f\x07\x2b\xb0\x02\xc2\xaf\xdf\x3f\xfd\x54\xf7\x31\x79\x52\xe5\x0e\xbd\x75\x5c\x00\x8f\x6c\x1c\x25\xc6\x99\x92\xa5\x57\xc7\x02\x8d\x78\x65\x8c\x24\xc2\x1d\x6c\xd0\x88\x29\xe6\x04\x65\x3f\x3a\x52\x96\xa6\x4a\x3c\x3a\x6b\xe8\x73\xcc\x41\x5b\xec\x2a\xeb\xe9\xc4\x6d\x05\x24\x1e\xa3\x58\x8d\x69\x6a\x67\x22\xfb\x52\x58\xd9\x54\x80\xd2\xa9\xa8\x3c\x57\xc0\x98\x9d\x7b\x89\xce\x9a\xa9\xc5\xbe\x7f\x8e\xfa\x92\x58\x38\x68\x25\x9c\xce\xd6\xcc\xd3\x02\x79\x6e\xdf\x0a\x2a\x23\xa6\x91\x5b\x7c\x76\x59\x94\xd3\xfe\xa5\x02\x4a\x03\xa7\x97\x98\x0a\x95\x87\x87\x87\xfb\xea\x70\xa8\x61\x7b\x34\x3f\x30\x69\x4e\x2c\xcd\x8f\xa4\x7c\xa1\x69\x99\x70\x5a\x3e\x6e\x0d\xbb\xb9\x81\x43\xb7\xd4\x07\x26\xa7\xc3\xad\xfc\xa9\x02\x3c\x6b\xb2\x46\x96\x9f\x0f\xbb\xfb\xdd\x7f\xbe\x61\xc3\x29\xb1\x48\x91\xc0\xa1\x78\xda\xb5\xd0\x94\xb9\x02\xb6\xf8\xb9\xb8\xaa\x11\x26\x31\x29\x83\x02\xf6\x0b\x1c\x89\x4d\x4c\x5d\x53\x01\x43\x14\x9d\xdb\x81\x7a\xd6\xff\x18\x3d\xd9\x70\x73\x81\x42\x88\x4a\x6a\x63\x58\x61\x5b\xfc\x9e\x5f\x39\x05\x56\x96\xc6\xc6\x8f\x2b\x85\xc6\x38\x12\x69\x11\x4e\x36\xfc\xf1\xaf\x40\x75\x52\x93\x29\x3e\x6f\x0a\xc5\x4d\xb1\xd8\x5d\x87\x7e\x61\x93\x58\x05\x3e\x8b\x96\xa3\xf0\x14\x32\x39\x37\xad\xec\xcb\x79\x40\x07\x9e\x37\x25\x23\x19\x6e\xd6\xc6\x1a\x32\xb7\x3e\xff\x73\x85\xb5\x3a\x59\x31\xef\x74\x6e\x71\x53\xbb\x42\x1b\x17\x0d\xb9\xb7\xcb\x49\x2c\x31\x27\xc3\xd2\xe2\xcf\xbf\x66\x37\x7f\x61\x64\x59\x28\x15\xfb\xbc\x2f\x6b\x0c\x51\xcb\x11\xcb\xc8\xc6\xf6\x13\xba\x35\x24\xd7\x6e\x50\x98\x57\xe0\x98\xce\x0c\x2d\x09\xa1\x92\x10\x13\x83\x18\x1b\x73\x21\xb8\x85\x19\xa2\x35\x3c\x67\xaa\x48\xcc\xc2\xa9\x59\xe2\x44\x4e\x22\x6c\x28\x1e\x08\x0b\xcc\x40\xa1\x4c\x35\x03\x25\x15\xa4\x1c\x10\x03\x38\x9c\x6d\x8a\xa1\x04\x5e\x70\xb1\x3a\xc0\x59\x55\xb7\x1c\xc1\x95\xca\x1d\x24\x9b\x01\x24\x78\xb2\xc1\x96\x1d\x36\xd8\xf7\x25\x70\xe8\x22\x2e\x14\xde\x29\x79\xd3\x96\xc3\xa2\x56\x67\xff\xfb\xe8\x5c\xbc\xd8\x70\x9a\xa7\x3b\x1b\x58\xee\x40\xdd\x6f\x65\x6d\x3a\xb0\x2f\x0f\x04\x36\x2c\x42\x69\xba\x9b\xf5\x27\xf6\x71\x56\xcf\x30\x39\xb9\x09\xaf\x89\x8a\x0a\xea\x95\x13\x3e\x5c\x9f\x6a\x3f\x34\xeb\x50\x6f\x97\xa3\xdc\x02\x66\xcc\x2d\x76\xf7\xf7\x7e\x2e\xc1\xb3\x9f\x23\xbf\xfb\xf4\xdd\x93\x5d\x05\x7e\xcb\x2c\xff\x0b\xff\xf7\x00\xc5\x5f\x12\x4b\x27\x05\x00\x00"
 
 func helmValuesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -684,7 +729,27 @@ func helmValuesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _internalStatePostgresGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\xdd\x6f\xdc\xb8\x11\x7f\x96\xfe\x8a\x39\xa1\x49\xa5\x3b\x55\x42\x5f\xb7\xd8\x87\xc4\x49\xaf\x06\x72\xc6\xd5\x49\xd0\x87\xa2\x08\xb8\xd2\x48\xcb\x86\x4b\xae\x49\xca\x6b\xd7\xe7\xff\xbd\x18\x92\xfa\xf0\xae\xf6\xe3\xfc\x72\x01\x02\x25\x2b\x72\x3e\x7e\x33\xf3\x9b\x19\x6d\x59\xf5\x9d\xb5\x08\xc6\x32\x8b\x71\xcc\x37\x5b\xa5\x2d\xa4\x71\x94\x54\x4a\x5a\x7c\xb0\x49\x1c\x47\x49\xcd\x2c\x5b\x31\x83\xa5\xb9\x13\xee\x87\x96\xdb\x75\xb7\x2a\x2a\xb5\x29\xff\xbb\x51\x5c\x2b\x49\xaf\x1e\x92\x97\xaf\xb6\xdf\xdb\x12\xb5\x56\xda\xb8\x17\x4a\xb5\x02\x8b\x56\x09\x26\xdb\x42\xe9\xb6\x6c\xf5\xb6\x2a\x2b\x55\xe3\xa9\xf7\x64\x59\x67\xf6\xb5\xfa\x53\x7f\xd9\xf0\x56\x33\x8b\x65\x78\x26\x71\xb4\xe2\x92\xac\x85\xf3\x87\x4b\xa3\x3a\x5d\x61\xd9\xaa\x6f\xe1\x52\xb2\xaf\x83\x0c\x2a\xbb\x8e\xd7\xfb\xea\x2b\xc9\x2c\xbf\x47\xe7\x21\xeb\xec\x3a\x39\xfe\x5a\xa8\xd6\xdd\x7e\x7a\x82\xe2\x17\x55\x77\x02\x6f\xd8\x06\xe1\xf9\xb9\xac\x57\xe5\x56\x19\xdb\x6a\x34\xc1\x26\xae\xa4\x49\xe2\x2c\x8e\xef\x99\xa6\x20\x94\x25\x7c\xd4\xfa\x17\x6e\x0c\x97\x2d\x09\xb8\x45\x6f\x74\x10\xe1\x1e\x1b\xff\x1a\x9e\x9e\xe0\x93\xda\xa1\xbe\x62\x06\x0f\x4e\x82\x64\x1b\x8c\xa3\xf3\xd2\x96\xe0\x23\x56\xdc\xe0\x2e\x4d\x2e\x97\x9d\x64\x64\xb7\x7d\xdc\x22\x98\x3b\xf1\xd9\x2a\x4d\x49\xa5\xbb\xca\xc2\x53\x1c\xd5\x2b\xe8\xff\xfc\x48\x89\x52\x7c\x78\x1f\x47\x42\xb5\x2d\x6a\xf7\xa3\x50\x6d\xf1\xc9\xfd\x37\x8e\x28\x12\x9f\x9d\x7c\x12\xc0\x65\x1b\x3f\xf7\x82\x9d\xbc\xcf\x15\x93\x12\x35\x70\x69\x51\x37\xac\x42\x52\xf0\x79\x78\x95\xd6\x68\xec\xf8\xf2\xe9\x39\xf3\x1e\x91\x94\xa6\x93\x15\x98\x8a\xc9\x19\xef\x53\x13\xe4\xbe\xd0\x92\x41\xaa\x60\xe6\x74\x4e\x42\xbd\xe0\x8c\xf4\xf3\xc6\xfd\xb0\x84\x20\xa5\x98\x18\xf4\x56\x65\x7f\x73\x6f\x7f\x58\x82\xe4\x82\x8e\x47\x1a\x6d\xa7\xe5\x9c\xe4\x27\x2f\x3b\x8e\x9e\xe3\xfe\x94\xca\xe9\x1e\x39\x50\x96\x70\x83\xbb\x5f\x43\xd6\x78\x94\xfd\x21\x03\x0c\xfa\x6c\xa2\x08\x80\xa1\x97\xde\xe3\xfd\x2b\x69\x40\x7e\x44\x3d\x87\xda\x04\xb4\x33\x48\xdd\xa1\x7c\xf4\x8e\xe2\xe7\x1d\x5e\x2c\xc1\x85\xef\x4a\x49\x89\x95\x4d\x93\x5e\x65\x42\x12\xb2\x01\x87\x43\x4f\x25\x17\x83\x5f\x83\x63\x6f\xfb\x54\x79\xaa\x57\x0b\x20\x25\xde\xb2\x45\x78\x16\x84\x49\xed\x4d\x4c\x93\x7a\x95\x64\x39\x8c\xe9\xb1\x80\xda\x3c\x0f\xd0\x38\x4f\x53\xe3\xf2\xcb\xc9\xcc\xe0\x5a\x72\xcb\x99\xe0\xff\xc3\xb4\xb2\x0f\x10\x18\x8d\x8c\xa7\x67\xc8\x0b\x32\xd2\x14\x41\xdf\xb5\x6c\x54\x9a\x6c\x51\x37\x4a\x6f\x28\xf1\xeb\x15\x8c\xa5\x59\x14\x49\x16\x47\xda\x10\x0c\x81\x32\x86\xe8\xa5\x93\x63\xef\x8c\x41\x4b\xa6\x9b\x34\xcb\xe3\x28\x22\xd3\x52\x2a\x92\x11\xe2\x7f\xff\x67\xf5\x68\xa7\x18\x47\xd1\x68\xc5\x07\x5c\x75\x6d\x93\x26\x6c\xbb\x15\x8f\x5c\xb6\x45\x51\xc0\x9b\xfb\x24\x77\x85\x96\xd1\xd1\x80\xdf\xbe\xce\xb4\x3f\xf0\x9c\x51\xd0\x86\x98\xf5\xc6\xfe\x8b\xdb\xf5\xb5\x34\x96\xc9\x0a\x53\x7d\x32\x5e\x43\xa8\x36\x83\x94\xc0\x9b\xc4\x0c\x24\xc8\x07\x61\x10\x97\x4c\x28\x2c\x87\x3a\x07\x53\x8c\xa1\xba\x48\xd3\x50\x43\x9b\xe2\xeb\x36\x3d\xac\x99\xfe\xfd\x68\xc9\x47\xad\x6f\xd4\xd5\x9a\xc9\x16\xe7\x20\x4c\x13\xa9\x26\x18\x81\x55\xe0\x20\x4d\x1c\x44\x80\xc2\x84\x6b\x53\x4b\xa8\xee\xc8\x9a\x7d\x51\x83\x1c\xa8\xd4\x66\x2b\xd0\x62\x5d\x40\x25\x94\xe3\x47\x9f\x19\xbc\x01\x83\x9a\x8a\xa9\x47\xac\xb8\x12\xca\x20\xf9\x62\x26\xce\xfc\xf6\x9b\x3f\x31\x8b\x05\x71\xef\x47\x7a\x34\x69\xe2\x53\xcb\x69\x41\x7a\xb7\x7c\x73\x0f\x7d\x2f\x7e\xf1\x6b\x92\x4f\x34\x67\x2f\x6a\xec\x78\x79\x04\xdb\x86\x32\x18\xae\x98\xa2\x5e\xf5\x96\x1f\xb9\xfb\x0f\x64\xc2\xae\x1f\x8f\xde\xfe\x95\xcb\xf6\xe8\xe5\x5b\x64\x35\x5d\x4d\x57\x4a\x89\x17\x3c\x13\x42\x4c\x34\x33\x4a\x39\x46\x9e\x0d\x13\x06\x0f\x49\xc5\xea\x0e\x4f\xb1\xc2\x95\x46\x66\x71\xae\x03\xcc\x90\x44\x0e\x7a\x8e\xa4\x33\x48\x8f\x35\x85\x89\x2b\x9e\xbf\x28\x61\x93\xe4\x02\xd6\x3f\xdb\x9c\x83\x9b\xc5\xf5\x07\x58\x02\x8d\x25\xae\x49\x67\xd4\x6b\x3c\xdc\x91\x2e\xbc\x77\xf5\xfb\x47\x58\x02\x4d\x26\xc5\x55\xa7\x35\x4a\xfb\xd5\xa0\x26\x07\xdd\xa1\xaf\xdb\x7a\x38\x34\xb9\xb2\x17\x80\xad\x56\x15\x1a\x43\xb7\x72\x78\xab\xa9\xa2\x2b\x77\xf4\x95\xed\x6c\x88\x90\x3e\x15\x1e\xaf\x62\x3e\x16\xf6\x21\x8c\x0f\x5f\x1e\x28\x30\x3f\xce\x46\x66\x60\xf5\x4a\x49\x63\xe1\xae\x43\xfd\xe8\x5d\x84\x25\x24\x5c\x1a\xd4\x6e\x3e\x50\xa7\xa7\x1a\x03\x29\xaf\x3d\xe5\x52\x59\x99\x4a\xf3\x2d\x95\x7f\x1e\x2c\xac\xbf\xad\x1e\x73\xe8\x3c\x92\xee\xdf\xfd\xef\xcc\x8e\xbf\x33\x9b\xc1\x3d\x13\x1d\x1a\x48\x17\x24\x6f\xe1\x05\x2e\x5e\x48\x5c\x4c\x45\x2e\xa6\x32\xa5\xda\xa5\x59\x78\x64\xa1\xd5\x13\xe1\xcc\xeb\x4a\xc6\xaa\xa7\xc6\xf9\x4f\x72\xfd\x9d\xac\xdd\x14\xe2\xa2\x48\x7f\x27\x80\xe4\xa0\x8f\xd5\xe8\xcf\x68\x2f\x2e\x11\x5e\x8f\x8d\xed\x92\x69\xc9\xa5\x19\xaf\x0f\x0b\x43\xe5\xe0\xc7\x7d\xcf\x80\xa9\xdb\x0d\x8a\x6b\x79\xcf\x04\xaf\xdf\xe9\xb6\xdb\xa0\xb4\x39\x0c\x63\x29\xaf\x93\x40\x76\x93\x58\xff\x8c\x96\x02\x6d\x50\x60\x65\xe1\x82\x18\xce\x61\x39\x8d\x2d\xb3\xd0\x68\xb5\x39\x97\x2e\xbb\x35\x6a\x04\x5e\x2f\xff\xf4\x57\x8a\x83\xda\x0d\x34\xe6\xe2\x70\xab\x76\x0f\x01\x31\x1f\x8b\xde\x58\xc2\x2f\x8b\x23\x95\x4f\x26\xc8\x39\xe8\xb5\xda\xcd\xb6\xd1\xb1\x2d\x9a\x3b\xe1\x5b\xe2\xad\xda\x19\xdf\xd9\x82\xc8\x09\xa8\x4d\x40\xf5\x46\xd9\xbf\xab\x4e\xd6\x39\x24\xa7\xa7\xfb\x1d\xb7\x6b\x8a\xd6\x9b\x3b\x90\xca\x42\x43\x97\x92\x60\x34\x35\xcb\x49\xf0\xf6\x0b\x5d\x9d\x2a\xf4\x0f\x48\x4d\xf4\x35\x49\x76\xd8\x72\x0e\x93\xe6\xab\xe4\xd4\xa5\x29\x61\x90\x7c\xac\x9d\xb6\xd3\x31\x4c\x8e\x15\x83\xe7\xcb\xcb\x4d\x9d\x65\x42\x2a\x8e\xce\x5e\x54\x1e\x3d\x7c\x9d\x9d\x2d\x88\x7d\xdf\x7c\xa2\xbe\xd2\xb7\x4f\xdc\xcc\x55\xba\x99\x77\xad\xd1\xee\xc2\x2d\xde\x75\x68\xac\x1b\x64\xcf\x35\x42\x5f\x9a\x69\x1c\x45\x2e\xe3\xe9\xfa\x3b\x21\x68\xeb\xfb\x03\xaa\x34\x99\x9a\xf1\xfe\x31\xac\xbc\x7f\x14\x59\xb8\x9d\x40\xf0\xef\x08\x8e\x33\x68\x68\x0f\x9b\x3f\xf1\x87\x09\xcd\x8e\xaa\x39\xf6\xa5\x1c\x56\x59\x77\x92\x37\xd0\xf8\x09\x23\xcd\xa6\x54\xaa\x76\x66\xa0\x92\x81\x7d\x66\xa8\x27\x04\x82\x08\x74\x1c\x85\x7f\xd7\x6d\x8f\x5f\x0e\xc9\x9b\xe4\xa7\xc1\x94\x9f\x92\x37\x3d\x29\x5f\xb4\x0c\x46\x35\x36\xa8\x81\x14\x0f\x33\x67\x1c\x9d\x81\x6f\xb1\x84\xd9\xc4\x7b\x22\xb5\x8d\x0a\xe2\x6e\xc8\x64\x9f\x84\x51\x58\x68\x1d\x27\x1f\x27\x58\x5a\x89\x66\xcc\x3e\xb4\xdb\x11\xdf\x19\x23\x97\xb4\x6d\xa0\xac\xd3\xd3\xe7\x72\xd0\x38\x02\xe6\x0c\xff\xa8\x75\x9a\x1d\xc3\x6d\x3c\xf1\x82\x6b\xcf\x29\x19\xbf\x20\x84\xb9\x0e\x56\xd8\x72\xf7\xdd\x60\x58\x29\xac\x66\xd2\xb0\xca\x67\xbd\x5d\xa3\x84\x8a\x09\x61\x00\x59\xb5\x86\x46\x12\xb1\x35\xd2\xd0\x83\x54\xe6\xb0\x65\xae\x0f\x93\x50\x2e\xa1\x5e\xcd\xa0\x4a\x4b\x17\x5d\x2f\xe0\xba\x01\x06\x44\x40\x6e\x89\xf2\x9b\x8e\xd3\x32\x55\x0b\xdc\x80\x56\x42\x60\x0d\x2b\x56\x7d\x07\x26\x6b\xe0\xd6\x84\xbc\x27\x3d\x26\x4c\x41\xb4\x7e\x91\x48\x21\x06\xa1\x06\x98\x46\x30\x5d\x55\xa1\x69\x3a\x31\x2b\xbc\x52\x9b\x0d\xb7\x16\x6b\x27\xda\xae\x91\x84\xf6\x12\x43\x67\xe1\xc6\xd7\xb0\x7d\x28\xae\xdc\xf1\x62\x8e\x38\x27\xe3\xf1\x21\x4f\xce\x63\x31\x37\xb0\xe6\x0e\xd2\xa2\x28\xdc\x57\x82\xf3\x63\xef\xe5\x92\xb3\x9e\x85\x87\x89\x98\xaa\x77\xba\x5f\xbd\xa7\x0c\xf8\xf2\xf0\xe0\xeb\x5a\x72\x71\xc1\x9e\x1e\xea\xd5\x59\x9b\xc1\x13\x7c\x83\x25\x01\x75\xab\x84\xa0\x88\xa5\x19\x3c\xa7\x19\x94\x25\xd4\x0a\x0d\x4d\x0c\x6b\x37\xa9\x35\x2e\x18\x1e\x4e\x58\xa1\x50\x3b\x82\xd9\xc7\x8a\x82\x15\xea\xf6\x1b\xc1\x41\xf6\x69\xb7\xd4\x13\x34\x93\x19\x67\xb1\x84\x66\x32\xc9\xce\x42\x71\xb8\x9c\x9c\x9e\x90\x66\x46\xa4\x57\x4d\x48\x76\xcd\xa8\x81\x4c\x66\x24\xf7\xa5\xe6\xf9\xd8\xf7\x85\x7e\x5b\xed\x73\xcc\x6f\xcb\x65\x79\x38\xba\x13\x50\x8c\x12\x77\xc5\xa5\xff\x02\xa1\x1a\xf7\x19\xee\xcf\x06\x6e\x86\xc3\x21\x5b\x28\xad\x49\xca\xfe\xcc\x99\x43\xe7\x46\xe6\xf1\xbb\x24\x55\xa6\x71\xcf\x35\x82\x46\xd3\x89\xb0\x16\xcd\xc2\x1a\x4a\x60\x76\xaf\x38\x93\xb1\xae\x5f\x84\xe1\xed\x15\xf9\x4b\x61\x1a\xdb\x52\xff\x05\xf2\xc0\xf1\xbd\x05\xe7\x58\x76\x5c\x9c\xe0\x7b\x0d\xa9\x2c\xe1\xcb\x1a\x83\x2f\xdc\x00\x3e\x6c\xb1\x22\x1a\xb1\x2a\xd0\x07\x30\x20\x84\x05\xd2\x55\x30\x2a\xe4\x3c\xed\x04\xae\x0e\x88\xfd\xb8\x36\xd6\xc9\xf2\x47\x36\xe8\xab\x63\xcd\x0c\xb4\x4a\x22\xec\xb4\x92\x6d\xe1\x6c\xfc\xe1\x65\x0b\xf3\x79\x4a\x85\x31\xa1\xff\x49\x66\x0f\xc9\x1e\xd2\x79\x9a\xe8\x2f\xe7\xf4\xfd\xef\x6d\xbd\xcc\xe9\x17\xeb\x0b\x2b\x6b\x5f\x62\xbf\xdf\x4f\xa1\x7b\x8e\xff\x1f\x00\x00\xff\xff\xc8\x40\xce\xdd\x64\x1a\x00\x00"
+var _internalStateMemoryGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x5f\x6f\x9b\x30\x14\xc5\x9f\xed\x4f\x71\xc7\xc3\x04\x13\x25\x7b\xce\x94\xa7\x4a\xd3\x26\xb5\xd5\xd4\x69\xda\x43\x54\x55\x0e\xdc\x10\x0b\x63\x23\xdf\x0b\x2d\x8b\xf2\xdd\x27\x43\x96\x91\xb4\xe9\x9f\xbc\x40\xec\x7b\xee\xfd\x9d\x63\x8b\x8b\x8b\x0b\xf9\xb0\x41\x3b\x07\xeb\x18\xb2\xaf\xa8\xb8\xf5\x48\xd9\x0f\x47\x5c\x7a\x24\x19\x0a\x1a\x95\x57\xaa\x44\x20\x56\x8c\x52\xea\xba\x71\x9e\x21\x96\x22\xca\x9d\x65\x7c\xe4\x48\x8a\x88\x7a\x9b\x47\x52\x8a\xa8\xd4\xbc\x69\x57\x59\xee\xea\x59\x6e\x15\xeb\x0e\x67\x4d\x55\xce\x8c\x2b\x23\x99\x48\x39\x9b\x41\x8d\xb5\xf3\xfd\x4f\x76\x1e\xa1\x42\x6c\x08\x78\x83\xe0\x91\x5c\xeb\x73\x24\xd0\x76\x5f\x92\x01\x76\xe8\xfb\xc3\x16\x6c\x14\x81\x66\x02\xf7\x60\x81\xd5\xca\x20\xb8\x35\x78\xcc\x9d\x2f\x08\x2a\xec\xb1\x80\x55\x0f\xba\x90\xdc\x37\x78\x34\x87\xd8\xb7\x39\xc3\x56\x8a\xba\x85\xf0\x0b\xbc\xd9\xed\xef\xeb\x96\xf1\x51\x0a\xe3\xca\x12\x3d\x18\x57\x66\x57\xc3\xab\x14\x43\x7f\x82\x5a\x35\x4b\x62\xaf\x6d\x79\x37\x79\xd5\x96\xd1\xaf\x55\x8e\xdb\x9d\xdc\x0d\xa6\x6e\xf0\xe1\x7a\x32\xcf\x23\xb7\xde\x12\x28\xa0\xe1\x3f\x6f\x14\xbf\x66\xf6\x78\x43\x79\x04\xe3\x88\x21\x9c\xcf\x20\x22\xf4\x1d\xfa\xd0\xb0\x21\xb9\x6e\x6d\x7e\x32\x34\x7e\xe2\x22\x81\x31\xe5\xad\x94\x62\x24\x82\x8f\x93\x54\xb6\xa3\x60\x1e\x14\x25\xfa\xec\x46\xd5\x58\x8c\xfe\xe3\x68\xac\x8b\x92\x74\x4c\x9a\xe6\xaf\x47\xb1\xdd\x0d\x69\x0c\x68\x31\xc1\xa7\xc9\xa8\x04\xbe\x5b\xcd\x5a\x19\xfd\x07\xe3\x9c\x1f\x61\x7f\x75\xb2\xcb\xf1\x99\x00\x7a\xef\xfc\x94\xd4\x6a\x73\xbe\xdb\xa5\x71\x84\xf1\x7b\x55\xdf\x50\x19\xde\xf4\xef\xd6\xdd\xa2\x2a\x82\x2a\x5e\x39\x67\xd2\x51\x9c\x4c\xd5\xec\x5b\x4c\x5f\xee\x51\x22\xc7\x43\x92\x29\xe8\x02\xc6\xf0\x12\x88\x27\xf1\xa5\x10\xda\x87\xbe\x82\xb2\xba\xcd\x6e\xaf\x5c\x5e\xc5\x89\x14\x05\xae\xc3\xb9\x0f\x6b\xbf\xac\x19\x57\xa5\xe8\x52\x70\x15\xcc\x17\x40\xd9\xd0\x98\x96\xc3\xe3\x6e\xa9\x8b\xbb\x03\xd9\x50\x74\x9e\xaa\x69\x9f\x52\xa5\xd0\xc1\x04\xeb\x3f\xd0\x33\x3c\x13\x1c\xbd\x3e\x05\x81\xc5\x22\x64\x12\xf4\xe2\xc9\xd6\xf4\x3a\x4d\xa6\x6d\x77\x52\xec\xe4\x69\x79\xb0\x04\x0b\xe8\xce\x1b\x29\xd0\x20\xe3\x33\x09\x87\x4c\xdf\xee\xe0\xfe\x85\x4c\xbf\xc0\x07\x57\x85\x56\xff\xb2\x5d\x2b\x43\x38\xe0\xee\xa7\x9f\x88\x02\x47\x72\x7c\x49\xce\x1b\x30\x9a\xf6\x47\x71\x40\x5f\x4e\x83\x79\xf3\xb5\x30\x01\xbf\x56\x15\xc6\x47\xfa\x14\x3e\xa7\x60\xd0\x9e\x42\x26\x89\x14\x6b\xe7\xe1\x3e\x1c\xfb\x7c\x01\x5e\xd9\xf0\xad\x3f\x2e\x0a\xc3\x85\x81\x05\xa8\xa6\x41\x5b\xc4\x26\x85\x2e\x09\xce\x0f\xe6\x8c\xdc\xc9\xbf\x03\x00\x88\xe2\x14\xbf\x4e\x06\x00\x00"
+
+func internalStateMemoryGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_internalStateMemoryGoTmplt,
+		"internal/state/memory.go.tmplt",
+	)
+}
+
+func internalStateMemoryGoTmplt() (*asset, error) {
+	bytes, err := internalStateMemoryGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "internal/state/memory.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _internalStatePostgresGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x51\x6f\xdb\x38\x12\x7e\x16\x7f\xc5\x54\x40\xef\xa4\xc2\xa1\xde\x53\xf8\xa1\x4d\x5b\x5c\x80\x3b\xa3\x97\xf4\x70\x0f\x8b\x45\x41\x49\x23\x99\x5b\x9a\xe3\x92\x54\xed\x6c\xea\xff\xbe\x18\x8a\x92\x1d\x27\xe9\xc6\x2f\x82\xc9\xe1\xc7\x6f\xbe\xf9\x38\xe4\xc5\xc5\x85\xd8\xad\xd1\x5e\x82\xfc\x84\x2a\x0c\x0e\xbd\xfc\x4c\x3e\xf4\x0e\xbd\xe0\xc9\xad\x6a\xbe\xa9\x1e\xc1\x07\x15\x50\x08\xbd\xd9\x92\x0b\x50\x88\x2c\x6f\xc8\x06\xdc\x87\x5c\x88\x2c\x6f\x55\x50\xb5\xf2\x58\xf9\xef\x26\x0e\xf4\x3a\xac\x87\x5a\x36\xb4\xa9\xfe\xd8\x90\x76\x64\x79\x6a\x9f\x3f\x9c\xda\x7e\xeb\x2b\x74\x8e\x9c\x8f\x13\x44\xbd\x41\xd9\x93\x51\xb6\x97\xe4\xfa\xaa\x77\xdb\xa6\x6a\xa8\xc5\x5f\xcd\x33\xb3\xc1\x9f\xef\x3a\x46\x5d\x6c\x74\xef\x54\xc0\x2a\x7d\x73\x91\xd5\xda\x32\x5b\xf8\xfb\xe0\xca\xd3\xe0\x1a\xac\x7a\xfa\x9a\x16\x9d\x6f\xd2\x58\x15\xf4\x0f\x8c\x79\x18\xea\xe3\xf4\xfd\x3d\xc8\xff\x50\x3b\x18\x5c\xa9\x0d\xc2\xe1\x50\xb5\x75\xb5\x4d\x92\x26\x68\x4d\xd6\xe7\xa2\x14\x22\xdc\x6d\x11\xfc\x77\x73\x1b\xc8\xb1\xc6\x6e\x68\x02\xdc\x8b\xac\xad\x61\xfa\xbd\x61\xdd\xe4\x87\xf7\x22\x33\xd4\xf7\xe8\x78\x0c\x0c\xf5\xf2\xdf\xf1\xaf\xc8\x38\x9b\xdb\xc8\x94\x01\xb4\xed\xc5\x61\x02\x8e\x78\xb7\x8d\xb2\x16\x1d\x68\x1b\xd0\x75\xaa\x41\xde\xe0\x76\x9e\x2a\x5a\xf4\xe1\x38\x79\x7f\x28\x21\x96\x84\x51\xaa\x0a\x56\xb8\x9b\xfc\x30\x92\x74\x18\x06\x67\x3d\x28\x98\x92\xe2\x04\xc0\xf3\xa4\xe8\x06\xdb\x3c\x5a\x52\x24\xe2\x47\xd2\x0b\x68\x7d\x22\x5b\x42\x11\x83\x16\xe3\xae\x25\xdc\x0b\x4e\x3f\xfe\x85\xcb\x25\x63\xef\xe5\x15\x59\x8b\x4d\x28\xf2\x69\xcb\x9c\x11\x4a\x91\xe9\x2e\xc6\xbd\x5a\x82\xd5\x86\xf3\xca\x46\x7a\xfc\x37\x42\x88\xec\x20\xc4\x34\xf8\x8f\x49\xe9\xfb\xb6\xbe\x04\xde\x64\x64\x76\x99\xbe\x92\x2b\xd6\x8e\x14\x8b\xbc\xad\xf3\x72\x01\x47\x75\x2f\xa1\xf5\x87\x05\x23\xb3\x34\x31\xd3\xc2\xc3\x9b\x09\xb3\x84\x6b\xab\x83\x56\x46\xff\x89\x45\x13\xf6\x90\xce\x87\xbc\x1a\xbf\x49\x56\x26\xe9\x65\xda\xef\xda\x76\x54\xe4\x5b\x74\x1d\xb9\x8d\xb6\x3d\xb4\x35\x1c\x1d\x22\x65\x5e\x8a\xcc\x79\x96\x21\x19\x50\xde\xe0\x68\xca\xe2\x24\xec\x9d\xf7\x18\x98\xba\x2f\xca\x85\xc8\x32\xa6\x56\x58\x36\xdf\x2c\xf1\x6f\xbf\xd7\x77\xe1\x54\xe3\x2c\x3b\xb2\xf8\x80\xf5\xd0\x77\x45\xae\xb6\x5b\x73\xa7\x6d\x2f\xa5\x84\xd7\x3f\xf2\x05\x30\x46\x29\xb2\x59\xd4\xf3\x3d\x8b\x29\xe0\x50\x72\xd1\xe6\x9a\x4d\x64\xff\xaf\xc3\xfa\xda\xfa\xa0\x6c\x83\x85\xfb\x65\xbd\xe6\x52\x6d\x66\x94\x74\x0a\xe5\x0a\x77\x0c\x34\x16\x61\x86\xcb\x8f\x64\xd8\x0c\x0b\xf0\xf2\x58\xaa\x17\xed\x94\x22\x96\xb0\x91\xff\xdb\x16\xe5\xdb\xf3\xf8\x69\xfe\xc8\xe4\xa3\x73\x2b\xba\x5a\x2b\xdb\xe3\x53\x12\x16\xb9\xa5\x93\xf2\x41\x20\x88\x92\x72\x15\xb3\x03\xa0\xf1\x69\xd9\x29\x93\xec\x30\x5a\xf4\x1c\x6a\xc6\x81\x86\x36\x5b\x83\x01\x5b\x09\x8d\x21\xcf\x36\x19\x9d\xa1\x3b\xf0\xe8\xdc\x02\xda\x49\x31\x79\x65\xc8\x23\xe7\xe2\x4f\x92\xf9\xf9\x13\xda\x93\xbf\x0f\xb5\x20\xe7\xe5\x47\xfe\x74\x45\x3e\x5a\x2b\xee\x82\x3c\xb7\x7c\xfd\x03\xa6\xce\xfe\x60\x34\x5f\x9c\xec\x5c\x3e\x38\x63\xcf\x1f\x8f\xc4\x6d\x3e\x06\xf3\x12\x2f\xdb\x7a\x62\xfe\xcc\xda\x7f\xa1\x32\x61\x7d\xf7\xec\xea\xcf\xda\xf6\xcf\x2e\xbe\x41\xd5\xf2\xd2\xa2\x26\x32\x0f\xfa\x4c\x2a\xf1\xe5\xf2\x14\xe5\x91\x11\xd2\x46\x9d\x32\x1e\x1f\x37\x95\xe0\x06\x9c\xbb\x42\x55\xc1\xd6\x51\x83\xde\x43\x8d\xbd\x8e\x6d\x72\x56\x30\x38\x65\xbd\x6a\xd8\x1c\x0b\x08\x6b\xb4\xd0\x28\x63\x3c\xa0\x6a\xd6\xd0\x59\xd0\x16\x3a\xeb\xf9\xc3\xc0\x0b\xd8\x2a\xcf\xd5\x16\x55\xc5\x63\xb1\x4d\x07\x8a\xd1\x12\xae\x3b\x50\xc0\xb9\x32\x5a\xaa\x63\x04\x3d\xdd\x05\xb4\x07\x47\xc6\x60\x0b\xb5\x6a\xbe\x81\xb2\x2d\xe8\xe0\x53\x7f\x67\x58\x9f\xda\x39\x9b\x8b\x21\x8d\x99\x41\x3d\x28\xbe\x92\x86\xa6\x41\xdf\x0d\xe6\x49\xf0\x86\x36\x1b\x1d\x02\xb6\x11\x3a\xac\x91\xb9\x4e\x88\xa9\x54\xda\x43\xe7\x68\x03\x81\xdb\x38\x87\xcb\xa7\x6a\x94\x54\x7b\xaa\x71\x2e\xe0\xfc\x86\x5a\x44\x9d\xa4\x94\x0c\xf4\xf4\x92\xb0\x4f\x17\xe7\x97\xfd\xe3\xf5\xc9\x45\x47\x33\x65\x61\x3f\xb7\x9d\xe8\xa7\xf7\x5c\xbc\x2f\xfb\x3d\x83\xc7\xe2\xbe\xa0\xa3\x64\x2d\x76\xe8\xa2\x7e\x45\x09\xf7\xf0\x15\x96\x9c\xf4\x0d\x19\xc3\xea\x17\x25\x1c\x8a\x12\xaa\x0a\x5a\x42\x0f\x96\xc2\x9a\x8f\xb2\xee\xa2\xb0\xa3\x34\x50\xa3\xa1\x1d\x97\x65\xd4\x9d\x85\x17\x22\xeb\xc8\xc1\x57\x4e\x9a\x6f\x02\x17\xdb\x0f\x0b\x70\xd2\xa4\x2e\x97\xd0\xd9\x91\x6c\x48\x09\x3f\xf6\xf1\x14\xbc\x8c\xb7\x2a\x9f\xfa\x15\xdd\xd0\x6e\x04\xca\xb2\x38\x15\xdf\x79\x43\x6a\x09\x45\x7c\x7a\xc9\x15\x85\x4f\x34\xd8\x76\x01\xb9\x4b\xd7\x0f\xec\x74\x58\x43\x58\xab\x00\xba\xe5\x5c\xa0\xe3\x08\xee\x4a\x19\x4b\xf1\x74\x8f\x4b\x63\xb3\x13\xc6\x13\x5b\x55\xf1\xa2\x69\xff\x3b\xa0\xbb\x7b\x67\x5b\x7e\xaf\xb0\x04\x8a\x3b\x5f\xad\xf9\x89\x45\x16\xa8\x63\xd2\xfb\x7f\x7a\x58\xcd\xc1\xa9\xda\x6c\x3e\x36\x5e\x04\xb8\xa1\xdd\x7e\x76\xc1\xc0\xe7\x07\x8e\x6f\x1d\x08\x04\x9e\xe1\x59\x72\x87\x7e\x30\xf1\xe5\x43\xd1\x21\xc9\x97\x8f\xb8\xbc\xc0\x60\xdf\x39\x3c\xdd\xb6\xcf\xda\x8d\x65\x76\xb4\xf3\x47\xa7\xf1\xd3\xe6\x51\x36\xc7\x2a\x46\xd4\x54\xcc\x17\xdb\x8f\x77\x98\x9b\xa9\xc8\xaa\x0a\xbe\xac\x71\x84\x62\x51\x71\xbf\xc5\x86\x0f\x6c\xa0\x74\x50\x41\x01\xab\x64\x10\x1c\xed\xc0\x53\x72\xa4\x43\x0e\xe7\xca\x2a\xe8\xb4\xf3\x21\x62\x8d\x21\x1b\x1c\xbd\xbb\x56\x1e\x7a\xb2\x08\x3b\x47\xb6\x97\x91\xe3\xab\x48\x60\xc5\x89\xf0\x5b\x6e\xb4\x15\xdb\x96\x87\x3f\x3a\x57\x94\x0f\xae\xd6\xd9\x9b\xc9\x7d\xa7\xbe\x1c\x8d\x73\x96\xe6\x2c\xc4\x84\x79\xf6\x92\x2d\xdf\xfe\x5a\xa6\xd9\x85\x0f\x84\x3a\x88\xbf\x06\x00\xa9\x4a\x34\x9b\x09\x0d\x00\x00"
 
 func internalStatePostgresGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -704,7 +769,7 @@ func internalStatePostgresGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _internalStateStoreGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xdb\x8e\xdb\x36\x13\xbe\x16\x9f\x62\xa0\x8b\x1f\xd2\x0f\x5b\xc6\xa6\x28\x50\x18\x75\x81\xc4\x9b\x16\x01\x82\x4d\x90\x6d\xaf\x82\x20\xa1\xa5\xb1\xcd\x46\x12\x15\x72\xb4\x1b\xc7\xf1\xbb\x17\x43\xea\xe8\x95\xb7\x29\xd2\xfa\xc6\xe2\x1c\xbe\x39\x0f\x59\xc9\xf4\xa3\xdc\x21\x58\x92\x84\x42\xa8\xa2\xd2\x86\x20\x12\x41\x98\xea\x92\xf0\x33\x85\x22\x08\xd1\x18\x6d\x2c\x7f\x29\x1d\x0a\x00\x80\x90\x54\x81\xa1\x10\x41\xb8\x53\xb4\xaf\x37\x49\xaa\x8b\x45\x5a\x4a\x52\x77\xb8\xa8\x3e\xee\x16\x7b\x94\x39\xed\x43\x11\x0b\xb1\x58\xec\xf4\x72\x87\x25\x1a\x49\x08\x16\xcd\x9d\x4a\x71\x53\xab\x3c\x43\x03\xea\xde\xc8\x0a\xe6\x5f\x60\xbe\x85\x64\x61\x49\x1b\x4c\x76\x1a\xe6\x0a\x6e\xf9\x1b\xe6\x73\x5d\x53\x55\xd3\x3c\x53\x06\x92\x05\xcc\x2b\xef\x2a\xcc\x0b\xb8\x64\x3b\xd7\xbb\x50\x88\x54\x97\xd6\x45\xb2\x58\xc0\xd3\xdb\x35\x3c\xb5\x29\x96\x99\x2a\x77\x60\x39\x44\x6d\x32\x34\x22\x60\xce\xad\x36\xf4\x8a\x8f\xb0\x02\xa5\x49\xc2\x1c\xae\x9c\xda\xf5\xf3\xdb\x35\x28\x0b\xd7\x38\xa9\xcb\x6c\x0e\xf0\x4e\x9a\xc6\xce\x73\x63\x6e\x34\xbd\x28\xaa\x1c\x0b\x2c\x09\x33\x28\x35\x81\x2a\xaa\xbc\x39\x1e\x90\x44\xf0\x50\x6a\x05\x3e\xc5\xc9\x0d\xde\x47\x61\xab\xd3\x70\xc3\x58\x78\x6f\x70\x2b\xeb\x9c\x5e\xcb\x1d\xde\xaa\x2f\xc8\x8e\xd1\x1e\xa1\xac\x8b\x0d\x1a\xd0\x5b\x30\xfa\xde\x82\x41\xaa\x4d\x89\x19\x6c\x0e\x90\x79\x0d\x11\x9c\xab\xae\xe0\xc9\x8f\xbe\x34\x7d\xec\xaa\xcc\x54\xca\x99\x65\x12\x38\x9a\xa0\x43\x85\x30\x14\xa1\x9f\x58\x09\x8e\x47\x48\xde\xa0\xd5\xb5\x49\xf1\x46\x16\x08\xa7\x13\x98\xe6\x0c\xf7\x8a\xf6\x90\x9a\x3a\xf3\xea\x53\xb2\x96\x4c\x9d\x12\x1c\x45\xf0\xe2\x1a\xba\x9f\x25\xc3\x29\x06\x80\x0f\xd9\x66\x19\xaa\x2c\x84\x3f\xad\x2e\xf9\x6b\xa6\x0b\x45\x58\x54\x74\x08\x3f\x88\xc0\xc1\x4c\x2b\x95\xb2\xc0\x56\x8d\xbf\xc7\x8a\x5c\x48\xa3\x2a\x52\xba\x3c\x57\xcc\x7a\x56\xab\x3f\x20\x8d\x61\xd6\x06\x25\x61\xf6\xec\xf0\xd0\x7e\xea\x59\xef\x37\x87\x16\xa5\xa7\x8c\x41\xfe\xa8\xb2\x4b\x20\xb5\x67\x0d\x40\x7a\xca\xa4\x27\x4f\x09\x00\x78\x24\x93\xdf\x55\x81\x63\x4f\x24\x9d\x7b\x22\x69\xd2\x93\x29\x90\xd6\x6e\x0f\xd2\x53\x46\x20\x27\xd7\x17\x7e\x66\x2b\xa3\xef\x54\x86\x16\x64\x9a\xa2\xb5\x40\x1a\x32\x49\x12\x68\x2f\x89\xbb\xd6\xe0\xa7\x5a\x19\xcc\x60\xab\x0d\x24\x4d\x93\x39\x4d\x55\x12\x9a\xad\x4c\xd1\xb5\x46\xa9\x48\xc9\x5c\x7d\xc1\x28\xa5\xcf\xd0\xec\xa3\x64\xed\xff\x63\x3f\x32\x22\x50\x3a\x59\xe7\xda\xf2\x40\xfa\xa5\x93\xbc\x36\x7a\x83\xa2\x4d\xce\x44\xff\x4d\xe1\xcd\xc0\x4c\xb5\x6a\x0c\xd1\x04\x75\xe6\x8d\xc7\x22\xf8\x0d\xe9\x9b\x0d\xa8\xac\x29\xf4\xdf\x81\xbe\x54\x76\x0a\xd5\x4e\xc3\xe6\xca\xd2\x1b\xfc\x04\x2f\xfd\x7f\x8d\x96\x62\x88\xde\xbe\x7b\xd4\x84\xaf\xf9\x7f\x9b\x9b\x6b\xcc\xf1\x1f\xd8\x18\xa4\xc7\x97\xd6\xf7\xd4\x20\x2c\xa8\x6d\xd3\x35\x1c\xb3\x2a\x77\xbe\x77\x86\x12\xc3\x0e\xe2\x79\x62\x8b\x51\xdc\x00\x8b\x80\x77\x99\x2a\x77\x6e\x9d\x45\x71\xbf\xda\x3c\xe7\xd9\x21\x8a\xe1\xed\xbb\x56\x98\xd7\x65\x14\x33\xe4\x0f\x4f\xfc\x89\x97\x67\x47\x39\x09\x6f\x3e\x1f\x98\xef\x16\x1b\xdb\x2e\xbb\x35\xd5\x22\x5a\x67\x84\x29\xbd\x15\xdb\xad\xd7\x81\x37\x55\xbb\xa9\x5b\xeb\x4c\xf0\x58\x9d\xf1\x26\x37\xaf\xfc\x3a\xbb\x9c\x9a\x46\x60\x34\x5b\xb2\xaa\xf2\x43\xf4\xff\x81\xeb\xb1\x38\x79\x15\xed\xc4\x7f\xad\xcb\x14\xb6\x75\x99\x9e\x09\x09\xa6\x41\xb4\x1d\x88\xc5\xe0\xd1\x2c\x8c\x44\xd9\xcc\x36\xb2\x71\xe3\xea\x0d\xde\x4f\x56\xd2\xa2\x34\xe9\x9e\x1d\x76\xc8\x63\xb1\xc8\xa5\xd0\x67\x6a\xc6\x26\x2d\x24\x49\xd2\x07\x15\x8f\x6a\x7f\xe4\x6b\xd2\x22\xd5\x55\x7b\xf3\x59\x11\xe4\x3c\x1a\xcb\x15\xfc\x6f\xe0\x9b\x2f\x4f\x5b\xa2\x65\xff\x39\x13\x41\x53\xa3\xe5\xb0\x48\x47\x7f\xad\x9c\x5a\xb6\x2b\xd2\x92\x5f\x15\x4c\xe1\xd2\x34\x18\x57\xed\x99\x6b\xb7\x6c\x6a\x15\x9d\xdd\xbe\xf1\x4c\x04\x27\x21\x02\x8e\xfe\xbd\x8b\x8a\xfd\x33\xb2\xdc\xa1\x0f\xf1\x28\x82\x40\x57\x94\xf8\xb4\x72\x00\xb1\xd7\xf0\x77\x3b\x30\x85\xb3\xea\x4b\x91\x9f\xa5\x7d\xd4\xf1\x70\x1c\xa8\x25\x1c\xc5\x65\xc5\x4b\xb3\x31\xc6\xe8\xe2\x7f\x1c\x68\x34\x4a\x0f\x11\x9e\x1d\x2e\xab\x0f\xc7\x8e\x73\xd1\x29\x72\x62\x1f\x57\x1b\xce\xe7\x03\x55\x66\xb6\xf7\x94\x9f\x43\xdf\xc3\x7c\x3f\x59\x24\xf7\x98\x6a\x18\xa9\xce\xeb\xa2\xb4\xde\x52\x13\x4e\xaa\x73\xd7\x7d\xed\x8e\x1a\x8c\x56\x6f\xa9\x9f\x8a\xc8\x0d\xcf\xb9\x93\xc7\xa6\x83\xd6\x0c\xb6\x5c\xf5\x1d\x76\x12\x41\xdb\x10\x69\xdf\x0e\xce\x26\xeb\xf4\x4a\x2b\x9e\x36\x2c\xb3\xa8\xa5\xcc\x20\x8d\x45\xe0\xfa\x23\x68\x93\x0b\x2b\x68\xd9\x22\x38\xc5\x83\xa8\xdb\x02\xb7\xb1\xd7\x16\x13\xf7\x3c\xf6\xaf\xdd\x3e\xe2\xae\x13\xf4\x78\x37\x7d\x47\xe0\x83\xe6\x81\x55\xfb\x88\xee\xbc\xe3\xfa\x4d\x54\xc4\x2d\x3e\xff\xc6\xf5\xce\xb9\xf6\x70\x54\x57\xe6\xef\xf2\xc7\xc1\xac\x9c\x0d\x11\x04\x6a\xeb\xad\xfd\x0c\x57\x3e\xe9\x9d\xc0\x95\x4b\xf0\xd8\x57\xb7\x9e\xbf\xd1\x5f\xd7\x97\xdd\x4e\xff\x77\xfc\x6e\x1e\xf2\x5d\x67\x77\xfe\x3b\x06\xc7\xf0\xf5\x6b\x7f\xfe\xe5\xc2\x2e\x1a\x06\xda\x20\x4e\x0b\x0e\x32\xf0\x57\x00\x00\x00\xff\xff\xc1\x29\x8a\xbc\x34\x0e\x00\x00"
+var _internalStateStoreGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xdb\x8e\xdb\x36\x13\xbe\x16\x9f\x62\x20\x04\x3f\xa4\x1f\xb6\x84\x4d\x51\xa0\x30\xea\x02\x59\x6f\x12\x04\x28\x36\x8b\x6c\x7a\x15\x04\x09\x2d\x8d\x6d\x36\x12\xa9\x90\xd4\x6e\x1c\x45\xef\x5e\x0c\x29\x59\x92\xd7\xce\xa1\xe9\x95\xa9\xe1\x1c\xbe\x99\xf9\x86\xa4\x2b\x9e\x7d\xe0\x5b\x04\x63\xb9\x45\xc6\x44\x59\x29\x6d\x21\x62\x41\x98\x29\x69\xf1\x93\x0d\x59\x10\xa2\xd6\x4a\x1b\x5a\x09\x15\x32\x00\x80\xd0\x8a\x12\x43\xc6\x82\x70\x2b\xec\xae\x5e\x27\x99\x2a\xd3\x4c\x72\x2b\xee\x30\xad\x3e\x6c\xd3\x1d\xf2\xc2\xee\x42\x16\x33\x96\xa6\x5b\xb5\xd8\xa2\x44\xcd\x2d\x82\x41\x7d\x27\x32\x5c\xd7\xa2\xc8\x51\x83\xb8\xd7\xbc\x82\xf9\x67\x98\x6f\x20\x49\x8d\x55\x1a\x93\xad\x82\xb9\x80\x5b\x5a\xc3\x7c\xae\x6a\x5b\xd5\x76\x9e\x0b\x0d\x49\x0a\xf3\xca\x43\x85\x79\x09\xe7\x62\x17\x6a\x1b\x32\x96\x29\x69\x5c\x26\x69\x0a\x4f\x6e\x57\xf0\xc4\x64\x28\x73\x21\xb7\x60\x28\x45\xa5\x73\xd4\x2c\xa0\x9d\x5b\xa5\xed\x4b\xfa\x84\x25\x08\x65\x39\xcc\xe1\x82\x05\x69\x0a\x57\x4f\x6f\x57\x20\x0c\x5c\xe1\x49\x5b\xda\xa6\x04\xef\xb8\xee\xe2\x3c\xd5\xfa\x5a\xd9\x17\x65\x55\x60\x89\xd2\x62\x0e\x52\x59\x10\x65\x55\x74\x9f\x7b\xb4\x2c\x78\xa8\xb5\x04\x5f\xe2\xe4\x1a\xef\xa3\xb0\xb7\xe9\x76\xc3\x98\x39\xe7\x57\xb8\xe1\x75\x61\x6f\xf8\x16\x6f\xc5\x67\x24\x60\x76\x87\x20\xeb\x72\x8d\x1a\xd4\x06\xb4\xba\x37\xa0\xd1\xd6\x5a\x62\x0e\xeb\x3d\xe4\xde\x82\x05\xc7\xa6\x4b\x78\xfc\x2b\x21\x4f\xd3\x21\x77\x21\x73\x91\x51\x87\x48\x04\x4e\xc6\xec\xbe\xc2\x51\x79\x84\xb4\xbf\x31\xd6\x34\x73\xd0\x5c\x6e\x11\x1e\x69\x58\x2c\x21\x79\x85\x46\xd5\x3a\x43\x03\x6d\xcb\x9a\x06\x2c\x96\x55\x41\x9e\xc2\x52\xe5\x58\x84\x10\x3d\x4a\x9e\x29\xdd\xab\xc1\x23\x1d\x7b\xcd\x39\xa0\xcc\x69\xc9\xd2\xb4\xeb\x77\xa5\xd5\x9d\xc8\xd1\x00\xcf\x32\x34\x06\xac\x82\x9c\x5b\x0e\x76\xc7\x2d\x65\xac\xf1\x63\x2d\x34\xe6\xb0\x51\x1a\x92\x0e\xa0\xb3\x14\xd2\xa2\xde\xf0\x0c\xa1\x61\xc1\x0b\x29\xac\xe0\x85\xf8\x8c\x51\x66\x3f\x41\xc7\xe5\x64\xe5\x7f\x63\x5f\x6e\x16\x08\x95\xac\x0a\x65\x88\x08\x9e\xb0\xc9\x8d\x56\x6b\xfc\xa1\x14\xd1\xee\x54\x6e\xbe\x23\x49\x9f\xe6\x9f\xc2\xd8\x57\xf8\xb1\x46\x63\xa1\x36\x5d\x22\x85\x30\x56\xc8\xad\x4f\x67\xac\x31\x4e\x8a\xe6\xee\x9a\x97\x18\xc5\x60\xac\x26\xf5\x80\x5a\x23\xe4\xd6\x75\x27\x8a\x87\x4e\xf9\x9d\xcb\x7d\x14\xc3\x9b\xb7\xbd\x32\x11\x27\x8a\x41\x48\xfb\xcb\x63\xff\x45\x34\x3a\x48\x5a\xe6\xc3\x17\xa3\xf0\xc6\xea\x3a\xb3\x5d\x6c\xc9\x4b\x24\x0c\x70\x08\x4f\x83\x74\xb9\x27\xc9\x10\xc5\x1c\xd8\x32\x42\x53\xf5\xc4\xeb\xa3\x93\x80\xec\x7a\xc1\x50\x9b\x97\x95\x15\x4a\x7e\xa5\x34\x9d\xc2\xa4\xdd\xbc\xaa\x8a\x7d\xf4\xff\x11\xf4\x98\xb5\x3e\x1d\xe5\xd4\x9f\xd5\x32\x83\x4d\x2d\xb3\x23\x25\x46\x32\x88\x36\x23\xb5\x18\xbc\x37\x03\x13\x55\x62\xd5\x26\x32\x71\x07\xf5\x1a\xef\x4f\x76\xd2\x20\xd7\xd9\x8e\x00\x3b\xcf\x53\xb5\xc8\x95\xd0\x57\x6a\x46\x21\x0d\x24\x49\x32\x24\x15\x4f\x7a\xdf\xd0\xd4\x1b\xb4\x75\xd5\x0f\xb2\x61\x41\xf1\x0a\x3f\xd2\xd0\xfd\x6f\x84\xcd\xb7\xa7\x6f\xd1\x62\x58\xce\x58\xe0\xfa\x71\xb9\x5f\x8c\x9b\xd4\x84\xb4\x19\xb6\xfd\xb6\x6b\xd2\x82\x0e\x49\x92\x50\x6b\x3a\x1f\x17\xfd\x37\x11\x65\xd1\xf5\x2a\x3a\x3a\x4c\xe2\x19\x0b\x5a\xc6\x02\x6a\xd6\x3b\x97\x15\xe1\xf3\x07\x84\x4b\xb1\x61\x41\xa0\x2a\x9b\xf8\xb2\x52\x02\xb1\xb7\xf0\x47\x15\x90\x84\xaa\xea\x0a\x16\x15\x47\x65\x9f\x30\x1e\x9a\x91\x59\x42\x59\x9c\x37\x3c\x37\x1b\x53\x1f\x87\xfc\xbf\xee\x68\x32\x4a\x0f\x3d\x5c\xee\xcf\x9b\x8f\xc7\x0e\x9a\x91\x21\x15\xf6\xeb\x66\xe3\xf9\x7c\x60\x4a\x9b\x1d\x19\x3d\xc2\x8e\xc3\x74\x64\x1a\xb4\xee\x6e\xe8\x36\x32\x55\xd4\xa5\x34\x9e\xec\x5d\x3a\x99\x2a\x1c\xfb\x3c\x1b\xe3\xf1\x68\x0d\x91\x86\xa9\x88\xc8\xf6\x01\xc8\xa6\x63\xd0\x8a\x9c\x2d\x96\x03\xc3\x5a\x16\xf4\x84\xc8\x06\x3a\xb8\x98\x64\x33\x18\x2d\x81\x57\x15\xca\x3c\xea\x25\x33\xc8\x62\x16\x38\x7e\x04\x7d\x71\x61\x09\xfd\x36\x0b\xda\x7e\x04\xc7\x0d\xee\x73\xaf\x0d\x26\x44\x64\xf0\x97\xf7\x90\xf1\x81\x09\x6a\x7a\x36\xfd\x44\xe2\x23\xf2\xc0\xb2\x7f\x13\x1c\xd0\x51\xff\x4e\x74\x84\x9a\xde\x5d\xd9\x1e\x1c\xe9\x45\x4e\xea\xa6\xeb\xa7\xf0\x38\x37\x4b\xa0\x1f\x16\x04\x62\xe3\x56\xf0\x3b\x5c\xb8\xfd\x41\xe1\xc2\x15\x78\x8a\x95\xe8\xf4\xbd\x78\x49\x37\xea\x39\xf8\x1f\xe1\xee\xde\x25\xfd\x72\xc0\x4f\x5f\x2e\x87\x2f\x5f\x86\xef\x3f\xce\x9c\x45\xe3\x44\x3b\x8f\xa7\x15\x47\x15\x68\x1a\x3a\x5f\x85\x1c\xde\x2b\x6d\x4b\x55\x69\x1a\x48\x6e\x85\xdc\xd6\x05\xd7\xc9\x0d\x37\x19\x2f\xa0\x6d\x41\xf7\x37\xfc\xbd\xb0\x3b\xc8\x74\x9d\xfb\x8b\xe6\x8c\xfa\xe1\x0a\x0d\x5e\x5c\xf5\xc7\x74\x7f\x83\xd2\xea\x7d\xbe\x5e\x84\x22\x0f\xe1\x6f\xa3\x24\xad\x66\xaa\x14\xf4\xbc\xb0\xfb\xf0\xfd\xe8\x21\x92\x3c\x13\x58\xe4\xee\xfd\x11\x50\xa8\xe7\x8a\x4e\x46\x02\xe4\xbf\x5e\x13\x86\xb6\xf5\xfe\x48\xb4\x72\x43\x0f\x6d\xdb\xbb\x9e\x08\x1f\x44\xe9\x1e\x29\xc1\x4a\x23\xb7\x98\x5f\xee\x1f\xa2\xcc\xfc\xd6\xbb\xf5\xbe\x77\x39\x48\x26\xfe\x82\xbf\xaa\xfc\x9c\x93\xba\xca\x8f\x9c\x0c\x92\xa9\x93\x0e\xc9\x13\x0b\x00\xf4\x77\x23\x79\x2d\x4a\x9c\x22\xe1\xf6\x18\x09\xb7\x27\x91\x9c\x72\xd2\xc7\xe5\xf6\x18\xc9\x91\x93\xe1\x15\x37\x6f\xa7\x74\xe9\xdf\x7e\x43\xe1\x4e\xb3\xe0\xd4\x23\x74\x06\xfa\x0c\xc5\x62\x88\x4e\x6f\xcc\xfc\xbb\x35\x66\xc1\x73\xb4\x3f\x12\x49\xe4\x5d\x1b\xbe\xc3\x35\xcd\x31\xf9\xbe\x29\x6a\xcd\x8b\x6f\x79\xee\xa6\x79\xfc\x70\x89\x21\x7a\xf3\xf6\x5b\x51\x7c\x5f\x7e\x24\x87\x9f\xa8\xd6\x15\x16\x68\xf1\x5f\x16\xcc\x21\x9e\x30\xe0\x9f\x01\x00\x95\x9a\x78\x07\x34\x0f\x00\x00"
 
 func internalStateStoreGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -724,7 +789,7 @@ func internalStateStoreGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _internalStateStore_observerGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xff\x6b\xdb\xb8\x1b\xfe\xd9\xfa\x2b\xde\x8f\x61\xc3\xde\x82\x93\x8e\xb5\x1b\xdd\x27\x83\xa3\xbb\x71\xa3\x6b\x37\xb6\x72\x70\x94\x52\x14\xfb\x8d\x23\x2a\x4b\x46\x92\x9b\x86\xd2\xff\xfd\x78\x25\x39\x71\xd2\xb4\x37\x8e\xeb\x0f\xa9\x2d\xbd\x7a\xf4\xe8\x79\xbf\xc9\x2d\x2f\x6f\x78\x8d\x60\x1d\x77\xc8\x98\x68\x5a\x6d\x1c\x64\x2c\x49\x4b\xad\x1c\xde\xb9\x94\x25\xa9\xc1\xb9\xc4\xd2\x3f\x3a\xd1\x60\xca\x58\x92\xd6\xc2\x2d\xba\x59\x51\xea\x66\x5c\x2a\xee\xc4\x2d\x8e\xdb\x9b\x7a\x2c\x75\x1d\xa6\x75\xa1\x5b\x54\x25\x2a\xdb\xd9\x42\xe8\x31\x6d\x60\xd3\x27\x67\xc6\xb7\x02\x97\x7b\xa7\x1d\xaf\x53\x96\x33\x76\xcb\x0d\xf1\x1a\x8f\x41\xf2\x19\x4a\x0b\xdc\x20\xb8\x05\xf6\xaf\x6e\xc1\x9d\x1f\xb3\xa8\x2a\x70\x1a\x5a\xa3\x1b\x74\x0b\xec\x2c\x4b\xa2\xcd\x14\x2e\xaf\xac\x33\x42\xd5\xf7\x29\xcd\xe9\x2a\x7d\x60\x1e\xf3\x14\x57\x67\x7e\x00\x84\xdd\xa0\xd2\xe6\xd0\x59\xac\x60\xb9\x10\x12\xc1\x20\xc9\x23\x54\x0d\x0d\x3a\x23\x4a\xcb\x92\xf5\xc2\x11\x5c\xc3\x14\x1c\xaf\x8b\x73\x5c\x9e\xe2\x2a\xeb\x77\xc8\x19\x4b\xac\xd3\x06\x4f\xb8\x94\x5f\xb9\x43\x55\xae\x00\x00\xa6\x5e\x74\x5b\x7c\x96\x9a\xbb\xa3\xb7\x59\xea\x8d\xc6\x32\x58\xa4\x23\x48\x2f\x3c\x8f\xb0\x40\x28\x68\x84\x94\xc2\x62\xa9\x55\x65\xa1\x45\x03\x25\x97\x92\xec\x1a\x9b\xe6\x83\x3d\x4e\x74\xa7\x1c\xc0\x70\x8f\x2f\x6a\xb0\x03\x2d\xb3\xb4\x4e\x75\xcd\x0c\x0d\xe8\x39\xf8\x09\x8f\x67\xa1\xe1\x15\xd2\xec\xc1\x16\xe8\xef\xc6\x68\x13\x90\x9f\x04\xbd\x46\x32\x7a\x06\xda\xfb\xc8\xa0\xeb\x8c\xc2\x0a\xbc\x75\xbf\xd3\x96\x87\x7f\xee\xc8\xf5\xa7\xc0\x65\x94\x9c\x3c\x6b\xb0\x35\x68\x51\xb9\xe8\xa9\xbd\x0a\xb1\x64\x2f\xca\x14\x5e\x52\xa8\x15\xf4\x72\xcf\x92\xe4\x9c\x37\x78\x0c\xf1\x2f\x9c\xe5\x9a\xc8\x6e\xfc\xc0\x92\xe4\x0c\xb9\xed\x4c\xb4\xdb\xf5\x25\x19\x7c\x42\x5b\x1a\xd1\x3a\xa1\xd5\x71\xf0\x5b\x25\x28\xd0\x66\x1d\x0d\x91\x0c\x1b\xa6\x02\x6d\x3a\x62\x2c\xa1\x83\x7e\xdd\x70\x9f\x75\xe5\x0d\x3a\x7b\x1c\x26\x2e\x3f\x4e\x27\x8d\x1d\xc1\xc7\xe9\x9b\xc3\xf0\xff\x30\xbe\xbf\x8b\xef\x07\x93\xde\xa0\x7f\x78\xdb\x3f\x1c\xf5\x0f\xef\xfb\x87\x83\x60\x19\xcc\x82\x8d\xbd\x62\x49\xf2\x5b\x5d\x1b\xac\x79\xe0\xed\x75\xf9\x34\xe0\x9d\x4d\x46\xf0\xe6\x70\x04\x87\x93\x11\xbc\x3b\x1c\xc1\xc1\x84\x06\xe8\xe7\x2d\xfd\x1c\xd1\xcf\x7b\xfa\x39\x98\xc4\x99\x30\x15\xe6\x26\x39\x29\x73\xc1\xeb\x53\x5c\xd9\x20\xdd\xe5\x15\xe5\xc7\x29\xae\xee\xd7\x69\xf3\x10\x13\xf0\xe7\x56\xf4\x3e\xeb\xf0\x4d\x6c\x51\x35\xb2\x31\xc2\x42\xb6\x85\xb2\x40\x0e\xc4\x6a\x10\x01\x1b\xd0\x5f\xf6\x7f\x49\x4b\x9e\xf1\xbe\x87\xdc\xef\xfb\x48\x30\xc6\xbc\xf6\xa4\xb7\x48\x7a\xd8\xc7\xda\x7b\xc8\x8c\x64\xdb\x15\x65\x93\x7d\xff\xb5\x32\x3b\xc8\xbf\x2a\x4f\x48\xf5\x7f\x14\x69\x83\xfe\xac\x52\xc3\x1a\xb1\x5c\x88\x72\x11\x8b\x44\x45\x89\xe1\x77\xfa\xb7\x32\xe6\x8c\x8d\xc7\xf0\x09\xe7\xbc\x93\xce\x1f\x9a\x4e\xb6\x69\x1e\x55\x98\x89\xb8\xb7\x7e\xae\x35\xfa\x56\x54\x58\xc1\x6c\x05\x6e\x21\x2c\xc4\x26\x59\xf8\x0a\xf5\x18\x8b\xba\xca\xab\xa1\x6a\xfb\x0a\xcf\x68\x5f\x34\x8e\x9e\x74\xc4\x88\x3d\x78\xe6\x0a\x97\xde\xe2\xdb\xcc\xa2\xb9\xa5\xa0\x32\xc8\x1d\x5a\xe0\x81\x72\x3f\xce\xe6\x9d\x2a\x1f\x59\x67\x52\xd7\x35\x1a\x90\xba\x2e\xbe\xfa\xc7\x1c\x5e\x6d\xad\x83\x7b\x96\x04\xad\xe1\xe5\xd6\xc4\x7d\x58\x7a\x0c\xe1\xff\x43\xe4\xb3\xbd\x18\x55\xc9\x5b\xdb\x49\xcf\x08\xef\x5a\x6d\xa9\x37\xae\x8b\xbe\x6d\xb1\x14\x73\x51\xf6\xdd\x92\x9c\xf8\x7d\xdd\x95\x0b\xe6\x56\x2d\xee\x20\x5a\x67\xba\xd2\x11\xab\x47\xd4\x23\x83\xe8\xb1\x2f\xb5\xd2\x06\xab\xb3\x41\x6c\xf7\x71\x1e\x22\xc8\x47\xbb\x6e\x1a\xad\xe4\x0a\xe6\xba\x53\x15\x50\x25\xee\x62\xb4\x59\xe0\xaa\x22\x3c\xb7\xe8\x2c\x88\x80\x06\xcb\x05\x2a\x68\x0d\x4a\xcd\x2b\xa1\xea\xe0\xf1\xfd\x3b\x0e\xef\x12\x27\x52\x5b\xdf\x33\xff\x40\x2e\xdd\xc2\x37\xef\x1f\xc8\x2b\xff\x70\x92\x06\xe2\xdf\x03\x2c\xf8\x9c\x41\xe3\x09\xc0\x42\x58\xa7\x6b\xc3\x1b\x0b\x73\x6d\x00\x79\xb9\x88\xe7\xa0\x6d\x05\xb5\x49\xad\xc0\x16\xf0\x97\xee\xa0\xe4\x8a\x80\xb4\xcf\x20\x2e\xe5\x0a\x6c\xd7\xb6\x72\x05\x78\xe7\x0c\x8f\x87\x38\xdb\x52\x61\x29\xa4\x84\x19\x02\xaf\x28\xa0\x43\x12\x3d\xa7\xa2\xe1\xab\x22\xc4\x52\x66\x77\x42\x25\xef\x4f\x90\x89\x79\x09\x82\xce\x30\xe7\x25\xde\x3f\x8c\xc2\xfe\x3b\x58\x45\x51\x04\x79\x72\xf2\xa6\xd8\x9e\x3c\x9e\x02\x6f\x5b\x54\x55\xb6\x97\xc8\x5e\xc4\xa2\x28\x72\x96\x34\x1b\x84\x1a\x5d\x9c\x22\x46\x39\x4b\x48\xc1\xeb\x51\xaf\xdf\xf1\x14\x0c\x57\xf5\x26\x2e\xa8\x9e\x89\x39\xd8\x85\xee\x64\x15\xb0\xb3\x26\xde\xdd\xb6\xf9\x79\xca\x49\x42\x57\x60\xa1\x3a\x64\x89\x2f\xc8\x3e\x07\xbc\x36\x7b\x20\x20\x1c\x76\x17\x69\x1d\x24\x39\xcc\xb4\x96\x84\x1b\x59\x06\xbb\x0d\xcb\x9d\x75\x91\x6c\x04\x9f\x4e\x7b\x7b\x4f\x2c\x66\xac\x33\x91\x9b\xa7\x17\x07\xe7\x5c\x5a\x8c\xb9\x12\x5d\x07\xa2\x69\xb0\x12\xdc\xa1\xa4\xab\x46\x69\xb0\x41\xe5\xc2\x3d\x37\x46\xa3\x8f\xbe\xb8\x19\xc5\x65\x00\xa3\x32\x43\x07\x26\xac\x41\x3c\xe9\x08\xcb\x55\xdf\x89\x84\xc3\x86\x6a\x75\xd5\x19\x5f\x89\x61\xc6\x6d\x08\x5d\x5f\x64\xe3\xe8\x93\x81\x15\x9f\xb2\xd2\xdd\x41\xfc\xee\x28\x4e\xc2\xff\xb5\x3b\x7b\x19\x09\x23\xf3\x0e\x2a\xdd\xdd\x88\xba\x03\x69\x18\xef\xdd\x99\x1f\xa3\x97\x2f\xca\xa2\x71\xd9\xe0\x7a\x1e\x70\xf2\x9c\x91\xae\xb4\xec\x7f\x53\x50\xc2\xbb\x24\xb1\x45\x28\x37\x85\xaf\xc2\xf3\x2c\xfd\xcc\x85\x0c\x09\xd3\x6b\x18\x69\xbc\xb0\xc7\xf0\xe2\x36\xed\xe1\x3c\x81\x3c\x38\x20\xdc\x8a\x7f\x60\xa9\x4d\x15\x88\x6c\x5f\x16\x8a\xb3\xec\x20\xcf\x61\x3c\x86\x93\x28\x3a\xe5\xa3\xe1\xe5\x4d\x5f\xcd\x7d\x0b\xf4\x40\xc6\x5d\x88\xc6\x47\x07\x75\xf2\xe2\x5c\x2f\xb3\x7c\xe0\xe3\xb5\x06\x49\xe3\x53\x61\x1e\xbf\x21\xbc\xf1\x4f\xa1\x4a\xcc\xd6\x20\x79\x71\xce\x95\x8e\xb7\xe2\x8c\x08\xc0\x01\x1e\xd1\xa1\x9f\xe6\x1b\x1b\x57\x71\x96\x35\x36\xcf\x63\xe8\x8f\xc7\x83\x94\xa3\xcf\xa2\x10\x41\xf1\xeb\xb0\xef\x92\x74\xa8\x1a\xc3\x7d\x24\x6a\xa6\x38\x5d\x46\xb4\x1a\x96\x34\xbe\xa9\x20\x21\x2c\x86\xd9\xac\x86\xe5\x25\x5f\xe7\x90\x2f\x24\x73\x8a\xb3\xe9\xc6\x75\x51\x92\x75\x31\x8e\xd9\xe0\x7c\x66\x05\x6a\xc5\xc5\xaa\xc5\x6f\xf3\x4c\xa8\xe0\x7c\x57\x9c\x0a\x55\x65\x39\x45\x40\x6f\xf2\xdd\xf9\x5e\x98\xd0\xe7\xcd\x60\xec\x42\x67\x2e\xba\x57\x75\xcd\xa0\x7c\xb9\xe2\xbc\x7f\xcf\xb6\xcb\x52\xc3\x6f\x30\xeb\xe9\x8c\x60\xb3\x2c\x56\x28\x41\x46\x93\x0f\x20\xe0\xff\x83\xc9\x0f\x20\x5e\xbf\x0e\x1e\x0d\x03\x97\xe2\x8a\xbe\x27\x8b\xb8\x87\x20\x37\x36\xb8\x95\xea\xd1\x92\x3d\xb0\xbf\x03\x00\x00\xff\xff\xb0\x8d\x97\xc9\xc7\x0f\x00\x00"
+var _internalStateStore_observerGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x6d\x6b\xe3\x3a\x16\xfe\x6c\xfd\x8a\x73\x0d\xf7\x62\xdf\x6b\x9c\x74\x68\x3b\x43\x66\x33\xb0\x74\x76\xd8\xa1\xd3\xce\x30\x53\x16\x96\x52\x8a\x62\x1f\x3b\xa2\xb6\x64\x24\xb9\x69\x08\xfd\xef\xcb\x91\xe4\xc4\xc9\xa4\xdd\x61\xd9\x7c\x70\x64\xbd\x3c\x7a\xf4\x9c\x17\x1d\x77\xbc\x78\xe0\x35\x82\xb1\xdc\x22\x63\xa2\xed\x94\xb6\x90\xb0\x28\x2e\x94\xb4\xf8\x64\x63\x16\xc5\x1a\xab\x06\x0b\xd7\xb4\xa2\xc5\x98\xb1\x28\xae\x85\x5d\xf6\x8b\xbc\x50\xed\xa4\x90\xdc\x8a\x47\x9c\x74\x0f\xf5\xa4\x51\xb5\x1f\x56\xb9\xea\x50\x16\x28\x4d\x6f\x72\xa1\x26\xb4\x81\x89\x5f\x1c\x99\x3c\x0a\x5c\x1d\x1d\xb6\xbc\x8e\x59\xca\xd8\x23\xd7\xc4\x6b\x32\x81\x86\x2f\xb0\x31\xc0\x35\x82\x5d\xe2\xf0\x6a\x97\xdc\xba\x3e\x83\xb2\x04\xab\xa0\xd3\xaa\x45\xbb\xc4\xde\xb0\x28\xcc\x99\xc3\xed\x9d\xb1\x5a\xc8\x7a\x13\xd3\x98\x2a\xe3\x67\xe6\x30\x2f\x71\x7d\xe5\x3a\x40\x98\x1d\xea\xc4\xf2\x1a\x7a\x83\x25\xac\x96\xa2\x41\xd0\x48\xf2\x08\x59\x43\x8b\x56\x8b\xc2\xb0\x68\xbb\x30\x83\x7b\x98\x83\xe5\x75\x7e\x8d\xab\x4b\x5c\x27\xc3\x0e\x29\x63\x91\xb1\x4a\xe3\x05\x6f\x9a\x2f\xdc\xa2\x2c\xd6\x00\x00\x73\x27\xba\xc9\x3f\x35\x8a\xdb\xf3\xd3\x24\x76\x93\x26\x8d\x9f\x11\x67\x10\xdf\xb8\xd3\xb9\x57\x10\x12\x5a\xd1\x34\xc2\x60\xa1\x64\x69\xa0\x43\x0d\x05\x6f\x1a\x9a\xd7\x9a\x38\x1d\xed\x71\xa1\x7a\x69\x01\xc6\x7b\x7c\x96\xa3\x1d\x68\x99\xa1\x75\xb2\x6f\x17\xa8\x41\x55\xe0\xd6\x3a\x3c\x03\x2d\x2f\x91\x46\x4f\xf6\x40\xff\xa1\xb5\xd2\x1e\xf9\x45\xd0\x7b\xa4\x49\xaf\x40\x3b\x1b\x69\xb4\xbd\x96\x58\x82\x9b\x3d\xec\xb4\x67\xe1\x1f\x07\x72\xfd\x4b\xe0\x2a\x48\x4e\x96\xd5\xd8\x69\x34\x28\x2d\xd8\x97\x15\x62\xd1\x51\x94\x39\xfc\x41\xae\x96\xd3\xcb\x86\x45\xd1\x35\x6f\x71\x06\xe1\xe7\xcf\x72\x4f\x3a\xec\xec\xc0\xa2\xe8\x0a\xb9\xe9\x75\x98\x67\x0e\x60\x33\x16\x45\x1f\xd1\x14\x5a\x74\x56\x28\x39\xf3\x76\x2b\x05\x39\xda\xa2\xa7\x2e\x52\x78\xc7\x54\xa0\x89\x33\xc6\x22\x72\xbb\x00\x41\xdc\x17\x7d\xf1\x80\xd6\xcc\xfc\xc0\xed\x87\xf9\xb4\x35\x19\x7c\x98\xbf\x39\xf3\xff\x67\xe1\xfd\x6d\x78\x3f\x99\x0e\x13\x86\xc6\xe9\xd0\x38\x1f\x1a\xef\x86\xc6\x89\x9f\xe9\x9e\xa7\xee\x79\x6e\xee\x58\x14\xfd\xbd\xae\x35\xd6\x9c\x48\xce\xc0\xe9\xf2\x71\xc4\x3b\x99\x66\xf0\xe6\x2c\x83\xb3\x69\x06\x6f\xcf\x32\x38\x99\x52\x07\x3d\x4e\xe9\x71\x4e\x8f\x77\xf4\x38\x99\x86\x11\x3f\xe4\xc7\xa6\x29\x29\x73\xc3\xeb\x4b\x5c\x1b\x2f\xdd\xed\x1d\xc5\xc7\x25\xae\x37\xdb\xb0\x79\x7e\x66\xfb\x26\x77\x3e\xf6\xaa\xc1\x77\xbe\x45\xd9\xc8\x04\x0f\xf3\xd1\xe6\xd3\x02\x19\x10\x4b\x16\x1d\x01\xfd\x65\xfb\x17\xb4\xe4\x15\xeb\x3b\x9e\xc7\x6d\x1f\x08\x06\x9f\x57\x8e\xf4\x1e\xc9\x38\x3b\xaa\xbd\x83\x4c\x48\xb6\x43\x51\x76\xd1\xf7\xff\x56\xe6\x00\xf9\x57\xe5\xf1\xa1\xfe\x5f\x45\xda\xa1\xbf\xaa\xd4\x38\x47\xac\x96\xa2\x58\x86\x24\x51\x52\x60\xb8\x9d\xfe\x57\x19\x53\xc6\x26\x13\xf8\x88\x15\xef\x1b\xeb\xdc\x81\x4e\xb6\xbb\x3c\x4a\x3f\x12\x70\xc9\x0a\x86\x2e\x8e\x47\x51\x62\x09\x8b\x35\xd8\xa5\x30\x10\x2e\xc9\xdc\x65\xa8\x9f\xb1\xe8\x56\xf9\x73\xac\xda\x8f\x83\x0c\x41\xd3\xb2\x51\xf7\x56\xee\xec\x45\x43\x64\xec\xd9\x31\x97\xb8\x72\xcb\xbe\x2e\x0c\xea\x47\x72\x2a\x8d\xdc\xa2\x01\x0e\x66\xdc\xcf\xaa\x5e\x16\x3f\xcd\x4e\x1a\x55\xd7\xa8\xa1\x51\x75\xfe\xc5\x35\x53\xf8\x73\x6f\x1d\x6c\x58\xe4\xb5\x86\x3f\xf6\x06\x36\x7e\xe9\x8c\xd6\xd6\xa8\x9f\x03\x9f\xfd\xc5\x28\x0b\xde\x99\x9e\xf2\x9a\x01\x7c\xea\x94\xa1\xbb\x71\x9b\xf4\x4d\x87\x85\xa8\x44\x11\xfc\xd5\xc5\xc2\xb7\xed\xad\x9c\x33\xbb\xee\xf0\x00\xd1\x58\xdd\x17\x96\x58\xfd\x44\x3d\x30\x08\x16\xfb\x5c\x4b\xa5\xb1\xbc\x1a\xf9\xf6\xe0\xe7\xde\x83\xa8\xa7\x50\x6d\xab\x64\xb3\x86\x4a\xf5\xb2\x04\xca\xc4\x7d\xf0\x36\x03\x5c\x96\xa4\xb0\x5d\xf6\x06\x84\x47\x83\xd5\x12\x25\x74\x1a\x1b\xc5\x4b\x21\x6b\x6f\xf1\xe3\x3b\x8e\x6b\x89\x8b\x46\x19\x77\x67\xfe\x13\x79\x63\x97\x6b\x6a\x7e\x47\x5e\xba\xc6\x05\xd5\x18\x93\x09\x7c\xf3\xb0\xe0\x62\x06\xb5\x23\x00\x4b\x61\xac\xaa\x35\x6f\x0d\x54\x4a\x03\xf2\x62\x19\xce\x01\x25\x56\x82\xae\x49\x25\xc1\xe4\xf0\x6f\xd5\x43\xc1\x25\x31\x56\x2e\x82\x78\xd3\xac\xc1\xf4\x5d\xd7\xac\x01\x9f\xac\xe6\xe1\x10\x03\x3f\xaf\xc2\x4a\x34\x0d\x2c\x10\x78\x49\x0e\xed\x83\xe8\x35\x15\x35\x5f\xe7\xde\x97\x12\x73\xe0\x2a\xe9\x70\x82\x44\x54\x05\x08\x69\x51\x57\xbc\xc0\xcd\x73\xe6\xf7\x3f\xc0\xca\xf3\xdc\xcb\x93\x92\x35\xc5\xfe\xe0\x6c\x0e\xbc\xeb\x50\x96\xc9\x51\x22\x47\x11\xf3\x3c\x4f\x59\x34\x18\x79\x36\x87\x1a\x6d\x18\x22\x46\x29\x8b\x48\xc1\xfb\x6c\xd0\x6f\x36\x07\xcd\x65\xbd\xf3\x0b\xca\x67\xa2\x02\xb3\x54\x7d\x53\x7a\xb6\x49\x1b\x6a\xb7\x7d\x7e\x8e\x72\x14\x51\x09\x2c\x64\x8f\x2c\x72\x09\xd9\xc5\x80\xd3\xe6\x08\x04\xf8\xc3\x1e\x22\x6d\x9d\x24\x85\x85\x52\x0d\x6c\xb6\x2c\xfd\xbc\x1d\xcb\x83\x75\x81\x6c\x00\x9f\xcf\xc3\x38\x01\x44\x43\xc4\x5a\x1d\xb8\x39\x7a\xa1\xb3\xe2\x8d\xc1\x10\x2b\xc1\x74\x20\xda\x16\x4b\xc1\x2d\x36\x6b\x10\xb2\xd0\xd8\xa2\xb4\xbe\xce\x0d\xde\xe8\xbc\x2f\x6c\x46\x7e\xe9\xc1\x28\xcd\xd0\x81\xc9\x63\x46\xfe\xa4\x02\x2c\x97\xc3\x4d\x24\x2c\xb6\x94\xab\xcb\x5e\xbb\x4c\x0c\x0b\x4e\x55\xb3\x92\x6e\x8f\xa1\xf7\x45\xc7\x0a\xad\xa4\xb0\x4f\x10\xbe\x3b\xf2\x0b\xff\xbf\x35\xe7\x20\x23\x61\x24\xce\x40\x85\x7d\xca\xa8\x88\x84\xd9\xb6\xee\x26\x84\xcc\x15\xe1\x9f\xa5\x41\x6d\x93\x51\x79\xee\x71\xd2\x94\x45\xa2\x72\xcb\x7e\x9b\x83\x14\xce\x24\x91\xc9\x7d\xba\xc9\x5d\x16\xae\x92\xf8\x13\x17\x8d\x0f\x98\xc0\x6d\xa0\xf1\xbb\x99\xc1\xef\x8f\xf1\x00\xe7\x08\xa4\xe4\x1b\x54\x2b\x53\x55\xfc\x1d\x0b\xa5\x4b\x4f\x64\xbf\x58\xc8\xaf\x92\x93\x34\x85\xc9\x04\x2e\x82\xe8\x14\x8f\x9a\x17\x0f\x43\x36\x77\xd7\xb3\x03\xd2\xf6\x46\xb4\xe8\x4e\x26\x5a\xcc\xaf\xd5\x2a\x49\x47\x36\xde\x6a\x10\xb5\x2e\x14\xaa\xf0\x0d\x41\x05\x51\xfe\x43\xc8\x02\x93\x2d\x48\x9a\x5f\x73\xa9\x42\x55\x9c\x10\x01\x38\xc1\x73\x16\xbd\xc6\x37\x5c\x5c\xf9\x55\xd2\x1a\x52\x6c\x48\xff\xbb\x90\xa3\xcf\x22\xef\x41\xe1\xeb\x70\xb8\x25\x49\xb3\x1a\x7d\x69\x1e\x34\x93\x9c\xca\x34\x25\xc7\x29\x8d\xef\x32\x88\x77\x8b\x71\x34\xcb\x71\x7a\x49\xb7\x31\x44\xa6\x12\x15\xf9\xd9\x7c\x67\xba\x20\xc9\x30\x67\x13\xa2\xc1\x92\x2c\x81\x5a\x7e\xb3\xee\xf0\x6b\x95\x08\xe9\x8d\x6f\xf3\x4b\x21\xcb\x24\x85\xdf\x76\x53\xbe\x59\x4d\xf0\x91\x85\xbd\xbe\x1b\x95\xd8\x60\x5e\xd9\xb7\xc3\xd9\xc9\x2c\xf9\xf5\xf0\x9e\xec\xa7\xa5\x96\x3f\x60\x32\xd0\xc9\x60\xb7\x2c\x64\x28\x41\x93\xa6\xef\x41\xc0\xdf\x46\x83\xef\x41\xfc\xf5\x97\x63\x10\xa0\x6e\xc5\x1d\x7d\x4f\xe6\x61\x0f\x41\x66\x6c\x71\x2f\xd4\x5b\xb4\x4b\x55\x1a\xf6\xcc\xfe\x33\x00\xb0\x8d\x97\xc9\xc7\x0f\x00\x00"
 
 func internalStateStore_observerGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -744,7 +809,47 @@ func internalStateStore_observerGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeBaseDeploymentYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\x4f\x4b\xf3\x40\x10\xc6\xef\xf9\x14\x43\xef\xef\xdb\xc6\x5b\xf6\xaa\x20\x82\x48\x0f\xe2\x45\x3c\x4c\x37\x43\xb3\xb8\xff\x98\x1d\x22\xa5\xf4\xbb\xcb\xd2\x18\x37\x98\xd4\x3a\xa7\xd9\x79\xf6\xf9\xcd\x33\x18\xcd\x0b\x71\x32\xc1\x2b\xc0\x18\xd3\xba\xaf\xab\x77\xe3\x5b\x05\x77\x14\x6d\x38\x38\xf2\x52\x39\x12\x6c\x51\x50\x55\x00\x1e\x1d\x29\x38\x1e\xe1\xff\x13\x3a\x82\xd3\xa9\x02\xb0\xb8\x23\x9b\xb2\x0a\x19\x32\x95\x53\x24\x9d\x25\xa6\x68\x8d\xc6\xa4\xa0\xae\x00\x12\x59\xd2\x12\xf8\x6c\x72\x28\xba\x7b\x2c\x28\x33\x1c\x00\x21\x17\x2d\x0a\x0d\x9e\x22\x54\x2e\x3b\xb1\xcf\x02\x00\xbe\xc2\xe4\xd2\xc1\x0b\x1a\x4f\x3c\x9a\xfe\x0d\xd7\x25\xe2\x9e\x78\x24\x19\x87\xfb\xe1\xe6\x87\xdc\x0e\x40\xd5\x52\xff\xbd\x8d\xf7\x49\xc1\xeb\xf8\x06\x58\x9d\x29\xab\x71\xf4\x36\x76\x31\xb0\x14\x49\x17\xd6\x16\x09\xb7\x81\x45\x41\xdd\x34\xcd\xa6\x90\x23\x07\x09\x3a\x58\x05\xcf\xb7\xdb\x1f\xb4\x3d\x0a\x7d\xe0\xe1\x32\xae\xbe\x1a\xd7\x11\x5a\xe9\x2e\xd3\x6e\xae\xa6\x39\x12\x36\x3a\x2d\xe3\x9a\x7a\xf3\x7b\x36\x6b\x7a\xf2\x94\xd2\x96\xc3\x8e\x54\xf1\xbd\x13\x89\xf7\x24\xe5\x08\x20\xa2\x74\x0a\xd6\xd9\x34\x9d\xcf\xe4\x67\xc2\xd6\xfc\x19\x9d\x5d\x87\x25\xf6\x67\x00\x00\x00\xff\xff\xd4\x38\xf7\x7b\x6a\x03\x00\x00"
+var _internalStateResource_memoryGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xcd\x6e\xdb\x38\x10\x3e\x8b\x4f\x31\x21\x76\x0b\xa9\x50\xe8\x7b\x17\x3e\xb8\x4e\x53\x04\xe8\x06\xc1\xa6\x39\x05\xc5\x82\x91\xc6\x0a\x1b\x89\x74\x49\xca\x49\xa1\xea\xdd\x17\x43\xd9\xb1\x1c\xcb\x76\x9c\xee\xc9\x88\xe6\x9b\xff\x99\x8f\x93\xd3\xd3\x53\xf6\x78\x8f\xfa\x03\x68\xe3\x41\x9c\xa3\xf4\xb5\x45\x27\xae\x8c\xf3\x85\x45\xc7\x08\x30\x97\xd9\x83\x2c\x10\x9c\x97\x1e\x19\x53\xd5\xdc\x58\x0f\x31\x8b\x78\x66\xb4\xc7\x27\xcf\x59\xc4\x9d\xb1\x9e\xb3\xa6\x39\x05\x35\x03\x71\xae\xb0\xcc\x81\x6b\x59\x21\x87\xb6\x25\xb9\xb7\x4a\x17\xae\x83\xa0\xce\xbb\xaf\x5e\x55\xc8\x19\x8b\x78\xa1\xfc\x7d\x7d\x27\x32\x53\x8d\x0a\x63\x8a\x12\x47\x75\xad\xf2\xb5\xc1\x7f\xf0\x47\xad\x2c\xe6\xc1\xb0\xeb\x94\x7b\x3a\xf3\x87\x62\x84\xd6\x1a\xfb\xd2\x41\x67\x4c\x14\xa6\x94\xba\x10\xc6\x16\xa3\xc2\xce\xb3\x51\x66\x72\x74\x7c\xb7\x9c\x52\xad\xdd\xcb\xc8\x32\x2d\xbd\x5a\x60\xf0\x26\x6b\x7f\xcf\x59\xc2\x58\x66\xb4\xf3\xd0\x34\x20\xae\x95\x2e\xea\x52\x5a\x31\x95\x15\x96\xd0\xb6\x5f\xe5\x5d\x89\x30\x06\x4e\xd2\xab\xb2\xb6\xb2\x14\xd7\x5a\x3e\x20\xb4\x2d\x67\x7b\x72\x63\x0b\x69\x21\x0e\x00\x2b\x75\x81\x83\xf9\x8f\x46\xf0\xc9\xda\xbf\x95\x73\x4a\x17\x4d\x03\x7f\xac\x03\xb8\x92\x2e\x93\x14\x01\x39\xfe\x6c\x2e\x65\x45\x2e\xa1\xea\xb0\xb0\x09\xfe\x62\x1e\xd1\x92\x98\xc0\x53\x53\xd6\x95\x0e\xf6\x8f\x34\x3e\x86\xae\x01\xe2\x12\x1f\x63\x7e\x8c\x2b\x9e\xf4\x7b\xb6\xf1\x07\x9b\xd5\x3a\x83\x85\x2c\x55\x2e\x3d\x6e\x14\xf9\x39\x8c\xd8\xc2\xb0\x20\xe9\x22\x82\x86\x35\xcd\x9e\x3a\xaa\x19\x58\xf1\x22\x97\x31\x70\x0e\x0d\x8b\x22\x8b\xbe\xb6\xfa\xc8\x42\xb3\xa8\x25\x97\xcb\x1c\x56\x36\xb4\x2a\xd9\x2a\xa3\xd8\xc1\xfb\x0a\x2b\x63\x7f\x5e\x7b\x63\x31\x81\xa9\xc5\xdd\x09\x66\xfe\x09\x96\xab\x26\xa6\xdd\x6f\x0a\xbb\xb3\x8e\x87\x05\x69\x57\x8e\x04\x1a\x16\x92\x46\x6b\xe1\xc3\xf8\x60\x71\x93\xbf\x48\x0f\x4e\xc6\xa0\x55\xd9\xaf\xc9\xb0\x42\xd3\xf9\xa1\x12\xb0\xc8\x8a\x8b\x33\x18\x03\xad\x72\x18\x8b\x44\x5c\x07\x1a\x88\x13\x92\x75\x39\xe7\x1f\x7f\xc2\x18\x68\x9d\xc4\xb4\xb6\x16\xb5\xbf\x71\x68\xe3\xcc\x3f\x05\xd0\xcd\x3c\x7f\x06\xf5\x54\x7a\xfa\x13\x0f\x63\x20\x22\x11\x97\xe6\x31\x4e\xc4\xcd\xd7\x69\xdc\x57\x9d\xf8\xbe\xea\xc4\x33\x16\x39\x31\xaf\x7d\xbc\x7b\x67\x53\xa0\xc8\x53\xb0\x09\x63\xab\x74\x6d\xba\xbf\x83\x9f\xd1\x1f\xd3\x3e\x95\x83\x0b\xb5\x78\x75\xbf\x54\xbe\x3d\x97\xc3\x9a\xd4\x83\x8e\xc1\xc4\x27\x32\x11\x07\xba\x13\x17\x3a\xf4\x7a\x62\x8b\xba\x42\xed\x53\x78\xde\x52\x95\xf3\xa4\xeb\xd8\x22\x05\xf3\x40\x63\xe1\x44\x81\xfb\x4b\xa4\xf2\x24\xc4\x75\x62\x1e\x8e\x0f\x69\xb6\x8c\xe9\xd2\xf8\x73\x53\xeb\x3c\x05\xbe\xa1\xf8\xcc\x14\x8f\xca\xdf\x83\xca\xe1\xcf\x1f\xe1\x89\x9a\x11\x98\x2f\x9d\xb7\xeb\xee\x2c\xc4\x8e\x2a\x26\x07\xda\x76\x86\x25\x7a\x7c\x63\xe7\x56\xfc\xb2\xb3\x3f\x6f\xec\x02\x55\xd5\x89\x3c\x84\x76\xa8\x07\xbb\xdc\xfd\xdf\x15\xde\x5b\xc5\x6e\xd5\x8e\xaa\xa2\xfe\x2d\xfe\xca\x3a\xaa\x08\x5c\xd3\x8d\xeb\xde\xfd\x23\x7f\xe2\xe2\x2c\x79\x26\xbe\x37\xd1\xd9\xab\x49\x53\xe9\xdf\x61\x4d\xa5\x57\x64\x15\xa8\x71\x99\xea\xfa\x5b\x1f\x31\xf1\xdb\x88\x89\x0f\x88\x3e\x73\xee\xa0\xd7\x35\x6a\x98\x44\x5f\xc3\x94\xa1\xb0\xf4\xd3\xe3\x4a\xa5\x0f\x6c\xdd\x17\xe5\x7c\xef\x28\x3a\x30\x2b\x33\x1b\x14\xe8\x06\x42\xe7\x13\x88\x6f\xbf\xbd\x62\x44\x7a\xf6\x57\x21\x53\xeb\x76\xe9\x36\x2d\x8b\x66\xc6\xc2\xbf\x29\x2c\x08\xd7\x1d\x0c\x4e\x94\xca\xed\x2b\xc0\x72\xff\x48\x63\x37\x07\x85\x93\x26\x30\xd9\xd6\x65\xbc\x3a\x02\x2f\xeb\xb2\x24\x7b\xe1\x7c\xa0\x29\x9d\x59\x41\xe7\x44\x9c\xd0\x10\x71\x0e\xef\xde\x41\xdc\x7d\xa2\x67\x80\xa6\xea\xd7\x2f\x38\x59\x9e\xd5\x61\xaf\xa4\xd2\x2e\x7e\xdf\x61\xd2\xb5\x7e\x42\x21\x92\x1b\x2c\xdd\x5e\xf3\xdb\xc6\xb6\x6d\x41\xb3\x71\x5f\x47\x11\x35\x4b\xe9\x1a\x59\x14\xb5\x9b\xa2\xa1\xfa\x8f\x41\xce\xe7\xa8\xf3\x78\x40\x18\x9e\xdb\xb0\x67\xf4\xbf\x84\xb8\x2e\x55\x86\xc3\x38\x7a\x80\x63\x95\xc2\x77\x50\xda\x27\x70\x67\xcc\xd6\x8a\xbd\xd0\xb9\x55\xdf\xd6\xdb\x21\x3e\xe2\xcc\xd8\x41\xdb\xb7\xdf\x7b\x38\xe2\xbf\xde\x50\x0f\xc0\x53\xd0\xaa\x64\x2d\xfb\x6f\x00\x11\x43\x00\x16\x47\x0d\x00\x00"
+
+func internalStateResource_memoryGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_internalStateResource_memoryGoTmplt,
+		"internal/state/{resource}_memory.go.tmplt",
+	)
+}
+
+func internalStateResource_memoryGoTmplt() (*asset, error) {
+	bytes, err := internalStateResource_memoryGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "internal/state/{resource}_memory.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _internalStateResource_postgresGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x5d\x6f\xdb\xb8\x12\x7d\x16\x7f\xc5\x5c\xa1\x2d\xa4\x5b\x85\xc6\x7d\xcd\x85\x1f\xda\xb4\x0d\x0a\x74\x83\x6e\xb3\x7d\x5a\x2c\x16\x8c\x34\x52\xb8\x91\x49\x67\x48\xc5\x0e\x04\xfd\xf7\xc5\x50\xb2\x22\xa7\xb2\xb7\x6e\xf7\xa1\x48\x2d\x0e\xe7\xe3\xcc\x99\xc3\x39\x3b\x3b\x13\x9b\x5b\x34\xe7\x20\x3f\xa0\xf2\x0d\xa1\x93\x9f\xad\xf3\x15\xa1\x13\x7c\xb8\x56\xf9\x9d\xaa\x10\x9c\x57\x1e\x85\xd0\xab\xb5\x25\x0f\x89\x88\xe2\xdc\x1a\x8f\x5b\x1f\x0b\x11\xc5\x85\xf2\xea\x46\x39\x5c\xb8\xfb\x3a\x7c\xa8\xb4\xbf\x6d\x6e\x64\x6e\x57\x8b\xca\xda\xaa\xc6\x45\xd3\xe8\x22\xde\x3f\xf9\x6b\x65\x35\x59\xc3\x97\xb6\xb1\x68\xdb\x33\xd0\x25\xc8\x2f\x78\xdf\x68\xc2\xe2\x83\xc6\xba\x70\xd0\x75\xfb\x97\xd6\x77\xd5\x02\x89\x2c\xb9\xfe\x0a\x9a\x62\xb0\x09\x71\x64\x65\x6b\x65\x2a\x69\xa9\x5a\x54\xb4\xce\x17\xb9\x2d\xd0\xc5\x87\xcf\xb9\xb0\xc6\x3d\x4f\x3a\x37\xca\xeb\x07\x0c\xd1\x54\xe3\x6f\x63\x91\x8a\x23\x19\x8a\x07\x45\x90\x04\x03\x52\xa6\xc2\xd9\x2a\x16\x0b\x78\x4f\xf4\x8b\x76\x4e\x9b\xaa\x6d\xe1\x85\xbc\xd6\xa6\x6a\x6a\x45\xf2\xb3\x72\xb9\xaa\xa1\xeb\xda\x16\xe4\xa5\xbd\x52\x2b\x84\xae\x83\x55\x6f\x0b\xfb\xc6\x9f\xec\x06\x89\x8f\xd9\xf8\xc2\xd6\xcd\xca\x04\xff\x27\x3a\x5f\x42\x0f\xa3\xbc\xc2\x4d\x12\x9f\x12\x2a\x4e\xa7\xc8\xef\xfd\x10\x65\x63\x72\x70\xb9\x32\x9c\xdc\xb7\x29\x24\x7c\x64\x90\xc0\x79\x6a\x72\x7f\xdd\xff\x4a\x21\xb1\x30\x7f\x21\xe3\x2c\xf9\x9f\xa5\x14\x5a\x11\xe9\x92\x7f\xc0\x12\x06\x47\xf2\x7a\x74\x94\xbc\xb2\xe9\xff\xc3\xe9\x7f\x96\x60\x74\xcd\xe6\x11\xa1\x6f\xc8\x1c\x70\xde\xf6\xee\x45\xd4\x89\x9d\xa1\xcd\xf8\xaa\xd8\x55\x92\x38\xf8\xaf\xbb\xaf\xaf\xbd\x25\x4c\xe1\x82\x50\x79\x9c\xf7\x95\xe4\x7e\x0b\xc3\x4c\xc8\x8b\xfe\x6f\x06\x74\x20\x72\x0a\xc9\xfc\x41\xf6\x54\x6b\xdb\x1e\x21\x93\x2e\x81\xe4\xb3\x86\x2e\x21\x8e\x9f\x15\x3d\x4b\x83\x2e\x3b\x91\x88\x0c\x50\xdb\xee\x7a\x1c\x91\xfc\xf8\x0e\x96\xc0\x13\x1d\xc8\x93\x72\x13\xb4\xa9\x92\x54\x44\x24\x7b\x90\x8a\xb7\x8f\xb0\x04\x1e\x1d\x79\xd1\x10\xa1\xf1\x5f\x1d\x12\x83\x14\x8c\xbe\xae\x8b\xd1\x68\x72\x45\x8c\x0d\x3e\x5f\x82\x93\x6b\xb2\x39\x3a\xc7\xb7\x32\x78\x45\x19\x38\x99\x1f\x69\xc1\x8f\x77\x7f\x6c\x3f\x1d\x6b\x7f\x7e\x72\xfb\xfd\x36\xdc\xdf\xca\xdf\xb6\xcc\x05\x6d\x3c\x52\xa9\x72\x6c\xbb\x94\x43\x5b\xe2\x76\xe5\xd6\x38\x0f\xf7\x0d\xd2\x63\x0f\x04\x2c\x21\xd6\xc6\x21\x79\xd0\xc6\xf7\x93\xf1\xb9\x6e\x48\xd5\xf2\xda\xa8\xbb\x30\xbd\x89\x2e\x32\x78\x62\xc8\xc8\x8c\xbd\x41\x0d\x16\x7d\xd7\xfa\xdc\x8b\x3f\x6f\x1e\x33\x68\x7a\xf0\xc3\xff\x77\xdf\x95\x7f\xfa\xae\x7c\x0a\x0f\xaa\x6e\xd0\x41\x72\x7e\x20\xce\xf9\xa1\x40\xe7\xd3\x48\xe7\xd3\x50\xc6\x6e\x92\x74\xf8\x93\x42\x0f\x38\x4b\xce\x7c\x0a\xf1\xd8\x13\xa3\x56\x58\xfc\xca\xf0\xbc\x31\x05\x2b\x06\x63\xcd\xd8\x66\x53\xd0\x32\xa0\xf4\x40\xdf\x2e\xd1\x9f\xd2\x34\x5d\xb0\x3a\x69\x53\x7d\xbf\x2c\x05\xda\xea\xe2\xdb\x11\xb4\x59\x78\x39\x1b\x27\xdf\xb3\x6d\x12\x5e\x23\xf9\xd1\x3c\xa8\x5a\x17\x6f\xa8\x6a\x56\x68\x7c\x06\xa3\xfc\xea\x22\x4e\x7b\x42\x4e\x58\x71\x89\x9e\x29\xe1\xb0\xc6\xdc\x73\x42\x1e\x57\xeb\x5a\x79\x84\x38\x0f\x9a\xec\x62\x90\x4c\x8a\x92\xec\x6a\x96\x2d\x9b\x5b\x24\x04\x5d\x2c\x5f\xfc\x8f\x71\xb5\x9b\x7e\xbe\x8a\x1b\x19\x70\xfd\x62\x37\xdb\xa1\xfc\x24\x1f\x71\xbd\xc4\x00\x46\x2a\x22\x9b\x4d\x44\xf7\x00\x94\x64\x37\xe9\x38\xbd\x93\x11\x1c\xbe\x2c\x97\xe0\xee\x6b\x46\xe1\xca\x7e\xb1\x1b\x17\xce\xa2\xc1\xeb\x04\xa1\x72\x80\xe8\xca\xfa\x0f\xb6\x31\x45\x06\xf1\x5e\xc0\xf1\x45\xda\x68\x7f\x0b\xba\x80\x97\xf7\x60\xac\x87\x92\x8d\xe3\x21\xdf\xa8\xdb\xeb\xc0\xf3\x21\x3f\xaa\xf1\xef\xb0\x46\x8f\x3f\xc8\x97\xdd\x4c\x8f\xa1\x66\x9a\xff\xd5\xe8\xd5\xba\x46\x6e\x3c\x72\x79\x45\x08\x08\xb3\x55\xc6\x87\x28\xdd\xeb\xe7\x49\x59\x1e\x92\x41\x66\x79\xe3\xbf\x97\xe7\x3b\x08\x1b\x9f\x7d\x4f\x71\xfd\x3c\x9f\x58\xdc\x27\xed\xfc\x84\xc5\xff\x50\x58\x49\xe1\x02\x2f\x5c\xe8\x7c\x0a\xc9\xef\x7f\x1c\xa9\x65\x57\x47\x3f\x5e\x89\x88\xa2\xc0\x75\xf6\xf0\xa6\xae\x01\xe0\xe7\x26\xed\x69\x8d\x0d\x4a\x09\x31\x8b\x57\x1c\x5e\xcd\xa7\x40\x6f\x1f\xc3\xbb\xfa\x6f\x8c\x34\xbb\x87\x5a\xdf\x21\xf0\x64\x4f\x16\xb1\x28\x15\x22\xea\xb7\xd2\x88\x07\xde\x0d\x0f\x11\xcf\x9e\xe8\x07\x8f\xe7\xd2\x92\x88\x52\x71\x38\x69\x5d\x42\x49\x92\xd3\x4d\xd2\xa9\xba\xd9\x8d\x1b\x35\x61\x94\x91\x19\x0d\x19\x70\x65\x4d\x03\xac\x1d\x9e\x7e\xbb\x07\x2b\x83\xf8\x65\xfc\x7a\x4c\xe5\x75\xfc\xb2\xd7\x49\x4e\x3c\xf8\xe5\x92\x4f\xcf\x6a\xba\xb9\xce\x68\xd7\x40\x76\xa3\xeb\x51\x44\xa2\x02\x4b\x24\xe0\x50\xf2\xa2\xb6\x0e\x13\x06\x7a\xd2\xa0\x0b\xb5\x42\x66\x1b\x2b\xec\x21\x26\xb6\x2c\xf0\xa5\x1d\xdc\x5c\x71\xd1\x3d\x2b\x23\xc2\x6c\x5c\x7f\x8e\x6a\xad\x4b\x45\x34\x93\xf1\xb7\x29\x73\xce\xb3\xf9\x2d\x41\xad\xd7\x68\x8a\x64\xe6\x30\x03\xc2\xe1\x1d\xe2\x45\x93\x8b\x7d\x4f\x94\xa4\x87\xc0\x79\xb2\xd8\x13\xda\x59\xcf\xfd\x7e\xd5\xb6\x50\x60\xa9\xcd\x94\xf0\x5d\xf7\x13\x4b\xcd\xdc\x16\x31\x5d\x76\x94\x1f\xae\x9d\x75\x9d\xf8\x7b\x00\xba\x6b\x0b\x07\xf7\x0e\x00\x00"
+
+func internalStateResource_postgresGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_internalStateResource_postgresGoTmplt,
+		"internal/state/{resource}_postgres.go.tmplt",
+	)
+}
+
+func internalStateResource_postgresGoTmplt() (*asset, error) {
+	bytes, err := internalStateResource_postgresGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "internal/state/{resource}_postgres.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _kustomizeBaseDeploymentYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x4f\x8b\xdb\x30\x10\xc5\xef\xfa\x14\x8f\xdc\xed\xac\x7b\x6a\x74\x6d\xe9\x52\x28\x25\xd0\xa5\x97\xd2\xc3\xc4\x9e\xc6\x62\xf5\xaf\xd2\x34\x8b\x09\xfb\xdd\x8b\xb0\xe3\x75\x4a\x1c\x28\xf6\x41\x7a\x6f\xe6\x37\x6f\xc0\xae\xaa\x4a\xbd\xf4\xec\x35\xf8\x37\xea\x8f\x1c\x6d\x18\x1c\x7b\x79\x1a\x22\xd7\xdf\x24\x19\x7f\xc4\xe6\xf9\x7d\xde\xa8\x48\xd2\x6b\x74\x73\xc5\xf6\x40\x99\xb7\x6f\xf7\x7a\x20\x67\x55\xe1\x51\x34\xdf\x39\x65\x13\xbc\x06\xc5\x98\xb7\xa7\x46\x3d\x1b\xdf\x69\xbc\xf1\x95\x63\xa1\x8e\x84\xb4\x02\x3c\x39\xd6\x38\x9f\x51\x7f\x25\xc7\x78\x7d\x55\x80\xa5\x03\xdb\x5c\x5c\x14\xc8\xb5\x9d\x23\xb7\xc5\x4a\x1c\xad\x69\x29\x6b\x34\x0a\xc8\x6c\xb9\x95\x90\x8a\x03\x38\x92\xb6\xff\xb2\xa0\xdc\xe0\x00\xc2\x2e\x5a\x12\x9e\x7a\x16\xa1\x80\xeb\x10\x2b\x00\xe0\x12\xa6\x3c\x6d\xf0\x42\xc6\x73\x9a\x9b\xaa\x69\xbb\xcc\xe9\xc4\x69\x12\x01\xe3\xe8\x38\xed\xfc\xb9\x1c\x27\xa0\xee\xf8\x34\xd7\x50\x3a\x66\x8d\x1f\xf3\x1d\xd8\x8c\x94\xcd\x2c\xfd\x9c\x4f\x31\x24\x59\x24\x5d\x19\xbb\x48\xb8\x0f\x49\x34\x9a\xdd\x6e\xf7\xb0\xb0\x63\x0a\x12\xda\x60\x35\x9e\x3e\xec\xd5\xf9\x5c\xc1\xfc\x42\xfd\x89\x49\xfe\x24\xce\xf5\x23\x09\xbf\xd0\x70\x59\x7d\x39\xe9\x38\x5a\xf7\x47\x35\x77\x47\xb1\xef\x6e\x91\x7b\x26\x2b\xfd\x7d\xf0\xbb\x55\xf0\xbf\x34\xc7\x92\x4c\x9b\xd7\x71\xbb\xe6\x61\x3d\xe6\x45\xb5\xe6\xc4\x9e\x73\xde\xa7\x70\x98\x3e\x9e\xf1\xed\x45\xe2\x23\xcb\x52\x02\xc6\x7f\x67\x5b\x9a\xae\xf5\x1b\xf9\x13\x53\x67\xfe\x1b\x5d\xba\x86\x35\xf6\xdf\x01\x00\x76\x2c\xc5\x32\xe5\x03\x00\x00"
 
 func kustomizeBaseDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -764,7 +869,7 @@ func kustomizeBaseDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeBaseIngressYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8d\xc1\x6a\xc3\x30\x10\x44\xef\xfe\x8a\xf9\x81\xb4\xf8\x68\x7d\x41\x7b\x09\xa6\x84\xde\xd7\xf6\xb4\x16\x91\x25\xa1\xdd\x84\x40\xd0\xbf\x17\x25\xa4\x10\xb2\xa7\xd9\xd9\x99\xb7\x92\xfd\x37\x8b\xfa\x14\x1d\x78\x31\xc6\x26\xf5\xfd\xdc\x4f\x34\xe9\xbb\xa3\x8f\x8b\xc3\x67\xfc\x2d\x54\xed\x36\x9a\x2c\x62\xe2\x3a\x20\xc8\xc4\xa0\x4d\x01\x92\xb3\xc3\xf5\x8a\xb7\xbd\x6c\x44\xad\x1d\x10\x65\xe3\xb3\xa7\x99\x73\x8b\x97\x53\xe0\xad\xb7\xc3\x9a\xd4\x1c\x42\x9a\x25\x34\x79\x63\xad\x66\xf9\x4e\x05\xb2\xd8\xaa\x8f\x65\x87\x49\xe6\x23\xe3\xf2\x30\xda\x28\xcb\xd9\xcf\xdc\xbf\x7c\x7b\x89\x8c\xa9\x98\x43\x3f\x0c\x43\xff\x7f\x6c\xfc\x7b\xed\xe3\x70\x18\xbf\xd2\xc9\x38\x16\xfe\xf8\x0b\x6a\xfd\x0b\x00\x00\xff\xff\x65\x6a\xf4\x8e\x19\x01\x00\x00"
+var _kustomizeBaseIngressYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xcf\x6e\xf2\x30\x10\xc4\xef\x7e\x8a\x15\xa7\xef\x3b\xc4\x28\xb7\xe2\x73\xd5\x3f\x17\x84\x5a\xd4\xfb\x26\x99\x12\x0b\xc7\x76\xed\x0d\x10\xa1\xbc\x7b\x65\x68\x91\x2a\xb4\x97\xd1\xee\xfc\x66\xb4\x55\x55\xa9\x63\x0f\x6f\x88\x7d\x47\xff\xf0\x45\xfa\x11\xd1\x85\x69\x80\x97\xed\x14\xa1\xdf\x25\x59\xbf\xa3\xc5\xfe\x21\x2f\xfe\x93\x7e\x02\xcb\x98\x90\xf5\x33\x0b\x8e\x3c\xa9\xc8\xd2\x1b\xea\x6e\xd0\xb2\xe1\x8c\xa5\xf5\xbb\x84\x9c\xf5\xc4\x83\x53\xa5\x84\xa3\xfd\x40\xca\x36\x78\x43\x38\x09\x7c\x91\x79\x79\xa8\x1b\x08\xd7\x6a\x6f\x7d\x67\xe8\xf5\x4a\xa9\x01\xc2\x1d\x0b\x1b\x45\xe4\xb8\x81\xcb\x45\x11\x71\x8c\x86\xce\x67\xd2\x6b\x1e\x40\xf3\xac\x88\x3c\x0f\xf8\xbb\xcb\x11\x6d\xb1\xa7\xd1\xe1\xc2\x55\xd4\x87\x2c\x86\x5c\x68\xd9\x15\x79\xc9\xea\x45\x62\xb9\x96\x29\x3f\xfc\x54\x10\x55\xd4\x70\xbb\x87\xef\x7e\x17\x65\x32\xd2\xc1\xb6\x58\xdf\xb5\xdd\x59\x36\x21\x89\xa1\x7a\xb5\x5a\xd5\xb7\x63\xc9\xbf\x62\x2f\xdb\xed\xe6\x2d\x8c\x82\x4d\xc2\xa7\x3d\xd1\x3c\x7f\x0f\x00\xda\xe6\x82\xad\x82\x01\x00\x00"
 
 func kustomizeBaseIngressYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -784,7 +889,7 @@ func kustomizeBaseIngressYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeBaseKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x4a\x2d\xce\x2f\x2d\x4a\x4e\x2d\xb6\xe2\xd2\x55\x48\x49\x2d\xc8\xc9\xaf\xcc\x4d\xcd\x2b\xd1\xab\x4c\xcc\xcd\xe1\xd2\x55\x28\x4e\x2d\x2a\xcb\x4c\x4e\x85\x71\x33\xf3\xd2\x8b\x52\x8b\x8b\xc1\x5c\x40\x00\x00\x00\xff\xff\x2a\xce\xa5\xa1\x3a\x00\x00\x00"
+var _kustomizeBaseKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcc\x41\xae\x82\x30\x14\x85\xe1\x79\x57\x71\xc3\xbc\x65\xfa\xc2\xf8\xe5\xbd\x05\xe8\x06\xae\x70\x84\x06\x68\xb1\xf7\x22\xa9\xa4\x7b\x37\x46\xa3\x0e\x4f\xf2\x9d\xdf\x5a\x6b\xb6\x01\xa1\x21\x5c\xc8\xfd\x62\x99\x62\x9e\x11\xf4\x98\x17\xb8\x83\x26\x1f\x7a\xaa\xc6\x1f\xa9\xcc\xc2\x3a\x34\xd4\xbd\x45\x7d\x62\x41\x3d\xae\xa2\x71\xf6\x37\x56\x1f\x83\xcb\x3c\x4f\xe6\x91\x4c\x90\xb8\xa6\x16\xd2\x18\xfb\xf5\x79\x01\x12\xa4\xab\x6f\xf1\x9c\xfb\x6e\xc9\x9f\xc9\xfd\x81\x75\x4d\x10\xf7\xcf\x8a\x8d\x33\x95\x62\x2c\xf9\xd0\x27\x88\x7c\x28\x42\x47\xa5\xdc\x07\x00\xc6\x76\xe9\x33\xb8\x00\x00\x00"
 
 func kustomizeBaseKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -804,7 +909,7 @@ func kustomizeBaseKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeBaseServiceYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcd\xb1\xca\xc3\x30\x0c\x04\xe0\xdd\x4f\x71\x2f\xf0\xff\x90\x31\x5a\xbb\x97\x40\x4b\x77\xe1\x88\x62\x1a\x5b\x46\x16\x59\x42\xde\xbd\x24\xe9\xe2\x4d\xba\xfb\xe0\xb8\xa6\x97\x58\x4b\x5a\x08\xeb\x10\x3e\xa9\xcc\x84\x87\xd8\x9a\xa2\x84\x2c\xce\x33\x3b\x53\x00\x0a\x67\x21\x6c\x1b\xfe\xef\x9c\x05\xfb\x1e\x5a\x95\x78\x34\x55\xcd\xdb\x71\xfc\xfd\x50\xa7\x80\x4b\x10\x86\x71\x1c\x87\xeb\x37\x75\x8d\xba\x10\x9e\xb7\xe9\x4c\x9c\xed\x2d\x3e\x75\xae\xc9\x22\xd1\xd5\xe8\x14\x5c\x6b\x37\xff\x0d\x00\x00\xff\xff\x03\x7c\xd0\xa9\xb9\x00\x00\x00"
+var _kustomizeBaseServiceYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcf\x4d\x4b\x03\x31\x10\xc6\xf1\x7b\x3e\xc5\xd0\xfb\xa6\xdd\x9b\x9b\xab\xa2\x37\x29\xb4\x78\x1f\x77\x1f\xdb\xd0\xbc\x99\x8c\x2d\xcb\xd2\xef\x2e\x31\x22\x16\x2f\xde\xf2\xf2\x9f\x1f\x4c\xd7\x75\xea\x72\x44\x30\x84\x77\xd2\x0f\x48\x2e\xce\x1e\x41\xf6\x73\x82\xde\x49\xb6\xe1\x40\xab\xd3\x5d\x59\xa9\xc4\x72\x34\x34\xfd\x14\xeb\x57\x2e\x58\x17\xe4\xb3\x1d\xa1\x67\xf6\x4e\x55\x8c\x93\x7d\x41\x2e\x36\x06\x43\xe7\x5e\x9d\x6c\x98\x0c\xed\x5a\xa5\x3c\x84\x27\x16\x36\x8a\x28\xb0\x87\xa1\x65\x21\xfd\xcc\x1e\x74\xbd\xaa\x92\x30\xd6\x9f\x14\xb3\x94\x7a\xe8\xbe\xa3\x9b\x6a\x59\x3a\xb2\x6f\xa4\x1f\xc1\xf2\x91\x51\xf4\x13\x0b\x2e\x3c\x57\x81\xa8\x4d\x1b\xea\x87\x61\xe8\xdb\x3d\x47\x89\x63\x74\x86\xf6\xf7\xdb\xaf\x17\xe1\x7c\x80\x6c\x7f\x75\xd5\x84\x2b\xf8\x6b\x6c\xfe\x69\x6c\x9a\x11\xa6\x46\x14\x38\x8c\x12\x73\xdd\x82\x88\x53\xba\xd9\xf4\x73\x00\x8e\xfd\x94\x45\x75\x01\x00\x00"
 
 func kustomizeBaseServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -824,7 +929,7 @@ func kustomizeBaseServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeDevIngress_patchYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x04\xc0\xbd\x0d\xc3\x20\x10\x05\xe0\x9e\x29\xde\x02\xe1\x52\x53\xa7\xce\x0e\x27\xfc\x24\x2c\x81\x39\xf1\xd7\x20\x76\xf7\xf7\x41\xb5\x80\x46\xcb\x1a\xe9\x00\xd3\x91\x02\xa4\x1b\xa3\xb4\x99\xd9\xe5\x2b\xa9\xf6\xe1\x80\xa5\x79\x32\xe0\xe2\xf2\x7b\xc3\xff\x6a\xd1\xfb\xf9\x6b\x21\xce\x79\x03\x00\x00\xff\xff\x4c\x39\x49\x5e\x47\x00\x00\x00"
+var _kustomizeDevIngress_patchYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xcd\xbd\xaa\xc2\x30\x18\x87\xf1\x3d\x57\xf1\xd2\xe9\x9c\x21\x89\xa3\x74\x2e\xba\xb9\xe8\x2e\x2f\xed\x9f\xa6\x98\x2f\x93\xb4\x25\x94\xde\xbb\x14\xc1\x0b\xf8\x3d\x8f\x94\x52\xac\x06\xbe\x25\xf6\x03\xfd\xe1\x4d\xaa\x43\xb4\xa1\x3a\xf8\xf2\xa8\x11\xea\x5e\xd2\xe4\x47\x6a\x5e\xe7\xdc\xfc\x93\xba\x80\xcb\x9c\x90\xd5\x95\x0b\x56\xae\x22\x72\x31\x2d\x0d\x3f\xa4\x07\x2c\x7a\xf2\x63\x42\xce\xcf\xc8\xa5\x37\xaa\xb2\xb3\xe2\x38\x49\x0a\xb1\xa5\x84\x68\xb9\x87\x20\xfa\x5a\x9d\x23\x7a\x9d\x66\x8b\xac\x4f\xda\x84\x5c\x04\xd1\xc2\x76\xc6\xd1\x5d\xd4\xb6\x91\xea\x82\xe3\xc9\xdf\xd8\x81\xf6\xfd\x33\x00\x70\xd6\x16\xd7\xb5\x00\x00\x00"
 
 func kustomizeDevIngress_patchYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -844,7 +949,7 @@ func kustomizeDevIngress_patchYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeDevKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x24\x8d\x41\x0e\x82\x30\x10\x45\xf7\x73\x8a\x7f\x01\x8a\xb8\x30\x71\x6e\xa0\x0b\xe3\x0d\xcc\x20\x23\x34\x4a\x21\x9d\x4a\x30\x84\xbb\x9b\xc2\xf2\xbd\xbc\xe4\xd5\x62\x6a\x4c\x05\x5c\xe9\x5c\x99\x89\x28\x48\xaf\xf7\xa8\x2f\x3f\x33\x1a\x9d\x0a\xa2\x51\xd2\xb3\x53\xbb\xda\x10\x4e\xe7\xc3\x31\xf7\x49\x62\xab\x89\x09\x00\xda\x38\x7c\x47\x86\xce\x49\x83\xf9\x21\xd8\x66\x27\x8d\x19\x18\x53\x55\x6b\x92\x6a\x93\x6f\x1f\x1a\xc6\x25\xb4\x51\x6d\xcf\xf2\x8d\xb1\x2c\x70\x37\xe9\x15\xeb\x4a\xc0\x28\xa9\x63\xf8\xbd\x7a\x6c\x77\xf7\x93\xfe\xf3\x0f\x00\x00\xff\xff\x5e\xa3\x5f\xdf\xae\x00\x00\x00"
+var _kustomizeDevKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8f\xc1\x4e\xc3\x30\x10\x44\xef\xfe\x8a\x55\xef\x76\x28\x07\x04\x3e\x23\x10\x1c\x10\x12\xdc\xd1\x96\x4c\x53\xab\xcd\x3a\xd8\xdb\xb4\xc1\xca\xbf\x23\x27\x12\xc7\x1d\x8d\xde\xdb\xb1\xd6\x9a\xcb\x01\xe2\x09\x3f\xe4\x1e\x31\x9c\xe2\xd4\x43\xf4\x73\x1a\xe0\x3e\x34\x05\xe9\x68\x73\xbc\xcf\x1b\x33\xb0\x1e\x3c\xb5\xff\x8d\xa6\xc5\xd8\x1c\xcf\x59\x63\x1f\x7e\x59\x43\x14\x37\x71\x7f\x32\x95\xb8\xe3\x8c\xec\x8d\x25\xd7\x38\xd7\xd4\xcb\x18\xe1\x1e\xef\x09\xfb\x70\xad\x94\xd1\x9a\x52\x2c\x85\x3d\xb9\x27\xb0\x9e\x13\xb2\x7b\x66\xc5\x85\x27\x9a\x67\x53\x6d\xdf\x07\xe4\xd7\x1c\xe5\xee\xe1\xe6\xb6\xb2\x94\x53\x07\xf5\x86\x88\xa8\x4b\xf1\x3c\x78\xc2\x55\x21\x39\x44\xc9\x4b\x3a\x22\xd5\xc3\xd3\xb8\xdd\x41\x79\xbb\x84\xc7\x20\xad\xa7\x17\xe9\x12\xf2\x5a\xab\x9f\x78\x2a\x85\xdc\x1b\xf7\xa8\x3a\xa2\x75\x5d\x58\x5b\x5f\x8b\x7d\x9d\x53\x8a\x25\x48\x4b\xf3\xfc\x37\x00\x6f\x62\x29\x36\x2b\x01\x00\x00"
 
 func kustomizeDevKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -864,7 +969,7 @@ func kustomizeDevKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4a\x4a\x2c\x4e\x2d\xb6\xe2\xd2\x55\xd0\xd3\x4f\x49\x2d\x03\xd3\xc5\x25\x89\xe9\x99\x79\xe9\x60\x76\x41\x51\x7e\x4a\x69\x72\x49\x66\x7e\x1e\x17\x57\x5e\x62\x6e\x6a\x40\x51\x6a\x5a\x66\x85\x95\x42\x72\x4e\x69\x71\x49\x6a\x91\x6e\xa2\x2e\x20\x00\x00\xff\xff\x4a\xfe\xa3\xfd\x41\x00\x00\x00"
+var _kustomizeKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8c\x31\x0e\xc2\x30\x0c\x45\xf7\x9c\xc2\xea\xee\x74\x45\x99\x39\x00\x12\x5c\xc0\xb4\xa6\x8d\xda\x38\x21\x76\x81\x70\x7a\xd4\x0e\x4c\xff\x0f\xef\x3d\x44\x74\xef\x99\x25\x00\x3f\xc1\x9f\xb9\xac\xb9\x25\x16\xbb\xb5\xc2\xfe\x6a\x35\xca\x04\xdd\x72\xd2\xce\x15\xb2\x39\xc0\xf8\x27\xfa\x65\x53\xcb\x29\x7e\xc9\x62\x16\xdf\x28\xad\x6e\xaf\xdd\x49\x59\x83\x43\xf0\xfd\xc8\xaf\x63\xd5\x68\x8a\x32\x1d\xbf\xd4\x3c\x6e\xc3\xae\x38\x27\x94\xf8\x52\xf9\x11\x3f\x01\x86\x75\x53\xe3\x8a\x84\xbf\x01\x00\xfe\xe2\xcd\xe9\x93\x00\x00\x00"
 
 func kustomizeKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -884,7 +989,7 @@ func kustomizeKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeProductionIngress_patchYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x04\xc0\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\x87\xaf\xa6\x4e\x9d\x1d\x2c\x62\x89\x48\x10\x5b\x18\xd2\x20\x76\xcf\xfb\x41\x2d\xa1\x8b\x55\xce\x12\x00\xe3\x51\x12\xc8\x4d\x32\xf5\x59\xc5\xe9\x4f\x45\x7d\x04\xe0\xe5\x3a\x25\xc1\xba\x5e\x71\x2d\xc4\x43\x1b\xdf\xcf\xc9\x4d\xb0\xf7\x17\x00\x00\xff\xff\x31\xd9\xc0\xa7\x48\x00\x00\x00"
+var _kustomizeProductionIngress_patchYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xcd\xbf\x4a\xc0\x30\x10\x80\xf1\x3d\x4f\x71\x74\xd2\x21\x89\xa3\x64\x2e\xba\xb9\xe8\x2e\x47\x7a\x34\xc1\xfc\x39\x93\x8b\x25\x94\xbe\xbb\x14\xc1\x07\xf8\x7e\x9f\xd6\x5a\x1d\x81\x8a\x03\x2c\x1b\x3c\xd0\x37\x98\x95\x38\xd5\x99\xa9\xc8\xc7\x64\x32\xef\xd2\x62\xd9\x61\xf9\x7a\xee\xcb\x23\x98\x17\x42\x19\x8d\xba\x79\x45\xa1\x03\xa7\x62\x94\xe0\x60\xfb\x8f\x2c\xb7\xba\x0d\x2f\xb1\x16\x1b\xcb\xde\xa8\xf7\x4f\x46\xf1\xc1\x4c\xcc\x49\xdd\x43\x0d\x95\x1d\x34\xe2\x84\x9e\x14\xc0\x1f\x61\x3b\x93\xb7\x6d\x24\xea\xf6\xc9\x86\xda\x45\x01\xfc\x60\x1a\xe4\xe0\x36\xcd\x79\x82\x59\x6b\xc6\x58\xde\x30\x13\x5c\xd7\xef\x00\x5d\x48\x61\x4a\xbd\x00\x00\x00"
 
 func kustomizeProductionIngress_patchYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -904,7 +1009,7 @@ func kustomizeProductionIngress_patchYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeProductionKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x24\x8d\x41\x0e\x82\x30\x10\x45\xf7\x3d\xc5\xbf\x00\x45\x5c\x98\x38\x37\xd0\x85\xf1\x06\x66\x90\x11\x1a\xa5\x6d\x3a\x95\x60\x08\x77\x37\x85\xe5\x7f\x79\x3f\xaf\x65\x15\x25\x53\xc1\xd6\xd6\xd6\x65\x19\xe3\x79\x94\x7b\x92\x97\x9b\x09\x31\x85\xae\x32\x26\x72\x7e\x0e\xa2\x57\x0d\xfe\x74\x3e\x1c\xcb\x21\x73\xea\x25\x93\x01\x80\x3e\x85\x6f\x24\xc8\x9c\xc5\xab\x0b\x5e\x37\x3a\x49\x2a\x83\x30\x35\xad\x64\x6e\x36\xf8\x76\xbe\x23\x5c\x7c\x9f\x44\x77\xad\xe4\x08\xcb\x02\x7b\xe3\x51\xb0\xae\x06\x88\x9c\x07\x82\xdb\xad\xc7\x56\xb7\x3f\x1e\x3f\xff\x00\x00\x00\xff\xff\xa5\xe0\x86\x20\xaf\x00\x00\x00"
+var _kustomizeProductionKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8f\xc1\x4e\xf3\x30\x10\x84\xef\x7e\x8a\x51\xef\x76\xfe\xfe\x07\x04\x3e\x23\x10\x1c\x10\x12\xdc\xd1\xb6\xd9\xa6\x56\x9b\x75\xb0\x37\x6d\x83\x95\x77\x47\x4e\x24\x8e\xb3\x1a\x7d\xdf\x8e\xb5\xd6\x5c\x8f\x2c\x1e\xfc\x0d\xf7\xc8\xc3\x39\x4e\x3d\x8b\x7e\x4e\x03\xbb\x0f\x4d\x41\x3a\x6c\x4e\xf7\x79\x63\x06\xd2\xa3\x47\xfb\xd7\x68\x86\x14\xdb\x71\xaf\x21\x4a\x73\x1a\xb3\xc6\x3e\xfc\x50\x4d\x6e\xa2\xfe\x6c\x2a\x78\x47\x99\xb3\x37\x16\xae\x71\xae\xa9\xc9\x18\xa1\x9e\xdf\x13\x1f\xc2\xcd\xa3\x12\xac\x29\xc5\x22\x1c\xe0\x9e\x98\x74\x4c\x9c\xdd\x33\x29\x5f\x69\xc2\x3c\x9b\x6a\xdd\x1f\x39\xbf\xe6\x28\x77\x0f\xff\xfe\x57\x98\x52\xea\x58\xbd\x01\x80\x2e\xc5\x71\xf0\xe0\x9b\xb2\xe4\x10\x25\x2f\xd7\x0b\xa7\x1a\x3c\x2e\xdb\x1d\x2b\x6d\x97\xe3\x29\x48\xeb\xf1\x22\x5d\xe2\xbc\xd6\xea\x2b\x1e\xa5\xc0\xbd\x51\xcf\x55\x07\xac\x2b\xc3\xda\xfa\x5a\xec\xeb\x9e\x52\x2c\x58\x5a\xcc\xf3\xef\x00\x4a\xf8\xe4\xa8\x33\x01\x00\x00"
 
 func kustomizeProductionKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -924,7 +1029,7 @@ func kustomizeProductionKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeStagingIngress_patchYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x04\xc0\xbb\x0d\x03\x21\x0c\x06\xe0\x9e\x29\xfe\x05\x82\x53\x53\xa7\xce\x0e\x16\xb2\x00\x89\x87\x85\xe1\x1a\xc4\xee\xf7\x7d\x30\x34\x60\x8a\x56\x8e\xe2\x00\xe5\x95\x03\xc8\x54\x22\xcd\x5d\xc5\xe8\x4b\x79\xd8\x72\xc0\xc3\x75\x4b\x80\x2d\x4e\xa5\x27\x7f\x0e\xfc\x6f\x34\x2e\xfd\xcf\x4d\x70\xef\x1b\x00\x00\xff\xff\xac\x95\xb5\xbd\x4b\x00\x00\x00"
+var _kustomizeStagingIngress_patchYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xcd\x3d\x6a\xc4\x30\x10\x40\xe1\x5e\xa7\x18\x5c\x25\x85\xa4\x94\x41\xb5\x49\xba\x34\x49\x1f\x06\x7b\x90\x44\xf4\x17\xcd\x78\x8d\x30\xbe\xfb\xb2\xec\xb2\x07\xf8\xde\xd3\x5a\xab\x3d\x50\x71\x80\x65\x85\x17\xfa\x07\x33\x53\x4b\x75\x64\x2a\xf2\x33\x1a\x99\x6f\xe9\xb1\x78\x98\xfe\xde\x79\x7a\x05\xf3\x41\x28\x5b\x27\x36\x9f\x28\xb4\xe3\x50\x0d\x25\x38\x58\x9f\xc8\xb2\xa0\x8f\xc5\xdb\x58\x7c\x27\xe6\xdf\x86\xb2\x04\x33\x30\x27\x75\xbb\x69\xa8\xcd\x41\xa7\x96\x70\x21\x05\x70\xf7\x96\x1b\x2d\xb6\x6f\x89\xd8\xbe\xd9\x50\x59\x14\xc0\x05\xd3\x46\x0e\x1e\x41\x73\x1c\x60\xe6\x9a\x31\x96\x2f\xcc\x04\xe7\x79\x1d\x00\x13\xcc\xcd\xc0\xbd\x00\x00\x00"
 
 func kustomizeStagingIngress_patchYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -944,7 +1049,7 @@ func kustomizeStagingIngress_patchYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kustomizeStagingKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x24\x8d\x51\xae\x82\x30\x10\x45\xff\xbb\x8a\xbb\x01\xca\xe3\x7d\x98\x38\x3b\xd0\x0f\xe3\x0e\xcc\xa0\x63\x69\x94\x42\x3a\x23\xc1\x10\xf6\x6e\x0a\x9f\xf7\xe4\xdc\x9c\x96\x55\x94\x5c\x05\x5f\x7b\x5f\x97\xe5\x5c\xe2\x5e\xae\x59\x9e\x71\x26\xa8\x71\x88\x29\x54\xce\x8d\x6c\xf7\x4e\xf4\xac\x43\x3a\x1c\xff\xfe\xcb\xc7\x38\x07\x31\x72\x00\x10\xf2\xf0\x19\x09\x32\x9b\x24\x8d\x43\xd2\x8d\x4e\x92\xcb\x20\x4c\x4d\x2b\xc6\xcd\x06\x5f\x31\x3d\x08\xa7\x14\xb2\xe8\xae\x95\x22\x61\x59\xe0\x2f\xdc\x0b\xd6\xd5\x01\x23\x5b\x47\x88\xbb\x75\xdb\xea\xfe\xcb\xfd\xfb\x17\x00\x00\xff\xff\xd5\xe8\xb6\xd2\xb2\x00\x00\x00"
+var _kustomizeStagingKustomizationYaml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8f\xc1\x4e\xf3\x30\x10\x84\xef\x7e\x8a\x55\xef\x76\xfe\xfe\x07\x04\x3e\x23\x10\x1c\x10\x12\xdc\xd1\x96\x6c\x1d\x2b\xcd\x3a\x78\x37\x6d\x83\x95\x77\x47\x4e\x10\xc7\x19\xcd\xce\xb7\x63\xad\x35\x97\x8e\xd8\x03\x7d\x81\xbb\xa7\xf1\x94\xe6\x81\x58\xdf\xe7\x91\xdc\x9b\xe6\xc8\x01\x76\xfd\xad\xec\xcc\x88\xda\x79\x68\xff\x12\x8d\x28\x86\xc8\xa1\xe9\x27\xd1\x34\xc4\x6f\xd4\x98\xd8\xcd\x38\x9c\x4c\x6d\x3d\xa0\x90\x78\x63\xc1\x35\xce\x35\x55\x19\xc3\x38\xd0\x6b\xa6\x63\xbc\x7a\xf8\x3d\xb7\xa6\x14\x0b\xf1\x08\xee\x81\x50\xa7\x4c\xe2\x1e\x51\xe9\x82\x33\x2c\x8b\xa9\xd4\xcf\x8e\xe4\x59\x12\xdf\xdc\xfd\xfb\x5f\xfb\x14\x73\x20\xf5\x06\x00\x20\xe4\x34\x8d\x1e\xe8\xaa\xc4\x12\x13\xcb\xea\x9e\x29\x57\xe1\xe1\xbc\x3f\x90\xe2\x7e\x35\xfb\xc8\xad\x87\x27\x0e\x99\x64\x8b\xd5\x6f\x3c\x94\x02\xee\x05\x07\xaa\x38\x80\x6d\x65\xdc\x52\x1f\x2b\x7d\x9b\x54\x8a\x05\xe2\x16\x96\xe5\x67\x00\x6a\x5a\x70\x21\x33\x01\x00\x00"
 
 func kustomizeStagingKustomizationYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -964,7 +1069,7 @@ func kustomizeStagingKustomizationYaml() (*asset, error) {
 	return a, nil
 }
 
-var _pkgApiGenShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x51\x6b\xab\x30\x14\xc7\xdf\xf3\x29\x72\xbd\x42\x5b\xb8\x49\xde\x2f\xe4\x61\x20\x6c\xbe\xac\x45\x0a\x65\x30\x90\x54\xcf\xd2\x30\xeb\x71\xc9\x91\xae\x88\xdf\x7d\xd4\xda\xd1\x8d\xad\xfa\xa4\x9e\xdf\xf9\xf1\xff\x87\xfc\xfd\xa3\xb6\xae\x56\x5b\x13\x76\x2c\x00\x71\x01\x8c\xcd\x59\xb6\x5c\xae\x93\x34\xd3\xf1\xbc\x74\xbe\x36\x7b\xe0\xf1\x6a\x93\x2c\x94\x94\xec\x7e\x93\xaf\x1f\xd2\x2c\x59\xdd\x65\xeb\x27\x1d\xcf\x2d\xf2\xca\x05\xe2\x62\xcf\xc5\x0b\x9f\x75\x5d\xd4\x75\x51\xdf\xcb\xc4\xf9\xe1\x25\xea\xfb\x19\xb7\x8e\x76\xed\x56\x16\xb8\x57\xd6\x37\x85\x80\x02\xc3\x31\x10\x8c\x9f\xd6\x10\x1c\xcc\x71\xa1\x68\xe7\x7c\x99\x37\xc6\xd3\x51\x59\x44\x5b\x81\x69\x5c\x60\x8d\x47\xc2\x42\x47\xf1\x98\x4b\x49\x42\xac\xc2\x90\xfc\x3c\xe3\x22\x95\x5c\xa4\xdf\x01\x57\x17\x55\x5b\xc2\x69\xf2\x25\x77\xc4\x58\x51\xf2\x0b\xcd\x58\x7c\xb1\x08\x8b\x39\xb6\xa4\x9b\xaa\xb5\xae\x0e\xfa\x14\xef\xff\xa7\xb5\x79\xb5\xca\x34\x8e\x3f\x33\x3e\x3e\x42\x5c\x17\x18\x56\x2b\xb4\x84\x81\x4a\xf0\x5e\x93\x6f\xe1\x9f\x87\xb7\x16\x02\xe5\x05\xd6\x04\xef\x34\xfc\xbc\xed\x0c\x07\x63\x2d\xf8\x1f\x75\xb7\x37\xcf\xb9\xf5\xb9\x8e\xb0\x50\x0b\x8b\xfa\xf7\x63\x1b\x89\x29\xc5\x55\xc5\x49\xd9\x15\x3b\xa1\x1d\x5b\x4e\x19\x47\x6c\x94\x75\x1d\x97\x8f\xa7\x0b\xc9\xfb\x5e\x0e\x14\x5b\x7c\x04\x00\x00\xff\xff\x6e\x18\xe9\x0a\xc4\x02\x00\x00"
+var _pkgApiGenShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x93\x51\x6b\xdb\x30\x10\xc7\xdf\xf5\x29\x6e\x9e\xa1\x09\x4c\xf2\xfb\xc0\x0f\x83\xb0\x2e\x2f\x6b\x09\x81\x32\x28\x04\xc5\xbe\x2a\x62\x8e\x4f\x93\xce\x74\x41\xe8\xbb\x0f\x3b\xea\x92\x75\x59\x3b\xa8\x9f\x6c\xeb\xef\xdf\xdd\xef\x0f\x7e\xff\xae\xda\xda\xbe\xda\xea\xb0\x13\x01\x19\x24\x0a\x31\x13\xab\x9b\x9b\xf5\x62\xb9\xaa\xcb\x59\x6b\x7d\xaf\xf7\x08\xe5\xed\xdd\x62\x5e\x29\x25\x62\x94\x60\x1f\x40\x7d\x46\xcd\x83\xc7\xa0\xae\x35\xe3\xa3\x3e\x40\x4a\xe2\xfa\x6e\xb3\xfe\xb2\x5c\x2d\x6e\x3f\xad\xd6\xdf\xea\x72\x66\x08\x3a\x1b\x18\xe4\x1e\xe4\x03\x5c\xc5\x58\xc4\x58\xa4\xa4\x16\xd6\x4f\x37\x45\x4a\x57\x60\x2c\xef\x86\xad\x6a\x68\x5f\x19\xef\x1a\x89\x0d\x85\x43\x60\xcc\x8f\xe6\x88\x9f\x57\xbc\xb3\xbe\xdd\x38\xed\xf9\x50\x19\x22\xd3\xa1\x76\x36\x08\xe7\x89\xa9\xa9\x8b\x32\xef\x5c\x29\x26\xea\xc2\x64\x75\x3c\x03\xb9\x54\x20\x97\xcf\x03\xb6\x6f\xba\xa1\xc5\xf1\xe4\x8f\xbd\x8b\x49\x11\xbb\x80\xa3\xd2\x5b\xf1\x99\xd6\xb7\x23\x4c\x34\x2d\x3c\xe5\x84\x28\x9f\xbe\x97\x86\x36\x34\x70\xed\xba\xc1\xd8\x3e\xd4\xa3\xf7\xc7\xdf\x3c\xf7\xdd\x54\xda\x59\xb8\x7f\xb1\x7a\xc8\x97\x94\xe7\xad\x4d\xd8\x8e\x0c\x53\xe0\x16\xbd\xaf\xd9\x0f\xf8\xc1\xe3\x8f\x01\x03\x6f\x1a\xea\x19\x7f\xf2\xf4\xf2\xd2\xbc\x13\x33\x3c\x6a\x63\xd0\x5f\xc4\xfd\x6b\xd3\xec\x7c\x82\x1c\xf5\xea\xa3\xb5\x34\xd8\x4b\x43\xf5\xf3\xda\x4e\xbd\xe6\xc4\x7f\x7b\x5f\xc0\x9f\x35\xf1\xea\xa0\xb3\x2c\xdc\xbf\x88\xcd\x65\xbc\x46\xcc\xb1\xbf\xdb\x88\x11\xd4\xd7\xf1\x9f\x82\x94\x94\xf3\xc4\x24\xe6\xbf\x06\x00\xd8\xab\xe0\x68\x87\x03\x00\x00"
 
 func pkgApiGenShTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -984,7 +1089,7 @@ func pkgApiGenShTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _pkgApiProtoGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\x48\x2c\xc8\xe4\xe2\xd2\xd7\x4f\xcf\xb7\x4a\x4f\xcd\x4b\x2d\x4a\x2c\x49\x55\xd0\x4f\xca\xcc\xd3\x2f\xce\x50\xd0\xd3\x4f\x4f\xcd\xd3\x2b\xce\xe0\x02\x04\x00\x00\xff\xff\xad\xea\x45\xe5\x2c\x00\x00\x00"
+var _pkgApiProtoGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x2c\x00\xd3\xff\x70\x61\x63\x6b\x61\x67\x65\x20\x61\x70\x69\x0a\x0a\x2f\x2f\x67\x6f\x3a\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x2f\x62\x69\x6e\x2f\x73\x68\x20\x2e\x2f\x67\x65\x6e\x2e\x73\x68\x0a\x03\x00\xad\xea\x45\xe5\x2c\x00\x00\x00"
 
 func pkgApiProtoGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -1004,7 +1109,7 @@ func pkgApiProtoGoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _protoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xc1\x72\x9b\x30\x10\xbd\xeb\x2b\x76\x38\x91\x1e\xa0\xb6\x73\x0a\xc3\x21\x75\x32\xed\x21\xd3\x66\x6c\xf7\xdc\x51\x60\x43\x35\x0d\x92\x2a\x89\x34\x2e\xa3\x7f\xef\x48\x80\x4d\x12\x30\x76\xdb\xe9\xf4\x86\x56\x4f\x4f\xfb\x76\xdf\x0a\xbd\xe5\x86\x3e\x41\x0a\x81\x54\xc2\x88\x45\x90\x10\x22\x69\xf6\x8d\x16\x08\x54\xb2\x84\x10\x21\x0d\x13\x1c\x0a\xf1\xa5\x8b\xa7\x10\x44\x09\x95\xcc\x61\x59\x29\x85\x32\x10\x14\x42\x14\x0f\x18\x7b\x92\xbb\xea\x3e\xc6\x52\x9a\x6d\xe4\x97\x41\xf2\x12\x45\x25\x8b\x29\xe7\xc2\x50\x47\xad\xc7\x60\x3b\x32\xc3\x4a\xd4\x86\x96\x72\x87\x24\x25\x6a\xed\x72\xa9\x6b\x88\x56\xa8\x45\xa5\x32\xfc\x48\x4b\x04\x6b\xa1\x26\x00\xda\x28\xc6\x0b\x60\x39\xa4\x30\x4b\xf6\x01\xee\x30\x29\xcc\x7b\xa1\x1c\x75\xa6\x58\xa3\x32\x85\x45\x42\xf6\x5b\x99\x42\x6a\x30\x7f\xb7\x75\x47\xfa\x34\x95\xcc\xf7\x1b\x9e\xac\xc9\x39\xea\x72\x8e\x36\x5d\xce\x1d\xc9\xa5\x71\xd8\xc5\x61\x6c\xcb\xdb\x60\xcf\x13\x62\xf7\x4a\x97\x9e\x66\x40\xef\x0a\xbf\x57\xa8\x4d\x5f\x76\xab\x72\x36\xaa\x72\xfe\x8c\xfa\x3d\x9a\xe3\x78\xbb\x72\xf6\xce\xde\x30\x3d\x74\x58\x4f\x64\x45\x00\x18\x37\x8b\x39\xc8\xc6\x51\x33\x1f\xdd\x87\xd6\xec\xa7\x0f\xfb\xda\x2a\x94\xbe\x84\x1d\x91\x16\xca\xf8\xd2\xcf\x7c\x39\x91\x57\xa5\x8f\x7d\x52\x39\x2a\x7f\x23\x40\x1c\x03\xd5\x19\xf2\xbc\x3b\x00\xc2\xed\xfa\xbd\xcb\xf5\x12\x52\x78\x9b\x74\x40\x57\x9b\x61\xe4\xd5\xb5\x87\xfa\xe4\xac\x53\xb2\xbb\xc5\x7d\x31\x5e\x34\x8b\x14\x66\xe7\x47\x56\x45\x4b\xc1\x35\xfa\x24\x77\xb2\x06\x4d\x5c\xc3\x8d\xf8\x81\x6a\x49\x35\xbe\xe6\x79\xd5\x86\xcf\xde\x38\xa7\x75\xd1\x77\xe1\x08\x7b\x5c\xe1\x03\x9e\xca\x6d\x09\xd1\xa8\x1e\x59\x36\x38\xa2\xeb\xc7\x0c\x6a\x37\x67\x4a\x66\xe3\xc6\x0e\xa7\x2c\x7f\x06\x0a\x4d\xa5\xb8\x86\x70\x00\x74\xd6\x3a\xa1\x7d\xc0\xc2\x76\xea\xa8\x64\xd1\x57\x63\xe4\x19\xa4\x2d\x00\x40\x0a\x6d\x2e\x20\x70\x24\x1f\x36\x9b\xdb\x95\xa8\x0c\xde\x2a\xbc\x67\x4f\x60\x6d\x7c\xb0\x15\x41\x4b\x71\x27\xf2\xed\x05\x04\x6f\x9a\xb5\x6d\x0c\xd3\x2a\x1c\x9e\xaf\xf0\xe0\xd8\xfd\x35\x6d\x05\xfe\xae\xb4\xb8\x66\xf9\x4e\xdf\x80\xac\x31\x93\x87\x13\x6f\x42\x4f\xda\xd4\x9c\xfc\x13\x9d\x43\x2d\x1b\x9d\xa7\x70\x6a\xd2\xfe\x07\x53\x3e\xeb\xdc\x21\x67\x8e\x8e\x76\x38\x35\xf4\x3d\x99\x2f\x7f\x67\xd7\xee\xdf\x7f\x82\xd0\xdc\x5f\xf5\xe7\x52\x6d\xf7\x4a\x13\xfb\x2b\x00\x00\xff\xff\x0b\x7b\xcd\x38\xd7\x08\x00\x00"
+var _protoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xcd\x6e\xe3\x36\x10\xbe\xf3\x29\x06\x42\x0e\x72\x01\xcb\x75\xb2\xa7\x08\x3a\x6c\x77\x93\x14\x68\x91\x1a\xb1\x7b\x2e\x68\x69\xac\x10\x91\x48\x96\xa4\x92\xb8\x04\xdf\xbd\x20\x2d\xd9\x56\x62\x39\x4e\xd0\xb4\x3d\x6a\xfe\xe7\xfb\x66\x86\x1a\x8f\xc7\x44\x52\x73\x7f\x09\x91\xb5\x90\xdc\xd2\x1a\xc1\xb9\x44\x2a\x61\x44\x44\xbc\x56\xaf\xb9\xa1\xcf\x90\x41\x14\x84\x17\x51\x4a\x88\xa4\xf9\x03\x2d\x11\xa8\x64\x29\x21\x42\x1a\x26\x38\x94\xe2\x8f\x4e\x9e\x41\x94\xa4\x54\x32\x6f\xcb\x6a\x29\x94\x81\xa8\x14\xa2\xac\x70\x12\x82\x2c\x9b\xd5\x04\x6b\x69\xd6\x6d\xa2\x94\x58\x3b\x06\xb6\x82\xe4\x1a\xa9\x69\x14\xea\xe4\x86\x1a\x7c\xa2\x6b\x70\xee\x65\x04\x2a\xd9\x84\x72\x2e\x0c\xf5\x69\x75\x2f\x04\xf2\xe2\x80\xc7\x36\xa7\x61\x35\x6a\x43\x6b\xb9\x75\x1a\xb2\x7c\x52\x54\x4a\x54\xfd\xe8\x8a\xf2\x12\xe1\x4c\xc1\x65\x06\xc9\x1d\x6a\xd1\xa8\x1c\xb5\x4f\x68\x2d\x18\xac\x65\x45\x0d\x42\xa4\x5a\x4d\x04\xf1\x59\x72\x2d\x54\x67\x09\x67\x6a\xb4\x31\xde\x16\x6a\x2d\x14\xb8\x62\xbc\xe7\xe5\x1c\xa9\x51\x6b\x0f\xa4\xe7\x64\xce\x78\xd9\x54\x54\x25\x33\xaa\x73\x5a\x81\x73\x60\x09\x80\x36\x8a\xf1\x12\x58\x01\x19\x4c\xf7\xeb\x4b\xae\x19\x56\x45\x28\x0b\x42\x80\x99\x6f\x6a\xb1\x96\x9e\xd9\x9d\xa0\xa5\x1a\xb2\x20\xba\x6d\xea\x25\x2a\x70\xae\x87\xe3\x2e\x4d\xae\x90\x1a\x2c\x7e\x5a\x43\x06\xe7\xd3\x74\xa7\x68\x64\xb1\x53\x9c\x7b\xc5\x06\xca\xa4\x83\x32\x59\x74\xa0\x77\x41\xbe\x1a\x6f\x7b\x71\xdc\xb6\x8d\xbb\xb1\xfd\x92\x12\x47\xb6\xa0\x7c\x0b\x61\x0e\x43\x73\x87\x7f\x36\xa8\x0d\xd8\xcf\x01\x64\xaf\x8a\x1b\x34\x6f\x95\xf0\x8a\xa4\x3d\xf7\x5f\x99\x0e\xfe\xb3\xaa\x51\xb4\x1a\x6a\x20\xac\x84\xa7\x13\x22\x4e\xeb\xcd\x6c\x6c\xa3\x7a\xc9\x8e\xfc\xb6\x42\x00\x02\xc0\xb8\xb9\x38\x07\xe9\x27\x28\x83\xe9\x34\xed\x89\xe6\xec\xaf\x20\x0e\x64\x29\x94\x1e\xcc\xa2\x8b\xa9\x85\x32\x81\xcb\x69\xe0\x07\x79\x53\x07\xd9\x6f\xaa\x40\x15\xe6\x0e\x60\x32\x01\xaa\x73\xe4\x45\xe7\x00\xc2\x6b\x83\xee\xeb\xfc\x1b\x64\xf0\x63\xda\x19\x16\x38\x64\xf9\xfd\x2a\x98\x86\xe2\x42\x53\xdb\x2c\x3e\x24\xe3\xe5\x26\x65\x06\xd3\x2f\x27\x02\xa7\xa5\xe0\x1a\xc1\xee\xb7\x75\x98\x22\xd8\x0b\x30\xe7\xf4\xa1\xe5\xbd\xcf\xd0\xef\x61\x02\xdf\xcd\xb1\xb5\x9f\x3a\x76\xdf\xb1\xc2\x0f\x54\xe5\x08\xd1\xa8\x1e\x59\x3e\x74\x53\xe6\x8f\x39\x58\xe2\xa1\x93\xf9\xd1\x0d\x8b\x4f\x58\xbf\x11\x28\x34\x8d\xe2\x1a\xe2\xc3\x76\x23\xb0\x47\x4f\xbe\x1f\x90\xf6\x59\x89\xdb\x1b\x41\x25\x4b\xee\x8d\x91\x23\x7f\xb1\xc2\x78\x01\x48\xa1\x4d\xfb\x72\xfd\xbc\x58\xcc\xee\x44\x63\x70\xa6\x70\xc5\x9e\xc1\xb9\x49\x2f\xf5\x2f\xb8\xa4\x4b\x70\x2e\x6a\x5d\x97\xa2\x58\x5f\x42\xf4\xc3\xe6\xbb\x0f\x37\x80\xeb\x90\x18\xdc\xf2\xf8\xad\xfd\xff\xb7\x30\x28\xf1\xbd\x10\x4c\x2c\x2b\xb6\x38\x1c\x6f\x7f\x68\xd9\xe2\xe1\x2d\x7c\xd9\xfc\xb0\xe5\x66\x5f\xff\x33\x18\xde\x60\xfe\xd8\xfa\xc7\x27\xdc\x86\xff\xf1\x0e\xf4\x06\xe0\xc4\x45\x38\x76\x77\xe2\x13\x8e\xd2\x1e\x1c\x2f\x1f\xfd\x2b\xff\x27\xf8\x0f\xa1\x51\x84\x4a\x3e\x8e\xc7\xeb\xfe\x01\xc8\xee\x97\x6d\xec\x1c\xf9\x7b\x00\x14\xc8\xee\xe3\x33\x0b\x00\x00"
 
 func protoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -1024,7 +1129,7 @@ func protoTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _scriptsInstall_toolsShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x5b\x6f\xdb\x36\x14\x7e\xe7\xaf\xe0\x38\xa1\xa8\x37\x50\x8c\xd7\x60\x97\x16\x7e\x48\x13\x37\xf3\xe0\xc5\x85\x9d\xe6\xa5\x28\x04\x4a\xa2\x65\xae\x12\x29\x90\x94\xed\x46\xd0\x7f\x1f\x44\xc9\xb2\x24\x2b\xb7\x15\x58\x1e\x92\xe8\x5c\x79\xbe\x73\xf8\x1d\xfe\xf8\x03\xc9\xb4\x22\x3e\x17\x84\x89\x2d\xf4\xa9\xde\x00\xa0\x99\x81\x98\x65\xe0\x33\x44\x4e\xfe\xfe\x62\xf5\xa7\x77\x37\x5d\xae\x66\x37\x1f\x16\x9f\xcf\xbe\x14\x08\xe2\x88\xc1\x37\xf0\x0b\x7c\xf5\x0a\x5a\x53\x09\x53\x9e\xb2\x35\xe5\x31\x00\x57\xb3\xe5\xc4\x79\x1d\x72\x25\x68\xc2\x20\x72\xce\xd0\x08\x2c\x17\x8b\xdb\x4a\x1e\x84\x10\x39\x57\xb3\x25\x71\x5d\x82\x4a\xff\x74\x17\xc2\x11\x00\x1f\x97\x8b\xdb\xc5\x65\x95\x66\x71\x33\xc9\x73\xe8\x7e\x54\xd2\xc8\xe0\x8e\x29\xcd\xa5\x80\x45\x01\xae\x17\xf3\x8b\x9b\xeb\xcb\x99\x37\x9f\xdd\xdc\x36\xa6\x63\xf7\x97\x37\xee\xef\xe0\xf2\xc3\x6a\x35\x6f\x09\xcf\xdd\x31\x58\x4d\x97\x77\xb3\xcb\xe9\xfb\x4f\xb3\xf9\xd5\x74\xd9\x28\xcf\xdc\x3f\xdc\xdf\xea\x68\xde\xdf\xb3\xeb\xe5\xc5\xed\xb4\x51\x9e\xbb\xe3\xb1\x7b\x06\x00\x55\xc1\x66\xe2\xbc\xce\x6c\x15\x38\x19\x01\xa9\x8f\x9f\x7a\x04\x52\x7b\x3a\x4f\xea\x09\x72\xa4\x46\x00\x04\x54\x97\xe5\x4a\x8d\x20\x17\x00\xc2\x2b\xaa\x76\x5c\xfc\x34\x02\xb0\xfe\x91\x7a\x12\x5a\x59\x23\x39\xc6\x90\x7a\xdf\x48\xdf\xbd\x03\x10\xce\xb9\xc8\xf6\x5d\xe7\xb8\x14\x0d\xf8\x76\xe5\xd6\xbb\xe5\xc8\x82\x8d\x84\x28\x13\x3a\x4b\x53\xa9\x0c\x0b\xdf\x42\x7b\xde\x46\xbf\xe7\x06\x8e\xdb\xee\x4c\xd3\x00\x00\xcf\xe3\x42\x1b\x1a\xc7\x5e\x95\xe9\xf5\x08\xe6\xd6\x8a\x6a\xcd\xcc\x04\x55\x52\xec\xe4\xdd\xce\x15\xd8\xc9\x9b\xa3\x95\x1f\x25\x8e\x85\x7b\xcf\xd3\x2a\x65\xad\xcb\x54\x3c\x41\x1b\x63\x52\xfd\x96\x90\x88\x9b\x4d\xe6\xbb\x81\x4c\x48\xa5\x96\xb1\x9f\xad\xd7\x4c\xe9\xea\xdb\xcf\xd6\x44\xb1\x98\x51\xcd\x34\x09\xe5\x4e\xc4\x92\x86\x64\x7b\x92\x9a\x38\xb9\x3d\x5c\x51\xa5\xaa\x2a\xbf\xaa\xed\xa1\x73\x4c\x8d\x80\x35\x08\x32\x15\x43\xac\xe7\x7f\x2d\x20\xea\x68\x4b\x65\x26\xee\x79\x0a\x71\x39\xae\xf5\xf4\x12\xd7\x48\x19\x6b\x04\x91\x63\xd3\x54\x76\x2a\x81\x58\xad\x8f\xb2\xa2\x0d\x5d\x24\x63\x2a\xa2\x80\x7b\x31\x17\xa6\x41\x30\x96\x01\x8d\x0f\x38\x1e\x4c\x70\x69\x82\x9d\x7c\x70\xc8\x4b\x20\x4b\x38\x69\x12\xfe\x7a\xee\x1a\xaa\xdc\xe8\x1e\xb5\x62\x3d\x04\xe7\x21\x38\xe9\x64\x19\x06\x73\x38\x31\xf9\xce\xf3\xf5\x9b\xd0\x47\x7f\x5d\xc3\xdf\xe0\x6e\xa8\x82\xf8\xb2\xe4\x9d\x1a\xf6\x02\xd5\xc0\x97\x1c\x05\x31\xd6\x46\xf1\x14\x07\x32\x49\xa5\x60\xc2\xe8\xc9\x18\xe2\xfb\xfd\x1a\x1e\x9a\x0f\xff\x03\xa4\xdd\x2a\x3b\x8d\x3d\x84\xed\x36\x36\x58\x6b\x1d\xf7\x1a\xea\x53\xcd\x3e\x2d\xe7\x83\x8d\x08\x62\x99\x85\xeb\x98\x2a\x46\xac\xeb\x70\x0b\x3a\x1c\x56\xd4\x38\xb5\x3a\xec\xd4\x29\xaa\x18\x5e\xdf\xc1\xb3\x15\x79\xb6\xa2\xe7\xa3\x5f\xb2\x77\x7f\xc6\xed\x3a\xb0\x49\x50\xbb\x37\xc1\x26\x91\x21\xfc\x79\xff\x98\xfd\x13\x87\xfe\x47\x4b\xf1\xbf\x1c\xbc\x4c\xf4\xd2\xc3\x5b\x9f\x6e\xa3\x35\x53\x5b\x1e\x30\x3f\xe3\x71\xc8\xd4\xe0\x15\xee\x9a\xb4\x6b\xa9\x6f\x42\xcb\xa3\x67\x5b\x02\x34\x34\x2d\x82\x1a\xbe\x65\xa4\x6b\x3d\x3c\x33\xc3\x3b\xee\xc8\x85\x83\x70\x9e\x1e\x63\x88\x12\xf3\x53\xb3\xe2\x79\x97\xb4\xbc\x8f\xa8\xcb\xc6\x0d\x4f\x36\xd2\x1e\x53\x5a\xf7\x06\xe0\x48\xc2\x5a\x05\xab\xbb\xe9\x4a\x15\x91\x3d\xa9\x92\x04\x49\x48\x22\xc9\x93\x72\xa3\xe9\x13\xfb\x3e\xff\x1d\xb7\x48\xbd\xb4\x22\x26\x70\x24\x1f\xf3\x53\x69\x80\x59\x20\xf5\x37\x6d\x58\xfd\x19\x51\xc3\x76\xf4\x5b\x27\x46\x4b\xfe\xfd\xd1\xf4\x8e\x46\x11\x53\x4f\x97\x93\xc8\xe0\xab\xfd\x15\x31\xf1\xa8\x35\xf6\xb9\x08\xa9\xa1\xc3\xff\x0e\xad\x2a\x2f\xe1\x91\xa2\x86\xf5\xa9\x8d\x8b\x09\xaa\x55\x6e\x8b\x37\xd1\xe9\x3e\x73\x72\x9f\x8b\xe2\xc5\x3b\x0a\xd7\xd1\xc9\xe1\xef\xd0\xb0\x0f\x3f\xd9\x48\x7b\x1d\x3f\x45\x1c\x2f\x5d\x37\xcf\x9a\xe4\x52\x9a\x6c\xdb\x41\xda\xd4\xe2\xf8\xdc\x32\xd1\xa0\xb2\x2e\xd7\xde\x86\x26\x70\xef\xc1\x01\x92\xaf\x21\x57\x10\xa7\x83\xd4\x85\x4e\xdf\x6a\x0f\x3e\x41\x4e\x56\xd8\xc3\x54\xf7\xf0\x6c\x0c\x5c\x5b\xf0\x6f\x00\x00\x00\xff\xff\xd7\xa5\xce\xca\x46\x0c\x00\x00"
+var _scriptsInstall_toolsShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x5f\x6f\xdb\x36\x10\x7f\xe7\xa7\xe0\x38\xa1\x88\x37\x50\x8c\xd7\x60\x7f\x5a\xf8\x21\xcd\xbf\x79\xf0\xe2\xc0\x49\xf3\x52\x14\x02\x25\xd1\x32\x57\x89\x14\x48\x2a\x71\x23\xe8\xbb\x0f\x94\x64\x99\xb2\x15\x37\x59\x81\xe5\x21\x16\x8f\xf7\xbb\x3b\xfe\x8e\x77\xc7\x1f\x7f\x20\x85\x56\x24\xe4\x82\x30\xf1\x00\x43\xaa\x57\x00\x68\x66\x20\x66\x05\xf8\x04\x91\x57\x7e\x38\xbd\xfd\x33\xb8\xbf\x58\xdc\x4e\xaf\x2f\xe7\x9f\x8e\x3f\x57\x08\xe2\x84\xc1\xb7\xf0\x33\x7c\xf3\x06\xd6\xaa\x12\xe6\x3c\x67\x4b\xca\x53\x00\xce\xa7\x8b\x89\x77\x14\x73\x25\x68\xc6\x20\xf2\x8e\xd1\x08\x2c\xe6\xf3\xbb\x46\x1e\xc5\x10\x79\xe7\xd3\x05\xf1\x7d\x82\x2c\x3e\x7f\x8c\xe1\x08\x80\x9b\xc5\xfc\x6e\x7e\xd6\xb8\x99\x5f\x4f\xca\x12\xfa\x37\x4a\x1a\x19\xdd\x33\xa5\xb9\x14\xb0\xaa\xc0\xd5\x7c\x76\x7a\x7d\x75\x36\x0d\x66\xd3\xeb\xbb\x4e\x75\xec\xff\xf2\xd6\xff\x1d\x9c\x5d\xde\xde\xce\x1c\xe1\x89\x3f\x06\xb7\x17\x8b\xfb\xe9\xd9\xc5\x87\x8f\xd3\xd9\xf9\xc5\xa2\xdb\x3c\xf6\xff\xf0\x7f\x03\x65\x89\x21\x5f\x42\xff\x92\x51\x53\x28\xa6\xfd\x1b\xa9\x4d\xa2\x98\xde\xba\x0a\xfe\x9e\x5e\x2d\x4e\xef\x2e\x3a\xe4\x89\x3f\x1e\xfb\xc7\x35\x94\x89\xd8\x2a\x02\xaa\xa2\xd5\xc4\x3b\x2a\xea\xd3\xe2\x6c\x04\xa4\xde\x2e\xf5\x08\xe4\xf5\x29\x02\xa9\x27\xc8\x93\x1a\x01\x10\x51\x6d\x69\x91\x1a\x41\x2e\x00\x84\xe7\x54\x3d\x72\xf1\xd3\x08\xc0\xf6\x4f\xea\x49\x5c\xcb\x3a\xc9\xd6\x86\xd4\xeb\x4e\xfa\xfe\x3d\x80\x70\xc6\x45\xb1\xee\x83\x53\x2b\x1a\xc0\xf6\xe5\x35\xda\x01\xb2\x68\x25\x21\x2a\x84\x2e\xf2\x5c\x2a\xc3\xe2\x77\xb0\x8e\xb7\xdb\x5f\x73\x03\xc7\x2e\x9c\x69\x1a\x01\x10\x04\x5c\x68\x43\xd3\x34\x68\xa2\x3c\x1a\xc1\xb2\xd6\xa2\x5a\x33\x33\x41\x8d\x14\x7b\x65\x3f\xc3\x15\xf6\xca\x2e\x34\xbb\xb0\x3c\x56\xfe\x13\xcf\x11\x70\xc2\x2e\x54\x3a\x41\x2b\x63\x72\xfd\x8e\x90\x84\x9b\x55\x11\xfa\x91\xcc\x48\x03\x95\x69\x58\x2c\x97\x4c\xe9\x66\x1d\x16\x4b\xa2\x58\xca\xa8\x66\x9a\xc4\xf2\x51\xa4\x92\xc6\xe4\x61\xcf\x35\xf1\xca\x3a\xb8\xaa\x71\xd5\x9c\xfc\xbc\xd5\x87\xde\xd6\x35\x02\xb5\x42\x54\xa8\x14\x62\x3d\xfb\x6b\x0e\x51\x6f\xd7\x6e\x16\xe2\x89\xe7\x10\xdb\x6b\xdd\xde\x72\xe2\x1b\x29\x53\x8d\x20\xf2\x6a\x37\x8d\x17\x95\x41\xac\x96\x5b\x59\xe5\x52\x97\xc8\x94\x8a\x24\xe2\x41\xca\x85\xe9\x18\x4c\x65\x44\xd3\x0d\x8f\x1b\x15\x6c\x55\xb0\x57\x0e\x16\x83\x25\x52\xea\x0a\xd3\x2c\xfe\xf5\xc4\x37\x54\xf9\xc9\x13\x72\x6c\x3d\x47\xe7\xc6\x78\xf7\x51\x7b\x19\x26\x73\xd8\x31\xf9\xce\xf8\x76\x93\xb0\xcb\xfe\xb2\xa5\xbf\xe3\xdd\x50\x05\xf1\x99\xed\x4f\x2d\xed\x15\x6a\x89\xb7\xbd\x0c\x62\xac\x8d\xe2\x39\x8e\x64\x96\x4b\xc1\x84\xd1\x93\x31\xc4\x4f\xeb\x25\xdc\x24\x1f\xa2\xd7\x87\xdc\x3f\x65\x2f\xb1\x1b\xb3\xfd\xc4\x46\x4b\xad\xd3\x9d\x84\x86\x54\xb3\x8f\x8b\xd9\x60\x22\xa2\x54\x16\xf1\x32\xa5\x8a\x91\x1a\x3a\x9c\x82\x5e\xaf\xab\x10\xd8\xcd\xb0\xd7\xba\x68\x6c\x04\xbb\x80\xa0\x4e\x42\x50\x27\xe1\xe5\xec\x43\x2c\xf7\xee\xb8\xa5\xba\x71\x82\xdc\xdc\x44\xab\x4c\xc6\xf0\xe7\xf5\x21\xfd\x6f\x04\xfd\x8f\x96\xe2\x7f\x09\xdc\x3a\x7a\x6d\xf0\x35\xa6\x9f\x68\xcd\xd4\x03\x8f\x58\x58\xf0\x34\x66\x6a\xb0\x84\xfb\x2a\xee\x59\xda\x4a\x70\x10\x3b\xba\x96\xa0\xa1\xdb\x22\xa8\xe1\x0f\x8c\xf4\xb5\x87\xef\xcc\xf0\x2c\xdc\xf6\xc2\x41\x3a\xf7\xc3\x18\x6a\x89\xe5\xbe\x5a\xf5\xb2\x22\xb5\xf5\x88\x36\x11\xec\xf4\xc9\x4e\xda\xe3\x39\x91\x35\xbc\x23\x38\x91\xb0\xdd\x82\x4d\x6d\xfa\x52\x25\x64\x4d\x6a\x2d\x12\x65\x31\x49\x24\xcf\xec\x44\xd3\x7b\xfa\x5b\x22\x1b\xe8\x76\x8a\xb4\x43\x2b\x61\x02\x27\x72\xff\xa5\x70\x45\x0d\x7b\xa4\x5f\xed\xfc\x3f\x60\x53\xe5\x11\x66\x91\xd4\x5f\xb5\x61\xed\x32\x69\x80\x3d\xfb\x8e\xfc\xfb\xad\xe9\x47\x9a\x24\x4c\xb9\x2f\x94\x03\x36\x9b\x53\x67\x32\xfa\x52\xff\x4b\x98\x38\xa8\x8d\x43\x2e\x62\x6a\xe8\xf0\xa7\xad\x87\x83\x6f\xaa\x5e\x16\xad\xe7\x20\xe3\x89\xa2\x86\xed\x54\x4b\xc8\xc5\x04\xb5\x5b\xbe\xd3\x7c\xd1\xfe\x50\xf4\xca\x90\x8b\xaa\x37\x48\x9c\x8e\x32\x50\x31\x4d\xa2\x71\x6b\x9d\x6c\x7e\x87\x2a\x66\xf8\x09\x48\xdc\x99\xfe\xad\xee\xf3\xda\x99\xf5\xa2\x72\xb0\x86\xb2\x07\xd7\x88\x63\x83\x78\x21\x17\xe8\xb9\xcd\xf6\xb8\xf6\xf1\xe1\x3e\x61\x3b\x1f\x2d\xa6\x85\x20\x90\x7d\x89\xb9\x82\x38\x1f\x6c\x85\xc8\x2d\xcb\xe6\x42\x3f\xfb\xa4\x71\x37\xea\xae\xeb\x0a\xfa\xdd\xe3\xbf\x5e\xa2\xde\x89\x5c\x25\x23\x65\xaa\xc1\xbf\x03\x00\xcc\x3d\x21\x1c\xe7\x0c\x00\x00"
 
 func scriptsInstall_toolsShTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -1044,7 +1149,7 @@ func scriptsInstall_toolsShTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _scriptsInstall_tools_checkShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x41\x6b\x2a\x31\x14\x85\xf7\xf7\x57\x9c\x17\x07\x79\x2e\x9c\x08\x6f\xf9\xb0\xd0\x62\x4b\xdd\x54\x18\x4b\x37\x22\x32\xce\xdc\x69\x42\x63\x66\x48\x32\x2a\x94\xfe\xf7\x92\xb1\x03\xd5\x8d\x66\x13\x92\x9c\xfb\xf1\xe5\x0c\xfe\xc8\xd6\x3b\xb9\xd5\x56\xb2\xdd\x63\x9b\x7b\x45\xe4\x39\x60\xcc\x2d\xad\x20\x92\xcf\x87\xfb\xe5\xf3\xe6\xed\x31\x5b\xce\x5f\x9e\x16\xab\xc9\xfa\x4b\x60\xfc\xce\xf8\x87\x35\x86\x43\x74\xd1\x1a\x8d\x6e\xb8\xca\xb5\x21\x9a\xcd\xb3\x69\xf2\xb7\xd4\xce\xe6\x3b\x86\x48\x26\x62\x44\xd9\x62\xf1\x7a\xba\x2f\x4a\x88\x64\x36\xcf\x64\x9a\x4a\x11\xe7\x9b\x43\x89\x11\x91\xae\xb0\xc2\xd8\x41\x24\x3f\x59\x99\x86\xba\x36\x5e\x16\x8a\x8b\x0f\xdf\xee\xd2\x70\x0c\x02\xeb\xff\x08\x8a\x2d\x01\x80\xb6\x3e\xe4\xc6\x6c\xfa\x44\xa4\xc7\xfd\x17\xc3\x17\x4e\x37\xc1\xcb\x3e\xda\x21\x53\xaf\xc4\xa8\x23\x14\xad\x73\x6c\xc3\x19\x21\x0f\x57\x1c\x4e\xa3\x9d\xaf\x48\x2e\x1d\x04\xa6\x53\x88\xe4\x12\x7c\x2e\x1e\x17\x1f\x75\xc0\xa4\x3b\x56\x9a\x2a\x4d\xe4\xd5\x2d\xe2\x18\x20\x28\xed\x71\xd0\xc6\xc0\xf1\xae\xde\x73\x04\xf7\x5f\xc1\xc9\x18\x55\x6d\x4a\x76\xd1\xb2\x71\xec\xe3\x43\x6e\xcb\xbe\x31\x54\x8e\xbd\xa2\x9b\xcb\xc2\xdd\x95\x4a\xe8\x3b\x00\x00\xff\xff\x15\xb1\x2c\x53\x45\x02\x00\x00"
+var _scriptsInstall_tools_checkShTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x41\x6b\xc2\x30\x1c\xc5\xef\xff\x4f\xf1\x16\x83\xe8\xc1\x46\xd8\x71\x74\xb0\xe1\xc6\xbc\x4c\xa8\x63\x17\x11\xa9\xed\xbf\x6b\x58\x4c\x4b\x92\xaa\x30\xf6\xdd\x47\xab\xc2\xf4\xa2\xe4\x96\xbc\xf7\xcb\x8f\xd7\xbb\x53\x8d\x77\x6a\xad\xad\x62\xbb\xc5\x3a\xf5\x25\x91\xe7\x80\x11\x37\xb4\x80\x90\x3f\xcf\x4f\xf3\xb7\xd5\xe7\x4b\x32\x9f\xbe\xbf\xce\x16\xe3\xe5\xaf\xc0\xe8\x8b\x71\x8f\x25\xfa\x7d\x74\xd1\x0a\xb5\xae\xb9\x48\xb5\x21\x9a\x4c\x93\x58\x0e\x72\xed\x6c\xba\x61\x08\x39\x16\x43\x4a\x66\xb3\x8f\xc3\x7d\x96\x43\xc8\xc9\x34\x51\x51\xa4\x44\xdb\xaf\x77\x39\x86\x44\xba\xc0\x02\x23\x07\x21\x8f\x59\x15\x85\xaa\x32\x5e\x65\x25\x67\xdf\xbe\xd9\x44\x61\x1f\x04\x96\x0f\x08\x25\x5b\x02\x00\x6d\x7d\x48\x8d\x59\x9d\x12\xb1\x1c\x74\xc9\x7f\x0c\x9f\x39\x5d\x07\xaf\x4e\xd1\x0e\x19\xf9\x52\x0c\x3b\x42\xd6\x38\xc7\x36\x9c\x11\xd2\x70\xc5\xe1\x50\xed\x7c\x85\xbc\x74\x10\x88\x63\x08\x79\x09\x3e\x17\x6f\x0f\xef\x75\xc0\xb8\x43\x15\x9a\x0a\x4d\xe4\xcb\x5b\xc4\xd1\x43\x28\xb5\xc7\x4e\x1b\x03\xc7\x9b\x6a\xcb\x2d\x18\xc7\x1f\x71\x58\x0d\x45\x65\x72\x76\xd0\x05\x6a\xc7\xbe\x7d\x48\x6d\x7e\x5a\x0c\x85\x63\x5f\xd2\xcd\x63\xe1\xf1\xca\x24\xf4\x37\x00\x15\xb1\x2c\x53\x45\x02\x00\x00"
 
 func scriptsInstall_tools_checkShTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -1064,7 +1169,7 @@ func scriptsInstall_tools_checkShTmplt() (*asset, error) {
 	return a, nil
 }
 
-var _toolsGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x90\xcd\x8e\xc3\x20\x0c\x84\xcf\xcb\x53\xa0\x9c\x76\xb5\x22\x7e\xa4\xca\x10\xea\xa2\x04\x8c\xc0\x51\x9a\xb7\xaf\xf2\x53\xa9\x55\xda\x1e\x7a\x81\x19\x6b\x3e\xcb\x1a\x00\xfd\x6f\xc7\x30\x74\x5a\x98\x87\xaa\x54\x46\xd7\x23\xf9\xbb\x0d\x31\x73\x11\xfd\xab\x7e\x4e\xba\xa1\x20\x97\xd1\xb6\x8e\x23\x10\x1b\x1b\x52\x87\x82\xaf\x65\x73\x04\x06\x4c\x04\x91\x5d\x0f\xc4\xcb\xf7\x31\xb2\x3c\xe4\xd3\xbb\x4c\x2e\x2c\x6c\xc7\xf3\x26\x9c\x21\x9f\x0c\xf1\x31\x5d\xb2\x33\xde\x71\x9d\xab\xf8\xdd\x12\x8a\x9f\x70\x7e\x22\x1f\xe6\xdf\xee\xa8\x13\x12\xf9\xb2\xe3\xeb\x95\x2d\x17\x82\x2b\xac\x4d\x82\x8b\x1d\x10\x6f\x75\xd6\x46\xfd\xa9\x5b\x00\x00\x00\xff\xff\x57\x05\x89\x2e\x7a\x01\x00\x00"
+var _toolsGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x50\x41\x4e\x03\x31\x0c\x3c\x93\x57\x44\x39\x81\x50\xd6\xbf\x80\x67\x20\x6f\xe2\x9a\xa8\x9b\x38\x4a\x1c\x95\xaa\xea\xdf\x51\xbb\x80\x40\x0b\x1c\x7a\xb1\x67\xac\xf1\x68\x6c\x00\xfb\x38\x8f\xb4\x44\xab\x22\x4b\x37\xa6\x62\xd8\x23\xd3\x27\x4d\xb9\x4a\x53\x7b\x6f\xee\x5e\xac\xe3\xa4\xaf\x63\x9e\x82\x64\x60\xf1\x73\x2a\x11\x15\x7f\x87\x6e\xbb\xb0\x60\x61\xc8\x12\xf6\xc0\x72\x69\xff\x4a\x2e\x85\xa9\xfc\xa5\xa9\x4d\x54\xe6\xb1\x5b\x41\xf0\x4c\xc5\xb3\x38\x73\x3a\x79\x9b\x76\x76\x7a\x22\xd4\xd1\xa8\x4f\xcf\xa8\x74\xc0\xa3\x3d\x9f\x37\x4e\xad\x06\x4f\x41\xfa\xb1\x2b\x7d\x50\x5e\xd5\x3f\x5c\xbf\xcd\xdd\x8d\x1e\xfd\x80\xcc\xd4\xd6\x78\x54\xe2\x57\x9a\xeb\x31\x93\x34\x86\x37\xb8\x3e\x1c\x42\x8e\xc0\x92\x72\x95\xa6\xdd\x99\x07\xf3\x3e\x00\xde\xa9\xcb\xad\xa1\x01\x00\x00"
 
 func toolsGoTmpltBytes() ([]byte, error) {
 	return bindataRead(
@@ -1136,21 +1241,23 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	".dockerignore.tmplt":       DockerignoreTmplt,
-	".gitignore.tmplt":          GitignoreTmplt,
-	".goreleaser.yml.tmplt":     GoreleaserYmlTmplt,
-	"Dockerfile.tmplt":          dockerfileTmplt,
-	"Makefile.tmplt":            makefileTmplt,
-	"README.md.tmplt":           readmeMdTmplt,
-	"cmd/config.go.tmplt":       cmdConfigGoTmplt,
-	"cmd/db.go.tmplt":           cmdDbGoTmplt,
-	"cmd/main.go.tmplt":         cmdMainGoTmplt,
-	"cmd/oce.go.tmplt":          cmdOceGoTmplt,
-	"cmd/oidc.go.tmplt":         cmdOidcGoTmplt,
-	"cmd/ports.go.tmplt":        cmdPortsGoTmplt,
-	"cmd/service.go.tmplt":      cmdServiceGoTmplt,
-	"db/postgres/gen.sh.tmplt":  dbPostgresGenShTmplt,
-	"db/postgres/init.go.tmplt": dbPostgresInitGoTmplt,
+	".dockerignore.tmplt":                               DockerignoreTmplt,
+	".gitignore.tmplt":                                  GitignoreTmplt,
+	".goreleaser.yml.tmplt":                             GoreleaserYmlTmplt,
+	"Dockerfile.tmplt":                                  dockerfileTmplt,
+	"Makefile.tmplt":                                    makefileTmplt,
+	"README.md.tmplt":                                   readmeMdTmplt,
+	"cmd/config.go.tmplt":                               cmdConfigGoTmplt,
+	"cmd/convert.go.tmplt":                              cmdConvertGoTmplt,
+	"cmd/db.go.tmplt":                                   cmdDbGoTmplt,
+	"cmd/main.go.tmplt":                                 cmdMainGoTmplt,
+	"cmd/oce.go.tmplt":                                  cmdOceGoTmplt,
+	"cmd/oidc.go.tmplt":                                 cmdOidcGoTmplt,
+	"cmd/ports.go.tmplt":                                cmdPortsGoTmplt,
+	"cmd/service.go.tmplt":                              cmdServiceGoTmplt,
+	"cmd/{resource}_service.go.tmplt":                   cmdResource_serviceGoTmplt,
+	"db/postgres/gen.sh.tmplt":                          dbPostgresGenShTmplt,
+	"db/postgres/init.go.tmplt":                         dbPostgresInitGoTmplt,
 	"db/postgres/migrations/000001_init.down.sql.tmplt": dbPostgresMigrations000001_initDownSqlTmplt,
 	"db/postgres/migrations/000001_init.up.sql.tmplt":   dbPostgresMigrations000001_initUpSqlTmplt,
 	"db/postgres/migrations/source.go.tmplt":            dbPostgresMigrationsSourceGoTmplt,
@@ -1164,9 +1271,12 @@ var _bindata = map[string]func() (*asset, error){
 	"helm/templates/ingress.yaml":                       helmTemplatesIngressYaml,
 	"helm/templates/service.yaml":                       helmTemplatesServiceYaml,
 	"helm/values.yaml":                                  helmValuesYaml,
+	"internal/state/memory.go.tmplt":                    internalStateMemoryGoTmplt,
 	"internal/state/postgres.go.tmplt":                  internalStatePostgresGoTmplt,
 	"internal/state/store.go.tmplt":                     internalStateStoreGoTmplt,
 	"internal/state/store_observer.go.tmplt":            internalStateStore_observerGoTmplt,
+	"internal/state/{resource}_memory.go.tmplt":         internalStateResource_memoryGoTmplt,
+	"internal/state/{resource}_postgres.go.tmplt":       internalStateResource_postgresGoTmplt,
 	"kustomize/base/deployment.yaml":                    kustomizeBaseDeploymentYaml,
 	"kustomize/base/ingress.yaml":                       kustomizeBaseIngressYaml,
 	"kustomize/base/kustomization.yaml":                 kustomizeBaseKustomizationYaml,
@@ -1234,13 +1344,15 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"Makefile.tmplt":        &bintree{makefileTmplt, map[string]*bintree{}},
 	"README.md.tmplt":       &bintree{readmeMdTmplt, map[string]*bintree{}},
 	"cmd": &bintree{nil, map[string]*bintree{
-		"config.go.tmplt":  &bintree{cmdConfigGoTmplt, map[string]*bintree{}},
-		"db.go.tmplt":      &bintree{cmdDbGoTmplt, map[string]*bintree{}},
-		"main.go.tmplt":    &bintree{cmdMainGoTmplt, map[string]*bintree{}},
-		"oce.go.tmplt":     &bintree{cmdOceGoTmplt, map[string]*bintree{}},
-		"oidc.go.tmplt":    &bintree{cmdOidcGoTmplt, map[string]*bintree{}},
-		"ports.go.tmplt":   &bintree{cmdPortsGoTmplt, map[string]*bintree{}},
-		"service.go.tmplt": &bintree{cmdServiceGoTmplt, map[string]*bintree{}},
+		"config.go.tmplt":             &bintree{cmdConfigGoTmplt, map[string]*bintree{}},
+		"convert.go.tmplt":            &bintree{cmdConvertGoTmplt, map[string]*bintree{}},
+		"db.go.tmplt":                 &bintree{cmdDbGoTmplt, map[string]*bintree{}},
+		"main.go.tmplt":               &bintree{cmdMainGoTmplt, map[string]*bintree{}},
+		"oce.go.tmplt":                &bintree{cmdOceGoTmplt, map[string]*bintree{}},
+		"oidc.go.tmplt":               &bintree{cmdOidcGoTmplt, map[string]*bintree{}},
+		"ports.go.tmplt":              &bintree{cmdPortsGoTmplt, map[string]*bintree{}},
+		"service.go.tmplt":            &bintree{cmdServiceGoTmplt, map[string]*bintree{}},
+		"{resource}_service.go.tmplt": &bintree{cmdResource_serviceGoTmplt, map[string]*bintree{}},
 	}},
 	"db": &bintree{nil, map[string]*bintree{
 		"postgres": &bintree{nil, map[string]*bintree{
@@ -1269,9 +1381,12 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	}},
 	"internal": &bintree{nil, map[string]*bintree{
 		"state": &bintree{nil, map[string]*bintree{
-			"postgres.go.tmplt":       &bintree{internalStatePostgresGoTmplt, map[string]*bintree{}},
-			"store.go.tmplt":          &bintree{internalStateStoreGoTmplt, map[string]*bintree{}},
-			"store_observer.go.tmplt": &bintree{internalStateStore_observerGoTmplt, map[string]*bintree{}},
+			"memory.go.tmplt":              &bintree{internalStateMemoryGoTmplt, map[string]*bintree{}},
+			"postgres.go.tmplt":            &bintree{internalStatePostgresGoTmplt, map[string]*bintree{}},
+			"store.go.tmplt":               &bintree{internalStateStoreGoTmplt, map[string]*bintree{}},
+			"store_observer.go.tmplt":      &bintree{internalStateStore_observerGoTmplt, map[string]*bintree{}},
+			"{resource}_memory.go.tmplt":   &bintree{internalStateResource_memoryGoTmplt, map[string]*bintree{}},
+			"{resource}_postgres.go.tmplt": &bintree{internalStateResource_postgresGoTmplt, map[string]*bintree{}},
 		}},
 	}},
 	"kustomize": &bintree{nil, map[string]*bintree{
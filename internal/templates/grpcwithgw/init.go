@@ -1,13 +1,12 @@
 package grpcwithgw
 
 import (
-	"fmt"
-	"strings"
 	"text/template"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/cnative/servicebuilder/internal/builder"
+	"github.com/cnative/servicebuilder/internal/templates"
 )
 
 //go:generate go-bindata -o ./grpc_service_with_gw.go -pkg grpcwithgw -nometadata -nomemcopy -prefix tmplt tmplt/...
@@ -20,13 +19,37 @@ type (
 )
 
 var (
-	funcs = template.FuncMap{
-		"TitleCase": strings.Title,
-		"LowerCase": strings.ToLower,
-		"Trim":      strings.Trim,
-	}
+	funcs = templates.Funcs()
 )
 
+func init() {
+	// ScalarTypes maps the go types of the fields that have nullable conversion helpers on to the
+	// well known wrapper types of their nullable proto fields
+	funcs["ScalarTypes"] = func() map[string]string {
+		return map[string]string{
+			"string":  "StringValue",
+			"int32":   "Int32Value",
+			"int64":   "Int64Value",
+			"float32": "FloatValue",
+			"float64": "DoubleValue",
+			"bool":    "BoolValue",
+		}
+	}
+
+	templates.Register(templates.Provider{
+		Name:        "grpc-gateway",
+		Description: "gRPC service with a REST/JSON gateway, postgres state store, OIDC auth and k8s/helm deployment",
+		New:         New,
+		Features:    builder.DefaultFeatures(),
+	})
+	templates.Register(templates.Provider{
+		Name:        "grpc-only",
+		Description: "gRPC service without the REST/JSON gateway. otherwise same as grpc-gateway",
+		New:         New,
+		Features:    builder.Features{Postgres: true, OIDC: true},
+	})
+}
+
 // New creates GRPC Service Builder with Gateway Builder
 func New(o *builder.Options) (builder.TemplateProvider, error) {
 
@@ -40,41 +63,11 @@ func New(o *builder.Options) (builder.TemplateProvider, error) {
 	return s, nil
 }
 
-func (g *grpcServiceTemplateProvider) initialize() error {
-
-	g.templates = make(map[string]*template.Template)
+func (g *grpcServiceTemplateProvider) initialize() (err error) {
 
-	for k, v := range _bindata {
-		t, err := v()
-		if err != nil {
-			return err
-		}
-
-		s := string(t.bytes)
-		f := strings.TrimSuffix(k, ".tmplt")
-		tmplt := template.New(f).Funcs(funcs)
-
-		if strings.HasPrefix(k, "helm") {
-			if g.options.DeploymentType != builder.HemlChart {
-				continue //ignore required deployment is not helm
-			}
-			f = fmt.Sprintf("deployment%s", strings.TrimPrefix(k, "helm"))
-			tmplt = template.New(f).Funcs(funcs).Delims("[[", "]]")
-		} else if strings.HasPrefix(k, "kustomize") {
-			if g.options.DeploymentType != builder.K8SManifest {
-				continue //ignore required deployment is not k8s
-			}
-			f = fmt.Sprintf("deployment%s", strings.TrimPrefix(k, "kustomize"))
-		}
-
-		pt, err := tmplt.Parse(s)
-		if err != nil {
-			return err
-		}
-		if f == "proto" {
-			f = fmt.Sprintf("%s.proto", g.options.Name)
-		}
-		g.templates[f] = pt
+	l := templates.Loader{Funcs: funcs}
+	if g.templates, err = l.Load(g.options, AssetNames(), Asset); err != nil {
+		return err
 	}
 
 	log.Info("gRPC service with Gateway template provider initialized")
@@ -0,0 +1,263 @@
+package grpcwithgw
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+)
+
+const (
+	storeFile     = "internal/state/store.go"
+	mainFile      = "cmd/main.go"
+	migrationsDir = "db/postgres/migrations"
+	initMigration = migrationsDir + "/000001_init"
+)
+
+var (
+	migrationRegEx = regexp.MustCompile(`^([0-9]+)_.+\.up\.sql$`)
+)
+
+// AddResource renders the files of resource r and extends the shared files of the project in dir.
+// Existing files are edited in place so that local modifications are retained.
+// r is appended to the resources and the added resources of the options
+func (g *grpcServiceTemplateProvider) AddResource(dir, r string) ([]*builder.File, error) {
+
+	for _, e := range g.options.Resources {
+		if strings.EqualFold(e, r) {
+			return nil, errors.Errorf("resource %s already exists", e)
+		}
+	}
+	o := g.options.ForResource(r)
+
+	files := []*builder.File{}
+	for k, t := range g.templates {
+		if !strings.Contains(k, builder.ResourceToken) {
+			continue
+		}
+
+		p := strings.Replace(k, builder.ResourceToken, strcase.ToSnake(r), -1)
+		if _, err := os.Stat(path.Join(dir, p)); !os.IsNotExist(err) {
+			return nil, errors.Errorf("%s already exists", p)
+		}
+
+		var b bytes.Buffer
+		if err := t.Execute(&b, o); err != nil {
+			return nil, errors.Wrapf(err, "unable to render %s", p)
+		}
+		files = append(files, &builder.File{Path: p, Content: builder.FormatSource(p, b.Bytes())})
+	}
+
+	edits := []struct {
+		file  string
+		apply func(src []byte) ([]byte, error)
+	}{
+		{storeFile, func(src []byte) ([]byte, error) {
+			methods, err := g.snippet(storeFile, "methods", o)
+			if err != nil {
+				return nil, err
+			}
+			model, err := g.snippet(storeFile, "model", o)
+			if err != nil {
+				return nil, err
+			}
+			return editGoSource(src, func(fs *token.FileSet, f *ast.File) ([]insertion, error) {
+				return storeInsertions(fs, f, model, methods)
+			})
+		}},
+		{mainFile, func(src []byte) ([]byte, error) {
+			handler, err := g.snippet(mainFile, "handler", o)
+			if err != nil {
+				return nil, err
+			}
+			return editGoSource(src, func(fs *token.FileSet, f *ast.File) ([]insertion, error) {
+				return handlerInsertions(fs, f, handler)
+			})
+		}},
+		{fmt.Sprintf("%s.proto", o.Name), func(src []byte) ([]byte, error) {
+			res, err := g.snippet(fmt.Sprintf("%s.proto", o.Name), "resource", o)
+			if err != nil {
+				return nil, err
+			}
+			return append(bytes.TrimRight(src, "\n"), []byte(res+"\n")...), nil
+		}},
+	}
+
+	for _, e := range edits {
+		src, err := ioutil.ReadFile(path.Join(dir, e.file))
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := e.apply(src)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to edit %s", e.file)
+		}
+		files = append(files, &builder.File{Path: e.file, Content: b})
+	}
+
+	if o.Features.Postgres {
+		migrations, err := g.migrations(dir, o)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, migrations...)
+	}
+
+	g.options.Resources = append(g.options.Resources, r)
+	g.options.AddedResources = append(g.options.AddedResources, r)
+
+	return files, nil
+}
+
+// snippet renders template name that is defined in the template of file p
+func (g *grpcServiceTemplateProvider) snippet(p, name string, data interface{}) (string, error) {
+
+	t, ok := g.templates[p]
+	if !ok {
+		return "", errors.Errorf("template for %s not found", p)
+	}
+
+	st := t.Lookup(name)
+	if st == nil {
+		return "", errors.Errorf("template for %s does not define %q", p, name)
+	}
+
+	var b bytes.Buffer
+	if err := st.Execute(&b, data); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// migrations renders up and down migrations that create the resource table.
+// the migration is numbered after the highest existing migration
+func (g *grpcServiceTemplateProvider) migrations(dir string, o *builder.Options) ([]*builder.File, error) {
+
+	entries, err := ioutil.ReadDir(path.Join(dir, migrationsDir))
+	if err != nil {
+		return nil, err
+	}
+
+	next := 1
+	for _, e := range entries {
+		m := migrationRegEx.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		if n, _ := strconv.Atoi(m[1]); n >= next {
+			next = n + 1
+		}
+	}
+
+	files := []*builder.File{}
+	for _, kind := range []string{"up", "down"} {
+		s, err := g.snippet(fmt.Sprintf("%s.%s.sql", initMigration, kind), kind, o)
+		if err != nil {
+			return nil, err
+		}
+		p := fmt.Sprintf("%s/%06d_add_%s.%s.sql", migrationsDir, next, o.Singular().Snake, kind)
+		files = append(files, &builder.File{Path: p, Content: []byte(s + "\n")})
+	}
+
+	return files, nil
+}
+
+type insertion struct {
+	offset int
+	text   string
+}
+
+// editGoSource inserts text at the offsets located by find and formats the result
+func editGoSource(src []byte, find func(fs *token.FileSet, f *ast.File) ([]insertion, error)) ([]byte, error) {
+
+	fs := token.NewFileSet()
+	f, err := parser.ParseFile(fs, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, err := find(fs, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply from the end so that the offsets stay valid
+	out := append([]byte{}, src...)
+	for i := len(ins) - 1; i >= 0; i-- {
+		in := ins[i]
+		out = append(out[:in.offset], append([]byte(in.text), out[in.offset:]...)...)
+	}
+
+	return format.Source(out)
+}
+
+// storeInsertions adds the model before the Store interface and the methods at the end of the interface
+func storeInsertions(fs *token.FileSet, f *ast.File, model, methods string) ([]insertion, error) {
+
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range gd.Specs {
+			ts := s.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || ts.Name.Name != "Store" {
+				continue
+			}
+
+			start := gd.Pos()
+			if gd.Doc != nil {
+				start = gd.Doc.Pos()
+			}
+
+			return []insertion{
+				{offset: fs.Position(start).Offset, text: strings.TrimLeft(model, "\n") + "\n\n"},
+				{offset: fs.Position(it.Methods.Closing).Offset, text: methods + "\n"},
+			}, nil
+		}
+	}
+
+	return nil, errors.New("Store interface not found")
+}
+
+// handlerInsertions registers the handler in the apiHandlers composite literal
+func handlerInsertions(fs *token.FileSet, f *ast.File, handler string) ([]insertion, error) {
+
+	var ins []insertion
+	ast.Inspect(f, func(n ast.Node) bool {
+		cl, ok := n.(*ast.CompositeLit)
+		if !ok || ins != nil {
+			return ins == nil
+		}
+
+		if id, ok := cl.Type.(*ast.Ident); ok && id.Name == "apiHandlers" {
+			ins = []insertion{{offset: fs.Position(cl.Rbrace).Offset, text: handler + "\n"}}
+			return false
+		}
+
+		return true
+	})
+
+	if ins == nil {
+		return nil, errors.New("apiHandlers registration not found")
+	}
+
+	return ins, nil
+}
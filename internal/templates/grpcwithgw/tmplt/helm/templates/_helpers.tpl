@@ -1,3 +1,8 @@
+---
+when: eq .DeploymentType.String "helm"
+path: deployment/templates/_helpers.tpl
+delims: ["[[", "]]"]
+---
 {{/* vim: set filetype=mustache: */}}
 {{/*
 Expand the name of the chart.
@@ -0,0 +1,205 @@
+package templates
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+)
+
+const (
+	// Suffix of template files. it is removed from the output path
+	Suffix = ".tmplt"
+
+	frontMatterFence = "---"
+
+	helmPrefix      = "helm"
+	kustomizePrefix = "kustomize"
+	deploymentDir   = "deployment"
+)
+
+type (
+	// Loader parses a template tree into templates keyed by their output path. The output path is the
+	// path of the template without the .tmplt suffix unless the front-matter of the template says otherwise.
+	// The conventions of trees that predate the front-matter are implied where the front-matter is not set
+	//  - files under helm/ are rendered to deployment/ when the deployment type is helm and are skipped otherwise
+	//  - files under kustomize/ are rendered to deployment/ when the deployment type is k8s and are skipped otherwise
+	//  - proto is rendered to <service name>.proto
+	Loader struct {
+		Funcs template.FuncMap
+		// Delims are the left and right delimiters of the templates. the go template defaults are used when empty
+		Delims []string
+		// HelmDelims are the delimiters of the templates under helm/ without delimiters in their front-matter.
+		// they default to [[ and ]] so that the go templates of the helm chart are retained
+		HelmDelims []string
+	}
+
+	// FrontMatter is the optional header of a template. It is YAML enclosed in --- lines at the start of the
+	// template. For example
+	//  ---
+	//  when: eq .DeploymentType.String "helm"
+	//  path: deployment/Chart.yaml
+	//  delims: ["[[", "]]"]
+	//  ---
+	FrontMatter struct {
+		// When is a template expression evaluated against the options. the template is skipped unless it is true
+		When string `yaml:"when"`
+		// Path is a template of the output path evaluated against the options
+		Path string `yaml:"path"`
+		// Delims are the left and right delimiters of the template
+		Delims []string `yaml:"delims"`
+	}
+)
+
+// Funcs returns the template functions available to every template
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		"TitleCase": strings.Title,
+		"LowerCase": strings.ToLower,
+		"Trim":      strings.Trim,
+	}
+}
+
+// Load parses every file in names. read returns the content of a file
+func (l Loader) Load(o *builder.Options, names []string, read func(name string) ([]byte, error)) (map[string]*template.Template, error) {
+
+	for _, d := range [][]string{l.Delims, l.HelmDelims} {
+		if err := validateDelims(d); err != nil {
+			return nil, err
+		}
+	}
+
+	tmplts := make(map[string]*template.Template)
+	for _, k := range names {
+
+		b, err := read(k)
+		if err != nil {
+			return nil, err
+		}
+
+		fm, body, err := ParseFrontMatter(b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid front-matter of template %s", k)
+		}
+		l.imply(k, fm)
+
+		if fm.When != "" {
+			ok, err := l.evaluate("{{ if "+fm.When+" }}true{{ end }}", o)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to evaluate 'when' of template %s", k)
+			}
+			if ok != "true" {
+				continue
+			}
+		}
+
+		f := strings.TrimSuffix(k, Suffix)
+		if fm.Path != "" {
+			if f, err = l.evaluate(fm.Path, o); err != nil {
+				return nil, errors.Wrapf(err, "unable to evaluate 'path' of template %s", k)
+			}
+			f = strings.TrimSpace(f)
+		}
+
+		delims := l.Delims
+		if len(fm.Delims) > 0 {
+			delims = fm.Delims
+		}
+
+		t := template.New(f).Funcs(l.Funcs)
+		if len(delims) == 2 {
+			t = t.Delims(delims[0], delims[1])
+		}
+		if t, err = t.Parse(string(body)); err != nil {
+			return nil, errors.Wrapf(err, "unable to parse template %s", k)
+		}
+		tmplts[f] = t
+	}
+
+	return tmplts, nil
+}
+
+// imply sets the front-matter of the template k that is not set to the conventions of the tree
+func (l Loader) imply(k string, fm *FrontMatter) {
+
+	f := strings.TrimSuffix(k, Suffix)
+	switch {
+	case strings.HasPrefix(k, helmPrefix+"/"):
+		if fm.When == "" {
+			fm.When = `eq .DeploymentType.String "helm"`
+		}
+		if fm.Path == "" {
+			fm.Path = deploymentDir + strings.TrimPrefix(f, helmPrefix)
+		}
+		if len(fm.Delims) == 0 {
+			fm.Delims = l.HelmDelims
+		}
+		if len(fm.Delims) == 0 {
+			fm.Delims = []string{"[[", "]]"}
+		}
+	case strings.HasPrefix(k, kustomizePrefix+"/"):
+		if fm.When == "" {
+			fm.When = `eq .DeploymentType.String "k8s"`
+		}
+		if fm.Path == "" {
+			fm.Path = deploymentDir + strings.TrimPrefix(f, kustomizePrefix)
+		}
+	case f == "proto":
+		if fm.Path == "" {
+			fm.Path = "{{ .Name }}.proto"
+		}
+	}
+}
+
+func (l Loader) evaluate(text string, o *builder.Options) (string, error) {
+
+	t, err := template.New("").Funcs(l.Funcs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	if err := t.Execute(&b, o); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// ParseFrontMatter splits b into the front-matter and the body of the template. the front-matter is
+// empty when b does not start with a --- line
+func ParseFrontMatter(b []byte) (*FrontMatter, []byte, error) {
+
+	fm := &FrontMatter{}
+	s := string(b)
+	if !strings.HasPrefix(s, frontMatterFence+"\n") {
+		return fm, b, nil
+	}
+
+	s = strings.TrimPrefix(s, frontMatterFence+"\n")
+	end := strings.Index(s, "\n"+frontMatterFence+"\n")
+	if end < 0 {
+		return nil, nil, errors.New("front-matter is not terminated by a --- line")
+	}
+
+	if err := yaml.UnmarshalStrict([]byte(s[:end]), fm); err != nil {
+		return nil, nil, err
+	}
+	if err := validateDelims(fm.Delims); err != nil {
+		return nil, nil, err
+	}
+
+	return fm, []byte(s[end+len(frontMatterFence)+2:]), nil
+}
+
+func validateDelims(d []string) error {
+	if len(d) != 0 && len(d) != 2 {
+		return errors.Errorf("invalid delimiters %v. expected a left and a right delimiter", d)
+	}
+
+	return nil
+}
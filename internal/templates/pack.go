@@ -0,0 +1,229 @@
+package templates
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+)
+
+// PackManifest is the name of the optional file, in the root of a template pack, that describes the pack
+const PackManifest = "pack.yaml"
+
+type (
+	// Pack is a template tree maintained outside of servicebuilder. The front-matter of each template
+	// decides whether and where the template is rendered. see FrontMatter and the conventions of Loader
+	Pack struct {
+		Name        string   `yaml:"name"`
+		Description string   `yaml:"description"`
+		Delims      []string `yaml:"delims"`
+		HelmDelims  []string `yaml:"helmDelims"`
+		// Funcs maps the name of a template function to one of the functions in FuncLibrary
+		Funcs map[string]string `yaml:"funcs"`
+
+		files map[string][]byte
+	}
+
+	packTemplateProvider struct {
+		options   *builder.Options
+		templates map[string]*template.Template
+	}
+)
+
+var (
+	// FuncLibrary are the functions a pack can declare as template functions
+	FuncLibrary = map[string]interface{}{
+		"strings.Contains":         strings.Contains,
+		"strings.HasPrefix":        strings.HasPrefix,
+		"strings.HasSuffix":        strings.HasSuffix,
+		"strings.Join":             strings.Join,
+		"strings.Repeat":           strings.Repeat,
+		"strings.Replace":          strings.Replace,
+		"strings.ReplaceAll":       strings.ReplaceAll,
+		"strings.Split":            strings.Split,
+		"strings.Title":            strings.Title,
+		"strings.ToLower":          strings.ToLower,
+		"strings.ToUpper":          strings.ToUpper,
+		"strings.Trim":             strings.Trim,
+		"strings.TrimPrefix":       strings.TrimPrefix,
+		"strings.TrimSpace":        strings.TrimSpace,
+		"strings.TrimSuffix":       strings.TrimSuffix,
+		"strcase.ToCamel":          strcase.ToCamel,
+		"strcase.ToKebab":          strcase.ToKebab,
+		"strcase.ToLowerCamel":     strcase.ToLowerCamel,
+		"strcase.ToScreamingSnake": strcase.ToScreamingSnake,
+		"strcase.ToSnake":          strcase.ToSnake,
+		"path.Base":                path.Base,
+		"path.Dir":                 path.Dir,
+		"path.Join":                path.Join,
+	}
+)
+
+// LoadPack reads the template pack in src. src is either a directory or a git repository url.
+// a branch or tag of the repository is selected with a #<ref> suffix
+func LoadPack(src string) (*Pack, error) {
+
+	// src may come from the manifest of a cloned project. it must not be taken as an option of git
+	if strings.HasPrefix(src, "-") {
+		return nil, errors.Errorf("invalid template pack %q. it cannot start with -", src)
+	}
+
+	dir := src
+	if IsGitURL(src) {
+		tmp, err := ioutil.TempDir("", "servicebuilder-pack")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(tmp)
+
+		if err := gitClone(src, tmp); err != nil {
+			return nil, err
+		}
+		dir = tmp
+	}
+
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, errors.Errorf("template pack %s is not a directory", src)
+	}
+
+	p := &Pack{Name: path.Base(strings.TrimSuffix(strings.SplitN(filepath.ToSlash(src), "#", 2)[0], ".git"))}
+	b, err := ioutil.ReadFile(filepath.Join(dir, PackManifest))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := yaml.UnmarshalStrict(b, p); err != nil {
+			return nil, errors.Wrapf(err, "invalid pack manifest %s", PackManifest)
+		}
+	}
+
+	for n, f := range p.Funcs {
+		if _, ok := FuncLibrary[f]; !ok {
+			return nil, errors.Errorf("%s: unknown function %q of template function %q", PackManifest, f, n)
+		}
+	}
+
+	p.files = map[string][]byte{}
+	err = filepath.Walk(dir, func(fp string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			if fi.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, fp)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == PackManifest {
+			return nil
+		}
+
+		b, err := ioutil.ReadFile(fp)
+		if err != nil {
+			return err
+		}
+		p.files[rel] = b
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(p.files) == 0 {
+		return nil, errors.Errorf("template pack %s has no templates", src)
+	}
+
+	return p, nil
+}
+
+// Provider creates a template provider that renders the pack
+func (p *Pack) Provider(o *builder.Options) (builder.TemplateProvider, error) {
+
+	funcs := Funcs()
+	for n, f := range p.Funcs {
+		funcs[n] = FuncLibrary[f]
+	}
+
+	names := make([]string, 0, len(p.files))
+	for n := range p.files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	l := Loader{Funcs: funcs, Delims: p.Delims, HelmDelims: p.HelmDelims}
+	tmplts, err := l.Load(o, names, func(name string) ([]byte, error) {
+		return p.files[name], nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "template pack %s", p.Name)
+	}
+	log.WithField("pack", p.Name).Info("template pack provider initialized")
+
+	return &packTemplateProvider{options: o, templates: tmplts}, nil
+}
+
+func (p *packTemplateProvider) GetTemplates() map[string]*template.Template {
+	return p.templates
+}
+
+func (p *packTemplateProvider) GetOptions() *builder.Options {
+	return p.options
+}
+
+// IsGitURL reports whether src of a template pack is a git repository rather than a local directory
+func IsGitURL(src string) bool {
+	if strings.HasPrefix(src, "-") {
+		return false
+	}
+
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git://", "git@", "file://"} {
+		if strings.HasPrefix(src, prefix) {
+			return true
+		}
+	}
+
+	return strings.HasSuffix(strings.SplitN(src, "#", 2)[0], ".git")
+}
+
+// gitClone makes a shallow clone of the repository url into dir
+func gitClone(url, dir string) error {
+
+	args := []string{"clone", "--quiet", "--depth", "1"}
+	if parts := strings.SplitN(url, "#", 2); len(parts) == 2 {
+		url = parts[0]
+		if strings.HasPrefix(parts[1], "-") {
+			return errors.Errorf("invalid ref %q of template pack %s", parts[1], url)
+		}
+		args = append(args, "--branch", parts[1])
+	}
+	args = append(args, "--", url, dir)
+
+	log.WithField("url", url).Info("cloning template pack")
+	out, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "unable to clone template pack %s: %s", url, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package simple
+
+import (
+	"strings"
+	"text/template"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+	"github.com/cnative/servicebuilder/internal/templates"
+)
+
+//go:generate go-bindata -o ./simple.go -pkg simple -nometadata -nomemcopy -prefix tmplt tmplt/...
+
+type (
+	// simpleTemplateProvider renders the layers of a template tree. a file in a later layer replaces the file
+	// with the same path in an earlier layer
+	simpleTemplateProvider struct {
+		options   *builder.Options
+		templates map[string]*template.Template
+	}
+)
+
+var (
+	funcs = templates.Funcs()
+
+	// layers of each template
+	layers = map[string][]string{
+		"http-only": {"common", "service", "http"},
+		"worker":    {"common", "service", "worker"},
+		"cli":       {"common", "cli"},
+	}
+)
+
+func init() {
+	templates.Register(templates.Provider{
+		Name:        "http-only",
+		Description: "HTTP/JSON service with an in memory CRUD handler for every resource. no gRPC, database or deployment artifacts",
+		New:         factory("http-only"),
+	})
+	templates.Register(templates.Provider{
+		Name:        "worker",
+		Description: "background worker that runs its work periodically and exposes health end points",
+		New:         factory("worker"),
+	})
+	templates.Register(templates.Provider{
+		Name:        "cli",
+		Description: "command line application with an example command",
+		New:         factory("cli"),
+	})
+}
+
+func factory(name string) templates.Factory {
+	return func(o *builder.Options) (builder.TemplateProvider, error) {
+		s := &simpleTemplateProvider{options: o}
+		if err := s.initialize(layers[name]); err != nil {
+			return nil, err
+		}
+		log.WithField("template", name).Info("template provider initialized")
+
+		return s, nil
+	}
+}
+
+func (s *simpleTemplateProvider) initialize(layers []string) error {
+
+	s.templates = make(map[string]*template.Template)
+	for _, l := range layers {
+		prefix := l + "/"
+		names := []string{}
+		for _, k := range AssetNames() {
+			if strings.HasPrefix(k, prefix) {
+				names = append(names, strings.TrimPrefix(k, prefix))
+			}
+		}
+
+		tmplts, err := templates.Loader{Funcs: funcs}.Load(s.options, names, func(name string) ([]byte, error) {
+			return Asset(prefix + name)
+		})
+		if err != nil {
+			return err
+		}
+		for k, t := range tmplts {
+			s.templates[k] = t
+		}
+	}
+
+	return nil
+}
+
+func (s *simpleTemplateProvider) GetTemplates() map[string]*template.Template {
+	return s.templates
+}
+
+func (s *simpleTemplateProvider) GetOptions() *builder.Options {
+	return s.options
+}
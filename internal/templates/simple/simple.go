@@ -0,0 +1,590 @@
+// Code generated for package simple by go-bindata DO NOT EDIT. (@generated)
+// sources:
+// tmplt/cli/cmd/commands.go.tmplt
+// tmplt/common/.dockerignore.tmplt
+// tmplt/common/.gitignore.tmplt
+// tmplt/common/Dockerfile.tmplt
+// tmplt/common/Makefile.tmplt
+// tmplt/common/README.md.tmplt
+// tmplt/common/cmd/main.go.tmplt
+// tmplt/common/go.mod.tmplt
+// tmplt/http/cmd/handlers.go.tmplt
+// tmplt/http/cmd/server.go.tmplt
+// tmplt/http/cmd/{resource}_handler.go.tmplt
+// tmplt/service/cmd/health.go.tmplt
+// tmplt/service/cmd/ports.go.tmplt
+// tmplt/worker/cmd/run.go.tmplt
+// tmplt/worker/internal/worker/worker.go.tmplt
+package simple
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+// Name return file name
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+
+// Size return file size
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+
+// Mode return file mode
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+
+// Mode return file modify time
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+
+// IsDir return file whether a directory
+func (fi bindataFileInfo) IsDir() bool {
+	return fi.mode&os.ModeDir != 0
+}
+
+// Sys return file is sys mode
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _cliCmdCommandsGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xcf\x8b\xd5\x30\x10\xc7\xcf\xc9\x5f\x31\x06\x16\x5a\x79\x64\xef\x85\x77\x58\x16\x1e\x08\xe2\x41\x51\x0f\xea\x21\xe6\x4d\xd2\x60\x7e\x94\x74\xba\x2b\x94\xfe\xef\x32\xe9\xeb\xd3\x83\x87\xa6\xe4\x33\xed\x37\x9f\xcc\x4c\xc6\xfe\x32\x1e\x21\x99\x90\xa5\x0c\x69\x2a\x95\xa0\x93\x42\xb9\x44\x4a\x4a\xa1\x7c\xa0\x71\xf9\xa9\x6d\x49\x8f\x4b\x75\xe6\x05\x1f\x6d\x0c\x4a\xf6\x52\xbe\x98\xca\x5f\xda\x92\x92\xc9\xd7\x19\xce\xf0\xed\x87\x8d\x41\x3f\xef\x60\x95\x42\xf0\x23\x3e\x98\x84\x03\x00\xa8\x11\x63\x2c\xea\xc4\xec\xf3\x6c\x3c\x43\x35\xd5\x90\x69\x06\x03\xbe\x22\x52\xc8\x5e\x43\xc5\x29\x1a\x8b\x10\x08\x5e\x03\x8d\x40\x23\xc2\xfd\x90\xe2\x60\x5d\x41\x73\x26\x6c\xdb\x1e\xf6\x64\x29\x94\x3c\x40\xcb\xdf\x37\x8d\x5f\xa2\xf1\xf3\x70\xb3\xe2\x4d\xd3\x11\xec\xf8\x89\x6a\xc8\xfe\x2f\x3b\x24\x55\x36\x09\xf7\xd4\xbb\x64\x63\x40\x65\x57\x3c\x8a\x5f\x4c\x5c\xb8\xf8\x5a\x6a\xbc\xde\xe0\xd6\x5e\x6d\xe5\x65\xe3\x2e\xb9\x25\xdb\x7f\xcd\x3a\x0b\x6f\xd9\xe0\xb9\x64\xc2\xdf\xd4\x03\xd6\x5a\x2a\xac\x52\x8a\x58\xbc\xc7\x7a\x62\x02\xc3\x19\x3c\xd2\xc7\x52\xe8\x7d\xa3\x9d\xed\xa5\x08\xae\xd5\xde\x9c\x21\x87\x08\x6c\x5e\x91\x96\x9a\x99\xf2\x71\xe2\x8a\x0e\x2b\xec\x39\xfa\x12\x97\x79\xec\xfa\x7b\xb0\x7e\x97\x5d\x71\x9d\x3a\x3a\x0d\x0f\xb3\x3a\x81\xbd\xf5\xa2\x6b\xd7\x54\x7d\x2f\x85\x4b\xa4\x2f\x6d\x30\xae\xb3\xfa\x69\x9a\xf4\xd7\x1a\x88\xcd\xf6\x11\xc2\xc3\xfc\x3d\xff\xf7\x57\x79\x08\xe5\x10\xe5\x26\xff\x0c\x00\x9d\xd1\xdc\x45\x5d\x02\x00\x00"
+
+func cliCmdCommandsGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_cliCmdCommandsGoTmplt,
+		"cli/cmd/commands.go.tmplt",
+	)
+}
+
+func cliCmdCommandsGoTmplt() (*asset, error) {
+	bytes, err := cliCmdCommandsGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cli/cmd/commands.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _commonDockerignoreTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x19\x00\xe6\xff\x76\x65\x6e\x64\x6f\x72\x0a\x2e\x76\x73\x63\x6f\x64\x65\x0a\x2e\x74\x6f\x6f\x6c\x73\x0a\x62\x69\x6e\x03\x00\xde\x92\x0a\x55\x19\x00\x00\x00"
+
+func commonDockerignoreTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_commonDockerignoreTmplt,
+		"common/.dockerignore.tmplt",
+	)
+}
+
+func commonDockerignoreTmplt() (*asset, error) {
+	bytes, err := commonDockerignoreTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "common/.dockerignore.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _commonGitignoreTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x41\x4b\xc3\x40\x10\x85\xef\xef\x57\x2c\xf4\xa2\x8b\x8c\x28\x28\x5e\xc5\xe2\x4d\x7a\xc8\x51\x24\x6c\x76\x27\xe9\x96\x74\x27\xee\x4c\x4a\x7a\xf1\xb7\x4b\xac\x7a\x1a\x66\xbe\x8f\x79\x6f\xe3\x52\x56\x43\x97\x0b\x40\x26\x32\x2a\x70\xe2\x92\xa4\x02\x89\xbb\x79\x00\x36\xee\x2d\x44\xb7\x6b\x5c\x9f\x47\x56\xd0\xb6\x69\x1b\x93\xca\xf0\x5f\x2b\x7c\x91\xe3\x94\x47\x4e\x6e\xd7\x1d\x38\xda\xc5\xba\x71\x8d\x05\xcb\xd1\x85\x92\xdc\xf6\x5c\xc2\x31\x47\x37\xe6\x4e\xdd\x55\xb3\x0f\xf5\xdf\xd6\x6b\x78\x12\x78\x0a\xf0\xa4\xb2\xfe\x7b\x95\x31\x71\x55\xb4\xd2\x1d\xd0\x1a\xab\xad\xd7\xe7\x1a\xf7\xd9\x38\xda\x5c\xd9\xe9\xc4\x31\xf7\x39\x3a\x5e\x8c\x8b\x66\x29\x7a\x3b\x55\xee\xf3\xc2\x0a\x4f\xef\x0f\x8f\x4f\xa7\xcf\x0f\xfc\x4e\x92\xd9\x00\x4f\x71\x90\x3b\x1a\xd6\xb0\x38\xc8\x3d\x45\xb4\x71\x90\x36\x71\x3f\x97\xbf\x65\x10\x3b\x4f\xac\xab\xf5\x03\x79\x99\xa4\x1a\x79\x5c\x8a\x1c\x43\x2e\x2b\x83\x27\x5e\x18\x9e\x8c\xd5\xe0\x69\xaa\xd2\x7f\x0f\x00\x12\xe2\xfd\xc6\x49\x01\x00\x00"
+
+func commonGitignoreTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_commonGitignoreTmplt,
+		"common/.gitignore.tmplt",
+	)
+}
+
+func commonGitignoreTmplt() (*asset, error) {
+	bytes, err := commonGitignoreTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "common/.gitignore.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _commonDockerfileTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\xc1\x6b\xc2\x30\x14\xc7\xf1\xfb\xfb\x2b\x1e\xbd\xa7\x41\xb6\xcb\x84\x1d\xc4\x4e\x91\xd9\x56\x32\xc7\x10\x91\xf1\x9a\x3c\x6b\x30\x4d\x4a\x62\x4f\xe2\xff\x3e\xa6\xf3\xb0\x8b\xd7\x1f\x5f\xf8\x7d\x66\xaa\x2e\xb1\x0d\x8e\x7c\x3b\x1e\xe5\xa3\x67\xa4\x84\xcd\x60\x9d\xe1\x08\x13\x35\xc7\x65\xf1\x3d\x5b\x4e\xe6\x1f\x00\xd3\x7a\xb5\xc1\x5c\xa2\x6c\x83\x4c\x51\xcb\xf3\x19\x31\x2f\x83\x19\x1c\x57\xd4\x31\xe2\xe5\x22\xe1\xab\x56\xef\xc5\x42\x3d\x8e\x40\x7d\x56\xd8\xd1\x91\xd1\x70\x9f\x50\x3b\x26\x7f\x3b\x05\xb8\x7a\xc8\xf5\xd6\xf3\xf8\x29\x7f\xb9\xa6\xd4\x1f\x51\x08\x1f\x84\x26\x7d\x60\x24\x63\x50\x93\xd0\x1c\x4f\x76\x6f\x35\x9d\x38\xdd\x70\x42\xec\x63\xe8\x5e\xff\xf8\x0f\x0d\x8d\xf5\xbf\x7b\x7e\x1f\x50\x0e\x29\xca\xc6\x7a\x78\xab\xd6\x6a\xb3\xaa\x17\xd5\x1a\xb7\xd9\x7d\xfd\xd7\x66\x3b\x98\x96\x05\x6e\x33\x71\xc8\x76\xf0\x33\x00\x2b\x96\xdf\xac\x41\x01\x00\x00"
+
+func commonDockerfileTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_commonDockerfileTmplt,
+		"common/Dockerfile.tmplt",
+	)
+}
+
+func commonDockerfileTmplt() (*asset, error) {
+	bytes, err := commonDockerfileTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "common/Dockerfile.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _commonMakefileTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\xef\x72\xe2\x36\x17\xc6\x3f\xc7\x57\xf1\x0c\xf1\x0e\x38\x6f\x64\xf2\xe7\x6d\x3f\x90\xa1\x9b\x84\x25\x59\xa6\x09\xd9\x34\x4c\xa6\xed\x6e\xcb\x08\xfb\x18\x34\x91\x65\x57\x12\xb0\x29\x61\xa6\x57\xd1\x4b\xe8\x25\xf4\x82\xf6\x4a\x3a\x72\x0c\x86\xec\x4e\xbb\x5f\x14\xf9\xe4\xd1\x79\x7e\xe7\x31\x32\x7d\xcc\x33\x6d\x71\xdf\xfd\xe1\xae\x77\xd3\x07\x80\xd7\x6d\xf8\x8b\xcb\xde\x60\xd8\xb9\xb9\xbe\xee\x0d\x96\x5e\x29\xa9\x4a\x85\xa4\x61\x26\x24\x25\xc6\xc2\x22\x26\x13\x69\x31\x22\x30\x66\xf9\xd8\x80\x31\x2e\xe7\xfc\xd1\x6d\x62\xa1\xed\x23\x18\x4b\xb9\x8d\x26\xed\xd9\x1e\x8e\xbe\x43\x33\xa6\x59\x53\x4d\xa5\xc4\xd3\x13\x28\x9a\x64\x98\xaa\x07\x95\xcd\x55\xb0\xb2\xba\x7a\x33\xbc\xb8\x3a\xbb\xbc\x43\x1b\xec\x47\xd4\x52\x2e\x54\x38\x16\xb6\x93\xa5\xa9\xb0\x6d\xbf\x51\xa1\x04\xb5\x4a\x31\x23\x6d\x44\xa6\xda\x7e\xa3\x9c\x26\xa8\x79\x6b\xf8\x9b\xf3\x5e\x1f\x0e\x9b\x8f\x4c\xce\xed\x04\x61\xd0\x0c\x6d\x96\x49\xd3\x1c\x09\xb5\x92\xbd\x3b\x1b\xbc\x45\xcb\xc9\x8a\x03\x41\x6b\x4b\x3f\x12\xaa\xe5\x37\x9c\x66\x4d\xda\xb9\xbc\x19\x76\xfb\x67\xe7\x57\xdd\x37\xed\x83\xb5\xdb\x3d\xda\x38\x58\x3d\xdc\x16\xb6\x22\x81\xdf\x48\x84\xb4\xa4\x71\xb8\xef\xdf\x07\xfb\xfb\xa7\xeb\x2e\xd7\xa8\x02\xcd\xb5\x50\x36\x41\xed\xc3\xc1\xf1\xf1\xfb\xe3\xff\x9f\x1c\xa6\x9f\xfe\xfc\xbb\x78\x38\x48\x6b\xc1\xda\xa2\xd3\x41\x1b\xe3\x0c\xa3\xa9\x90\x31\x98\x8c\x13\xe9\xa2\xaf\xfb\x8d\x55\x78\x41\xdd\xf3\xc2\x77\x6f\x6f\xfa\x3f\xb5\x30\x21\x99\x7b\x6e\x69\x79\x3b\xa7\x63\x4d\x39\x58\x17\xf5\x5f\xdf\x83\xb3\xdf\xcf\xd8\xcf\x43\xf6\xcb\xff\x5a\xe1\xde\xeb\xdd\x5d\x84\x7b\xbe\x5f\x87\xdf\xb8\x3e\xfb\xbe\x7b\xd1\xbb\xea\x0e\xaf\x7a\x77\x83\x00\x4f\xe0\xf3\x07\xd4\xcf\xbb\x97\xbd\x3e\x16\x17\xee\xcd\xd4\xca\x13\xb5\xe5\x09\x16\xdb\xd4\xdf\xa6\xaf\xd8\xd1\x37\xa6\xa4\xc6\x2b\xf3\x41\xd5\xf6\xe1\xfb\x87\x6e\x39\x5a\x6e\x80\xc5\x94\x1b\xcf\x2d\x2d\xec\xee\x22\x21\x1b\x4d\x10\x53\x4e\x2a\x26\x15\x09\x32\xde\x8e\xdf\x10\x2a\xc9\x1c\x51\xf0\xfc\x7f\xa1\xc6\x4e\x62\xf0\xe9\x8f\xbf\x02\x6f\xc7\xbf\x75\x31\x8c\xc9\x82\xc5\x60\x33\x84\xcd\x30\x0c\x57\xe5\x34\x8b\x61\x45\xfc\x58\x19\x26\xa9\xf5\x92\xd4\x16\x76\x7a\xaa\x9c\x28\x49\x2d\x32\x05\x2e\x25\x4c\x36\xd5\x11\x21\x11\xf2\x33\xeb\x4c\xa7\xdc\x5a\x67\xbe\xe1\xeb\x8e\x32\x09\x36\x47\xd8\x8c\xd2\x78\xb1\x80\x48\x40\xbf\x21\x1c\x50\x9a\x4b\x6e\x09\xb5\x79\xa6\x1f\x48\xd7\xb0\x5c\x22\x6c\x0a\x65\x49\x2b\x2e\x17\x0b\x90\x8a\xb1\x5c\x56\x60\x33\xb2\xde\x8c\xb6\xc0\x66\xf4\x15\x60\x33\xfa\x8c\xaa\x38\xf8\x9c\xc3\xba\x7d\xf1\x3b\xf1\x8a\xb5\x08\xa1\xd0\x38\xaf\xa2\x84\x2f\x91\x47\x52\x38\xec\x48\x0a\x87\x2b\x0d\x61\xb9\x34\xa4\x67\x22\xa2\x8a\x7f\x8b\xa5\xe8\xe5\x60\xe8\x23\x45\x53\xcb\x47\x92\x2a\x2e\xbf\xd1\xe9\x04\x60\x19\x46\x42\x35\x17\x0b\x84\x7d\x9e\xba\x96\xcf\xd1\x55\xa4\x96\x8c\xf5\xdc\xb2\x19\x85\x7b\x36\x98\x0b\x3b\x81\xe6\x11\x21\x26\x4b\x91\xcd\xf4\xb6\xbf\x53\xbd\xcc\xc2\xd5\x8a\xfb\x3c\x1c\x74\xef\x06\xe5\xd5\xa8\x3e\x5f\x19\xa4\x30\x65\x5a\xc1\x8b\xb8\x98\x48\xf9\x98\xbc\x8d\x7d\xab\x4a\x2c\xca\x94\xe5\x42\x91\x46\xa1\xc2\xd4\x38\xe7\x38\x8b\x1e\xc8\x51\xdd\x96\xdb\x52\xcd\x58\xf1\x97\x71\x3d\x46\x7d\x75\x43\xdb\x9b\x97\x15\xcc\xba\xb7\x10\xf6\x5c\xb7\x32\x9a\x56\xf5\x29\x43\x88\x13\x7c\x31\xeb\xd2\xe7\x99\xa2\x98\x7c\x3d\x45\x3e\x35\x93\x72\x88\x6a\xbb\x35\x5c\x35\x0f\x57\x31\xf2\xe9\x48\x0a\x33\xf9\xea\xd9\x5c\xd3\x7f\x85\xde\x46\x76\xf2\xff\x20\x8e\x24\x71\xe5\x15\x6b\xeb\xc5\xc0\x45\x71\xf5\x76\x77\x4e\x1d\x79\x51\x9a\xe6\xa0\x19\xe9\x47\x3b\x11\x6a\xec\xed\x9c\xea\x14\x4c\x27\x18\x09\xe5\xfd\x33\x00\xe6\xf6\xb7\x4c\xdd\x06\x00\x00"
+
+func commonMakefileTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_commonMakefileTmplt,
+		"common/Makefile.tmplt",
+	)
+}
+
+func commonMakefileTmplt() (*asset, error) {
+	bytes, err := commonMakefileTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "common/Makefile.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _commonReadmeMdTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6a\xe3\x48\x10\xbd\xeb\x2b\x8a\x18\xc2\xfa\x20\x69\xc3\xee\x69\x21\x2c\x6c\x02\xd9\x19\x86\x4c\x70\x32\xa7\x30\xa0\x76\x77\x59\x2a\xdc\xaa\x56\xaa\x4b\x76\x8c\xd0\xbf\x0f\x2d\x8d\x27\x09\x3e\x24\x73\xd4\xa3\x9e\xea\xbd\x7a\x4f\x5a\xc0\x30\x40\x71\x6b\x5a\x04\x18\xc7\x2c\x4b\x4f\xd7\x18\xad\x50\xa7\x14\xf8\x05\x3c\x8e\x00\x45\x30\x89\x44\x1b\xc0\x27\x28\x1e\xb0\xed\xbc\x51\x84\xb3\x46\xb5\xcb\x03\xfb\xc3\x19\x8c\xe3\xff\x0f\x0f\x77\xe5\xe7\xfb\xaf\xb7\x10\x51\x76\x64\x71\x18\x00\x7d\xc4\x53\xda\x3e\xc8\x16\x25\x71\xd6\xc6\x6e\x6b\x09\x3d\x3b\x98\xc1\x23\x67\x1c\x6d\x68\x5b\xc3\x0e\x3c\x31\x82\xe9\x3a\x4f\xd6\x24\x7d\x69\x82\x1d\x8c\x23\xac\x4d\x44\x07\x81\xe1\xb1\xfa\xb9\x71\xdd\x93\x77\x28\xd5\xf7\x3f\x92\xb2\xf8\x4f\x59\xd6\xa4\x4d\xbf\x2e\x6c\x68\x4b\xcb\x46\x69\x87\xe5\xdb\xd9\x72\x09\xda\x18\xcd\x86\x21\x7f\xc7\x5f\x96\xe5\x93\x33\x8c\xa0\x0d\xc2\xd5\xea\xdb\x35\x08\xc6\xd0\x8b\xc5\x38\x0c\x20\x86\x6b\x84\x62\x75\x84\x92\xc4\x2a\xdd\x11\xc6\xb1\x7a\x51\xdd\xb3\x43\x81\x04\x14\xe9\x62\xab\xd0\x2b\xde\x09\x6e\xe8\x39\xcd\x65\x39\xe0\x73\x17\x22\x46\x68\xd0\x78\x6d\xc0\x36\x68\xb7\x93\xe5\x2e\x10\x6b\x9c\x94\xbe\x77\xd7\xa4\x55\x7a\x9e\x95\x26\x18\x88\xa1\x22\x56\x14\x36\xbe\x4c\x08\x4a\x05\x1d\x0a\x05\x47\xd6\x78\x7f\x00\x25\xef\x81\x34\xa5\x1d\x35\x74\x1d\xba\xdf\x11\x33\xef\xec\x24\xec\xc8\x61\xea\x4b\x54\xc3\xce\x88\x83\xab\x2f\x9f\x60\x4f\xc9\xc9\x9c\x68\x9c\xb4\xd8\xd6\x95\x47\xa0\xa8\x43\x35\xbf\x69\x0a\x36\xad\x65\xb3\xf6\x18\xc1\x06\x8e\x14\x15\x59\xc1\x87\xba\x26\xae\xb3\x1c\xa6\x94\xd3\x8a\xc7\xeb\x60\xb7\x28\x2f\x69\xef\xf7\xfb\xc2\x4d\xd8\x94\xf8\x32\xf1\xd5\x10\xa3\x00\xb5\xa6\xc6\x2c\x5b\x2c\xe0\x06\x55\x89\x6b\xb8\x57\x23\x8a\x2e\x61\x0b\xb8\x13\xcc\x57\xf8\x94\x3c\x3c\xde\x04\xb8\x28\x2e\xfe\x7e\x55\xa2\xe0\x0d\xd7\x45\x90\xba\x74\xbe\x5c\x66\xf9\xe9\xe6\xa8\x41\xf0\xf5\xee\x88\x46\x6c\xf3\xef\xd3\xe5\xb9\x1e\x3a\xbc\x44\x47\xa9\xbc\xe7\x61\xb3\x41\x21\xae\x2f\x93\xf7\x9e\x49\x0f\xcb\x59\xc0\x7f\xc9\x54\xf2\x97\x55\x55\xb5\x36\xb1\xc9\x5a\xb3\x45\x70\xd8\xc5\xd9\x70\xc2\xe7\xd1\x55\xcf\xfc\x66\xf2\x03\xdd\x2d\xca\x35\x71\xf9\xeb\xab\x1e\xc7\xb9\xca\xf2\xb1\x36\x9d\xb2\xa5\x67\xc8\xf3\xa9\x50\x3b\xe3\xe1\xaf\x3f\xdf\x56\xe1\x94\xd0\xa0\xf7\x01\xf2\x9c\xd3\x4f\x65\x1f\xc4\xbb\xd7\x89\x4f\xde\xaa\xc9\x71\x83\xbe\xab\xc0\x53\xd4\xb9\xbd\x41\x1b\x14\x50\x23\x35\x6a\x2c\xb2\x1f\x03\x00\x76\xe2\x46\x35\xbe\x04\x00\x00"
+
+func commonReadmeMdTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_commonReadmeMdTmplt,
+		"common/README.md.tmplt",
+	)
+}
+
+func commonReadmeMdTmplt() (*asset, error) {
+	bytes, err := commonReadmeMdTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "common/README.md.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _commonCmdMainGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x53\x4d\x6f\xdb\x38\x10\x3d\x93\xbf\x62\x96\xc0\x02\xe2\x42\x90\xb3\xbd\xd5\x85\x0e\x8e\x93\xb8\x05\x82\xa4\x48\x90\xf4\xd0\xf6\x40\x33\x14\x4d\x84\x22\x05\x8a\x52\x5a\x18\xfa\xef\xc5\x50\x94\x9b\xb4\x97\x44\xf3\xde\x7c\x70\xde\x3c\x77\x42\x3e\x0b\xad\xa0\x15\xc6\x51\x6a\xda\xce\x87\x08\x05\x25\xac\x69\x23\xa3\x84\x59\xaf\xf1\x9f\xef\xf1\x6f\x18\x5c\x34\xad\x62\x94\x12\xa6\x4d\x3c\x0c\xfb\x4a\xfa\x76\x35\x84\x46\x8c\x6a\x25\xad\x41\x46\x3a\xeb\x35\xbc\xe6\xa5\x13\xd1\x8c\x6a\xd5\x3d\xeb\x55\xea\xc7\x29\x1d\x45\xc0\x31\xa3\x0a\xbd\xf1\x0e\x00\x6a\x60\x83\x7b\x76\xfe\xc5\x31\x4a\xb4\x89\x5b\xdf\xb6\x26\xbe\x81\x29\x11\x5d\x07\x35\x48\x6b\xaa\x1b\xf5\xb2\xe9\xba\x82\xcf\xe0\x95\x15\xba\x87\x1a\xbe\x7e\x47\x0e\xa3\x23\x25\x04\xbf\xcf\xbd\xb7\x4b\x4c\x6e\x44\xab\xd6\x00\xc0\x9e\xd4\x7e\xd0\xac\xa4\x84\x90\x87\x5e\x68\x04\xd9\xa5\x13\x7b\xab\x20\x51\x60\xbd\xd6\xc6\xe5\x94\x4b\x37\x3e\x8a\xb0\x06\x76\x71\x79\xfe\xb0\x4b\xd8\x54\x52\x32\x51\x4e\x69\x33\x38\x09\xc6\x99\x58\x70\x38\xd2\x34\xf2\x71\xde\xe9\x73\x30\x2e\xaa\x00\x35\x60\x4e\x21\xe1\x3f\x24\xb7\xde\x45\xf5\x23\xa6\x64\xd2\xb4\xb1\x4a\x69\x4d\xc1\xfe\xed\xbf\x39\xc8\xa5\x6b\x80\x14\xee\x4c\x84\x59\x87\x13\xe2\xff\xcc\xb9\xbd\x5f\x6d\x82\x3c\xa4\x70\x95\xaa\xce\x07\x63\x97\x82\xf4\x58\xc2\x8e\x47\xa8\x70\x79\x98\x26\x56\x42\x16\xbd\x84\x93\xcc\x25\xe4\xd3\x56\xb9\x7b\xc1\x7f\x43\xbb\xdb\xdb\xfb\xd7\xd1\xe6\x6e\xfb\xb1\x04\x59\x6d\xba\xae\xda\xfa\xb6\x33\x56\x3d\x71\x94\x23\x9d\x62\x9e\x53\xc3\x9b\x99\x33\xb3\xf5\xdd\xcf\x60\xf4\x21\x5d\xb5\x90\x1c\xde\x9d\xfd\xff\x1e\x4e\x68\xce\x4a\x07\x59\x1a\x5c\xa8\x5e\x06\xd3\xc5\xe4\x11\x6c\x34\xe7\xe4\x57\x42\xbd\xec\x32\xc3\x8b\x0d\x16\x47\x2c\x63\xdb\x56\xb8\x27\x24\x64\xfe\xa4\x53\xbe\x9c\x56\xf1\xce\xfb\x78\xed\xb5\x56\xe1\xaf\x1b\x15\xc9\xcb\xd5\xcc\x96\xa0\x42\xf0\x21\x5d\xce\x5a\x58\xd7\x30\xb3\x9f\x5c\xe3\xaf\xd5\xa8\x2c\x25\xa6\x01\x59\xed\xac\xdf\x0b\x8b\xbe\x2b\xb2\xcf\x52\x09\xb1\x16\x96\x92\x0b\x84\x73\x0d\xca\x16\x54\x1c\x82\xcb\xe4\x8d\x7a\xc9\x73\xbf\x98\x78\x40\x35\x8b\x37\x5a\xf2\x32\x27\x22\x9d\x9a\x14\xd6\x72\x7e\x5a\x09\x7f\xc9\xb3\x19\x4d\x83\x4f\xc6\x97\xa2\x0c\x77\x83\x2b\x7c\x5f\x6d\x82\xee\xf9\x87\x44\xfc\x53\x83\x33\x16\x33\x09\x2e\x72\xaf\x62\x12\xb0\x38\xe3\x19\xb9\x12\x51\x58\xf4\xe6\x88\x4e\x02\x15\x02\xa7\x64\xa2\x13\xfd\x35\x00\xa1\x95\xbd\x37\x36\x04\x00\x00"
+
+func commonCmdMainGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_commonCmdMainGoTmplt,
+		"common/cmd/main.go.tmplt",
+	)
+}
+
+func commonCmdMainGoTmplt() (*asset, error) {
+	bytes, err := commonCmdMainGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "common/cmd/main.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _commonGoModTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xc9\x31\x0e\xc2\x30\x0c\x05\xd0\x19\x9f\xe2\x8f\xb0\x38\x75\xd5\x85\x43\xc0\x1d\x42\x30\x21\x6a\x43\x8a\x69\xb2\x54\xbd\x3b\x82\xad\xdb\x93\x5e\x2e\xf7\x3a\x29\xd6\x15\xe0\xcb\xdf\x57\x9f\x15\xd8\x36\xa2\x58\x20\x2c\x03\x91\xe9\xbb\x26\x53\x1c\xe9\x10\xd3\xf2\xac\x37\x0e\x25\xbb\xf0\xf2\x4b\x6a\xea\xe6\x31\xa2\x75\x2c\xdc\xed\x7a\x1e\xa3\x53\xb3\x62\x9f\xdf\x9e\x59\x76\x5b\xed\xe1\x9b\xba\x30\x25\x34\xe1\xbe\xe7\x81\x4e\xf4\x1d\x00\xc4\xc8\x7f\xdf\x8d\x00\x00\x00"
+
+func commonGoModTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_commonGoModTmplt,
+		"common/go.mod.tmplt",
+	)
+}
+
+func commonGoModTmplt() (*asset, error) {
+	bytes, err := commonGoModTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "common/go.mod.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _httpCmdHandlersGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x41\x6f\x9c\x30\x10\x85\xcf\xf8\x57\x4c\x39\xd9\x15\x05\xf5\xd2\x43\xaa\x9c\xda\x48\x69\xa5\xa6\x52\x36\x52\x0f\x51\x94\x78\x61\x00\x37\x30\xb6\xc6\x66\x59\x14\xed\x7f\xaf\x0c\x6c\x77\x93\x53\x7b\x03\x7b\xe6\xcd\x37\xef\xd9\xe9\xf2\x59\x37\x08\xbd\x36\x24\x84\xe9\x9d\xe5\x00\x52\x24\x69\xc9\x93\x0b\xb6\x60\x4d\x55\x2a\x92\x14\xa9\xb4\x95\xa1\xa6\x68\x71\xff\xea\xff\xb7\xb7\x14\x0f\x08\x43\xd1\x86\xe0\x52\xa1\x84\x08\x93\xc3\xa8\x52\x14\xa0\x9d\xb9\xd6\x54\x75\xc8\x60\x3c\x98\xde\x75\xd8\x23\x05\xac\x60\x3b\x41\x68\x11\xda\xf5\xd6\xd6\x80\x3b\xe4\x09\x18\xbd\x1d\xb8\x44\x91\x9c\xf7\x52\x40\xae\x75\x89\xf0\x22\x92\xe4\x16\x1b\xe3\x03\xb2\x74\x8c\xb5\xd9\x83\x0f\x6c\xa8\xc9\xa0\x1f\xf6\xf0\x3e\x52\xe4\x1b\xe4\x1d\xfe\x18\xf6\x4a\x24\x07\xf1\x06\xc4\x03\xaf\xfd\xfe\x38\xdd\x83\xad\x41\x77\xdd\x4c\x74\x04\xf0\x60\x69\x3e\xf0\xba\xc7\xa8\x7d\x4e\xe4\xe1\xfe\xe1\xf4\x27\x44\x82\xcc\x96\x6f\xd1\x3b\x4b\x1e\x23\xd1\x50\x86\x19\xf6\x2a\x5e\xac\x88\xf0\x14\xfd\xba\x48\xe7\xe2\xf4\x29\xc2\x29\x21\x8a\x02\x8e\x1b\x9d\xa1\x2d\x6e\xfc\xb5\x87\x22\x01\x0c\x54\x21\xc3\xb2\xb6\xa8\x07\x2a\x41\xb6\xe7\xab\x29\xf8\x0f\x6f\x22\x5e\x6d\x19\x1e\x33\xd0\x70\x71\x09\xac\xa9\x41\x68\xe3\x71\xa2\xf3\x37\x42\xb3\xbb\xb3\x9d\x07\xb1\x4c\x1e\xd9\x04\xfc\xbe\xf9\x79\x23\x47\x98\x85\x8f\xdb\xff\x8a\x17\x9c\x81\x0f\x3a\x0c\x3e\x66\x97\xc1\xee\x14\xe1\xcb\x41\xc5\x11\x63\x7e\x8d\xba\x42\x96\x2a\xdf\x60\x90\xe9\x17\x4b\x01\x29\x7c\xb8\x9b\x1c\xa6\x19\xa4\xda\xb9\xce\x94\x3a\x18\x4b\x45\x74\x2d\x55\xb1\x67\xd6\x5e\x1b\x17\x7d\x25\x92\x47\xb8\x84\x58\x92\xdf\xe0\x78\x15\x5f\x2a\xb2\x1c\x55\xbe\x7c\xca\x9d\x7a\x8d\x3c\x07\xf2\x2f\xcc\xbd\x6f\x56\xfb\x16\xe0\xd3\xbe\xc7\xba\x0c\x5e\xc5\xfe\x32\x4b\x5f\x40\xef\x9b\xc3\x69\x28\xe1\xf8\xed\xab\x54\xab\x54\x54\xda\x46\xb7\x7b\xfd\x8c\xf2\xfe\x61\x3b\x05\xcc\xe0\xe3\x27\x25\x12\x53\xc7\x28\x90\x79\x0d\xa3\xca\x6f\x51\x57\x72\xab\x3e\xc7\x31\xf0\xee\x12\xc8\x74\xb1\x3f\x71\x9a\x4c\x29\x91\x79\x7d\xdf\x8c\x61\x60\x82\x16\xf7\xeb\xd2\x77\x76\x13\xd8\x50\x23\xb7\x4a\x1c\xc4\x9f\x01\x00\x53\x84\x89\x48\xe6\x03\x00\x00"
+
+func httpCmdHandlersGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_httpCmdHandlersGoTmplt,
+		"http/cmd/handlers.go.tmplt",
+	)
+}
+
+func httpCmdHandlersGoTmplt() (*asset, error) {
+	bytes, err := httpCmdHandlersGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "http/cmd/handlers.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _httpCmdServerGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xdc\x36\x10\x3d\x93\xbf\x62\x4a\xd4\x06\x55\xc8\x32\xd0\xa3\xe2\x3d\xb8\xae\x03\x17\x48\xd3\xc5\x7a\x93\x1e\x8a\xa2\x60\xa8\x91\x44\x58\x22\x17\x24\xb5\xde\x42\xd0\x7f\x2f\x86\xd2\x66\x65\x24\x97\x1e\x6c\x2d\x67\x38\x6f\xde\x9b\x0f\x1e\x94\x7e\x51\x0d\x42\xaf\x8c\xe5\xdc\xf4\x07\xe7\x23\x48\xce\x84\x76\x36\xe2\x29\x0a\xce\x44\xdd\xa7\x8f\xc5\x78\xdb\xc6\x78\x10\x9c\x33\xd1\x98\xd8\x0e\x5f\x0a\xed\xfa\xdb\xc3\x4b\x73\x8b\xde\x3b\x1f\xc4\x5b\xc7\xe0\x6b\x75\xc4\x5b\xdd\x19\xc1\x33\xce\x8f\xca\x13\xb2\x76\x7d\xaf\x6c\x15\x60\x03\x7f\xfd\xad\x3b\x53\x3c\xcc\x86\x91\x33\x46\x7f\xec\xa3\xea\xb1\x04\x00\x11\xd0\x1f\xd1\x8b\x9c\x8c\x9f\x82\x6a\xc8\x2a\x42\x54\x3e\xc2\xda\x75\xaf\xa3\x71\xb6\x5c\x6c\xf3\x29\xc5\xbc\xef\x54\x13\xca\x25\x0b\x1d\x12\x3c\xa3\x9c\x9f\x8c\x8d\x17\xcb\x25\x25\xe9\xbb\xa1\x1a\xcc\xd0\x8c\x7d\x56\xdd\x40\x2e\x72\x6c\x9d\x8f\x8b\xf9\xd1\x1e\x3f\x2b\x5f\x82\x78\xda\xef\xb7\xff\x6c\xff\xd8\xed\x97\x80\x69\xfe\xb4\xa8\xba\xd8\x52\x00\x65\x49\xb6\xe4\xa1\x7f\x13\x15\xa3\x1e\xac\x7e\xc3\x58\x6a\xf8\x89\x98\x3d\xcc\x75\xcf\x20\x95\x14\x46\xce\x59\xe7\x9a\x06\x7d\x4e\x16\x28\x37\xd0\x60\xdc\x39\x17\x3f\x24\xab\xd4\x19\x67\xa6\x4e\xbe\x1f\x36\x60\x4d\x07\xa4\xc9\x63\x1c\xbc\x25\x2b\xe5\x63\x15\xd6\xe8\x61\xc6\x29\xde\x77\x43\x68\x65\xc6\x53\x9c\x4e\xa5\x90\x2b\xe1\x19\x6c\x36\x17\x73\xd2\x71\x76\xbc\x45\x76\x3e\x14\x1f\xf1\x75\x15\x0b\xca\x56\xb0\x0a\x01\xad\xac\x75\x11\xbe\x20\xc4\x16\x21\xa8\x1e\x45\x46\x84\x38\xd3\xf1\x94\x93\x5b\x63\x47\x9a\x82\x69\xac\xea\x16\xed\x32\x3b\x33\x9e\x2f\x24\xae\xad\xb2\x55\x87\x3e\xd0\x6d\x75\x30\x4f\xcb\x71\xe4\xe3\x78\x03\x5e\xd9\x06\xe1\x47\x4f\xce\x62\x87\xc1\x0d\x5e\x63\x80\x69\xe2\x8c\x59\x7c\x1d\x47\xf2\x4d\xd3\x12\x24\xe7\x42\x64\x79\x8a\x45\x5b\xa5\x8b\xc4\xaa\x1f\x4e\x04\x41\x82\x48\xda\x33\xf5\xe7\xf7\xe1\x24\xb3\x4b\xfe\x62\x87\x8d\x09\x11\xbd\x14\xe3\x08\xc5\xd3\x7e\xbf\xdd\xb9\x21\xe2\xd6\x63\x6d\x4e\x30\x4d\x22\x87\x7e\x38\x65\x9c\x05\x7f\x24\xb0\xeb\x84\x96\xa0\xfc\x78\x5f\x55\xbe\x84\xba\x8f\xc5\xf3\xc1\x1b\x1b\x6b\x29\xca\xab\x4a\xe4\xdf\xeb\x43\x96\xc3\x42\xb8\x24\x44\xe2\x87\xde\x6b\xc2\xec\xd5\x0b\x4a\xdd\xaa\xa5\x0f\x39\xfc\x4c\x0c\x53\xe1\xc9\x6d\xf1\xf5\x29\x1d\xe6\xac\xf2\xbb\xdd\xfc\x1a\x51\xa4\x95\x92\x84\x4d\x85\x6e\x1c\xd0\x70\xca\xb9\xdd\xcb\x68\x51\x8b\xfc\xb1\xf8\x40\xca\xed\xbd\xad\x12\xb0\xcc\xde\xad\xe7\xee\xfa\xfa\x7c\x4a\x8a\x1f\xbd\x4f\xb7\xfc\x43\xe7\x02\x56\x09\x8d\x51\x12\xb8\xbb\xa1\x8b\xb4\x0d\x9c\x4d\x72\xc5\x03\xe3\x0e\x55\xf5\xaf\x8c\x7e\xc0\xec\x3c\xf7\xc5\x6f\xb6\x76\xf5\x5c\x6d\xda\x53\x98\xa6\x65\x71\xa0\x4b\x74\x8c\x6d\xc0\x59\xb8\x0a\x22\x4f\x24\xa9\xc6\x24\x24\x60\x87\x3a\x52\x5e\xad\x02\xc2\xdd\x8d\x8e\xa7\xe2\x57\x67\x51\x66\xe5\x62\x23\xbe\x1b\xb8\xbb\x21\x5a\x25\x67\xe7\x8c\x8f\x34\xdc\xb5\x14\x3b\xd4\x68\x8e\x58\x2d\xbb\x58\x7b\xd7\x2f\x3f\xa9\xf6\x16\x3b\xb8\x3a\x8a\xb4\x97\x69\xaa\xbf\xd1\x51\xab\x2e\x60\xa2\x92\xc6\x3d\x5c\xe6\x7d\x79\x5d\x8b\x3f\x4d\x6c\xf7\xa6\x47\x37\x44\x79\xb6\xfd\xa2\xf4\x4b\xe3\xdd\x60\x2b\x99\xe5\x10\xda\x21\x56\xee\xd5\x2e\xb7\xbe\xae\xc6\x02\x46\xe5\x33\x35\x84\x55\x93\x9e\x97\x08\x19\x74\x3c\x65\xef\x20\x7c\xdb\xa3\xcd\xe5\xa5\x48\xc7\x74\x27\x49\x58\x61\x9d\xd5\x44\x77\x90\xff\x13\x86\xaf\x1f\xa0\x89\xff\x37\x00\x7d\x8b\xb7\xd5\x65\x06\x00\x00"
+
+func httpCmdServerGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_httpCmdServerGoTmplt,
+		"http/cmd/server.go.tmplt",
+	)
+}
+
+func httpCmdServerGoTmplt() (*asset, error) {
+	bytes, err := httpCmdServerGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "http/cmd/server.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _httpCmdResource_handlerGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4d\x6f\xdb\x38\x13\x3e\x8b\xbf\x62\x2a\xbc\x28\xa4\x46\x2f\xbd\xe7\x14\x3e\xb4\xc9\xf6\x63\x9b\xba\x41\x3e\xd0\x43\x10\xa4\x8c\x38\xb6\xd9\x48\xa4\x96\xa4\xea\x04\xae\xfe\xfb\x62\x48\x39\xb1\x63\x3b\x75\x2e\xdd\xf5\xc5\x14\x39\x9f\xcf\x33\x9c\x61\x23\xca\x1b\x31\x41\xa8\x85\xd2\x8c\xa9\xba\x31\xd6\x43\xc6\x92\x14\x75\x69\xa4\xd2\x93\xc1\x77\x67\x74\xca\x92\x54\xa3\x1f\x4c\xbd\x6f\x68\xed\x8c\xf5\xe1\xdf\x5b\xa5\x27\x2e\x2c\xef\x74\x49\xff\x5e\xd5\x98\x32\x96\xa4\x13\xe5\xa7\xed\x35\x2f\x4d\x3d\x28\xb5\xf0\xea\x07\x0e\x9a\x9b\xc9\xa0\x32\x93\x94\xe5\x8c\x0d\x06\x30\x9f\x03\x3f\x55\x7a\xd2\x56\xc2\xf2\x63\xe1\x4a\x51\x41\xd7\x81\x45\x67\x5a\x5b\x22\xcc\x94\x9f\x42\x69\x5b\xc9\xfc\x5d\x83\xdb\xc4\x9d\xb7\x6d\xe9\x61\xce\x92\x8f\x87\x10\x03\x82\x6f\x14\xf4\x7e\xaa\x64\x61\x6a\xe5\xb1\x6e\xfc\x5d\xfa\x8d\xcd\xe7\xff\x07\x2b\xf4\x04\x81\xbf\x53\x58\x49\x07\x5d\xc7\x12\x32\xfb\xde\x8c\x44\x8d\x64\x2d\x7e\x9d\x91\xbf\xae\x5b\xd8\xa1\xcd\x03\x53\xb5\xb5\x86\xae\x5b\x33\x89\x5a\x06\x43\x07\x16\x85\x47\xf9\xc6\x03\x61\xc0\xcf\x54\x8d\x0b\x03\x65\x3c\xba\x12\x7e\x45\x3b\x39\x6f\xe4\x36\x95\xb6\x91\x9b\x54\xba\x75\xe4\x0e\x44\x8d\x04\xdc\x07\xa1\x65\x85\x16\x1c\xda\x1f\xe8\xb6\xc1\x35\xb6\xa6\x86\x1a\x6b\x63\xef\x38\x58\x6c\x2a\x51\x22\x90\x7d\x17\xf1\x16\x60\x51\x54\xe0\xbc\xb1\xb8\x01\xf7\x35\x67\xf7\xe8\xd7\x2d\xd0\xcf\xdd\xe9\x92\x9f\x7c\xfd\xdc\x7a\xbc\x65\x49\x34\x0c\xb5\x68\x2e\x22\x35\x97\x9b\xc3\x62\x49\x63\x71\xac\x6e\x7b\x02\x59\x52\x99\xc9\x04\x2d\x54\x66\xc2\x8f\xc2\x92\x75\x8c\x8d\x5b\x5d\x82\xc6\xd9\x66\x1b\x7d\x48\x59\xb5\xa4\x95\xc3\xab\x27\xc3\x9f\xb3\x44\x8d\xa1\x82\xe1\x10\xb4\xaa\xa8\x88\x92\xaa\x80\x2b\x18\x06\x1b\x23\x9c\x8d\x4c\x93\xe5\x2c\xe9\x58\x62\xd1\xb7\x56\xc3\xcb\xa7\xec\x91\x7e\x48\x79\x7f\x87\x9c\xe7\x5d\x41\xee\x42\x9c\xfb\x50\x15\xe4\x25\xd2\x7b\x82\x13\xe5\x3c\x5a\xb0\xfd\xc2\x81\x9f\x3e\x22\xe2\xc8\xcc\xd0\x12\xa1\xd6\xb4\x1e\x1d\x18\x0d\x75\x7b\x1b\x21\xca\xa6\x4f\xa7\x9d\xdf\x7b\xc8\x56\x60\x2f\xc8\x04\xbc\xa2\x5b\xce\x4f\xa9\x8a\x3e\xb7\xb7\x39\x81\x32\xe5\xbd\xdc\x10\xfa\xc5\x1e\xa4\x83\x15\x17\x9f\xf0\x5a\x5c\x43\xd7\xa5\x2c\xa9\xdb\x5b\x1e\xc9\x78\xd7\xea\x32\x5b\x28\x17\x30\xe5\xa5\xa9\x2a\x2c\xbd\x32\x3a\xdf\x2a\xb7\x97\x0e\x52\x92\x25\x20\xf3\x7b\xda\x7f\x9d\xd3\x83\xed\x6c\x06\x21\x87\x13\x74\x8d\xd1\x0e\xbf\x5a\xe5\xd1\x16\x60\xfb\xdc\x4e\xf0\xef\x16\x9d\x0f\xa9\xb9\x99\xf2\xe5\x14\x2c\xff\x8c\x7e\x6a\x24\x6d\x95\xc2\x61\x34\x10\xf7\xde\xa3\xdf\x67\x49\x32\xe5\x95\x72\x3e\x9b\x15\x60\xf3\x75\xa1\x63\xe3\x7a\xa9\x78\xd9\x17\x72\x12\xc7\xa2\xad\xc2\xd1\x8c\xc2\xf8\xd3\x5a\x63\xe9\x30\x38\x38\xf5\xc2\xb7\x2e\x5a\x18\x19\xff\xa6\xaa\xcc\x0c\x65\x01\x69\x1d\xb6\x40\x1b\x0f\x22\x6e\xa6\x79\x5f\x20\x3b\xc2\x41\xf0\x3d\x07\x08\x25\x61\x7f\xd8\x17\x82\xe3\x67\x56\xd5\xc7\x81\x8e\xcc\xf2\xf3\x93\x23\x7e\x2c\xfc\xb4\x80\x65\x8e\xf2\x70\x77\x94\xa4\xcb\x93\xa6\xf0\xf3\xe7\xbd\xf2\x81\xd1\x5e\x28\xed\x32\x45\xa9\x0c\xd2\x00\xf4\xf6\xf4\x47\xc6\xbf\x33\xad\x26\x59\xca\x77\x4c\x6b\xca\xb6\xbf\x72\x94\xf6\xf3\x78\x9a\x60\xa0\x49\xc9\x2d\x3c\x15\x2b\x1b\x6d\xaf\x15\x3b\x2e\x29\xda\x2d\xba\x87\x58\xa1\xc7\x28\x2d\xc3\xfa\xde\xcd\xbf\x46\xf3\xa2\xda\x76\x27\xfa\x87\xb0\xa0\x34\x6c\x6e\x4a\x81\x53\xb4\x96\x6a\x81\x06\x11\x1f\xe1\xec\x10\x4b\x23\xd1\x66\x96\xbf\x35\xf2\x2e\xe7\xf1\x3b\x7b\xa9\x74\xfe\x3a\xc8\xbe\x78\xe8\x9e\x5b\x93\x7f\x2b\x64\x1f\x44\x41\x3a\x3c\x02\x94\xaf\xd2\xbc\x34\xa1\x49\x58\x59\x94\x4b\x93\x9a\xaa\x4d\xf3\xd5\x79\x1d\x6b\x6f\x67\xcf\x69\xad\x9c\xa3\x17\xc2\x7c\x0e\xff\xdb\xd0\x4c\x57\x26\xfd\xa3\x1a\x5c\x9a\xf5\x2c\x51\x9a\x7f\x3c\x84\x21\x4d\xa3\x8f\x87\x34\x21\x94\xe6\x0f\x0f\x80\x61\x9c\xe7\x23\x33\xcb\x72\x7e\x7e\x76\xd0\x0b\x3c\x8c\xfb\x21\x2c\xcb\x33\xea\xb0\x75\xcb\x8f\x4c\x79\x43\xa2\xb1\xf7\xb9\x8b\xe0\xe4\x32\x08\xf7\x12\xe7\xba\x8a\x32\x2c\x96\xd9\x5f\xa7\x5f\x46\x8f\xd2\xed\xad\x16\xa0\xf4\x73\xba\x67\xec\x6d\xbb\x57\x51\x08\xe7\x64\x11\x71\x88\x97\x6a\xa6\x16\x37\x98\x5d\x6c\x99\x78\x05\xfc\x51\x40\x85\x3a\xeb\x13\x24\xf6\xc7\xc6\xc2\x55\x01\x8a\x94\x23\xf5\xfd\x21\xdc\x0f\x53\x18\x82\x68\x1a\xd4\x32\x0b\x07\x05\xa8\x70\x47\xfa\x10\x96\x20\xa1\x67\x29\x3f\xad\x54\x89\x0b\x49\xba\x44\x99\x2a\xe0\x3b\x28\xed\x73\xb8\x36\x26\x96\x69\x3f\xcc\x7b\x98\x2f\x1f\xa8\xe0\x6f\x71\x6c\x6c\xaf\x7f\xf1\x7d\xe9\x84\x7c\x3e\x85\xfb\x97\x4f\x45\x7c\x47\x3d\x07\xf5\x09\x6e\x05\x5d\xc9\xbe\x9f\x6e\x82\xbb\x00\x73\x43\x88\xf5\x58\x5d\x28\x79\xb9\x01\x0f\x35\x86\x17\xe6\x66\xe7\xe6\xbb\xf9\x7d\x11\x5e\x85\x4a\x42\xba\xa7\xe4\x5e\x0a\xdb\x3b\xf4\x93\xc0\x3c\x07\x94\x45\x23\xde\xa9\x18\x1f\x03\xf5\x1f\xee\x6e\x3d\x43\x0b\x12\x25\x8e\xd1\xc2\xe3\x6b\x8d\xb7\xca\xf9\xf0\x16\xdb\xc0\xf0\xef\x25\x74\xd1\xe3\x94\x5c\x6b\x6f\x8b\x28\x1f\x36\xd7\x1a\xdc\x7a\x07\x5c\x4a\x25\xf6\xb4\x5f\xd4\xcc\xb3\xfa\xd7\x62\x1e\xef\x7c\x99\x9e\xa6\x41\x8d\xe1\x6a\x03\x05\xaf\x7f\x1f\xfe\x49\x9f\x51\xef\x9d\x72\xa0\xc0\x66\x3c\xe4\xf4\x01\x05\x8d\xe4\x15\xbf\x07\x46\x7b\xd4\x3e\x67\x1d\xfb\x67\x00\x8b\x61\xe5\x6e\xd7\x0f\x00\x00"
+
+func httpCmdResource_handlerGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_httpCmdResource_handlerGoTmplt,
+		"http/cmd/{resource}_handler.go.tmplt",
+	)
+}
+
+func httpCmdResource_handlerGoTmplt() (*asset, error) {
+	bytes, err := httpCmdResource_handlerGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "http/cmd/{resource}_handler.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _serviceCmdHealthGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xdf\x4f\x1c\x37\x10\x7e\x5e\xff\x15\xd3\x95\x8a\xec\x68\xd9\x0d\xed\xdb\x35\xf7\x40\x29\x09\xa8\x84\x44\x1c\x49\x1e\x2b\xe3\x9d\xbb\xb5\xd8\xb5\xaf\xf6\xec\x71\x08\xdd\xff\x5e\x8d\xbd\x5c\x00\xd1\x1f\xea\x0b\x8b\x3d\x33\x9f\xe7\xfb\xbe\xb1\x6f\xad\xcd\xad\x5e\x21\x0c\xda\x3a\x21\xec\xb0\xf6\x81\x40\x8a\xa2\x34\xde\x11\x6e\xa9\x14\x45\xb9\x1c\xd2\xc7\x21\x35\x1d\xd1\x9a\xff\xf7\x31\xff\x6d\xa2\x5d\x39\xdd\xf3\x22\xde\x3b\xd3\x68\xf2\x83\x35\x79\x19\x8d\xee\x53\x84\xec\x80\xa5\x10\x45\xb9\xb2\xd4\x8d\x37\xb5\xf1\x43\x33\x86\xa5\xde\x60\x63\x7a\x5b\x0a\x25\x84\xf1\x2e\x12\xc4\x6e\xa4\xd6\xdf\xb9\x6b\x3b\xa0\x1f\x09\xe6\x70\xf4\x16\xde\x00\xd7\xd7\x0b\x34\xde\xb5\x42\x6c\x74\xe0\xfe\x3a\xd4\x3d\x75\x9f\x7d\xa0\xf7\xbd\x5e\xc1\x1c\x4c\x6f\xeb\x2f\xd6\xa5\xe5\x83\x28\x8a\x4b\x3d\xe0\x0c\x00\xca\x9c\x79\xc8\xc4\xca\x4a\x14\xc5\x57\xdd\x8f\x1c\xf9\x8e\xc0\xbb\xa7\x6e\xf3\x55\x87\x19\x94\x67\xa7\xc7\x17\xd7\x67\x7f\x7c\xfe\x74\x75\xcd\xe9\x3b\xee\xae\x69\xa6\xec\x05\x86\x0d\x06\x88\xfc\x89\xd0\xf4\x76\x83\xa0\x5d\x0b\x4d\x40\xdd\xde\xd7\xd3\x17\xe2\x68\x0c\x62\x1b\xc1\x3b\x83\x40\x1d\xe6\x8a\x00\x36\xc2\xa0\xc3\x2d\xb6\x90\x12\x05\xdd\xaf\xf1\x05\x34\x85\xd1\x10\x3c\x88\x22\x65\x80\x75\xf4\xf3\x4f\xa2\x88\x61\x03\x00\x6f\x58\xfe\x3a\x37\x21\x76\x42\x2c\x47\x67\xc0\xe1\xdd\xd9\x13\x04\xc9\x44\x61\xb4\x8e\x14\xbc\x79\x06\xfd\x20\x8a\x0e\x66\x73\x38\x78\xba\xfb\xb0\x13\xa2\x18\xc6\x2d\x07\x12\xfa\x25\xde\xa5\xc8\xc7\x71\x2b\x55\x0a\xd5\x67\xda\xb5\x3d\xbe\x1f\x9d\x91\x65\xe2\x5c\x56\xc0\x47\xcb\xbb\x5c\x72\x85\x71\xed\x5d\xc4\x6f\xc1\x12\x86\x0a\xc2\xd4\xe8\x15\xfe\x39\x62\x24\xc5\x6c\x8a\xbb\x3a\x85\xcf\x50\xb7\x18\x64\x8a\x2f\x48\xd3\x18\x3f\xfd\xae\x44\xb1\x7b\xed\xa8\xa4\xc0\xff\x39\xcb\x2e\x21\x0f\x62\x7d\xe1\x75\x7b\xce\x12\xca\x83\xae\x4e\x78\x0a\xe6\x73\x78\x9b\x5a\xfa\xfb\x9e\x58\x01\x6b\xf0\x8b\xd3\x1b\x6d\x7b\x7d\xd3\xa3\xe2\xfc\x80\x34\x06\x27\x8a\x62\xf7\x9f\x08\x75\x35\xdb\xc6\x82\x7f\xb7\xed\xe1\xb8\x6d\xc3\x0c\x96\x03\xd5\x8b\x75\xb0\x8e\x96\xb2\x9c\xfd\xd8\x96\x15\xb0\x6f\xaa\x82\x2c\x76\x98\xc1\x30\x6e\xd9\x9b\x7c\x26\x74\xec\x77\xd3\x40\x24\x1d\xe8\x71\x00\x79\xb2\xb2\x99\x80\xae\x85\xb5\xb7\x8e\x62\x0d\x18\x82\x0f\x11\x74\xe0\xb9\x73\x04\xde\xf1\x96\xc9\xe3\x22\xbb\xe7\x63\xa1\x32\xa4\xe4\x0c\x30\x9d\x76\xef\x0e\x39\xdb\x87\x24\xe5\xca\x67\xf5\xf7\xba\x62\x08\x69\x54\x98\x5a\x7d\x61\x23\xa1\x3b\x76\x6d\x82\x92\xea\x17\xae\x84\x1f\xe6\xe0\x6c\x0f\x07\x07\x8f\xab\x64\xd0\x69\x08\x29\x2b\x9c\xf4\x3e\x62\x9b\xf0\x8a\x74\x68\x3e\x30\xab\xba\x93\x6a\x3f\xd8\xaf\x74\x8a\x74\xc5\x1e\xca\xe4\x24\xdc\x78\xdf\xa7\xc6\x36\x3a\xc0\xe6\xf1\xaa\xd8\x65\xbe\x5c\x1c\x28\x58\xfe\x23\xbe\xc5\xc5\x34\x10\x0b\xf2\x01\x9f\x4f\x44\x05\x9b\x7f\x3c\x94\xfc\x5a\x2a\x6e\xd1\x07\xc6\x34\xb4\xad\xc0\x68\x67\xb0\x67\x25\xa6\x77\xb2\xfe\x66\xa9\x9b\xde\x2d\xf9\xb8\xf7\xab\x36\xb7\xab\xe0\x47\xd7\x4a\x55\xbd\x7c\xdd\x94\x28\x5a\x5c\x62\x98\xb0\xa4\x7a\x62\x76\x52\x77\x31\xe5\x4b\x43\x5b\xf5\x68\x7f\x7a\x6e\x4f\x32\x3e\x3f\x27\xb9\xb8\xc7\x96\x5d\x5e\x9c\x7f\x38\xbf\xbc\x06\x1f\x60\x71\xfe\xe1\xfa\xf4\xea\x63\xe6\xf4\xac\x48\x2a\xd8\xf7\x37\x6d\x55\x7b\x12\x27\x09\x8d\x6f\xba\xfa\x17\xaa\x39\xf3\x55\xa6\x4c\x24\xda\x95\xe1\x92\x41\xdf\xa2\xe4\xa9\x02\x1f\xeb\x45\xea\xa3\x82\x23\x95\x12\x9c\xee\xeb\x4b\x4f\x76\x79\x2f\x39\xbd\x82\xe9\x27\xa3\xce\x34\x9e\xad\x99\x8c\x7a\x39\x8e\x11\x7b\xcc\x6f\x65\x61\x74\x44\x78\x77\xc8\x38\x33\x1e\xac\xbd\xa6\xfb\x90\xa1\x6d\xfd\x9b\x77\x28\xd5\x6c\xba\xc0\x53\x0b\x0b\xf6\x97\x0b\xf9\xd6\x3e\x75\xe1\x09\x7b\xb1\x13\x7f\x0d\x00\xae\xfa\x37\xb6\x29\x07\x00\x00"
+
+func serviceCmdHealthGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_serviceCmdHealthGoTmplt,
+		"service/cmd/health.go.tmplt",
+	)
+}
+
+func serviceCmdHealthGoTmplt() (*asset, error) {
+	bytes, err := serviceCmdHealthGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "service/cmd/health.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _serviceCmdPortsGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcc\x31\x6e\x85\x30\x10\x04\xd0\x9a\x3d\xc5\x88\x22\x4a\x0a\x62\x3a\x68\x72\x87\x14\xb9\xc0\xca\xde\xc4\x16\xc6\x76\xec\x15\x11\x42\xdc\x3d\x82\xff\xcb\xdd\x99\x79\x85\xed\xc2\x3f\x82\x95\x43\x22\xb2\x39\x35\xc5\x2b\x1d\xc7\x80\xf0\x0d\xf9\xc5\xfb\x97\xac\x25\xb2\x0a\x7a\xaf\x5a\x86\x9c\xe2\xde\xe3\x3c\xa9\xbb\xce\xcf\x5c\x15\x1f\x98\xc7\x79\x84\x31\xb8\x5e\x28\xb9\xea\x0d\x48\x72\x57\x91\x3a\x63\xd0\xc4\xe6\xe4\xb8\xee\x77\xdc\x90\x13\x84\xad\x47\x93\xba\x05\x2b\xd4\x79\xe1\xa8\xfe\xe9\x4d\xe3\x74\x7b\x26\x86\x4d\xf0\x02\x53\x85\xdd\x8e\xd0\xf0\x17\xaa\x38\x68\xc6\x32\x37\x3c\x36\xb0\x5e\xec\x42\x6f\xf4\x3f\x00\x29\xf1\x5d\x5a\xcb\x00\x00\x00"
+
+func serviceCmdPortsGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_serviceCmdPortsGoTmplt,
+		"service/cmd/ports.go.tmplt",
+	)
+}
+
+func serviceCmdPortsGoTmplt() (*asset, error) {
+	bytes, err := serviceCmdPortsGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "service/cmd/ports.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _workerCmdRunGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x53\x4d\x6f\xdb\x38\x10\x3d\x8b\xbf\x62\x56\x40\x02\x2a\xb0\xe5\x60\xf7\xa6\xb5\x0f\x41\x3e\xd0\x00\x69\x50\x38\x4d\x2e\x45\x0f\x0c\x35\x92\x09\x53\xa4\x41\x52\xb2\x03\xc3\xff\xbd\x18\x4a\xb2\xdd\xb4\x87\xc4\xe2\x7c\xbc\xf7\xf8\x66\xb8\x11\x72\x2d\x6a\x84\x46\x28\xc3\x98\x6a\x36\xd6\x05\xe0\x2c\x49\x83\x6a\x30\x65\x2c\x49\x6b\x15\x56\xed\x7b\x2e\x6d\x33\xdb\xac\xeb\x19\x3a\x67\x9d\x4f\x7f\x4f\xb4\xae\x12\x1d\xce\xa4\x56\xb1\x65\xbf\x87\xfc\xab\x2d\x5b\x8d\xcf\xa2\x41\x38\x1c\x66\xca\x04\x74\x46\xe8\xd9\xd6\xba\x35\xba\x94\x65\x8c\x75\xc2\x11\x93\xb4\x4d\x23\x4c\xe9\x61\x01\x3f\x7e\x4a\xad\xf2\xdb\x3e\xb0\x67\x49\x42\x7f\x09\x61\x14\x00\x90\xba\xd6\xa4\x13\x8a\xbc\x7a\x51\x63\x01\x90\xfa\x20\x5c\x80\x01\x33\xa6\x6e\x64\x50\xd6\x14\xe0\x5a\xd3\x7f\xc6\xe8\x83\x16\xb5\x2f\x06\x7c\x3a\x44\xe0\x84\xd8\xee\x5a\x27\xa8\xee\x14\x3d\x11\x46\xd5\x9d\xd0\x3d\xeb\x19\x2f\x99\x03\xef\x18\xb6\x88\x06\xc2\xd6\x12\x9d\x07\x5b\x41\x58\x61\x94\x33\x76\xbc\x09\xdd\x52\xc7\x7f\xd7\x70\x05\xd4\x95\xbf\xa0\xb4\xa6\x1c\xd2\xf7\xa6\x7b\x13\xae\x80\xf4\xf1\xf9\xfb\xfd\xf2\xed\xe6\x69\xe8\x3b\xf4\x3f\x2b\x14\x3a\xac\xbe\x59\x17\x48\x5d\x8c\xc5\x0c\xfd\x3b\x90\x85\x55\x6b\xe4\xe9\xaa\x5c\xc2\x15\x5d\xe9\xd6\x9a\x80\xbb\x90\x41\x9c\x15\xec\x19\x4b\xb4\xad\x6b\x74\x13\x8a\x40\xb1\x80\x1a\xc3\xd2\xda\xf0\x14\xa3\x5c\x66\x2c\x51\x55\xcc\xfd\xb3\x00\xa3\x34\x90\x11\x0e\x43\xeb\x0c\x45\x89\x2c\x29\xb1\x42\x07\x3d\x4e\xfe\xa0\x5b\xbf\xe2\x19\x63\xc9\x68\x11\xa1\xca\xa3\x99\xfc\x64\x5d\x8f\x7d\x2c\x9b\x2f\xe0\xfa\x13\xbc\x75\x3e\xbf\x27\xa5\x15\xb5\x75\x42\xab\xf2\x54\x7f\xe1\xd3\xc9\xf1\x94\x91\x12\x96\xc8\xb0\x9b\x80\x14\x46\x62\xa4\xf5\xaa\x36\x42\x0f\x97\xe6\xd9\x28\xb5\x2f\x88\x22\xd1\x39\x49\x95\x8d\x58\x23\x97\x2b\x31\xd0\x4e\xe0\xdf\x8c\x0d\x26\x53\xda\xe0\xf6\x4b\x3c\xbc\xa0\xeb\xd0\x71\x99\xbf\x2a\x13\x78\xda\x57\x4c\xe9\x69\xa4\xd9\xb1\x23\x8f\xcb\xc7\x09\x9b\x38\xb6\x84\x40\xa3\x47\x97\x3f\xe3\x96\x8f\x9a\x27\x83\x67\x19\x4b\x6a\x0b\x34\x30\x9e\x45\x03\xa8\x11\xe6\x53\xd8\xe6\xcb\xd6\x70\x19\x76\x74\x3b\x7e\x06\x8f\x61\x89\xa2\xfc\xe0\xc1\xb5\x98\x8d\x23\xcc\x1f\x4d\x65\x2b\x1e\x9f\xd8\xf0\xb8\x06\x56\x88\x7a\xb0\xcc\x69\x21\x8c\x32\x35\x60\x87\xee\xe3\xb3\x83\x2c\xf1\xa8\x51\x06\xd2\x20\x85\x47\x98\x4f\x65\xd8\xe5\x77\xd6\x20\xcf\x8a\x21\x46\xab\xb0\x80\xf9\x94\x34\x16\x2c\xf9\xcb\x76\x8c\x72\xc6\xc1\x2d\x51\xa2\xea\xb0\x1c\x76\xae\x72\xb6\x19\x3e\xc9\x6f\x83\x1a\x2e\xba\x34\xee\x5f\x46\x0b\x4c\x83\xfc\xe3\xa2\x95\xd0\x1e\x49\xa1\xaa\xc0\x0f\x9b\x3a\xd6\x04\xbb\xe1\xd9\xff\xe0\xcf\x64\x5c\x5e\x12\x1c\x2c\x4e\xa2\xe2\x31\xd6\x10\x3e\x3b\x5f\xe1\x03\xfb\x35\x00\x5f\x2c\x0a\x96\xea\x04\x00\x00"
+
+func workerCmdRunGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_workerCmdRunGoTmplt,
+		"worker/cmd/run.go.tmplt",
+	)
+}
+
+func workerCmdRunGoTmplt() (*asset, error) {
+	bytes, err := workerCmdRunGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "worker/cmd/run.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _workerInternalWorkerWorkerGoTmplt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcd\x8a\xdb\x30\x10\xc7\xcf\x9a\xa7\x98\x1a\x5a\xec\x92\x2a\xf7\xb4\x39\xed\xf6\x50\x28\x3e\x6c\x0b\x3d\x16\x55\x1e\x7b\xc5\x2a\x92\x19\x8f\xe3\x2c\xc1\xef\x5e\x24\xdb\x9b\xa5\x1f\xa7\x10\x33\xf3\xff\xf8\x69\x7a\x63\x9f\x4c\x47\x38\x45\x7e\x22\x06\x70\xa7\x3e\xb2\x60\x09\xaa\xb0\x31\x08\x5d\xa4\x00\x55\x88\x3b\x51\x01\xa0\x8a\xce\xc9\xe3\xf8\x4b\xdb\x78\xda\xdb\x60\xc4\x9d\x69\xdf\x3f\x75\x7b\x1f\xbb\x02\x2a\x80\xfd\x1e\x7f\x64\x21\xe4\x31\x0c\x28\x8f\x8b\x30\xc6\x16\xaf\x57\xd4\xb5\x39\x11\xce\x33\xf6\xc4\x2e\x36\xce\x1a\xef\x9f\x41\x9e\x7b\xda\xb6\x06\xe1\xd1\x0a\x5e\x41\xb9\x20\xc4\x67\xe3\x31\x59\xeb\xfb\x91\x8d\xb8\x18\x40\xf9\xd8\x75\xc4\x88\xe8\x63\xa7\xbf\xe6\x3f\x30\x67\xe3\x9a\x26\xb4\x4c\x46\x68\x40\xb3\xf6\x41\x79\x34\xb2\x64\xa1\x33\xf1\x33\x6e\xb2\xd0\x8e\xc1\x62\x4d\x53\xf9\x6f\xa3\x1d\xfa\x57\x0e\x15\xbe\x5f\x03\xa6\x64\x2d\x7a\x3c\x1e\x31\x38\x9f\x82\x2a\xbf\xc3\x9f\x78\xcc\xd3\x35\x4d\x75\xec\xcb\x0a\xd4\x0c\xa0\x98\x64\xe4\x80\xef\x96\xd5\x34\xba\x79\x1d\x5e\x72\xec\x92\x40\xf6\x38\xa4\x4e\xbb\xb4\xb9\xd4\x79\x18\xc3\x1f\x10\xc5\x79\x8f\x56\x2e\xe8\x06\x6c\x62\x20\x8d\xc4\x1c\x79\x48\x74\x4d\x9a\x45\xc3\x94\x72\x74\xd4\xa0\x09\x4d\xc6\x1f\xe8\x92\x01\xa4\x25\x23\x42\xa7\x5e\xa8\x59\xda\x97\xd3\x56\xab\xc2\x87\x31\x94\x49\x7a\x7d\x73\x7d\xb7\xfc\x56\x8b\x05\x5e\x01\x94\x38\x9b\x88\x1e\x8e\x0b\xa9\x9a\xa6\xef\xf9\x4b\x39\xe9\xad\x4d\x05\xaa\xa1\x96\x18\x97\x59\xfd\x4d\x32\x0d\x50\x6d\xd6\x50\x89\x1d\x71\xd6\x98\x74\xcf\xd1\xd2\x30\x24\xdb\xea\x63\xf2\xc1\x37\x37\xa8\x6a\xd2\xb9\x08\xeb\xcf\x29\x40\x5b\x16\xa9\x43\x6b\x9c\xa7\x06\xdf\x9e\x8b\x5d\x5a\xa8\x40\x65\xd0\x6a\x20\x4f\xcb\xd9\x28\x6b\x06\xc2\x4f\x1f\xac\x5c\xf4\x7d\x0c\x54\x56\x07\x50\x6a\x7b\x8a\xe0\xfc\x6d\x64\xcd\x78\x97\x06\xe6\x1b\xf7\x35\x16\x36\x31\xdf\xd1\xe0\x42\xe7\x29\x13\x8c\xed\xcb\x5b\xfc\x0d\xf0\x55\x9b\xff\x43\xbc\xb5\xfa\x12\xda\xd8\x96\xc5\xba\xe5\x42\x57\x54\xb7\x8b\x09\xce\xc3\x0c\xbf\x07\x00\x55\xd0\x39\x7d\x9a\x03\x00\x00"
+
+func workerInternalWorkerWorkerGoTmpltBytes() ([]byte, error) {
+	return bindataRead(
+		_workerInternalWorkerWorkerGoTmplt,
+		"worker/internal/worker/worker.go.tmplt",
+	)
+}
+
+func workerInternalWorkerWorkerGoTmplt() (*asset, error) {
+	bytes, err := workerInternalWorkerWorkerGoTmpltBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "worker/internal/worker/worker.go.tmplt", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"cli/cmd/commands.go.tmplt":              cliCmdCommandsGoTmplt,
+	"common/.dockerignore.tmplt":             commonDockerignoreTmplt,
+	"common/.gitignore.tmplt":                commonGitignoreTmplt,
+	"common/Dockerfile.tmplt":                commonDockerfileTmplt,
+	"common/Makefile.tmplt":                  commonMakefileTmplt,
+	"common/README.md.tmplt":                 commonReadmeMdTmplt,
+	"common/cmd/main.go.tmplt":               commonCmdMainGoTmplt,
+	"common/go.mod.tmplt":                    commonGoModTmplt,
+	"http/cmd/handlers.go.tmplt":             httpCmdHandlersGoTmplt,
+	"http/cmd/server.go.tmplt":               httpCmdServerGoTmplt,
+	"http/cmd/{resource}_handler.go.tmplt":   httpCmdResource_handlerGoTmplt,
+	"service/cmd/health.go.tmplt":            serviceCmdHealthGoTmplt,
+	"service/cmd/ports.go.tmplt":             serviceCmdPortsGoTmplt,
+	"worker/cmd/run.go.tmplt":                workerCmdRunGoTmplt,
+	"worker/internal/worker/worker.go.tmplt": workerInternalWorkerWorkerGoTmplt,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = &bintree{nil, map[string]*bintree{
+	"cli": &bintree{nil, map[string]*bintree{
+		"cmd": &bintree{nil, map[string]*bintree{
+			"commands.go.tmplt": &bintree{cliCmdCommandsGoTmplt, map[string]*bintree{}},
+		}},
+	}},
+	"common": &bintree{nil, map[string]*bintree{
+		".dockerignore.tmplt": &bintree{commonDockerignoreTmplt, map[string]*bintree{}},
+		".gitignore.tmplt":    &bintree{commonGitignoreTmplt, map[string]*bintree{}},
+		"Dockerfile.tmplt":    &bintree{commonDockerfileTmplt, map[string]*bintree{}},
+		"Makefile.tmplt":      &bintree{commonMakefileTmplt, map[string]*bintree{}},
+		"README.md.tmplt":     &bintree{commonReadmeMdTmplt, map[string]*bintree{}},
+		"cmd": &bintree{nil, map[string]*bintree{
+			"main.go.tmplt": &bintree{commonCmdMainGoTmplt, map[string]*bintree{}},
+		}},
+		"go.mod.tmplt": &bintree{commonGoModTmplt, map[string]*bintree{}},
+	}},
+	"http": &bintree{nil, map[string]*bintree{
+		"cmd": &bintree{nil, map[string]*bintree{
+			"handlers.go.tmplt":           &bintree{httpCmdHandlersGoTmplt, map[string]*bintree{}},
+			"server.go.tmplt":             &bintree{httpCmdServerGoTmplt, map[string]*bintree{}},
+			"{resource}_handler.go.tmplt": &bintree{httpCmdResource_handlerGoTmplt, map[string]*bintree{}},
+		}},
+	}},
+	"service": &bintree{nil, map[string]*bintree{
+		"cmd": &bintree{nil, map[string]*bintree{
+			"health.go.tmplt": &bintree{serviceCmdHealthGoTmplt, map[string]*bintree{}},
+			"ports.go.tmplt":  &bintree{serviceCmdPortsGoTmplt, map[string]*bintree{}},
+		}},
+	}},
+	"worker": &bintree{nil, map[string]*bintree{
+		"cmd": &bintree{nil, map[string]*bintree{
+			"run.go.tmplt": &bintree{workerCmdRunGoTmplt, map[string]*bintree{}},
+		}},
+		"internal": &bintree{nil, map[string]*bintree{
+			"worker": &bintree{nil, map[string]*bintree{
+				"worker.go.tmplt": &bintree{workerInternalWorkerWorkerGoTmplt, map[string]*bintree{}},
+			}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
@@ -0,0 +1,145 @@
+package templates
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/cnative/servicebuilder/internal/builder"
+)
+
+// Default is the name of the template provider used when none is specified
+const Default = "grpc-gateway"
+
+type (
+	// Factory creates a template provider for the options
+	Factory func(o *builder.Options) (builder.TemplateProvider, error)
+
+	// Provider is a named template provider
+	Provider struct {
+		Name        string
+		Description string
+		New         Factory
+		// Features are the optional features the templates support. they are enabled in new projects
+		// unless they are turned off
+		Features builder.Features
+	}
+)
+
+var (
+	mu        sync.RWMutex
+	providers = map[string]Provider{}
+)
+
+// Register makes a template provider available by its name. It is usually called from the init function
+// of the package that implements the provider. Register panics if a provider with the same name exists
+func Register(p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if p.New == nil {
+		panic("templates: Register factory is nil for " + p.Name)
+	}
+	if _, dup := providers[p.Name]; dup {
+		panic("templates: Register called twice for " + p.Name)
+	}
+	providers[p.Name] = p
+}
+
+// Lookup returns the provider registered with name
+func Lookup(name string) (Provider, error) {
+	if name == "" {
+		name = Default
+	}
+
+	mu.RLock()
+	p, ok := providers[name]
+	mu.RUnlock()
+
+	if !ok {
+		return Provider{}, errors.Errorf("unknown template %q. possible values [%s]", name, strings.Join(Names(), ", "))
+	}
+
+	return p, nil
+}
+
+// New creates the template provider registered with the name set in the options. The template pack
+// in the template directory of the options is used instead when the directory is set
+func New(o *builder.Options) (builder.TemplateProvider, error) {
+	if o.TemplateDir != "" {
+		p, err := LoadPack(o.TemplateDir)
+		if err != nil {
+			return nil, err
+		}
+		o.Template = p.Name
+
+		return p.Provider(o)
+	}
+
+	p, err := Lookup(o.Template)
+	if err != nil {
+		return nil, err
+	}
+	o.Template = p.Name
+
+	if unsupported := p.unsupported(o.Features); len(unsupported) > 0 {
+		return nil, errors.Errorf("template %s does not support [%s]. turn them off with the --without-* flags or the features of the spec", p.Name, strings.Join(unsupported, ", "))
+	}
+
+	return p.New(o)
+}
+
+// DefaultFeatures are the features of a new project of the template name. all features are
+// enabled for unknown templates which includes the template packs
+func DefaultFeatures(name string) builder.Features {
+	p, err := Lookup(name)
+	if err != nil {
+		return builder.DefaultFeatures()
+	}
+
+	return p.Features
+}
+
+// unsupported returns the names of the features enabled in f that the provider does not support
+func (p Provider) unsupported(f builder.Features) []string {
+	unsupported := []string{}
+	if f.Postgres && !p.Features.Postgres {
+		unsupported = append(unsupported, "postgres")
+	}
+	if f.Gateway && !p.Features.Gateway {
+		unsupported = append(unsupported, "gateway")
+	}
+	if f.OIDC && !p.Features.OIDC {
+		unsupported = append(unsupported, "oidc")
+	}
+
+	return unsupported
+}
+
+// List returns the registered providers sorted by name
+func List() []Provider {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	l := make([]Provider, 0, len(providers))
+	for _, p := range providers {
+		l = append(l, p)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Name < l[j].Name
+	})
+
+	return l
+}
+
+// Names of the registered providers sorted by name
+func Names() []string {
+	names := []string{}
+	for _, p := range List() {
+		names = append(names, p.Name)
+	}
+
+	return names
+}
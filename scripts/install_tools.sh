@@ -6,7 +6,7 @@ set -eu
 DIR=$(dirname "$0")
 ROOTDIR=$(cd "$DIR/../" && pwd )
 GORELEASER_VERSION=0.141.0
-GOLANGCI_LINT_VERSION=1.23.8
+GOLANGCI_LINT_VERSION=1.56.2
 
 os=$(uname -s)
 case "$os" in